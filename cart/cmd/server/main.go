@@ -3,7 +3,9 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
@@ -26,11 +28,18 @@ func main() {
 	}
 
 	go func() {
-		if err := app.ListenAndServe(); err != nil {
+		// после Shutdown Serve сразу возвращает http.ErrServerClosed, не дожидаясь текущих запросов
+		if err := app.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			logger.Fatalw(fmt.Sprintf("ListenAndServe : %v", err))
 		}
 	}()
 
+	go func() {
+		if err := app.ListenAndServeGRPC(); err != nil {
+			logger.Fatalw(fmt.Sprintf("ListenAndServeGRPC : %v", err))
+		}
+	}()
+
 	stop := make(chan os.Signal, 1)
 	signal.Notify(stop, syscall.SIGINT, syscall.SIGTERM)
 
@@ -0,0 +1,80 @@
+// Package abandoned ...
+package abandoned
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultInterval ...
+	DefaultInterval = time.Minute
+	// DefaultBatchSize ...
+	DefaultBatchSize = 100
+)
+
+// Service ...
+type Service interface {
+	ReportAbandonedCarts(ctx context.Context, threshold time.Duration, limit int)
+}
+
+// Config нулевые Interval и BatchSize заменяются значениями по умолчанию
+type Config struct {
+	// Threshold через сколько после последнего изменения корзина считается брошенной
+	Threshold time.Duration
+	// Interval как часто искать брошенные корзины
+	Interval time.Duration
+	// BatchSize сколько корзин обрабатывается за один проход
+	BatchSize int
+}
+
+// Worker периодически отправляет события о брошенных корзинах
+type Worker struct {
+	ctx       context.Context
+	cancel    context.CancelFunc
+	waitGroup sync.WaitGroup
+	cfg       Config
+}
+
+// NewWorker ...
+func NewWorker(ctx context.Context, cfg Config) *Worker {
+	if cfg.Interval <= 0 {
+		cfg.Interval = DefaultInterval
+	}
+	if cfg.BatchSize < 1 {
+		cfg.BatchSize = DefaultBatchSize
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	return &Worker{
+		ctx:    ctx,
+		cancel: cancel,
+		cfg:    cfg,
+	}
+}
+
+// Start ...
+func (w *Worker) Start(service Service) {
+	w.waitGroup.Add(1)
+	go func() {
+		defer w.waitGroup.Done()
+		ticker := time.NewTicker(w.cfg.Interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				service.ReportAbandonedCarts(w.ctx, w.cfg.Threshold, w.cfg.BatchSize)
+			case <-w.ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// Stop дожидается завершения текущего прохода
+func (w *Worker) Stop() {
+	w.cancel()
+	w.waitGroup.Wait()
+}
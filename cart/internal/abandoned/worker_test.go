@@ -0,0 +1,43 @@
+package abandoned
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type serviceStub struct {
+	calls     int64
+	threshold time.Duration
+	limit     int
+}
+
+func (s *serviceStub) ReportAbandonedCarts(_ context.Context, threshold time.Duration, limit int) {
+	s.threshold, s.limit = threshold, limit
+	atomic.AddInt64(&s.calls, 1)
+}
+
+func TestWorker(t *testing.T) {
+	t.Parallel()
+
+	// Setup
+	service := &serviceStub{}
+	w := NewWorker(context.Background(), Config{Threshold: time.Hour, Interval: time.Millisecond})
+
+	// Execute
+	w.Start(service)
+	assert.Eventually(t, func() bool {
+		return atomic.LoadInt64(&service.calls) >= 2
+	}, time.Second, time.Millisecond)
+	w.Stop()
+
+	// Verify
+	calls := atomic.LoadInt64(&service.calls)
+	time.Sleep(5 * time.Millisecond)
+	assert.Equal(t, calls, atomic.LoadInt64(&service.calls))
+	assert.Equal(t, time.Hour, service.threshold)
+	assert.Equal(t, DefaultBatchSize, service.limit)
+}
@@ -3,46 +3,77 @@ package app
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net"
 	"net/http"
+	"os"
 
 	"time"
 
+	"github.com/IBM/sarama"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/abandoned"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/app/grpcserver"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/app/server"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/client/loms"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/promo"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/repository"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/repository/postgres"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/repository/postgres/connect"
+	redisrepo "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/repository/redis"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/auth"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/breaker"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/config"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/idempotency"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/kafka/producer"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/health"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/tracer"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
+	goredis "github.com/redis/go-redis/v9"
 	"golang.org/x/time/rate"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
 
 	product_service "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/product-service"
+	productcache "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/product-service/cache"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/http/middlewares"
 	retryclient "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/http/retry_client"
 	rt "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/http/round_trippers"
 
+	pbCart "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
 	pbLoms "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/loms/api/v1"
 )
 
 const (
 	// ServiceName ...
 	ServiceName = "cart"
+
+	// Значения по умолчанию для незаданных в конфиге таймаутов http сервера
+	defaultReadHeaderTimeout = 5 * time.Second
+	defaultReadTimeout       = 10 * time.Second
+	defaultWriteTimeout      = 30 * time.Second
+	defaultIdleTimeout       = 60 * time.Second
+	defaultShutdownTimeout   = 15 * time.Second
 )
 
 // App ...
 type App struct {
-	config   *config.Config
-	server   http.Server
-	service  *service.Service
-	connLoms *grpc.ClientConn
-	tracer   *tracer.TManager
+	config    *config.Config
+	server    http.Server
+	grpc      *grpc.Server
+	service   *service.Service
+	connLoms  *grpc.ClientConn
+	idemp     *idempotency.Store
+	products  *productcache.ProductCache
+	events    *producer.Producer
+	abandoned *abandoned.Worker
+	limiter   *middlewares.RateLimiter
+	tracer    *tracer.TManager
 }
 
 // NewApp ...
@@ -58,6 +89,11 @@ func NewApp(ctx context.Context) (*App, error) {
 
 	app := &App{config: c}
 
+	app.server.ReadHeaderTimeout = orDefault(c.Server.ReadHeaderTimeout, defaultReadHeaderTimeout)
+	app.server.ReadTimeout = orDefault(c.Server.ReadTimeout, defaultReadTimeout)
+	app.server.WriteTimeout = orDefault(c.Server.WriteTimeout, defaultWriteTimeout)
+	app.server.IdleTimeout = orDefault(c.Server.IdleTimeout, defaultIdleTimeout)
+
 	app.server.Handler, err = app.bootstrapHandlers(ctx)
 	if err != nil {
 		return nil, err
@@ -66,6 +102,20 @@ func NewApp(ctx context.Context) (*App, error) {
 	return app, nil
 }
 
+// ListenAndServeGRPC ...
+func (app *App) ListenAndServeGRPC() error {
+	address := fmt.Sprintf("%s:%s", app.config.Server.Host, app.config.Server.GRPCPort)
+
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		return err
+	}
+
+	logger.Infow(fmt.Sprintf("grpc bootstrap : %s", address))
+
+	return app.grpc.Serve(l)
+}
+
 // ListenAndServe ...
 func (app *App) ListenAndServe() error {
 	address := fmt.Sprintf("%s:%s", app.config.Server.Host, app.config.Server.Port)
@@ -93,61 +143,221 @@ func (app *App) bootstrapHandlers(ctx context.Context) (http.Handler, error) {
 		Transport: transport,
 		Timeout:   10 * time.Second,
 	}
+	psRetry := app.config.ProductService.Retry
 	psClientConfig := retryclient.NewRetryClient(
 		&httpClient,
-		3,
-		5*time.Second,
+		retryclient.Policy{
+			MaxAttempts: psRetry.MaxAttempts,
+			BaseDelay:   psRetry.BaseDelay,
+			MaxDelay:    psRetry.MaxDelay,
+			Jitter:      psRetry.Jitter,
+		},
 	)
+	limiterPS := rate.NewLimiter(rate.Limit(app.config.ProductService.Limit), app.config.ProductService.Burst)
 	productService := product_service.NewProductService(
 		*psClientConfig,
+		limiterPS,
 		app.config.ProductService.Token,
 		fmt.Sprintf("%s:%s", app.config.ProductService.Host, app.config.ProductService.Port),
 	)
 
-	repo := repository.NewInMemoryRepository(t.Tracer)
+	repo, err := initRepository(ctx, app.config, t.Tracer)
+	if err != nil {
+		return nil, fmt.Errorf("initRepository : %w", err)
+	}
+
 	clientLoms, err := initClientLoms(app)
 	if err != nil {
 		return nil, fmt.Errorf("initClientLoms : %v", err)
 	}
 
-	limiterPS := rate.NewLimiter(rate.Limit(app.config.ProductService.Limit), app.config.ProductService.Burst)
+	app.products = productcache.NewProductCache(
+		product_service.NewWithBreaker(
+			productService,
+			newBreaker("product-service", app.config.ProductService.CircuitBreaker, product_service.IsFailure),
+		),
+		app.config.ProductService.CacheTTL,
+		app.config.ProductService.CacheNegativeTTL,
+		app.config.ProductService.CacheStaleTTL,
+	)
+
+	limits := model.CartLimits{
+		MaxSkuCount: app.config.Limits.MaxSkuCount,
+		MaxLines:    app.config.Limits.MaxLines,
+		MaxTotal:    app.config.Limits.MaxTotal,
+	}
+
+	promoEngine, err := promo.LoadRules(app.config.Promo.RulesFile)
+	if err != nil {
+		return nil, fmt.Errorf("promo.LoadRules: %w", err)
+	}
+
+	var events service.EventProducer
+	if app.config.Kafka.Brokers != "" {
+		syncProducer, err := initKafkaProducer(app.config.Kafka.Brokers)
+		if err != nil {
+			return nil, fmt.Errorf("initKafkaProducer: %w", err)
+		}
+		app.events = producer.NewProducer(
+			syncProducer,
+			app.config.Kafka.TopicName,
+			app.config.Kafka.AbandonedTopic,
+			app.config.Kafka.BufferSize,
+		)
+		events = app.events
+		logger.Infow(fmt.Sprintf("cart events : %s, topic %s", app.config.Kafka.Brokers, app.config.Kafka.TopicName))
+	}
 
-	service := service.NewService(productService, repo, clientLoms, limiterPS, t.Tracer)
+	app.service = service.NewService(
+		app.products,
+		repo,
+		clientLoms,
+		t.Tracer,
+		limits,
+		promoEngine,
+		events,
+	)
+
+	if app.events != nil && app.config.AbandonedCarts.Threshold > 0 {
+		app.abandoned = abandoned.NewWorker(ctx, abandoned.Config{
+			Threshold: app.config.AbandonedCarts.Threshold,
+			Interval:  app.config.AbandonedCarts.Interval,
+			BatchSize: app.config.AbandonedCarts.BatchSize,
+		})
+		app.abandoned.Start(app.service)
+		logger.Infow(fmt.Sprintf("abandoned carts : threshold %s, topic %s", app.config.AbandonedCarts.Threshold, app.config.Kafka.AbandonedTopic))
+	}
+
+	app.idemp = idempotency.NewStore(app.config.Checkout.IdempotencyTTL, repo)
+
+	s := server.NewServer(app.service, t.Tracer, app.idemp)
+
+	verifier, err := initAuth(app.config)
+	if err != nil {
+		return nil, fmt.Errorf("initAuth: %w", err)
+	}
 
-	s := server.NewServer(service, t.Tracer)
+	app.limiter = initRateLimiter(app.config)
+
+	// методы gRPC делят лимиты с HTTP ручками тех же операций
+	grpcRoutes := map[string]string{
+		model.AddItemGRPC:    model.AddItemURL,
+		model.DeleteItemGRPC: model.DeleteItemURL,
+		model.ClearCartGRPC:  model.DeleteItemsByUserIDURL,
+		model.ListCartGRPC:   model.GetItemsByUserIDURL,
+		model.CheckoutGRPC:   model.OrderFullCartURL,
+	}
+	interceptors := []grpc.UnaryServerInterceptor{app.limiter.Unary(grpcRoutes), middlewares.Validate}
+	if verifier != nil {
+		interceptors = append([]grpc.UnaryServerInterceptor{middlewares.Auth(verifier)}, interceptors...)
+	}
+
+	app.grpc = grpc.NewServer(
+		grpc.ChainUnaryInterceptor(interceptors...),
+	)
+	reflection.Register(app.grpc)
+	pbCart.RegisterCartServer(app.grpc, grpcserver.NewServer(app.service, t.Tracer, app.idemp))
+
+	// route ручки корзины: лимит запросов клиента, затем проверка доступа к корзине user_id
+	route := func(pattern string, h http.HandlerFunc) (string, http.HandlerFunc) {
+		return pattern, app.limiter.Limit(pattern, middlewares.RequireUser(h))
+	}
+
+	checker := health.NewChecker(health.DefaultTimeout)
+	checker.Add("loms", health.GRPCConn(app.connLoms))
 
 	mx := http.NewServeMux()
-	mx.HandleFunc(model.AddItemURL, s.AddItem)
-	mx.HandleFunc(model.DeleteItemURL, s.DeleteItem)
-	mx.HandleFunc(model.DeleteItemsByUserIDURL, s.DeleteItemsByUserID)
-	mx.HandleFunc(model.GetItemsByUserIDURL, s.GetItemsByUserID)
-	mx.HandleFunc(model.OrderFullCartURL, s.OrderFullCart)
+	mx.HandleFunc(route(model.AddItemURL, s.AddItem))
+	mx.HandleFunc(route(model.SetItemCountURL, s.SetItemCount))
+	mx.HandleFunc(route(model.DeleteItemURL, s.DeleteItem))
+	mx.HandleFunc(route(model.DeleteItemsByUserIDURL, s.DeleteItemsByUserID))
+	mx.HandleFunc(route(model.MergeCartURL, s.MergeCart))
+	mx.HandleFunc(route(model.ApplyPromoCodeURL, s.ApplyPromoCode))
+	mx.HandleFunc(route(model.RemovePromoCodeURL, s.RemovePromoCode))
+	mx.HandleFunc(route(model.SaveForLaterURL, s.SaveForLater))
+	mx.HandleFunc(route(model.MoveToCartURL, s.MoveToCart))
+	mx.HandleFunc(route(model.DeleteSavedItemURL, s.DeleteSavedItem))
+	mx.HandleFunc(route(model.GetSavedItemsURL, s.GetSavedItems))
+	mx.HandleFunc(route(model.GetItemsByUserIDURL, s.GetItemsByUserID))
+	mx.HandleFunc(route(model.OrderFullCartURL, s.OrderFullCart))
 	mx.Handle(model.GetMetricsURL, promhttp.Handler())
-	mx.HandleFunc(model.DebugPprof, pprofhandler)
+	mx.HandleFunc(health.LivenessURL, checker.LivenessHandler)
+	mx.HandleFunc(health.ReadinessURL, checker.ReadinessHandler)
+	mx.HandleFunc(model.DebugPprof, middlewares.RequireAdmin(pprofhandler))
 
-	h := middlewares.NewTimerMiddleware(mx)
+	var h http.Handler = mx
+	if verifier != nil {
+		h = middlewares.NewAuthMiddleware(h, verifier)
+	}
+	h = middlewares.NewBodyLimitMiddleware(h, app.config.Server.MaxBodyBytes)
+	h = middlewares.NewRecoveryMiddleware(h)
+	h = middlewares.NewAccessLogMiddleware(h, t.Tracer, middlewares.AccessLogConfig{
+		SampleRate:    app.config.AccessLog.SampleRate,
+		RedactHeaders: app.config.AccessLog.RedactHeaders,
+	})
+	h = middlewares.NewTimerMiddleware(h)
 
 	return h, nil
 }
 
 // Close ...
 func (app *App) Close(ctx context.Context) error {
+	// сначала серверы перестают принимать запросы и дожидаются текущих, пока их зависимости еще открыты
+	drainCtx, cancel := context.WithTimeout(ctx, orDefault(app.config.Server.ShutdownTimeout, defaultShutdownTimeout))
+	defer cancel()
+
+	grpcStopped := make(chan struct{})
+	go func() {
+		app.grpc.GracefulStop()
+		close(grpcStopped)
+	}()
+
+	err := app.server.Shutdown(drainCtx)
+	if err != nil {
+		logger.Errorw(fmt.Sprintf("http server Shutdown: %v", err))
+	} else {
+		logger.Infow("http server stopped")
+	}
+
+	select {
+	case <-grpcStopped:
+	case <-drainCtx.Done():
+		// не дождались текущих запросов, соединения закрываются принудительно
+		app.grpc.Stop()
+		<-grpcStopped
+	}
+	logger.Infow("grpc server stopped")
+
+	if app.abandoned != nil {
+		app.abandoned.Stop()
+		logger.Infow("abandoned carts worker stopped")
+	}
+	if app.events != nil {
+		if err := app.events.Close(); err != nil {
+			logger.Errorw(fmt.Sprintf("events producer Close: %v", err))
+		}
+		logger.Infow("events producer closed")
+	}
 	//nolint:errcheck, gosec
 	app.connLoms.Close()
 	logger.Infow("connect loms closed")
 	app.service.Repository.Close()
 	logger.Infow("connect repo closed")
+	app.idemp.Close()
+	logger.Infow("idempotency store closed")
+	app.limiter.Close()
+	logger.Infow("rate limiter closed")
+	app.products.Close()
+	logger.Infow("product cache closed")
 	//nolint:errcheck, gosec
 	app.tracer.TracerProvider.Shutdown(ctx)
 	logger.Infow("tracer Shutdown")
-	ctx, cancel := context.WithTimeout(ctx, 5*time.Second)
-	defer cancel()
-	return app.server.Shutdown(ctx)
 
+	return err
 }
 
 // lsof -iTCP:50051 -sTCP:LISTEN
-func initClientLoms(app *App) (*loms.Client, error) {
+func initClientLoms(app *App) (*loms.WithBreaker, error) {
 	config := app.config
 	url := fmt.Sprintf("%s:%s", config.LomsService.Host, config.LomsService.Port)
 	conn, err := grpc.NewClient(
@@ -161,11 +371,137 @@ func initClientLoms(app *App) (*loms.Client, error) {
 
 	app.connLoms = conn
 
-	cliLoms := loms.NewLomsCliemt(pbLoms.NewLomsClient(conn))
+	cliLoms := loms.NewWithBreaker(
+		loms.NewLomsCliemt(pbLoms.NewLomsClient(conn)),
+		newBreaker("loms", config.LomsService.CircuitBreaker, loms.IsFailure),
+	)
 	logger.Infow(fmt.Sprintf("Loms service at : %s", url))
 	return cliLoms, nil
 }
 
+// initKafkaProducer ...
+func initKafkaProducer(broker string) (sarama.SyncProducer, error) {
+	config := sarama.NewConfig()
+	config.Producer.Return.Successes = true
+	config.Producer.Return.Errors = true
+	config.Producer.Partitioner = sarama.NewHashPartitioner
+	config.Producer.RequiredAcks = sarama.WaitForAll
+	syncProducer, err := sarama.NewSyncProducer([]string{broker}, config)
+	if err != nil {
+		return nil, err
+	}
+
+	return syncProducer, nil
+}
+
+// initAuth nil возвращается только при явном auth.enabled: false, без ключей сервис не запускается
+func initAuth(cfg *config.Config) (*auth.Verifier, error) {
+	if cfg.Auth.Enabled != nil && !*cfg.Auth.Enabled {
+		logger.Infow("auth disabled by config: cart endpoints are not protected")
+		return nil, nil
+	}
+
+	authCfg := auth.Config{
+		HS256Secret: cfg.Auth.HS256Secret,
+		Issuer:      cfg.Auth.Issuer,
+		Audience:    cfg.Auth.Audience,
+		AdminScope:  cfg.Auth.AdminScope,
+	}
+
+	if cfg.Auth.RS256PublicKeyFile != "" {
+		key, err := os.ReadFile(cfg.Auth.RS256PublicKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("ReadFile: %w", err)
+		}
+		authCfg.RS256PublicKey = key
+	}
+
+	verifier, err := auth.NewVerifier(authCfg)
+	if err != nil {
+		if errors.Is(err, auth.ErrNoKeys) {
+			return nil, fmt.Errorf("%w: set auth.hs256_secret or auth.rs256_public_key_file, or auth.enabled: false", err)
+		}
+		return nil, err
+	}
+
+	return verifier, nil
+}
+
+// initRateLimiter ...
+func initRateLimiter(cfg *config.Config) *middlewares.RateLimiter {
+	routes := make(map[string]middlewares.RateLimit, len(cfg.RateLimits.Routes))
+	for pattern, limit := range cfg.RateLimits.Routes {
+		routes[pattern] = middlewares.RateLimit{RPS: limit.RPS, Burst: limit.Burst}
+	}
+
+	return middlewares.NewRateLimiter(middlewares.RateLimiterConfig{
+		Default: middlewares.RateLimit{
+			RPS:   cfg.RateLimits.Default.RPS,
+			Burst: cfg.RateLimits.Default.Burst,
+		},
+		Routes:  routes,
+		IdleTTL: cfg.RateLimits.IdleTTL,
+	})
+}
+
+// orDefault ...
+func orDefault(value, def time.Duration) time.Duration {
+	if value <= 0 {
+		return def
+	}
+
+	return value
+}
+
+// newBreaker ...
+func newBreaker(name string, cfg config.CircuitBreaker, isFailure func(err error) bool) *breaker.Breaker {
+	return breaker.New(name, breaker.Config{
+		FailureThreshold: cfg.FailureThreshold,
+		OpenTimeout:      cfg.OpenTimeout,
+		HalfOpenRequests: cfg.HalfOpenRequests,
+	}, isFailure)
+}
+
+// initRepository выбирает хранилище корзин по storage.type из конфига
+func initRepository(ctx context.Context, cfg *config.Config, tracer service.Tracer) (service.Repository, error) {
+	switch cfg.Storage.Type {
+	case config.StoragePostgres:
+		dsn := fmt.Sprintf("postgresql://%s:%s@%s:%s/%s?sslmode=disable",
+			cfg.Storage.Postgres.User,
+			cfg.Storage.Postgres.Password,
+			cfg.Storage.Postgres.Host,
+			cfg.Storage.Postgres.Port,
+			cfg.Storage.Postgres.DBName,
+		)
+
+		pool, err := connect.NewPool(ctx, dsn)
+		if err != nil {
+			return nil, fmt.Errorf("NewPool: %w", err)
+		}
+
+		logger.Infow(fmt.Sprintf("cart storage : postgres %s:%s", cfg.Storage.Postgres.Host, cfg.Storage.Postgres.Port))
+		return postgres.NewRepository(pool, tracer), nil
+	case config.StorageRedis:
+		client := goredis.NewClient(&goredis.Options{
+			Addr:     fmt.Sprintf("%s:%s", cfg.Storage.Redis.Host, cfg.Storage.Redis.Port),
+			Password: cfg.Storage.Redis.Password,
+			DB:       cfg.Storage.Redis.DB,
+		})
+
+		if err := client.Ping(ctx).Err(); err != nil {
+			return nil, fmt.Errorf("redis Ping: %w", err)
+		}
+
+		logger.Infow(fmt.Sprintf("cart storage : redis %s:%s, ttl %s", cfg.Storage.Redis.Host, cfg.Storage.Redis.Port, cfg.Storage.Redis.TTL))
+		return redisrepo.NewRepository(client, cfg.Storage.Redis.TTL, tracer), nil
+	case config.StorageMemory, "":
+		logger.Infow("cart storage : memory")
+		return repository.NewInMemoryRepository(tracer), nil
+	default:
+		return nil, fmt.Errorf("unknown storage type: %s", cfg.Storage.Type)
+	}
+}
+
 // pprofhandler ...
 func pprofhandler(w http.ResponseWriter, r *http.Request) {
 	http.DefaultServeMux.ServeHTTP(w, r)
@@ -0,0 +1,35 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// AddItem ...
+func (s *Server) AddItem(ctx context.Context, in *pb.AddItemRequest) (*pb.AddItemResponse, error) {
+	ctx, span := s.tracer.Start(
+		ctx,
+		model.AddItemGRPC,
+		trace.WithAttributes(
+			attribute.Int64("UserID", in.GetUserId()),
+			attribute.Int64("Sku", in.GetSku()),
+			attribute.Int64("Count", int64(in.GetCount())),
+		),
+	)
+	defer span.End()
+
+	err := s.cartService.AddItem(ctx, model.RequestData{
+		UserID: in.GetUserId(),
+		Sku:    in.GetSku(),
+		Count:  in.GetCount(),
+	})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.AddItemResponse{}, nil
+}
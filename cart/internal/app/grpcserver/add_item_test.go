@@ -0,0 +1,83 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_AddItem(t *testing.T) {
+	in := &pb.AddItemRequest{
+		// nolint:gosec
+		UserId: rand.Int63(),
+		Sku:    1076963,
+		Count:  2,
+	}
+	data := model.RequestData{
+		UserID: in.UserId,
+		Sku:    in.Sku,
+		Count:  in.Count,
+	}
+
+	tests := []struct {
+		name         string
+		serviceErr   error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "success",
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "err product not found",
+			serviceErr:   model.ErrProductNotFound,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "err more than in stock",
+			serviceErr:   model.ErrAddedMoreItemThanInStock,
+			expectedCode: codes.FailedPrecondition,
+		},
+		{
+			name:         "err service unavailable",
+			serviceErr:   model.ErrServiceUnavailable,
+			expectedCode: codes.Unavailable,
+		},
+		{
+			name:         "err internal",
+			serviceErr:   errors.New("test"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.tracer.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tc.mock.AddItemMock.
+				Expect(minimock.AnyContext, data).
+				Return(tt.serviceErr)
+
+			// Execute
+			resp, err := tc.server.AddItem(context.Background(), in)
+
+			// Verify
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				require.NotNil(t, resp)
+			}
+		})
+	}
+}
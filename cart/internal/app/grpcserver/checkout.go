@@ -0,0 +1,43 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/app/server"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Checkout повторный запрос с тем же idempotency_key возвращает уже созданный заказ, если корзина с тех пор не менялась
+func (s *Server) Checkout(ctx context.Context, in *pb.CheckoutRequest) (*pb.CheckoutResponse, error) {
+	ctx, span := s.tracer.Start(
+		ctx,
+		model.CheckoutGRPC,
+		trace.WithAttributes(
+			attribute.Int64("UserID", in.GetUserId()),
+		),
+	)
+	defer span.End()
+
+	data := model.RequestData{UserID: in.GetUserId()}
+
+	var (
+		orderID int64
+		err     error
+	)
+
+	if key := in.GetIdempotencyKey(); key == "" || s.idempotency == nil {
+		orderID, err = server.Checkout(ctx, s.cartService, data)
+	} else {
+		orderID, err = s.idempotency.Do(ctx, data.UserID, key, server.CartFingerprint(s.cartService, data.UserID), func() (int64, error) {
+			return server.Checkout(ctx, s.cartService, data)
+		})
+	}
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return &pb.CheckoutResponse{OrderId: orderID}, nil
+}
@@ -0,0 +1,89 @@
+package grpcserver
+
+import (
+	"context"
+	"math/rand"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/idempotency"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_Checkout(t *testing.T) {
+	// nolint:gosec
+	userID := rand.Int63()
+	data := model.RequestData{UserID: userID}
+	items := &model.GetItemsFromCartResponce{
+		Items:      []model.Item{{Sku: 1, Name: "item", Count: 1, Price: 100}},
+		TotalPrice: 100,
+	}
+
+	t.Run("success", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, data).
+			Return(items, nil)
+		tc.mock.OrderCreateMock.
+			Expect(minimock.AnyContext, userID, items).
+			Return(42, nil)
+		tc.mock.ClaimCartMock.
+			Expect(minimock.AnyContext, userID, items, 42).
+			Return(nil)
+
+		resp, err := tc.server.Checkout(context.Background(), &pb.CheckoutRequest{UserId: userID})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), resp.GetOrderId())
+	})
+
+	t.Run("success with idempotency key", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.idempotency.DoMock.
+			Set(func(_ context.Context, gotUserID int64, key string, _ idempotency.Fingerprint, _ func() (int64, error)) (int64, error) {
+				assert.Equal(t, userID, gotUserID)
+				assert.Equal(t, "key", key)
+				return 42, nil
+			})
+
+		resp, err := tc.server.Checkout(context.Background(), &pb.CheckoutRequest{UserId: userID, IdempotencyKey: "key"})
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), resp.GetOrderId())
+	})
+
+	t.Run("err cart empty", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, data).
+			Return(&model.GetItemsFromCartResponce{Items: []model.Item{}}, nil)
+
+		_, err := tc.server.Checkout(context.Background(), &pb.CheckoutRequest{UserId: userID})
+		assert.Equal(t, codes.NotFound, status.Code(err))
+	})
+
+	t.Run("err insufficient stock", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, data).
+			Return(items, nil)
+		tc.mock.OrderCreateMock.
+			Expect(minimock.AnyContext, userID, items).
+			Return(0, &model.InsufficientStockError{Items: []model.StockShortage{{Sku: 1, Requested: 1}}})
+
+		_, err := tc.server.Checkout(context.Background(), &pb.CheckoutRequest{UserId: userID})
+		assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+	})
+}
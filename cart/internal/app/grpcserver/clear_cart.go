@@ -0,0 +1,30 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ClearCart ...
+func (s *Server) ClearCart(ctx context.Context, in *pb.ClearCartRequest) (*pb.ClearCartResponse, error) {
+	ctx, span := s.tracer.Start(
+		ctx,
+		model.ClearCartGRPC,
+		trace.WithAttributes(
+			attribute.Int64("UserID", in.GetUserId()),
+		),
+	)
+	defer span.End()
+
+	err := s.cartService.DeleteItemsByUserID(ctx, model.RequestData{UserID: in.GetUserId()})
+	if err != nil && !errors.Is(err, model.ErrNoContent) {
+		return nil, toStatus(err)
+	}
+
+	return &pb.ClearCartResponse{}, nil
+}
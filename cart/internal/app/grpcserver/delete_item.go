@@ -0,0 +1,34 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeleteItem ...
+func (s *Server) DeleteItem(ctx context.Context, in *pb.DeleteItemRequest) (*pb.DeleteItemResponse, error) {
+	ctx, span := s.tracer.Start(
+		ctx,
+		model.DeleteItemGRPC,
+		trace.WithAttributes(
+			attribute.Int64("UserID", in.GetUserId()),
+			attribute.Int64("Sku", in.GetSku()),
+		),
+	)
+	defer span.End()
+
+	err := s.cartService.DeleteItem(ctx, model.RequestData{
+		UserID: in.GetUserId(),
+		Sku:    in.GetSku(),
+	})
+	if err != nil && !errors.Is(err, model.ErrNoContent) {
+		return nil, toStatus(err)
+	}
+
+	return &pb.DeleteItemResponse{}, nil
+}
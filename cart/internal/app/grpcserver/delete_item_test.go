@@ -0,0 +1,101 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServer_DeleteItem(t *testing.T) {
+	in := &pb.DeleteItemRequest{
+		// nolint:gosec
+		UserId: rand.Int63(),
+		Sku:    1076963,
+	}
+
+	tests := []struct {
+		name         string
+		serviceErr   error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "success",
+			serviceErr:   model.ErrNoContent,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "err internal",
+			serviceErr:   errors.New("test"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.tracer.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tc.mock.DeleteItemMock.
+				Expect(minimock.AnyContext, model.RequestData{UserID: in.UserId, Sku: in.Sku}).
+				Return(tt.serviceErr)
+
+			// Execute
+			_, err := tc.server.DeleteItem(context.Background(), in)
+
+			// Verify
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
+
+func TestServer_ClearCart(t *testing.T) {
+	in := &pb.ClearCartRequest{
+		// nolint:gosec
+		UserId: rand.Int63(),
+	}
+
+	tests := []struct {
+		name         string
+		serviceErr   error
+		expectedCode codes.Code
+	}{
+		{
+			name:         "success",
+			serviceErr:   model.ErrNoContent,
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "err internal",
+			serviceErr:   errors.New("test"),
+			expectedCode: codes.Internal,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.tracer.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tc.mock.DeleteItemsByUserIDMock.
+				Expect(minimock.AnyContext, model.RequestData{UserID: in.UserId}).
+				Return(tt.serviceErr)
+
+			// Execute
+			_, err := tc.server.ClearCart(context.Background(), in)
+
+			// Verify
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package grpcserver
+
+import (
+	"context"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ListCart ...
+func (s *Server) ListCart(ctx context.Context, in *pb.ListCartRequest) (*pb.ListCartResponse, error) {
+	ctx, span := s.tracer.Start(
+		ctx,
+		model.ListCartGRPC,
+		trace.WithAttributes(
+			attribute.Int64("UserID", in.GetUserId()),
+		),
+	)
+	defer span.End()
+
+	resp, err := s.cartService.GetItemsFromCart(ctx, model.RequestData{UserID: in.GetUserId()})
+	if err != nil {
+		return nil, toStatus(err)
+	}
+
+	return convertToListCartResponse(resp), nil
+}
+
+func convertToListCartResponse(resp *model.GetItemsFromCartResponce) *pb.ListCartResponse {
+	items := make([]*pb.Item, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, &pb.Item{
+			Sku:          item.Sku,
+			Name:         item.Name,
+			Count:        item.Count,
+			Price:        item.Price,
+			SavedPrice:   item.SavedPrice,
+			PriceChanged: item.PriceChanged,
+			Unavailable:  item.Unavailable,
+		})
+	}
+
+	discounts := make([]*pb.Discount, 0, len(resp.Discounts))
+	for _, d := range resp.Discounts {
+		discounts = append(discounts, &pb.Discount{
+			Code:        d.Code,
+			Sku:         d.Sku,
+			Description: d.Description,
+			Amount:      d.Amount,
+		})
+	}
+
+	return &pb.ListCartResponse{
+		Items:             items,
+		TotalPrice:        resp.TotalPrice,
+		CheckedOutOrderId: resp.CheckedOutOrderID,
+		PriceChanged:      resp.PriceChanged,
+		Degraded:          resp.Degraded,
+		Subtotal:          resp.Subtotal,
+		PromoCode:         resp.PromoCode,
+		Discounts:         discounts,
+		PromoNotApplied:   resp.PromoNotApplied,
+	}
+}
@@ -0,0 +1,79 @@
+package grpcserver
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestServer_ListCart(t *testing.T) {
+	// nolint:gosec
+	userID := rand.Int63()
+
+	t.Run("success", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+			Return(&model.GetItemsFromCartResponce{
+				Items: []model.Item{
+					{Sku: 1, Name: "item", Count: 2, Price: 100, SavedPrice: 90, PriceChanged: true},
+					{Sku: 2, Count: 1, Price: 50, SavedPrice: 50, Unavailable: true},
+				},
+				TotalPrice:   250,
+				PriceChanged: true,
+				Degraded:     true,
+			}, nil)
+
+		resp, err := tc.server.ListCart(context.Background(), &pb.ListCartRequest{UserId: userID})
+		require.NoError(t, err)
+
+		expected := &pb.ListCartResponse{
+			Items: []*pb.Item{
+				{Sku: 1, Name: "item", Count: 2, Price: 100, SavedPrice: 90, PriceChanged: true},
+				{Sku: 2, Count: 1, Price: 50, SavedPrice: 50, Unavailable: true},
+			},
+			TotalPrice:   250,
+			PriceChanged: true,
+			Degraded:     true,
+		}
+		assert.True(t, proto.Equal(expected, resp), "got %v", resp)
+	})
+
+	t.Run("success empty cart", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+			Return(&model.GetItemsFromCartResponce{Items: []model.Item{}}, nil)
+
+		resp, err := tc.server.ListCart(context.Background(), &pb.ListCartRequest{UserId: userID})
+		require.NoError(t, err)
+		assert.Empty(t, resp.GetItems())
+	})
+
+	t.Run("err internal", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+			Return(nil, errors.New("test"))
+
+		_, err := tc.server.ListCart(context.Background(), &pb.ListCartRequest{UserId: userID})
+		assert.Equal(t, codes.Internal, status.Code(err))
+	})
+}
@@ -0,0 +1,65 @@
+// Package grpcserver ...
+package grpcserver
+
+import (
+	"errors"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/app/server"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Server gRPC API корзины поверх того же сервиса, что и http ручки
+type Server struct {
+	pb.UnimplementedCartServer
+	cartService server.Service
+	tracer      service.Tracer
+	idempotency server.IdempotencyStore
+}
+
+// NewServer ...
+func NewServer(cartService server.Service, tracer service.Tracer, idempotency server.IdempotencyStore) *Server {
+	return &Server{
+		cartService: cartService,
+		tracer:      tracer,
+		idempotency: idempotency,
+	}
+}
+
+// toStatus переводит ошибки сервиса в gRPC статусы так же, как http ручки переводят их в http статусы
+func toStatus(err error) error {
+	var (
+		stockErr      *model.InsufficientStockError
+		skuLimitErr   *model.SkuQuantityLimitError
+		linesLimitErr *model.CartLinesLimitError
+		totalLimitErr *model.CartTotalLimitError
+	)
+
+	switch {
+	case errors.As(err, &stockErr):
+		return status.Error(codes.FailedPrecondition, stockErr.Error())
+	case errors.As(err, &skuLimitErr):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case errors.As(err, &linesLimitErr), errors.As(err, &totalLimitErr):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrServiceUnavailable):
+		return status.Error(codes.Unavailable, err.Error())
+	case errors.Is(err, model.ErrManyRequest):
+		return status.Error(codes.ResourceExhausted, err.Error())
+	case errors.Is(err, model.ErrProductNotFound):
+		return status.Error(codes.FailedPrecondition, model.ErrSkuNotExists)
+	case errors.Is(err, model.ErrAddedMoreItemThanInStock):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	case errors.Is(err, model.ErrCartEmpty), errors.Is(err, model.ErrNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, model.ErrCartChanged):
+		return status.Error(codes.Aborted, err.Error())
+	case errors.Is(err, model.ErrIdempotencyKeyReused):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	default:
+		return status.Error(codes.Internal, err.Error())
+	}
+}
@@ -0,0 +1,67 @@
+package grpcserver
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/http/middlewares"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name         string
+		req          interface{}
+		expectedCode codes.Code
+	}{
+		{
+			name:         "valid add item",
+			req:          &pb.AddItemRequest{UserId: 1, Sku: 1, Count: 1},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "err user id",
+			req:          &pb.AddItemRequest{Sku: 1, Count: 1},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "err sku",
+			req:          &pb.DeleteItemRequest{UserId: 1},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "err count",
+			req:          &pb.AddItemRequest{UserId: 1, Sku: 1},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "err list user id",
+			req:          &pb.ListCartRequest{UserId: -1},
+			expectedCode: codes.InvalidArgument,
+		},
+		{
+			name:         "err idempotency key too long",
+			req:          &pb.CheckoutRequest{UserId: 1, IdempotencyKey: strings.Repeat("k", 256)},
+			expectedCode: codes.InvalidArgument,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			called := false
+			handler := func(_ context.Context, _ interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := middlewares.Validate(context.Background(), tt.req, nil, handler)
+
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			assert.Equal(t, tt.expectedCode == codes.OK, called)
+		})
+	}
+}
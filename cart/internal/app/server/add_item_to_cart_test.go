@@ -8,6 +8,7 @@ import (
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
@@ -33,6 +34,10 @@ func TestHandler_AddItem(t *testing.T) {
 
 	testBody := fmt.Sprintf(`{"count":%d}`, testData.Count)
 
+	skuLimitErr := &model.SkuQuantityLimitError{Sku: testData.Sku, Max: 100}
+	linesLimitErr := &model.CartLinesLimitError{Max: 10}
+	totalLimitErr := &model.CartTotalLimitError{Max: 1000}
+
 	tests := []struct {
 		name           string
 		testData       model.RequestData
@@ -167,6 +172,81 @@ func TestHandler_AddItem(t *testing.T) {
 			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrAddedMoreItemThanInStock.Error()),
 			expectedResp:   model.ErrAddedMoreItemThanInStock,
 		},
+		{
+			name:     "err sku quantity limit",
+			testData: testData,
+			testBody: testBody,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				tc.tracer.StartMock.
+					Expect(
+						context.Background(),
+						model.AddItemURL,
+						trace.WithAttributes(
+							attribute.Int64("UserID", testData.UserID),
+							attribute.Int64("Sku", testData.Sku),
+							attribute.Int64("Count", int64(testData.Count)),
+						),
+					).
+					Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
+
+				tc.mock.AddItemMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(skuLimitErr)
+			},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", skuLimitErr.Error()),
+			expectedResp:   skuLimitErr,
+		},
+		{
+			name:     "err cart lines limit",
+			testData: testData,
+			testBody: testBody,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				tc.tracer.StartMock.
+					Expect(
+						context.Background(),
+						model.AddItemURL,
+						trace.WithAttributes(
+							attribute.Int64("UserID", testData.UserID),
+							attribute.Int64("Sku", testData.Sku),
+							attribute.Int64("Count", int64(testData.Count)),
+						),
+					).
+					Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
+
+				tc.mock.AddItemMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(linesLimitErr)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", linesLimitErr.Error()),
+			expectedResp:   linesLimitErr,
+		},
+		{
+			name:     "err cart total limit",
+			testData: testData,
+			testBody: testBody,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				tc.tracer.StartMock.
+					Expect(
+						context.Background(),
+						model.AddItemURL,
+						trace.WithAttributes(
+							attribute.Int64("UserID", testData.UserID),
+							attribute.Int64("Sku", testData.Sku),
+							attribute.Int64("Count", int64(testData.Count)),
+						),
+					).
+					Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
+
+				tc.mock.AddItemMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(totalLimitErr)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", totalLimitErr.Error()),
+			expectedResp:   totalLimitErr,
+		},
 	}
 
 	for _, tt := range tests {
@@ -221,3 +301,23 @@ func TestParseRequest_err(t *testing.T) {
 		require.Error(t, err, errors.New(model.ErrCounItemsMoreThanZero))
 	})
 }
+
+func TestHandler_AddItem_BodyTooLarge(t *testing.T) {
+	const testURL = "/user/{user_id}/cart/{sku_id}"
+
+	tc := setupTest(t)
+
+	// тело, обрезанное http.MaxBytesReader, отдается как 413
+	body := fmt.Sprintf(`{"count":1,"comment":"%s"}`, strings.Repeat("a", 64))
+	req := httptest.NewRequest(http.MethodPost, testURL, strings.NewReader(body))
+	req.SetPathValue("sku_id", "1076963")
+	req.SetPathValue("user_id", "1")
+
+	w := httptest.NewRecorder()
+	req.Body = http.MaxBytesReader(w, req.Body, 16)
+
+	tc.server.AddItem(w, req)
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, w.Code)
+	assert.Equal(t, fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrRequestTooLarge), w.Body.String())
+}
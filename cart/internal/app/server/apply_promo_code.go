@@ -0,0 +1,64 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// ApplyPromoCode применяет промокод к корзине, в ответе корзина с пересчитанным итогом
+func (s *Server) ApplyPromoCode(w http.ResponseWriter, r *http.Request) {
+	data, err := parseRequest(r, int(model.ValidateByUserID))
+	if err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	var body model.ApplyPromoCodeRequest
+	if err = json.NewDecoder(r.Body).Decode(&body); err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if strings.TrimSpace(body.Code) == "" {
+		MakeErrorResponse(w, errors.New(model.ErrPromoCodeRequired), http.StatusBadRequest)
+		return
+	}
+
+	ctx, span := s.tracer.Start(
+		r.Context(),
+		model.ApplyPromoCodeURL,
+		trace.WithAttributes(
+			attribute.Int64("UserID", data.UserID),
+			attribute.String("Code", body.Code),
+		),
+	)
+	defer span.End()
+
+	resp, err := s.cartService.ApplyPromoCode(ctx, data.UserID, body.Code)
+	if err != nil {
+		switch {
+		case errors.Is(err, model.ErrNotFound):
+			MakeErrorResponse(w, model.ErrNotFound, http.StatusNotFound)
+		case errors.Is(err, model.ErrPromoCodeNotFound):
+			MakeErrorResponse(w, model.ErrPromoCodeNotFound, http.StatusNotFound)
+		case errors.Is(err, model.ErrPromoNotApplicable):
+			MakeErrorResponse(w, err, http.StatusUnprocessableEntity)
+		default:
+			MakeErrorResponse(w, err, http.StatusInternalServerError)
+		}
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+}
@@ -0,0 +1,153 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler_ApplyPromoCode(t *testing.T) {
+	const (
+		testURL = "/user/{user_id}/cart/promo"
+		userID  = int64(1)
+		code    = "WELCOME10"
+	)
+
+	startSpan := func(tc testComponent) {
+		tc.tracer.StartMock.
+			Expect(
+				context.Background(),
+				model.ApplyPromoCodeURL,
+				trace.WithAttributes(
+					attribute.Int64("UserID", userID),
+					attribute.String("Code", code),
+				),
+			).
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+	}
+
+	testBody := fmt.Sprintf(`{"code":"%s"}`, code)
+	errNotApplicable := fmt.Errorf("%w: минимальная сумма корзины 3000", model.ErrPromoNotApplicable)
+
+	tests := []struct {
+		name           string
+		testBody       string
+		setupMock      func(tc testComponent)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:     "success",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.ApplyPromoCodeMock.
+					Expect(minimock.AnyContext, userID, code).
+					Return(&model.GetItemsFromCartResponce{
+						Items:      []model.Item{{Sku: 1, Name: "item", Count: 1, Price: 100, SavedPrice: 100}},
+						Subtotal:   100,
+						TotalPrice: 90,
+						PromoCode:  code,
+						Discounts:  []model.DiscountLine{{Code: code, Description: "скидка 10% на корзину", Amount: 10}},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody: "{\"items\":[{\"sku\":1,\"name\":\"item\",\"count\":1,\"price\":100,\"saved_price\":100,\"price_changed\":false}]," +
+				"\"subtotal\":100,\"total_price\":90,\"promo_code\":\"WELCOME10\"," +
+				"\"discounts\":[{\"code\":\"WELCOME10\",\"description\":\"скидка 10% на корзину\",\"amount\":10}],\"price_changed\":false}\n",
+		},
+		{
+			name:           "err code required",
+			testBody:       `{"code":" "}`,
+			setupMock:      func(_ testComponent) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrPromoCodeRequired),
+		},
+		{
+			name:     "err empty cart",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.ApplyPromoCodeMock.
+					Expect(minimock.AnyContext, userID, code).
+					Return(nil, model.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrNotFound),
+		},
+		{
+			name:     "err unknown code",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.ApplyPromoCodeMock.
+					Expect(minimock.AnyContext, userID, code).
+					Return(nil, model.ErrPromoCodeNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrPromoCodeNotFound),
+		},
+		{
+			name:     "err not applicable",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.ApplyPromoCodeMock.
+					Expect(minimock.AnyContext, userID, code).
+					Return(nil, errNotApplicable)
+			},
+			expectedStatus: http.StatusUnprocessableEntity,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", errNotApplicable),
+		},
+		{
+			name:     "err internal",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.ApplyPromoCodeMock.
+					Expect(minimock.AnyContext, userID, code).
+					Return(nil, errors.New("test"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "{\"Message\":\"test\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tt.setupMock(tc)
+
+			// Execute
+			reader := bytes.NewReader([]byte(tt.testBody))
+			req := httptest.NewRequest(http.MethodPut, testURL, reader)
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("user_id", fmt.Sprintf("%d", userID))
+
+			w := httptest.NewRecorder()
+			tc.server.ApplyPromoCode(w, req)
+
+			res := w.Result()
+			defer func() {
+				err := res.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DeleteSavedItem ...
+func (s *Server) DeleteSavedItem(w http.ResponseWriter, r *http.Request) {
+	data, err := parseRequest(r, int(model.ValidateBySku))
+	if err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	ctx, span := s.tracer.Start(
+		r.Context(),
+		model.DeleteSavedItemURL,
+		trace.WithAttributes(
+			attribute.Int64("UserID", data.UserID),
+			attribute.Int64("Sku", data.Sku),
+		),
+	)
+	defer span.End()
+
+	if err := s.cartService.DeleteSavedItem(ctx, *data); err != nil {
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -2,18 +2,40 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"net/http"
 
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
 )
 
-// MakeErrorResponse ...
+// MakeErrorResponse недоступность зависимого сервиса (открытый circuit breaker) всегда отдается как 503,
+// превышение лимита количества товара - 400, лимитов корзины - 422, слишком большое тело запроса - 413
 func MakeErrorResponse(w http.ResponseWriter, err error, statusCode int) {
 	type ErrorMessage struct {
 		Message string
 	}
 
+	var (
+		skuLimitErr   *model.SkuQuantityLimitError
+		linesLimitErr *model.CartLinesLimitError
+		totalLimitErr *model.CartTotalLimitError
+		maxBytesErr   *http.MaxBytesError
+	)
+
+	switch {
+	case errors.Is(err, model.ErrServiceUnavailable):
+		statusCode = http.StatusServiceUnavailable
+	case errors.As(err, &skuLimitErr):
+		statusCode = http.StatusBadRequest
+	case errors.As(err, &linesLimitErr), errors.As(err, &totalLimitErr):
+		statusCode = http.StatusUnprocessableEntity
+	case errors.As(err, &maxBytesErr):
+		statusCode = http.StatusRequestEntityTooLarge
+		err = model.ErrRequestTooLarge
+	}
+
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(statusCode)
 
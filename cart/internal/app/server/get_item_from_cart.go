@@ -2,15 +2,18 @@ package server
 
 import (
 	"encoding/json"
-	"errors"
 	"net/http"
+	"strconv"
+	"strings"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
 )
 
-// GetItemsByUserID ...
+// GetItemsByUserID отдает корзину с ETag по версии корзины.
+// Если If-None-Match совпадает с текущей версией, отдается 304 без обращения к product-service.
+// Корзина, собранная без product-service (Degraded), отдается без ETag
 func (s *Server) GetItemsByUserID(w http.ResponseWriter, r *http.Request) {
 	data, err := parseRequest(r, int(model.ValidateByUserID))
 	if err != nil {
@@ -27,16 +30,29 @@ func (s *Server) GetItemsByUserID(w http.ResponseWriter, r *http.Request) {
 	)
 	defer span.End()
 
+	// версия читается до корзины: если корзина изменится между чтениями, ETag просто устареет
+	version, err := s.cartService.GetCartVersion(ctx, data.UserID)
+	if err != nil {
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	tag := makeETag(version)
+	if matchETag(r.Header.Get("If-None-Match"), tag) {
+		w.Header().Set("ETag", tag)
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	resp, err := s.cartService.GetItemsFromCart(ctx, *data)
 	if err != nil {
-		if errors.Is(err, model.ErrNotFound) {
-			MakeErrorResponse(w, errors.New(model.ErrNotFound.Error()), http.StatusNotFound)
-			return
-		}
 		MakeErrorResponse(w, err, http.StatusNoContent)
 		return
 	}
 
+	if !resp.Degraded {
+		w.Header().Set("ETag", tag)
+	}
 	w.Header().Add("Content-Type", "application/json")
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(resp); err != nil {
@@ -44,3 +60,20 @@ func (s *Server) GetItemsByUserID(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// makeETag ...
+func makeETag(version uint64) string {
+	return `"` + strconv.FormatUint(version, 10) + `"`
+}
+
+// matchETag сравнивает If-None-Match со списком тегов через запятую, слабые теги сравниваются по значению
+func matchETag(ifNoneMatch, tag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == tag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -40,7 +40,7 @@ func TestGetItemsByUserID(t *testing.T) {
 			Price: 2,
 		}
 
-		totalPrice := item.Price + item2.Price
+		totalPrice := uint64(item.Price) + uint64(item2.Price)
 
 		items := []model.Item{item, item2}
 		tc := setupTest(t)
@@ -54,6 +54,10 @@ func TestGetItemsByUserID(t *testing.T) {
 			).
 			Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
 
+		tc.mock.GetCartVersionMock.
+			Expect(minimock.AnyContext, data.UserID).
+			Return(1, nil)
+
 		tc.mock.GetItemsFromCartMock.
 			Expect(minimock.AnyContext, data).
 			Return(
@@ -78,7 +82,7 @@ func TestGetItemsByUserID(t *testing.T) {
 		require.Equal(t, http.StatusOK, res.StatusCode)
 	})
 
-	t.Run("err 'not found' get items", func(t *testing.T) {
+	t.Run("successful get empty cart", func(t *testing.T) {
 		t.Parallel()
 		data := model.RequestData{
 			UserID: 1,
@@ -95,9 +99,13 @@ func TestGetItemsByUserID(t *testing.T) {
 			).
 			Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
 
+		tc.mock.GetCartVersionMock.
+			Expect(minimock.AnyContext, data.UserID).
+			Return(0, nil)
+
 		tc.mock.GetItemsFromCartMock.
 			Expect(minimock.AnyContext, data).
-			Return(nil, model.ErrNotFound)
+			Return(&model.GetItemsFromCartResponce{Items: []model.Item{}}, nil)
 
 		req := httptest.NewRequest(http.MethodGet, testURL, nil)
 		req.Header.Set("Content-Type", "application/json")
@@ -112,7 +120,7 @@ func TestGetItemsByUserID(t *testing.T) {
 			require.NoError(t, err)
 		}()
 
-		require.Equal(t, http.StatusNotFound, res.StatusCode)
+		require.Equal(t, http.StatusOK, res.StatusCode)
 
 	})
 
@@ -133,6 +141,10 @@ func TestGetItemsByUserID(t *testing.T) {
 			).
 			Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
 
+		tc.mock.GetCartVersionMock.
+			Expect(minimock.AnyContext, data.UserID).
+			Return(1, nil)
+
 		tc.mock.GetItemsFromCartMock.
 			Expect(minimock.AnyContext, data).
 			Return(nil, errors.New("test"))
@@ -163,100 +175,159 @@ func TestHandler_GetItemsByUserID(t *testing.T) {
 	}
 
 	testItem := model.Item{
-		Sku:   1,
-		Name:  "item",
-		Count: 1,
-		Price: 1,
+		Sku:        1,
+		Name:       "item",
+		Count:      1,
+		Price:      1,
+		SavedPrice: 1,
 	}
 	testItem2 := model.Item{
-		Sku:   2,
-		Name:  "item2",
-		Count: 2,
-		Price: 2,
+		Sku:          2,
+		Name:         "item2",
+		Count:        2,
+		Price:        2,
+		SavedPrice:   3,
+		PriceChanged: true,
 	}
 
-	totalPrice := testItem.Price + (testItem2.Price * testItem2.Count)
+	totalPrice := uint64(testItem.Price) + uint64(testItem2.Price)*uint64(testItem2.Count)
 	testItems := []model.Item{testItem, testItem2}
 
+	const version = uint64(7)
+
+	startSpan := func(tc testComponent) {
+		tc.tracer.StartMock.
+			Expect(
+				context.Background(),
+				model.GetItemsByUserIDURL,
+				trace.WithAttributes(
+					attribute.Int64("UserID", testData.UserID),
+				),
+			).
+			Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
+	}
+
 	tests := []struct {
 		name           string
 		testData       model.RequestData
-		testBody       string
+		ifNoneMatch    string
 		setupMock      func(tc testComponent, mockData model.RequestData)
 		expectedStatus int
 		expectedBody   string
-		expectedResp   error
+		expectedETag   string
 	}{
 		{
 			name:     "success",
 			testData: testData,
 			setupMock: func(tc testComponent, mockData model.RequestData) {
-				tc.tracer.StartMock.
-					Expect(
-						context.Background(),
-						model.GetItemsByUserIDURL,
-						trace.WithAttributes(
-							attribute.Int64("UserID", testData.UserID),
-						),
-					).
-					Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
-
+				startSpan(tc)
+				tc.mock.GetCartVersionMock.
+					Expect(minimock.AnyContext, mockData.UserID).
+					Return(version, nil)
 				tc.mock.GetItemsFromCartMock.
 					Expect(minimock.AnyContext, mockData).
 					Return(&model.GetItemsFromCartResponce{
-						Items:      testItems,
-						TotalPrice: totalPrice,
+						Items:        testItems,
+						Subtotal:     totalPrice,
+						TotalPrice:   totalPrice,
+						PriceChanged: true,
 					}, nil)
 			},
 			expectedStatus: http.StatusOK,
 			//TO DO: сделать унифицированый метод, если изменится слайс items по кол-ву, то expectedBody будет другой
-			expectedBody: fmt.Sprintf("{\"items\":[{\"sku\":%d,\"name\":\"%s\",\"count\":%d,\"price\":%d},{\"sku\":%d,\"name\":\"%s\",\"count\":%d,\"price\":%d}],\"total_price\":%d}\n",
-				testItem.Sku, testItem.Name, testItem.Count, testItem.Price, testItem2.Sku, testItem2.Name, testItem2.Count, testItem2.Price, totalPrice),
-			expectedResp: nil,
+			expectedBody: fmt.Sprintf("{\"items\":[{\"sku\":%d,\"name\":\"%s\",\"count\":%d,\"price\":%d,\"saved_price\":%d,\"price_changed\":false},"+
+				"{\"sku\":%d,\"name\":\"%s\",\"count\":%d,\"price\":%d,\"saved_price\":%d,\"price_changed\":true}],\"subtotal\":%d,\"total_price\":%d,\"price_changed\":true}\n",
+				testItem.Sku, testItem.Name, testItem.Count, testItem.Price, testItem.SavedPrice,
+				testItem2.Sku, testItem2.Name, testItem2.Count, testItem2.Price, testItem2.SavedPrice, totalPrice, totalPrice),
+			expectedETag: `"7"`,
 		},
 		{
-			name:     "err 'not found' get items",
+			name:     "success empty cart",
 			testData: testData,
 			setupMock: func(tc testComponent, mockData model.RequestData) {
-				tc.tracer.StartMock.
-					Expect(
-						context.Background(),
-						model.GetItemsByUserIDURL,
-						trace.WithAttributes(
-							attribute.Int64("UserID", testData.UserID),
-						),
-					).
-					Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
-
+				startSpan(tc)
+				tc.mock.GetCartVersionMock.
+					Expect(minimock.AnyContext, mockData.UserID).
+					Return(0, nil)
+				tc.mock.GetItemsFromCartMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(&model.GetItemsFromCartResponce{Items: []model.Item{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"items\":[],\"subtotal\":0,\"total_price\":0,\"price_changed\":false}\n",
+			expectedETag:   `"0"`,
+		},
+		{
+			name:        "not modified",
+			testData:    testData,
+			ifNoneMatch: `"6", W/"7"`,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc)
+				tc.mock.GetCartVersionMock.
+					Expect(minimock.AnyContext, mockData.UserID).
+					Return(version, nil)
+			},
+			expectedStatus: http.StatusNotModified,
+			expectedETag:   `"7"`,
+		},
+		{
+			name:        "stale etag",
+			testData:    testData,
+			ifNoneMatch: `"6"`,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc)
+				tc.mock.GetCartVersionMock.
+					Expect(minimock.AnyContext, mockData.UserID).
+					Return(version, nil)
+				tc.mock.GetItemsFromCartMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(&model.GetItemsFromCartResponce{Items: []model.Item{}}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"items\":[],\"subtotal\":0,\"total_price\":0,\"price_changed\":false}\n",
+			expectedETag:   `"7"`,
+		},
+		{
+			name:     "degraded cart without etag",
+			testData: testData,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc)
+				tc.mock.GetCartVersionMock.
+					Expect(minimock.AnyContext, mockData.UserID).
+					Return(version, nil)
 				tc.mock.GetItemsFromCartMock.
 					Expect(minimock.AnyContext, mockData).
-					Return(nil, model.ErrNotFound)
+					Return(&model.GetItemsFromCartResponce{Items: []model.Item{}, Degraded: true}, nil)
 			},
-			expectedStatus: http.StatusNotFound,
-			expectedBody:   "{\"Message\":\"not found\"}\n",
-			expectedResp:   nil,
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"items\":[],\"subtotal\":0,\"total_price\":0,\"price_changed\":false,\"degraded\":true}\n",
+		},
+		{
+			name:     "err get version",
+			testData: testData,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc)
+				tc.mock.GetCartVersionMock.
+					Expect(minimock.AnyContext, mockData.UserID).
+					Return(0, errors.New("test"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "{\"Message\":\"test\"}\n",
 		},
 		{
 			name:     "err  get items",
 			testData: testData,
 			setupMock: func(tc testComponent, mockData model.RequestData) {
-				tc.tracer.StartMock.
-					Expect(
-						context.Background(),
-						model.GetItemsByUserIDURL,
-						trace.WithAttributes(
-							attribute.Int64("UserID", testData.UserID),
-						),
-					).
-					Return(minimock.AnyContext, trace.SpanFromContext(context.Background()))
-
+				startSpan(tc)
+				tc.mock.GetCartVersionMock.
+					Expect(minimock.AnyContext, mockData.UserID).
+					Return(version, nil)
 				tc.mock.GetItemsFromCartMock.
 					Expect(minimock.AnyContext, mockData).
 					Return(nil, errors.New("test"))
 			},
 			expectedStatus: http.StatusNoContent,
 			expectedBody:   "{\"Message\":\"test\"}\n",
-			expectedResp:   nil,
 		},
 	}
 
@@ -267,8 +338,11 @@ func TestHandler_GetItemsByUserID(t *testing.T) {
 			tt.setupMock(tc, testData)
 
 			// Execute
-			req := httptest.NewRequest(http.MethodDelete, testURL, nil)
+			req := httptest.NewRequest(http.MethodGet, testURL, nil)
 			req.Header.Set("Content-Type", "application/json")
+			if tt.ifNoneMatch != "" {
+				req.Header.Set("If-None-Match", tt.ifNoneMatch)
+			}
 			req.SetPathValue("user_id", fmt.Sprintf("%d", testData.UserID))
 
 			w := httptest.NewRecorder()
@@ -283,6 +357,7 @@ func TestHandler_GetItemsByUserID(t *testing.T) {
 			// Verify
 			assert.Equal(t, tt.expectedStatus, res.StatusCode)
 			assert.Equal(t, tt.expectedBody, w.Body.String())
+			assert.Equal(t, tt.expectedETag, res.Header.Get("ETag"))
 		})
 	}
 }
@@ -0,0 +1,81 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler_GetSavedItems(t *testing.T) {
+	const testURL = "/user/{user_id}/saved"
+
+	testData := model.RequestData{UserID: 1}
+
+	tests := []struct {
+		name           string
+		resp           *model.GetSavedItemsResponse
+		serviceErr     error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name: "success",
+			resp: &model.GetSavedItemsResponse{
+				Items: []model.Item{{Sku: 1, Name: "item", Count: 2, Price: 100, SavedPrice: 100}},
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"items\":[{\"sku\":1,\"name\":\"item\",\"count\":2,\"price\":100,\"saved_price\":100,\"price_changed\":false}]}\n",
+		},
+		{
+			name:           "err saved list empty",
+			serviceErr:     model.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrNotFound),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.tracer.StartMock.
+				Expect(
+					context.Background(),
+					model.GetSavedItemsURL,
+					trace.WithAttributes(
+						attribute.Int64("UserID", testData.UserID),
+					),
+				).
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tc.mock.GetSavedItemsMock.
+				Expect(minimock.AnyContext, testData).
+				Return(tt.resp, tt.serviceErr)
+
+			// Execute
+			req := httptest.NewRequest(http.MethodGet, testURL, nil)
+			req.SetPathValue("user_id", fmt.Sprintf("%d", testData.UserID))
+
+			w := httptest.NewRecorder()
+			tc.server.GetSavedItems(w, req)
+
+			res := w.Result()
+			defer func() {
+				err := res.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -9,20 +9,23 @@ import (
 )
 
 type testComponent struct {
-	mock   *mock.ServiceMock
-	server *Server
-	tracer *mockTracer.TracerMock
+	mock        *mock.ServiceMock
+	server      *Server
+	tracer      *mockTracer.TracerMock
+	idempotency *mock.IdempotencyStoreMock
 }
 
 func setupTest(t *testing.T) testComponent {
 	mc := minimock.NewController(t)
 	tracer := mockTracer.NewTracerMock(mc)
 	serviceMock := mock.NewServiceMock(mc)
-	server := NewServer(serviceMock, tracer)
+	idempotencyMock := mock.NewIdempotencyStoreMock(mc)
+	server := NewServer(serviceMock, tracer, idempotencyMock)
 
 	return testComponent{
-		mock:   serviceMock,
-		server: server,
-		tracer: tracer,
+		mock:        serviceMock,
+		server:      server,
+		tracer:      tracer,
+		idempotency: idempotencyMock,
 	}
 }
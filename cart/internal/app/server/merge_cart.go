@@ -0,0 +1,74 @@
+package server
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/auth"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// MergeCart переносит позиции корзины source_user_id (например, гостевой) в корзину user_id
+func (s *Server) MergeCart(w http.ResponseWriter, r *http.Request) {
+	data, err := parseRequest(r, int(model.ValidateByUserID))
+	if err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	var body model.MergeCartRequest
+	if err = json.NewDecoder(r.Body).Decode(&body); err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	if body.SourceUserID < 1 {
+		MakeErrorResponse(w, errors.New(model.ErrSourceUserIDMoreThanZero), http.StatusBadRequest)
+		return
+	}
+
+	if body.SourceUserID == data.UserID {
+		MakeErrorResponse(w, errors.New(model.ErrMergeSameCart), http.StatusBadRequest)
+		return
+	}
+
+	// исходная корзина тоже должна принадлежать владельцу токена, гостевые корзины объединяет администратор
+	if !auth.CanAccess(r.Context(), body.SourceUserID) {
+		MakeErrorResponse(w, model.ErrForbidden, http.StatusForbidden)
+		return
+	}
+
+	ctx, span := s.tracer.Start(
+		r.Context(),
+		model.MergeCartURL,
+		trace.WithAttributes(
+			attribute.Int64("UserID", data.UserID),
+			attribute.Int64("SourceUserID", body.SourceUserID),
+		),
+	)
+	defer span.End()
+
+	resp, err := s.cartService.MergeCart(ctx, data.UserID, body.SourceUserID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			MakeErrorResponse(w, model.ErrNotFound, http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, model.ErrCartChanged) {
+			MakeErrorResponse(w, model.ErrCartChanged, http.StatusConflict)
+			return
+		}
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+}
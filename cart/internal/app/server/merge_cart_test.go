@@ -0,0 +1,176 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/auth"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler_MergeCart(t *testing.T) {
+	const (
+		testURL      = "/user/{user_id}/cart/merge"
+		targetUserID = int64(1)
+		sourceUserID = int64(2)
+	)
+
+	startSpan := func(tc testComponent) {
+		tc.tracer.StartMock.
+			Expect(
+				context.Background(),
+				model.MergeCartURL,
+				trace.WithAttributes(
+					attribute.Int64("UserID", targetUserID),
+					attribute.Int64("SourceUserID", sourceUserID),
+				),
+			).
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+	}
+
+	testBody := fmt.Sprintf(`{"source_user_id":%d}`, sourceUserID)
+
+	tests := []struct {
+		name           string
+		testBody       string
+		claims         *auth.Claims
+		setupMock      func(tc testComponent)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:     "success",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.MergeCartMock.
+					Expect(minimock.AnyContext, targetUserID, sourceUserID).
+					Return(&model.MergeCartResponse{
+						Adjusted: []model.MergedLine{{Sku: 5, Requested: 6, Merged: 3}},
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"adjusted\":[{\"sku\":5,\"requested\":6,\"merged\":3}]}\n",
+		},
+		{
+			name:     "success admin",
+			testBody: testBody,
+			claims:   &auth.Claims{UserID: 99, Admin: true},
+			setupMock: func(tc testComponent) {
+				tc.tracer.StartMock.
+					Expect(
+						minimock.AnyContext,
+						model.MergeCartURL,
+						trace.WithAttributes(
+							attribute.Int64("UserID", targetUserID),
+							attribute.Int64("SourceUserID", sourceUserID),
+						),
+					).
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+				tc.mock.MergeCartMock.
+					Expect(minimock.AnyContext, targetUserID, sourceUserID).
+					Return(&model.MergeCartResponse{}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"adjusted\":null}\n",
+		},
+		{
+			name:           "err source cart of another user",
+			testBody:       testBody,
+			claims:         &auth.Claims{UserID: targetUserID},
+			setupMock:      func(_ testComponent) {},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrForbidden),
+		},
+		{
+			name:           "err source user id required",
+			testBody:       `{}`,
+			setupMock:      func(_ testComponent) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrSourceUserIDMoreThanZero),
+		},
+		{
+			name:           "err same cart",
+			testBody:       fmt.Sprintf(`{"source_user_id":%d}`, targetUserID),
+			setupMock:      func(_ testComponent) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrMergeSameCart),
+		},
+		{
+			name:     "err source cart not found",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.MergeCartMock.
+					Expect(minimock.AnyContext, targetUserID, sourceUserID).
+					Return(nil, model.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrNotFound),
+		},
+		{
+			name:     "err source cart changed",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.MergeCartMock.
+					Expect(minimock.AnyContext, targetUserID, sourceUserID).
+					Return(nil, model.ErrCartChanged)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrCartChanged),
+		},
+		{
+			name:     "err internal",
+			testBody: testBody,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.MergeCartMock.
+					Expect(minimock.AnyContext, targetUserID, sourceUserID).
+					Return(nil, errors.New("test"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "{\"Message\":\"test\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tt.setupMock(tc)
+
+			// Execute
+			reader := bytes.NewReader([]byte(tt.testBody))
+			req := httptest.NewRequest(http.MethodPost, testURL, reader)
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("user_id", fmt.Sprintf("%d", targetUserID))
+			if tt.claims != nil {
+				req = req.WithContext(auth.WithClaims(req.Context(), tt.claims))
+			}
+
+			w := httptest.NewRecorder()
+			tc.server.MergeCart(w, req)
+
+			res := w.Result()
+			defer func() {
+				err := res.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,511 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.4). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/app/server.IdempotencyStore -o idempotency_store_mock.go -n IdempotencyStoreMock -p mocks
+
+import (
+	"context"
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/idempotency"
+	"github.com/gojuno/minimock/v3"
+)
+
+// IdempotencyStoreMock implements mm_server.IdempotencyStore
+type IdempotencyStoreMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcDo          func(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error)) (i1 int64, err error)
+	funcDoOrigin    string
+	inspectFuncDo   func(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error))
+	afterDoCounter  uint64
+	beforeDoCounter uint64
+	DoMock          mIdempotencyStoreMockDo
+}
+
+// NewIdempotencyStoreMock returns a mock for mm_server.IdempotencyStore
+func NewIdempotencyStoreMock(t minimock.Tester) *IdempotencyStoreMock {
+	m := &IdempotencyStoreMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.DoMock = mIdempotencyStoreMockDo{mock: m}
+	m.DoMock.callArgs = []*IdempotencyStoreMockDoParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mIdempotencyStoreMockDo struct {
+	optional           bool
+	mock               *IdempotencyStoreMock
+	defaultExpectation *IdempotencyStoreMockDoExpectation
+	expectations       []*IdempotencyStoreMockDoExpectation
+
+	callArgs []*IdempotencyStoreMockDoParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// IdempotencyStoreMockDoExpectation specifies expectation struct of the IdempotencyStore.Do
+type IdempotencyStoreMockDoExpectation struct {
+	mock               *IdempotencyStoreMock
+	params             *IdempotencyStoreMockDoParams
+	paramPtrs          *IdempotencyStoreMockDoParamPtrs
+	expectationOrigins IdempotencyStoreMockDoExpectationOrigins
+	results            *IdempotencyStoreMockDoResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// IdempotencyStoreMockDoParams contains parameters of the IdempotencyStore.Do
+type IdempotencyStoreMockDoParams struct {
+	ctx         context.Context
+	userID      int64
+	key         string
+	fingerprint idempotency.Fingerprint
+	fn          func() (int64, error)
+}
+
+// IdempotencyStoreMockDoParamPtrs contains pointers to parameters of the IdempotencyStore.Do
+type IdempotencyStoreMockDoParamPtrs struct {
+	ctx         *context.Context
+	userID      *int64
+	key         *string
+	fingerprint *idempotency.Fingerprint
+	fn          *func() (int64, error)
+}
+
+// IdempotencyStoreMockDoResults contains results of the IdempotencyStore.Do
+type IdempotencyStoreMockDoResults struct {
+	i1  int64
+	err error
+}
+
+// IdempotencyStoreMockDoOrigins contains origins of expectations of the IdempotencyStore.Do
+type IdempotencyStoreMockDoExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originKey         string
+	originFingerprint string
+	originFn          string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDo *mIdempotencyStoreMockDo) Optional() *mIdempotencyStoreMockDo {
+	mmDo.optional = true
+	return mmDo
+}
+
+// Expect sets up expected params for IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) Expect(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error)) *mIdempotencyStoreMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &IdempotencyStoreMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.paramPtrs != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by ExpectParams functions")
+	}
+
+	mmDo.defaultExpectation.params = &IdempotencyStoreMockDoParams{ctx, userID, key, fingerprint, fn}
+	mmDo.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDo.expectations {
+		if minimock.Equal(e.params, mmDo.defaultExpectation.params) {
+			mmDo.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDo.defaultExpectation.params)
+		}
+	}
+
+	return mmDo
+}
+
+// ExpectCtxParam1 sets up expected param ctx for IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) ExpectCtxParam1(ctx context.Context) *mIdempotencyStoreMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &IdempotencyStoreMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.params != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Expect")
+	}
+
+	if mmDo.defaultExpectation.paramPtrs == nil {
+		mmDo.defaultExpectation.paramPtrs = &IdempotencyStoreMockDoParamPtrs{}
+	}
+	mmDo.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDo.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDo
+}
+
+// ExpectUserIDParam2 sets up expected param userID for IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) ExpectUserIDParam2(userID int64) *mIdempotencyStoreMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &IdempotencyStoreMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.params != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Expect")
+	}
+
+	if mmDo.defaultExpectation.paramPtrs == nil {
+		mmDo.defaultExpectation.paramPtrs = &IdempotencyStoreMockDoParamPtrs{}
+	}
+	mmDo.defaultExpectation.paramPtrs.userID = &userID
+	mmDo.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDo
+}
+
+// ExpectKeyParam3 sets up expected param key for IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) ExpectKeyParam3(key string) *mIdempotencyStoreMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &IdempotencyStoreMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.params != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Expect")
+	}
+
+	if mmDo.defaultExpectation.paramPtrs == nil {
+		mmDo.defaultExpectation.paramPtrs = &IdempotencyStoreMockDoParamPtrs{}
+	}
+	mmDo.defaultExpectation.paramPtrs.key = &key
+	mmDo.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmDo
+}
+
+// ExpectFingerprintParam4 sets up expected param fingerprint for IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) ExpectFingerprintParam4(fingerprint idempotency.Fingerprint) *mIdempotencyStoreMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &IdempotencyStoreMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.params != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Expect")
+	}
+
+	if mmDo.defaultExpectation.paramPtrs == nil {
+		mmDo.defaultExpectation.paramPtrs = &IdempotencyStoreMockDoParamPtrs{}
+	}
+	mmDo.defaultExpectation.paramPtrs.fingerprint = &fingerprint
+	mmDo.defaultExpectation.expectationOrigins.originFingerprint = minimock.CallerInfo(1)
+
+	return mmDo
+}
+
+// ExpectFnParam5 sets up expected param fn for IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) ExpectFnParam5(fn func() (int64, error)) *mIdempotencyStoreMockDo {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &IdempotencyStoreMockDoExpectation{}
+	}
+
+	if mmDo.defaultExpectation.params != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Expect")
+	}
+
+	if mmDo.defaultExpectation.paramPtrs == nil {
+		mmDo.defaultExpectation.paramPtrs = &IdempotencyStoreMockDoParamPtrs{}
+	}
+	mmDo.defaultExpectation.paramPtrs.fn = &fn
+	mmDo.defaultExpectation.expectationOrigins.originFn = minimock.CallerInfo(1)
+
+	return mmDo
+}
+
+// Inspect accepts an inspector function that has same arguments as the IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) Inspect(f func(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error))) *mIdempotencyStoreMockDo {
+	if mmDo.mock.inspectFuncDo != nil {
+		mmDo.mock.t.Fatalf("Inspect function is already set for IdempotencyStoreMock.Do")
+	}
+
+	mmDo.mock.inspectFuncDo = f
+
+	return mmDo
+}
+
+// Return sets up results that will be returned by IdempotencyStore.Do
+func (mmDo *mIdempotencyStoreMockDo) Return(i1 int64, err error) *IdempotencyStoreMock {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	if mmDo.defaultExpectation == nil {
+		mmDo.defaultExpectation = &IdempotencyStoreMockDoExpectation{mock: mmDo.mock}
+	}
+	mmDo.defaultExpectation.results = &IdempotencyStoreMockDoResults{i1, err}
+	mmDo.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDo.mock
+}
+
+// Set uses given function f to mock the IdempotencyStore.Do method
+func (mmDo *mIdempotencyStoreMockDo) Set(f func(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error)) (i1 int64, err error)) *IdempotencyStoreMock {
+	if mmDo.defaultExpectation != nil {
+		mmDo.mock.t.Fatalf("Default expectation is already set for the IdempotencyStore.Do method")
+	}
+
+	if len(mmDo.expectations) > 0 {
+		mmDo.mock.t.Fatalf("Some expectations are already set for the IdempotencyStore.Do method")
+	}
+
+	mmDo.mock.funcDo = f
+	mmDo.mock.funcDoOrigin = minimock.CallerInfo(1)
+	return mmDo.mock
+}
+
+// When sets expectation for the IdempotencyStore.Do which will trigger the result defined by the following
+// Then helper
+func (mmDo *mIdempotencyStoreMockDo) When(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error)) *IdempotencyStoreMockDoExpectation {
+	if mmDo.mock.funcDo != nil {
+		mmDo.mock.t.Fatalf("IdempotencyStoreMock.Do mock is already set by Set")
+	}
+
+	expectation := &IdempotencyStoreMockDoExpectation{
+		mock:               mmDo.mock,
+		params:             &IdempotencyStoreMockDoParams{ctx, userID, key, fingerprint, fn},
+		expectationOrigins: IdempotencyStoreMockDoExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDo.expectations = append(mmDo.expectations, expectation)
+	return expectation
+}
+
+// Then sets up IdempotencyStore.Do return parameters for the expectation previously defined by the When method
+func (e *IdempotencyStoreMockDoExpectation) Then(i1 int64, err error) *IdempotencyStoreMock {
+	e.results = &IdempotencyStoreMockDoResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times IdempotencyStore.Do should be invoked
+func (mmDo *mIdempotencyStoreMockDo) Times(n uint64) *mIdempotencyStoreMockDo {
+	if n == 0 {
+		mmDo.mock.t.Fatalf("Times of IdempotencyStoreMock.Do mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDo.expectedInvocations, n)
+	mmDo.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDo
+}
+
+func (mmDo *mIdempotencyStoreMockDo) invocationsDone() bool {
+	if len(mmDo.expectations) == 0 && mmDo.defaultExpectation == nil && mmDo.mock.funcDo == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDo.mock.afterDoCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDo.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Do implements mm_server.IdempotencyStore
+func (mmDo *IdempotencyStoreMock) Do(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error)) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmDo.beforeDoCounter, 1)
+	defer mm_atomic.AddUint64(&mmDo.afterDoCounter, 1)
+
+	mmDo.t.Helper()
+
+	if mmDo.inspectFuncDo != nil {
+		mmDo.inspectFuncDo(ctx, userID, key, fingerprint, fn)
+	}
+
+	mm_params := IdempotencyStoreMockDoParams{ctx, userID, key, fingerprint, fn}
+
+	// Record call args
+	mmDo.DoMock.mutex.Lock()
+	mmDo.DoMock.callArgs = append(mmDo.DoMock.callArgs, &mm_params)
+	mmDo.DoMock.mutex.Unlock()
+
+	for _, e := range mmDo.DoMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmDo.DoMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDo.DoMock.defaultExpectation.Counter, 1)
+		mm_want := mmDo.DoMock.defaultExpectation.params
+		mm_want_ptrs := mmDo.DoMock.defaultExpectation.paramPtrs
+
+		mm_got := IdempotencyStoreMockDoParams{ctx, userID, key, fingerprint, fn}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDo.t.Errorf("IdempotencyStoreMock.Do got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDo.DoMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDo.t.Errorf("IdempotencyStoreMock.Do got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDo.DoMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmDo.t.Errorf("IdempotencyStoreMock.Do got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDo.DoMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.fingerprint != nil && !minimock.Equal(*mm_want_ptrs.fingerprint, mm_got.fingerprint) {
+				mmDo.t.Errorf("IdempotencyStoreMock.Do got unexpected parameter fingerprint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDo.DoMock.defaultExpectation.expectationOrigins.originFingerprint, *mm_want_ptrs.fingerprint, mm_got.fingerprint, minimock.Diff(*mm_want_ptrs.fingerprint, mm_got.fingerprint))
+			}
+
+			if mm_want_ptrs.fn != nil && !minimock.Equal(*mm_want_ptrs.fn, mm_got.fn) {
+				mmDo.t.Errorf("IdempotencyStoreMock.Do got unexpected parameter fn, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDo.DoMock.defaultExpectation.expectationOrigins.originFn, *mm_want_ptrs.fn, mm_got.fn, minimock.Diff(*mm_want_ptrs.fn, mm_got.fn))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDo.t.Errorf("IdempotencyStoreMock.Do got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDo.DoMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDo.DoMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDo.t.Fatal("No results are set for the IdempotencyStoreMock.Do")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmDo.funcDo != nil {
+		return mmDo.funcDo(ctx, userID, key, fingerprint, fn)
+	}
+	mmDo.t.Fatalf("Unexpected call to IdempotencyStoreMock.Do. %v %v %v %v %v", ctx, userID, key, fingerprint, fn)
+	return
+}
+
+// DoAfterCounter returns a count of finished IdempotencyStoreMock.Do invocations
+func (mmDo *IdempotencyStoreMock) DoAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDo.afterDoCounter)
+}
+
+// DoBeforeCounter returns a count of IdempotencyStoreMock.Do invocations
+func (mmDo *IdempotencyStoreMock) DoBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDo.beforeDoCounter)
+}
+
+// Calls returns a list of arguments used in each call to IdempotencyStoreMock.Do.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDo *mIdempotencyStoreMockDo) Calls() []*IdempotencyStoreMockDoParams {
+	mmDo.mutex.RLock()
+
+	argCopy := make([]*IdempotencyStoreMockDoParams, len(mmDo.callArgs))
+	copy(argCopy, mmDo.callArgs)
+
+	mmDo.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDoDone returns true if the count of the Do invocations corresponds
+// the number of defined expectations
+func (m *IdempotencyStoreMock) MinimockDoDone() bool {
+	if m.DoMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DoMock.invocationsDone()
+}
+
+// MinimockDoInspect logs each unmet expectation
+func (m *IdempotencyStoreMock) MinimockDoInspect() {
+	for _, e := range m.DoMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Do at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDoCounter := mm_atomic.LoadUint64(&m.afterDoCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DoMock.defaultExpectation != nil && afterDoCounter < 1 {
+		if m.DoMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Do at\n%s", m.DoMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to IdempotencyStoreMock.Do at\n%s with params: %#v", m.DoMock.defaultExpectation.expectationOrigins.origin, *m.DoMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDo != nil && afterDoCounter < 1 {
+		m.t.Errorf("Expected call to IdempotencyStoreMock.Do at\n%s", m.funcDoOrigin)
+	}
+
+	if !m.DoMock.invocationsDone() && afterDoCounter > 0 {
+		m.t.Errorf("Expected %d calls to IdempotencyStoreMock.Do at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DoMock.expectedInvocations), m.DoMock.expectedInvocationsOrigin, afterDoCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *IdempotencyStoreMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockDoInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *IdempotencyStoreMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *IdempotencyStoreMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockDoDone()
+}
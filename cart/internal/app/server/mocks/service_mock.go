@@ -26,6 +26,20 @@ type ServiceMock struct {
 	beforeAddItemCounter uint64
 	AddItemMock          mServiceMockAddItem
 
+	funcApplyPromoCode          func(ctx context.Context, userID int64, code string) (gp1 *model.GetItemsFromCartResponce, err error)
+	funcApplyPromoCodeOrigin    string
+	inspectFuncApplyPromoCode   func(ctx context.Context, userID int64, code string)
+	afterApplyPromoCodeCounter  uint64
+	beforeApplyPromoCodeCounter uint64
+	ApplyPromoCodeMock          mServiceMockApplyPromoCode
+
+	funcClaimCart          func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64) (err error)
+	funcClaimCartOrigin    string
+	inspectFuncClaimCart   func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64)
+	afterClaimCartCounter  uint64
+	beforeClaimCartCounter uint64
+	ClaimCartMock          mServiceMockClaimCart
+
 	funcDeleteItem          func(ctx context.Context, data model.RequestData) (err error)
 	funcDeleteItemOrigin    string
 	inspectFuncDeleteItem   func(ctx context.Context, data model.RequestData)
@@ -40,6 +54,20 @@ type ServiceMock struct {
 	beforeDeleteItemsByUserIDCounter uint64
 	DeleteItemsByUserIDMock          mServiceMockDeleteItemsByUserID
 
+	funcDeleteSavedItem          func(ctx context.Context, data model.RequestData) (err error)
+	funcDeleteSavedItemOrigin    string
+	inspectFuncDeleteSavedItem   func(ctx context.Context, data model.RequestData)
+	afterDeleteSavedItemCounter  uint64
+	beforeDeleteSavedItemCounter uint64
+	DeleteSavedItemMock          mServiceMockDeleteSavedItem
+
+	funcGetCartVersion          func(ctx context.Context, userID int64) (u1 uint64, err error)
+	funcGetCartVersionOrigin    string
+	inspectFuncGetCartVersion   func(ctx context.Context, userID int64)
+	afterGetCartVersionCounter  uint64
+	beforeGetCartVersionCounter uint64
+	GetCartVersionMock          mServiceMockGetCartVersion
+
 	funcGetItemsFromCart          func(ctx context.Context, data model.RequestData) (gp1 *model.GetItemsFromCartResponce, err error)
 	funcGetItemsFromCartOrigin    string
 	inspectFuncGetItemsFromCart   func(ctx context.Context, data model.RequestData)
@@ -47,12 +75,54 @@ type ServiceMock struct {
 	beforeGetItemsFromCartCounter uint64
 	GetItemsFromCartMock          mServiceMockGetItemsFromCart
 
+	funcGetSavedItems          func(ctx context.Context, data model.RequestData) (gp1 *model.GetSavedItemsResponse, err error)
+	funcGetSavedItemsOrigin    string
+	inspectFuncGetSavedItems   func(ctx context.Context, data model.RequestData)
+	afterGetSavedItemsCounter  uint64
+	beforeGetSavedItemsCounter uint64
+	GetSavedItemsMock          mServiceMockGetSavedItems
+
+	funcMergeCart          func(ctx context.Context, targetUserID int64, sourceUserID int64) (mp1 *model.MergeCartResponse, err error)
+	funcMergeCartOrigin    string
+	inspectFuncMergeCart   func(ctx context.Context, targetUserID int64, sourceUserID int64)
+	afterMergeCartCounter  uint64
+	beforeMergeCartCounter uint64
+	MergeCartMock          mServiceMockMergeCart
+
+	funcMoveToCart          func(ctx context.Context, data model.RequestData) (err error)
+	funcMoveToCartOrigin    string
+	inspectFuncMoveToCart   func(ctx context.Context, data model.RequestData)
+	afterMoveToCartCounter  uint64
+	beforeMoveToCartCounter uint64
+	MoveToCartMock          mServiceMockMoveToCart
+
 	funcOrderCreate          func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) (i1 int64, err error)
 	funcOrderCreateOrigin    string
 	inspectFuncOrderCreate   func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce)
 	afterOrderCreateCounter  uint64
 	beforeOrderCreateCounter uint64
 	OrderCreateMock          mServiceMockOrderCreate
+
+	funcRemovePromoCode          func(ctx context.Context, userID int64) (err error)
+	funcRemovePromoCodeOrigin    string
+	inspectFuncRemovePromoCode   func(ctx context.Context, userID int64)
+	afterRemovePromoCodeCounter  uint64
+	beforeRemovePromoCodeCounter uint64
+	RemovePromoCodeMock          mServiceMockRemovePromoCode
+
+	funcSaveForLater          func(ctx context.Context, data model.RequestData) (err error)
+	funcSaveForLaterOrigin    string
+	inspectFuncSaveForLater   func(ctx context.Context, data model.RequestData)
+	afterSaveForLaterCounter  uint64
+	beforeSaveForLaterCounter uint64
+	SaveForLaterMock          mServiceMockSaveForLater
+
+	funcSetItemCount          func(ctx context.Context, data model.RequestData) (err error)
+	funcSetItemCountOrigin    string
+	inspectFuncSetItemCount   func(ctx context.Context, data model.RequestData)
+	afterSetItemCountCounter  uint64
+	beforeSetItemCountCounter uint64
+	SetItemCountMock          mServiceMockSetItemCount
 }
 
 // NewServiceMock returns a mock for mm_server.Service
@@ -66,18 +136,48 @@ func NewServiceMock(t minimock.Tester) *ServiceMock {
 	m.AddItemMock = mServiceMockAddItem{mock: m}
 	m.AddItemMock.callArgs = []*ServiceMockAddItemParams{}
 
+	m.ApplyPromoCodeMock = mServiceMockApplyPromoCode{mock: m}
+	m.ApplyPromoCodeMock.callArgs = []*ServiceMockApplyPromoCodeParams{}
+
+	m.ClaimCartMock = mServiceMockClaimCart{mock: m}
+	m.ClaimCartMock.callArgs = []*ServiceMockClaimCartParams{}
+
 	m.DeleteItemMock = mServiceMockDeleteItem{mock: m}
 	m.DeleteItemMock.callArgs = []*ServiceMockDeleteItemParams{}
 
 	m.DeleteItemsByUserIDMock = mServiceMockDeleteItemsByUserID{mock: m}
 	m.DeleteItemsByUserIDMock.callArgs = []*ServiceMockDeleteItemsByUserIDParams{}
 
+	m.DeleteSavedItemMock = mServiceMockDeleteSavedItem{mock: m}
+	m.DeleteSavedItemMock.callArgs = []*ServiceMockDeleteSavedItemParams{}
+
+	m.GetCartVersionMock = mServiceMockGetCartVersion{mock: m}
+	m.GetCartVersionMock.callArgs = []*ServiceMockGetCartVersionParams{}
+
 	m.GetItemsFromCartMock = mServiceMockGetItemsFromCart{mock: m}
 	m.GetItemsFromCartMock.callArgs = []*ServiceMockGetItemsFromCartParams{}
 
+	m.GetSavedItemsMock = mServiceMockGetSavedItems{mock: m}
+	m.GetSavedItemsMock.callArgs = []*ServiceMockGetSavedItemsParams{}
+
+	m.MergeCartMock = mServiceMockMergeCart{mock: m}
+	m.MergeCartMock.callArgs = []*ServiceMockMergeCartParams{}
+
+	m.MoveToCartMock = mServiceMockMoveToCart{mock: m}
+	m.MoveToCartMock.callArgs = []*ServiceMockMoveToCartParams{}
+
 	m.OrderCreateMock = mServiceMockOrderCreate{mock: m}
 	m.OrderCreateMock.callArgs = []*ServiceMockOrderCreateParams{}
 
+	m.RemovePromoCodeMock = mServiceMockRemovePromoCode{mock: m}
+	m.RemovePromoCodeMock.callArgs = []*ServiceMockRemovePromoCodeParams{}
+
+	m.SaveForLaterMock = mServiceMockSaveForLater{mock: m}
+	m.SaveForLaterMock.callArgs = []*ServiceMockSaveForLaterParams{}
+
+	m.SetItemCountMock = mServiceMockSetItemCount{mock: m}
+	m.SetItemCountMock.callArgs = []*ServiceMockSetItemCountParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -425,52 +525,56 @@ func (m *ServiceMock) MinimockAddItemInspect() {
 	}
 }
 
-type mServiceMockDeleteItem struct {
+type mServiceMockApplyPromoCode struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockDeleteItemExpectation
-	expectations       []*ServiceMockDeleteItemExpectation
+	defaultExpectation *ServiceMockApplyPromoCodeExpectation
+	expectations       []*ServiceMockApplyPromoCodeExpectation
 
-	callArgs []*ServiceMockDeleteItemParams
+	callArgs []*ServiceMockApplyPromoCodeParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockDeleteItemExpectation specifies expectation struct of the Service.DeleteItem
-type ServiceMockDeleteItemExpectation struct {
+// ServiceMockApplyPromoCodeExpectation specifies expectation struct of the Service.ApplyPromoCode
+type ServiceMockApplyPromoCodeExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockDeleteItemParams
-	paramPtrs          *ServiceMockDeleteItemParamPtrs
-	expectationOrigins ServiceMockDeleteItemExpectationOrigins
-	results            *ServiceMockDeleteItemResults
+	params             *ServiceMockApplyPromoCodeParams
+	paramPtrs          *ServiceMockApplyPromoCodeParamPtrs
+	expectationOrigins ServiceMockApplyPromoCodeExpectationOrigins
+	results            *ServiceMockApplyPromoCodeResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockDeleteItemParams contains parameters of the Service.DeleteItem
-type ServiceMockDeleteItemParams struct {
-	ctx  context.Context
-	data model.RequestData
+// ServiceMockApplyPromoCodeParams contains parameters of the Service.ApplyPromoCode
+type ServiceMockApplyPromoCodeParams struct {
+	ctx    context.Context
+	userID int64
+	code   string
 }
 
-// ServiceMockDeleteItemParamPtrs contains pointers to parameters of the Service.DeleteItem
-type ServiceMockDeleteItemParamPtrs struct {
-	ctx  *context.Context
-	data *model.RequestData
+// ServiceMockApplyPromoCodeParamPtrs contains pointers to parameters of the Service.ApplyPromoCode
+type ServiceMockApplyPromoCodeParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	code   *string
 }
 
-// ServiceMockDeleteItemResults contains results of the Service.DeleteItem
-type ServiceMockDeleteItemResults struct {
+// ServiceMockApplyPromoCodeResults contains results of the Service.ApplyPromoCode
+type ServiceMockApplyPromoCodeResults struct {
+	gp1 *model.GetItemsFromCartResponce
 	err error
 }
 
-// ServiceMockDeleteItemOrigins contains origins of expectations of the Service.DeleteItem
-type ServiceMockDeleteItemExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originData string
+// ServiceMockApplyPromoCodeOrigins contains origins of expectations of the Service.ApplyPromoCode
+type ServiceMockApplyPromoCodeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originCode   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -478,341 +582,375 @@ type ServiceMockDeleteItemExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteItem *mServiceMockDeleteItem) Optional() *mServiceMockDeleteItem {
-	mmDeleteItem.optional = true
-	return mmDeleteItem
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) Optional() *mServiceMockApplyPromoCode {
+	mmApplyPromoCode.optional = true
+	return mmApplyPromoCode
 }
 
-// Expect sets up expected params for Service.DeleteItem
-func (mmDeleteItem *mServiceMockDeleteItem) Expect(ctx context.Context, data model.RequestData) *mServiceMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+// Expect sets up expected params for Service.ApplyPromoCode
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) Expect(ctx context.Context, userID int64, code string) *mServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{}
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &ServiceMockApplyPromoCodeExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by ExpectParams functions")
+	if mmApplyPromoCode.defaultExpectation.paramPtrs != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteItem.defaultExpectation.params = &ServiceMockDeleteItemParams{ctx, data}
-	mmDeleteItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteItem.expectations {
-		if minimock.Equal(e.params, mmDeleteItem.defaultExpectation.params) {
-			mmDeleteItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItem.defaultExpectation.params)
+	mmApplyPromoCode.defaultExpectation.params = &ServiceMockApplyPromoCodeParams{ctx, userID, code}
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApplyPromoCode.expectations {
+		if minimock.Equal(e.params, mmApplyPromoCode.defaultExpectation.params) {
+			mmApplyPromoCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApplyPromoCode.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteItem
+	return mmApplyPromoCode
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.DeleteItem
-func (mmDeleteItem *mServiceMockDeleteItem) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.ApplyPromoCode
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) ExpectCtxParam1(ctx context.Context) *mServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{}
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &ServiceMockApplyPromoCodeExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.params != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Expect")
+	if mmApplyPromoCode.defaultExpectation.params != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Expect")
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteItem.defaultExpectation.paramPtrs = &ServiceMockDeleteItemParamPtrs{}
+	if mmApplyPromoCode.defaultExpectation.paramPtrs == nil {
+		mmApplyPromoCode.defaultExpectation.paramPtrs = &ServiceMockApplyPromoCodeParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmApplyPromoCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmApplyPromoCode
 }
 
-// ExpectDataParam2 sets up expected param data for Service.DeleteItem
-func (mmDeleteItem *mServiceMockDeleteItem) ExpectDataParam2(data model.RequestData) *mServiceMockDeleteItem {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Service.ApplyPromoCode
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) ExpectUserIDParam2(userID int64) *mServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Set")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{}
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &ServiceMockApplyPromoCodeExpectation{}
 	}
 
-	if mmDeleteItem.defaultExpectation.params != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Expect")
+	if mmApplyPromoCode.defaultExpectation.params != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Expect")
 	}
 
-	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
-		mmDeleteItem.defaultExpectation.paramPtrs = &ServiceMockDeleteItemParamPtrs{}
+	if mmApplyPromoCode.defaultExpectation.paramPtrs == nil {
+		mmApplyPromoCode.defaultExpectation.paramPtrs = &ServiceMockApplyPromoCodeParamPtrs{}
 	}
-	mmDeleteItem.defaultExpectation.paramPtrs.data = &data
-	mmDeleteItem.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+	mmApplyPromoCode.defaultExpectation.paramPtrs.userID = &userID
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmDeleteItem
+	return mmApplyPromoCode
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.DeleteItem
-func (mmDeleteItem *mServiceMockDeleteItem) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockDeleteItem {
-	if mmDeleteItem.mock.inspectFuncDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteItem")
+// ExpectCodeParam3 sets up expected param code for Service.ApplyPromoCode
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) ExpectCodeParam3(code string) *mServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Set")
 	}
 
-	mmDeleteItem.mock.inspectFuncDeleteItem = f
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &ServiceMockApplyPromoCodeExpectation{}
+	}
 
-	return mmDeleteItem
+	if mmApplyPromoCode.defaultExpectation.params != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Expect")
+	}
+
+	if mmApplyPromoCode.defaultExpectation.paramPtrs == nil {
+		mmApplyPromoCode.defaultExpectation.paramPtrs = &ServiceMockApplyPromoCodeParamPtrs{}
+	}
+	mmApplyPromoCode.defaultExpectation.paramPtrs.code = &code
+	mmApplyPromoCode.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmApplyPromoCode
 }
 
-// Return sets up results that will be returned by Service.DeleteItem
-func (mmDeleteItem *mServiceMockDeleteItem) Return(err error) *ServiceMock {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Service.ApplyPromoCode
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) Inspect(f func(ctx context.Context, userID int64, code string)) *mServiceMockApplyPromoCode {
+	if mmApplyPromoCode.mock.inspectFuncApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("Inspect function is already set for ServiceMock.ApplyPromoCode")
 	}
 
-	if mmDeleteItem.defaultExpectation == nil {
-		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{mock: mmDeleteItem.mock}
+	mmApplyPromoCode.mock.inspectFuncApplyPromoCode = f
+
+	return mmApplyPromoCode
+}
+
+// Return sets up results that will be returned by Service.ApplyPromoCode
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) Return(gp1 *model.GetItemsFromCartResponce, err error) *ServiceMock {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Set")
 	}
-	mmDeleteItem.defaultExpectation.results = &ServiceMockDeleteItemResults{err}
-	mmDeleteItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem.mock
+
+	if mmApplyPromoCode.defaultExpectation == nil {
+		mmApplyPromoCode.defaultExpectation = &ServiceMockApplyPromoCodeExpectation{mock: mmApplyPromoCode.mock}
+	}
+	mmApplyPromoCode.defaultExpectation.results = &ServiceMockApplyPromoCodeResults{gp1, err}
+	mmApplyPromoCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApplyPromoCode.mock
 }
 
-// Set uses given function f to mock the Service.DeleteItem method
-func (mmDeleteItem *mServiceMockDeleteItem) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
-	if mmDeleteItem.defaultExpectation != nil {
-		mmDeleteItem.mock.t.Fatalf("Default expectation is already set for the Service.DeleteItem method")
+// Set uses given function f to mock the Service.ApplyPromoCode method
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) Set(f func(ctx context.Context, userID int64, code string) (gp1 *model.GetItemsFromCartResponce, err error)) *ServiceMock {
+	if mmApplyPromoCode.defaultExpectation != nil {
+		mmApplyPromoCode.mock.t.Fatalf("Default expectation is already set for the Service.ApplyPromoCode method")
 	}
 
-	if len(mmDeleteItem.expectations) > 0 {
-		mmDeleteItem.mock.t.Fatalf("Some expectations are already set for the Service.DeleteItem method")
+	if len(mmApplyPromoCode.expectations) > 0 {
+		mmApplyPromoCode.mock.t.Fatalf("Some expectations are already set for the Service.ApplyPromoCode method")
 	}
 
-	mmDeleteItem.mock.funcDeleteItem = f
-	mmDeleteItem.mock.funcDeleteItemOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem.mock
+	mmApplyPromoCode.mock.funcApplyPromoCode = f
+	mmApplyPromoCode.mock.funcApplyPromoCodeOrigin = minimock.CallerInfo(1)
+	return mmApplyPromoCode.mock
 }
 
-// When sets expectation for the Service.DeleteItem which will trigger the result defined by the following
+// When sets expectation for the Service.ApplyPromoCode which will trigger the result defined by the following
 // Then helper
-func (mmDeleteItem *mServiceMockDeleteItem) When(ctx context.Context, data model.RequestData) *ServiceMockDeleteItemExpectation {
-	if mmDeleteItem.mock.funcDeleteItem != nil {
-		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) When(ctx context.Context, userID int64, code string) *ServiceMockApplyPromoCodeExpectation {
+	if mmApplyPromoCode.mock.funcApplyPromoCode != nil {
+		mmApplyPromoCode.mock.t.Fatalf("ServiceMock.ApplyPromoCode mock is already set by Set")
 	}
 
-	expectation := &ServiceMockDeleteItemExpectation{
-		mock:               mmDeleteItem.mock,
-		params:             &ServiceMockDeleteItemParams{ctx, data},
-		expectationOrigins: ServiceMockDeleteItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockApplyPromoCodeExpectation{
+		mock:               mmApplyPromoCode.mock,
+		params:             &ServiceMockApplyPromoCodeParams{ctx, userID, code},
+		expectationOrigins: ServiceMockApplyPromoCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteItem.expectations = append(mmDeleteItem.expectations, expectation)
+	mmApplyPromoCode.expectations = append(mmApplyPromoCode.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.DeleteItem return parameters for the expectation previously defined by the When method
-func (e *ServiceMockDeleteItemExpectation) Then(err error) *ServiceMock {
-	e.results = &ServiceMockDeleteItemResults{err}
+// Then sets up Service.ApplyPromoCode return parameters for the expectation previously defined by the When method
+func (e *ServiceMockApplyPromoCodeExpectation) Then(gp1 *model.GetItemsFromCartResponce, err error) *ServiceMock {
+	e.results = &ServiceMockApplyPromoCodeResults{gp1, err}
 	return e.mock
 }
 
-// Times sets number of times Service.DeleteItem should be invoked
-func (mmDeleteItem *mServiceMockDeleteItem) Times(n uint64) *mServiceMockDeleteItem {
+// Times sets number of times Service.ApplyPromoCode should be invoked
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) Times(n uint64) *mServiceMockApplyPromoCode {
 	if n == 0 {
-		mmDeleteItem.mock.t.Fatalf("Times of ServiceMock.DeleteItem mock can not be zero")
+		mmApplyPromoCode.mock.t.Fatalf("Times of ServiceMock.ApplyPromoCode mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteItem.expectedInvocations, n)
-	mmDeleteItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteItem
+	mm_atomic.StoreUint64(&mmApplyPromoCode.expectedInvocations, n)
+	mmApplyPromoCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApplyPromoCode
 }
 
-func (mmDeleteItem *mServiceMockDeleteItem) invocationsDone() bool {
-	if len(mmDeleteItem.expectations) == 0 && mmDeleteItem.defaultExpectation == nil && mmDeleteItem.mock.funcDeleteItem == nil {
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) invocationsDone() bool {
+	if len(mmApplyPromoCode.expectations) == 0 && mmApplyPromoCode.defaultExpectation == nil && mmApplyPromoCode.mock.funcApplyPromoCode == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItem.mock.afterDeleteItemCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItem.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmApplyPromoCode.mock.afterApplyPromoCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApplyPromoCode.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteItem implements mm_server.Service
-func (mmDeleteItem *ServiceMock) DeleteItem(ctx context.Context, data model.RequestData) (err error) {
-	mm_atomic.AddUint64(&mmDeleteItem.beforeDeleteItemCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteItem.afterDeleteItemCounter, 1)
+// ApplyPromoCode implements mm_server.Service
+func (mmApplyPromoCode *ServiceMock) ApplyPromoCode(ctx context.Context, userID int64, code string) (gp1 *model.GetItemsFromCartResponce, err error) {
+	mm_atomic.AddUint64(&mmApplyPromoCode.beforeApplyPromoCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmApplyPromoCode.afterApplyPromoCodeCounter, 1)
 
-	mmDeleteItem.t.Helper()
+	mmApplyPromoCode.t.Helper()
 
-	if mmDeleteItem.inspectFuncDeleteItem != nil {
-		mmDeleteItem.inspectFuncDeleteItem(ctx, data)
+	if mmApplyPromoCode.inspectFuncApplyPromoCode != nil {
+		mmApplyPromoCode.inspectFuncApplyPromoCode(ctx, userID, code)
 	}
 
-	mm_params := ServiceMockDeleteItemParams{ctx, data}
+	mm_params := ServiceMockApplyPromoCodeParams{ctx, userID, code}
 
 	// Record call args
-	mmDeleteItem.DeleteItemMock.mutex.Lock()
-	mmDeleteItem.DeleteItemMock.callArgs = append(mmDeleteItem.DeleteItemMock.callArgs, &mm_params)
-	mmDeleteItem.DeleteItemMock.mutex.Unlock()
+	mmApplyPromoCode.ApplyPromoCodeMock.mutex.Lock()
+	mmApplyPromoCode.ApplyPromoCodeMock.callArgs = append(mmApplyPromoCode.ApplyPromoCodeMock.callArgs, &mm_params)
+	mmApplyPromoCode.ApplyPromoCodeMock.mutex.Unlock()
 
-	for _, e := range mmDeleteItem.DeleteItemMock.expectations {
+	for _, e := range mmApplyPromoCode.ApplyPromoCodeMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.err
+			return e.results.gp1, e.results.err
 		}
 	}
 
-	if mmDeleteItem.DeleteItemMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteItem.DeleteItemMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteItem.DeleteItemMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteItem.DeleteItemMock.defaultExpectation.paramPtrs
+	if mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockDeleteItemParams{ctx, data}
+		mm_got := ServiceMockApplyPromoCodeParams{ctx, userID, code}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteItem.t.Errorf("ServiceMock.DeleteItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmApplyPromoCode.t.Errorf("ServiceMock.ApplyPromoCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
-				mmDeleteItem.t.Errorf("ServiceMock.DeleteItem got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmApplyPromoCode.t.Errorf("ServiceMock.ApplyPromoCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmApplyPromoCode.t.Errorf("ServiceMock.ApplyPromoCode got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteItem.t.Errorf("ServiceMock.DeleteItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmApplyPromoCode.t.Errorf("ServiceMock.ApplyPromoCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteItem.DeleteItemMock.defaultExpectation.results
+		mm_results := mmApplyPromoCode.ApplyPromoCodeMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteItem.t.Fatal("No results are set for the ServiceMock.DeleteItem")
+			mmApplyPromoCode.t.Fatal("No results are set for the ServiceMock.ApplyPromoCode")
 		}
-		return (*mm_results).err
+		return (*mm_results).gp1, (*mm_results).err
 	}
-	if mmDeleteItem.funcDeleteItem != nil {
-		return mmDeleteItem.funcDeleteItem(ctx, data)
+	if mmApplyPromoCode.funcApplyPromoCode != nil {
+		return mmApplyPromoCode.funcApplyPromoCode(ctx, userID, code)
 	}
-	mmDeleteItem.t.Fatalf("Unexpected call to ServiceMock.DeleteItem. %v %v", ctx, data)
+	mmApplyPromoCode.t.Fatalf("Unexpected call to ServiceMock.ApplyPromoCode. %v %v %v", ctx, userID, code)
 	return
 }
 
-// DeleteItemAfterCounter returns a count of finished ServiceMock.DeleteItem invocations
-func (mmDeleteItem *ServiceMock) DeleteItemAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItem.afterDeleteItemCounter)
+// ApplyPromoCodeAfterCounter returns a count of finished ServiceMock.ApplyPromoCode invocations
+func (mmApplyPromoCode *ServiceMock) ApplyPromoCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPromoCode.afterApplyPromoCodeCounter)
 }
 
-// DeleteItemBeforeCounter returns a count of ServiceMock.DeleteItem invocations
-func (mmDeleteItem *ServiceMock) DeleteItemBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItem.beforeDeleteItemCounter)
+// ApplyPromoCodeBeforeCounter returns a count of ServiceMock.ApplyPromoCode invocations
+func (mmApplyPromoCode *ServiceMock) ApplyPromoCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApplyPromoCode.beforeApplyPromoCodeCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.DeleteItem.
+// Calls returns a list of arguments used in each call to ServiceMock.ApplyPromoCode.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteItem *mServiceMockDeleteItem) Calls() []*ServiceMockDeleteItemParams {
-	mmDeleteItem.mutex.RLock()
+func (mmApplyPromoCode *mServiceMockApplyPromoCode) Calls() []*ServiceMockApplyPromoCodeParams {
+	mmApplyPromoCode.mutex.RLock()
 
-	argCopy := make([]*ServiceMockDeleteItemParams, len(mmDeleteItem.callArgs))
-	copy(argCopy, mmDeleteItem.callArgs)
+	argCopy := make([]*ServiceMockApplyPromoCodeParams, len(mmApplyPromoCode.callArgs))
+	copy(argCopy, mmApplyPromoCode.callArgs)
 
-	mmDeleteItem.mutex.RUnlock()
+	mmApplyPromoCode.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteItemDone returns true if the count of the DeleteItem invocations corresponds
+// MinimockApplyPromoCodeDone returns true if the count of the ApplyPromoCode invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockDeleteItemDone() bool {
-	if m.DeleteItemMock.optional {
+func (m *ServiceMock) MinimockApplyPromoCodeDone() bool {
+	if m.ApplyPromoCodeMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteItemMock.expectations {
+	for _, e := range m.ApplyPromoCodeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteItemMock.invocationsDone()
+	return m.ApplyPromoCodeMock.invocationsDone()
 }
 
-// MinimockDeleteItemInspect logs each unmet expectation
-func (m *ServiceMock) MinimockDeleteItemInspect() {
-	for _, e := range m.DeleteItemMock.expectations {
+// MinimockApplyPromoCodeInspect logs each unmet expectation
+func (m *ServiceMock) MinimockApplyPromoCodeInspect() {
+	for _, e := range m.ApplyPromoCodeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.ApplyPromoCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteItemCounter := mm_atomic.LoadUint64(&m.afterDeleteItemCounter)
+	afterApplyPromoCodeCounter := mm_atomic.LoadUint64(&m.afterApplyPromoCodeCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteItemMock.defaultExpectation != nil && afterDeleteItemCounter < 1 {
-		if m.DeleteItemMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s", m.DeleteItemMock.defaultExpectation.returnOrigin)
+	if m.ApplyPromoCodeMock.defaultExpectation != nil && afterApplyPromoCodeCounter < 1 {
+		if m.ApplyPromoCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.ApplyPromoCode at\n%s", m.ApplyPromoCodeMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s with params: %#v", m.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.ApplyPromoCode at\n%s with params: %#v", m.ApplyPromoCodeMock.defaultExpectation.expectationOrigins.origin, *m.ApplyPromoCodeMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteItem != nil && afterDeleteItemCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s", m.funcDeleteItemOrigin)
+	if m.funcApplyPromoCode != nil && afterApplyPromoCodeCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.ApplyPromoCode at\n%s", m.funcApplyPromoCodeOrigin)
 	}
 
-	if !m.DeleteItemMock.invocationsDone() && afterDeleteItemCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.DeleteItem at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteItemMock.expectedInvocations), m.DeleteItemMock.expectedInvocationsOrigin, afterDeleteItemCounter)
+	if !m.ApplyPromoCodeMock.invocationsDone() && afterApplyPromoCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.ApplyPromoCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyPromoCodeMock.expectedInvocations), m.ApplyPromoCodeMock.expectedInvocationsOrigin, afterApplyPromoCodeCounter)
 	}
 }
 
-type mServiceMockDeleteItemsByUserID struct {
+type mServiceMockClaimCart struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockDeleteItemsByUserIDExpectation
-	expectations       []*ServiceMockDeleteItemsByUserIDExpectation
+	defaultExpectation *ServiceMockClaimCartExpectation
+	expectations       []*ServiceMockClaimCartExpectation
 
-	callArgs []*ServiceMockDeleteItemsByUserIDParams
+	callArgs []*ServiceMockClaimCartParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockDeleteItemsByUserIDExpectation specifies expectation struct of the Service.DeleteItemsByUserID
-type ServiceMockDeleteItemsByUserIDExpectation struct {
+// ServiceMockClaimCartExpectation specifies expectation struct of the Service.ClaimCart
+type ServiceMockClaimCartExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockDeleteItemsByUserIDParams
-	paramPtrs          *ServiceMockDeleteItemsByUserIDParamPtrs
-	expectationOrigins ServiceMockDeleteItemsByUserIDExpectationOrigins
-	results            *ServiceMockDeleteItemsByUserIDResults
+	params             *ServiceMockClaimCartParams
+	paramPtrs          *ServiceMockClaimCartParamPtrs
+	expectationOrigins ServiceMockClaimCartExpectationOrigins
+	results            *ServiceMockClaimCartResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockDeleteItemsByUserIDParams contains parameters of the Service.DeleteItemsByUserID
-type ServiceMockDeleteItemsByUserIDParams struct {
-	ctx  context.Context
-	data model.RequestData
+// ServiceMockClaimCartParams contains parameters of the Service.ClaimCart
+type ServiceMockClaimCartParams struct {
+	ctx     context.Context
+	UserID  int64
+	items   *model.GetItemsFromCartResponce
+	orderID int64
 }
 
-// ServiceMockDeleteItemsByUserIDParamPtrs contains pointers to parameters of the Service.DeleteItemsByUserID
-type ServiceMockDeleteItemsByUserIDParamPtrs struct {
-	ctx  *context.Context
-	data *model.RequestData
+// ServiceMockClaimCartParamPtrs contains pointers to parameters of the Service.ClaimCart
+type ServiceMockClaimCartParamPtrs struct {
+	ctx     *context.Context
+	UserID  *int64
+	items   **model.GetItemsFromCartResponce
+	orderID *int64
 }
 
-// ServiceMockDeleteItemsByUserIDResults contains results of the Service.DeleteItemsByUserID
-type ServiceMockDeleteItemsByUserIDResults struct {
+// ServiceMockClaimCartResults contains results of the Service.ClaimCart
+type ServiceMockClaimCartResults struct {
 	err error
 }
 
-// ServiceMockDeleteItemsByUserIDOrigins contains origins of expectations of the Service.DeleteItemsByUserID
-type ServiceMockDeleteItemsByUserIDExpectationOrigins struct {
-	origin     string
-	originCtx  string
-	originData string
+// ServiceMockClaimCartOrigins contains origins of expectations of the Service.ClaimCart
+type ServiceMockClaimCartExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originItems   string
+	originOrderID string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -820,339 +958,3881 @@ type ServiceMockDeleteItemsByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Optional() *mServiceMockDeleteItemsByUserID {
-	mmDeleteItemsByUserID.optional = true
-	return mmDeleteItemsByUserID
+func (mmClaimCart *mServiceMockClaimCart) Optional() *mServiceMockClaimCart {
+	mmClaimCart.optional = true
+	return mmClaimCart
 }
 
-// Expect sets up expected params for Service.DeleteItemsByUserID
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Expect(ctx context.Context, data model.RequestData) *mServiceMockDeleteItemsByUserID {
-	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+// Expect sets up expected params for Service.ClaimCart
+func (mmClaimCart *mServiceMockClaimCart) Expect(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64) *mServiceMockClaimCart {
+	if mmClaimCart.mock.funcClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Set")
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation == nil {
-		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{}
+	if mmClaimCart.defaultExpectation == nil {
+		mmClaimCart.defaultExpectation = &ServiceMockClaimCartExpectation{}
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation.paramPtrs != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by ExpectParams functions")
+	if mmClaimCart.defaultExpectation.paramPtrs != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteItemsByUserID.defaultExpectation.params = &ServiceMockDeleteItemsByUserIDParams{ctx, data}
-	mmDeleteItemsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteItemsByUserID.expectations {
-		if minimock.Equal(e.params, mmDeleteItemsByUserID.defaultExpectation.params) {
-			mmDeleteItemsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItemsByUserID.defaultExpectation.params)
+	mmClaimCart.defaultExpectation.params = &ServiceMockClaimCartParams{ctx, UserID, items, orderID}
+	mmClaimCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmClaimCart.expectations {
+		if minimock.Equal(e.params, mmClaimCart.defaultExpectation.params) {
+			mmClaimCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmClaimCart.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteItemsByUserID
+	return mmClaimCart
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.DeleteItemsByUserID
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteItemsByUserID {
-	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
+// ExpectCtxParam1 sets up expected param ctx for Service.ClaimCart
+func (mmClaimCart *mServiceMockClaimCart) ExpectCtxParam1(ctx context.Context) *mServiceMockClaimCart {
+	if mmClaimCart.mock.funcClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Set")
+	}
+
+	if mmClaimCart.defaultExpectation == nil {
+		mmClaimCart.defaultExpectation = &ServiceMockClaimCartExpectation{}
+	}
+
+	if mmClaimCart.defaultExpectation.params != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Expect")
+	}
+
+	if mmClaimCart.defaultExpectation.paramPtrs == nil {
+		mmClaimCart.defaultExpectation.paramPtrs = &ServiceMockClaimCartParamPtrs{}
+	}
+	mmClaimCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmClaimCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmClaimCart
+}
+
+// ExpectUserIDParam2 sets up expected param UserID for Service.ClaimCart
+func (mmClaimCart *mServiceMockClaimCart) ExpectUserIDParam2(UserID int64) *mServiceMockClaimCart {
+	if mmClaimCart.mock.funcClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Set")
+	}
+
+	if mmClaimCart.defaultExpectation == nil {
+		mmClaimCart.defaultExpectation = &ServiceMockClaimCartExpectation{}
+	}
+
+	if mmClaimCart.defaultExpectation.params != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Expect")
+	}
+
+	if mmClaimCart.defaultExpectation.paramPtrs == nil {
+		mmClaimCart.defaultExpectation.paramPtrs = &ServiceMockClaimCartParamPtrs{}
+	}
+	mmClaimCart.defaultExpectation.paramPtrs.UserID = &UserID
+	mmClaimCart.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmClaimCart
+}
+
+// ExpectItemsParam3 sets up expected param items for Service.ClaimCart
+func (mmClaimCart *mServiceMockClaimCart) ExpectItemsParam3(items *model.GetItemsFromCartResponce) *mServiceMockClaimCart {
+	if mmClaimCart.mock.funcClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Set")
+	}
+
+	if mmClaimCart.defaultExpectation == nil {
+		mmClaimCart.defaultExpectation = &ServiceMockClaimCartExpectation{}
+	}
+
+	if mmClaimCart.defaultExpectation.params != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Expect")
+	}
+
+	if mmClaimCart.defaultExpectation.paramPtrs == nil {
+		mmClaimCart.defaultExpectation.paramPtrs = &ServiceMockClaimCartParamPtrs{}
+	}
+	mmClaimCart.defaultExpectation.paramPtrs.items = &items
+	mmClaimCart.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmClaimCart
+}
+
+// ExpectOrderIDParam4 sets up expected param orderID for Service.ClaimCart
+func (mmClaimCart *mServiceMockClaimCart) ExpectOrderIDParam4(orderID int64) *mServiceMockClaimCart {
+	if mmClaimCart.mock.funcClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Set")
+	}
+
+	if mmClaimCart.defaultExpectation == nil {
+		mmClaimCart.defaultExpectation = &ServiceMockClaimCartExpectation{}
+	}
+
+	if mmClaimCart.defaultExpectation.params != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Expect")
+	}
+
+	if mmClaimCart.defaultExpectation.paramPtrs == nil {
+		mmClaimCart.defaultExpectation.paramPtrs = &ServiceMockClaimCartParamPtrs{}
+	}
+	mmClaimCart.defaultExpectation.paramPtrs.orderID = &orderID
+	mmClaimCart.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmClaimCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.ClaimCart
+func (mmClaimCart *mServiceMockClaimCart) Inspect(f func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64)) *mServiceMockClaimCart {
+	if mmClaimCart.mock.inspectFuncClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("Inspect function is already set for ServiceMock.ClaimCart")
+	}
+
+	mmClaimCart.mock.inspectFuncClaimCart = f
+
+	return mmClaimCart
+}
+
+// Return sets up results that will be returned by Service.ClaimCart
+func (mmClaimCart *mServiceMockClaimCart) Return(err error) *ServiceMock {
+	if mmClaimCart.mock.funcClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Set")
+	}
+
+	if mmClaimCart.defaultExpectation == nil {
+		mmClaimCart.defaultExpectation = &ServiceMockClaimCartExpectation{mock: mmClaimCart.mock}
+	}
+	mmClaimCart.defaultExpectation.results = &ServiceMockClaimCartResults{err}
+	mmClaimCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmClaimCart.mock
+}
+
+// Set uses given function f to mock the Service.ClaimCart method
+func (mmClaimCart *mServiceMockClaimCart) Set(f func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64) (err error)) *ServiceMock {
+	if mmClaimCart.defaultExpectation != nil {
+		mmClaimCart.mock.t.Fatalf("Default expectation is already set for the Service.ClaimCart method")
+	}
+
+	if len(mmClaimCart.expectations) > 0 {
+		mmClaimCart.mock.t.Fatalf("Some expectations are already set for the Service.ClaimCart method")
+	}
+
+	mmClaimCart.mock.funcClaimCart = f
+	mmClaimCart.mock.funcClaimCartOrigin = minimock.CallerInfo(1)
+	return mmClaimCart.mock
+}
+
+// When sets expectation for the Service.ClaimCart which will trigger the result defined by the following
+// Then helper
+func (mmClaimCart *mServiceMockClaimCart) When(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64) *ServiceMockClaimCartExpectation {
+	if mmClaimCart.mock.funcClaimCart != nil {
+		mmClaimCart.mock.t.Fatalf("ServiceMock.ClaimCart mock is already set by Set")
+	}
+
+	expectation := &ServiceMockClaimCartExpectation{
+		mock:               mmClaimCart.mock,
+		params:             &ServiceMockClaimCartParams{ctx, UserID, items, orderID},
+		expectationOrigins: ServiceMockClaimCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmClaimCart.expectations = append(mmClaimCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.ClaimCart return parameters for the expectation previously defined by the When method
+func (e *ServiceMockClaimCartExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockClaimCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.ClaimCart should be invoked
+func (mmClaimCart *mServiceMockClaimCart) Times(n uint64) *mServiceMockClaimCart {
+	if n == 0 {
+		mmClaimCart.mock.t.Fatalf("Times of ServiceMock.ClaimCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmClaimCart.expectedInvocations, n)
+	mmClaimCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmClaimCart
+}
+
+func (mmClaimCart *mServiceMockClaimCart) invocationsDone() bool {
+	if len(mmClaimCart.expectations) == 0 && mmClaimCart.defaultExpectation == nil && mmClaimCart.mock.funcClaimCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmClaimCart.mock.afterClaimCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmClaimCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ClaimCart implements mm_server.Service
+func (mmClaimCart *ServiceMock) ClaimCart(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64) (err error) {
+	mm_atomic.AddUint64(&mmClaimCart.beforeClaimCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmClaimCart.afterClaimCartCounter, 1)
+
+	mmClaimCart.t.Helper()
+
+	if mmClaimCart.inspectFuncClaimCart != nil {
+		mmClaimCart.inspectFuncClaimCart(ctx, UserID, items, orderID)
+	}
+
+	mm_params := ServiceMockClaimCartParams{ctx, UserID, items, orderID}
+
+	// Record call args
+	mmClaimCart.ClaimCartMock.mutex.Lock()
+	mmClaimCart.ClaimCartMock.callArgs = append(mmClaimCart.ClaimCartMock.callArgs, &mm_params)
+	mmClaimCart.ClaimCartMock.mutex.Unlock()
+
+	for _, e := range mmClaimCart.ClaimCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmClaimCart.ClaimCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmClaimCart.ClaimCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmClaimCart.ClaimCartMock.defaultExpectation.params
+		mm_want_ptrs := mmClaimCart.ClaimCartMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockClaimCartParams{ctx, UserID, items, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmClaimCart.t.Errorf("ServiceMock.ClaimCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimCart.ClaimCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.UserID != nil && !minimock.Equal(*mm_want_ptrs.UserID, mm_got.UserID) {
+				mmClaimCart.t.Errorf("ServiceMock.ClaimCart got unexpected parameter UserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimCart.ClaimCartMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.UserID, mm_got.UserID, minimock.Diff(*mm_want_ptrs.UserID, mm_got.UserID))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmClaimCart.t.Errorf("ServiceMock.ClaimCart got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimCart.ClaimCartMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmClaimCart.t.Errorf("ServiceMock.ClaimCart got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmClaimCart.ClaimCartMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmClaimCart.t.Errorf("ServiceMock.ClaimCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmClaimCart.ClaimCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmClaimCart.ClaimCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmClaimCart.t.Fatal("No results are set for the ServiceMock.ClaimCart")
+		}
+		return (*mm_results).err
+	}
+	if mmClaimCart.funcClaimCart != nil {
+		return mmClaimCart.funcClaimCart(ctx, UserID, items, orderID)
+	}
+	mmClaimCart.t.Fatalf("Unexpected call to ServiceMock.ClaimCart. %v %v %v %v", ctx, UserID, items, orderID)
+	return
+}
+
+// ClaimCartAfterCounter returns a count of finished ServiceMock.ClaimCart invocations
+func (mmClaimCart *ServiceMock) ClaimCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimCart.afterClaimCartCounter)
+}
+
+// ClaimCartBeforeCounter returns a count of ServiceMock.ClaimCart invocations
+func (mmClaimCart *ServiceMock) ClaimCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmClaimCart.beforeClaimCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.ClaimCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmClaimCart *mServiceMockClaimCart) Calls() []*ServiceMockClaimCartParams {
+	mmClaimCart.mutex.RLock()
+
+	argCopy := make([]*ServiceMockClaimCartParams, len(mmClaimCart.callArgs))
+	copy(argCopy, mmClaimCart.callArgs)
+
+	mmClaimCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockClaimCartDone returns true if the count of the ClaimCart invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockClaimCartDone() bool {
+	if m.ClaimCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ClaimCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ClaimCartMock.invocationsDone()
+}
+
+// MinimockClaimCartInspect logs each unmet expectation
+func (m *ServiceMock) MinimockClaimCartInspect() {
+	for _, e := range m.ClaimCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.ClaimCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterClaimCartCounter := mm_atomic.LoadUint64(&m.afterClaimCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ClaimCartMock.defaultExpectation != nil && afterClaimCartCounter < 1 {
+		if m.ClaimCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.ClaimCart at\n%s", m.ClaimCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.ClaimCart at\n%s with params: %#v", m.ClaimCartMock.defaultExpectation.expectationOrigins.origin, *m.ClaimCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcClaimCart != nil && afterClaimCartCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.ClaimCart at\n%s", m.funcClaimCartOrigin)
+	}
+
+	if !m.ClaimCartMock.invocationsDone() && afterClaimCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.ClaimCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ClaimCartMock.expectedInvocations), m.ClaimCartMock.expectedInvocationsOrigin, afterClaimCartCounter)
+	}
+}
+
+type mServiceMockDeleteItem struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockDeleteItemExpectation
+	expectations       []*ServiceMockDeleteItemExpectation
+
+	callArgs []*ServiceMockDeleteItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockDeleteItemExpectation specifies expectation struct of the Service.DeleteItem
+type ServiceMockDeleteItemExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockDeleteItemParams
+	paramPtrs          *ServiceMockDeleteItemParamPtrs
+	expectationOrigins ServiceMockDeleteItemExpectationOrigins
+	results            *ServiceMockDeleteItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockDeleteItemParams contains parameters of the Service.DeleteItem
+type ServiceMockDeleteItemParams struct {
+	ctx  context.Context
+	data model.RequestData
+}
+
+// ServiceMockDeleteItemParamPtrs contains pointers to parameters of the Service.DeleteItem
+type ServiceMockDeleteItemParamPtrs struct {
+	ctx  *context.Context
+	data *model.RequestData
+}
+
+// ServiceMockDeleteItemResults contains results of the Service.DeleteItem
+type ServiceMockDeleteItemResults struct {
+	err error
+}
+
+// ServiceMockDeleteItemOrigins contains origins of expectations of the Service.DeleteItem
+type ServiceMockDeleteItemExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originData string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteItem *mServiceMockDeleteItem) Optional() *mServiceMockDeleteItem {
+	mmDeleteItem.optional = true
+	return mmDeleteItem
+}
+
+// Expect sets up expected params for Service.DeleteItem
+func (mmDeleteItem *mServiceMockDeleteItem) Expect(ctx context.Context, data model.RequestData) *mServiceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteItem.defaultExpectation.params = &ServiceMockDeleteItemParams{ctx, data}
+	mmDeleteItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteItem.expectations {
+		if minimock.Equal(e.params, mmDeleteItem.defaultExpectation.params) {
+			mmDeleteItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.DeleteItem
+func (mmDeleteItem *mServiceMockDeleteItem) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &ServiceMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// ExpectDataParam2 sets up expected param data for Service.DeleteItem
+func (mmDeleteItem *mServiceMockDeleteItem) ExpectDataParam2(data model.RequestData) *mServiceMockDeleteItem {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{}
+	}
+
+	if mmDeleteItem.defaultExpectation.params != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Expect")
+	}
+
+	if mmDeleteItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteItem.defaultExpectation.paramPtrs = &ServiceMockDeleteItemParamPtrs{}
+	}
+	mmDeleteItem.defaultExpectation.paramPtrs.data = &data
+	mmDeleteItem.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmDeleteItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.DeleteItem
+func (mmDeleteItem *mServiceMockDeleteItem) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockDeleteItem {
+	if mmDeleteItem.mock.inspectFuncDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteItem")
+	}
+
+	mmDeleteItem.mock.inspectFuncDeleteItem = f
+
+	return mmDeleteItem
+}
+
+// Return sets up results that will be returned by Service.DeleteItem
+func (mmDeleteItem *mServiceMockDeleteItem) Return(err error) *ServiceMock {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+	}
+
+	if mmDeleteItem.defaultExpectation == nil {
+		mmDeleteItem.defaultExpectation = &ServiceMockDeleteItemExpectation{mock: mmDeleteItem.mock}
+	}
+	mmDeleteItem.defaultExpectation.results = &ServiceMockDeleteItemResults{err}
+	mmDeleteItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem.mock
+}
+
+// Set uses given function f to mock the Service.DeleteItem method
+func (mmDeleteItem *mServiceMockDeleteItem) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
+	if mmDeleteItem.defaultExpectation != nil {
+		mmDeleteItem.mock.t.Fatalf("Default expectation is already set for the Service.DeleteItem method")
+	}
+
+	if len(mmDeleteItem.expectations) > 0 {
+		mmDeleteItem.mock.t.Fatalf("Some expectations are already set for the Service.DeleteItem method")
+	}
+
+	mmDeleteItem.mock.funcDeleteItem = f
+	mmDeleteItem.mock.funcDeleteItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem.mock
+}
+
+// When sets expectation for the Service.DeleteItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteItem *mServiceMockDeleteItem) When(ctx context.Context, data model.RequestData) *ServiceMockDeleteItemExpectation {
+	if mmDeleteItem.mock.funcDeleteItem != nil {
+		mmDeleteItem.mock.t.Fatalf("ServiceMock.DeleteItem mock is already set by Set")
+	}
+
+	expectation := &ServiceMockDeleteItemExpectation{
+		mock:               mmDeleteItem.mock,
+		params:             &ServiceMockDeleteItemParams{ctx, data},
+		expectationOrigins: ServiceMockDeleteItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteItem.expectations = append(mmDeleteItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.DeleteItem return parameters for the expectation previously defined by the When method
+func (e *ServiceMockDeleteItemExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockDeleteItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.DeleteItem should be invoked
+func (mmDeleteItem *mServiceMockDeleteItem) Times(n uint64) *mServiceMockDeleteItem {
+	if n == 0 {
+		mmDeleteItem.mock.t.Fatalf("Times of ServiceMock.DeleteItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteItem.expectedInvocations, n)
+	mmDeleteItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteItem
+}
+
+func (mmDeleteItem *mServiceMockDeleteItem) invocationsDone() bool {
+	if len(mmDeleteItem.expectations) == 0 && mmDeleteItem.defaultExpectation == nil && mmDeleteItem.mock.funcDeleteItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItem.mock.afterDeleteItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteItem implements mm_server.Service
+func (mmDeleteItem *ServiceMock) DeleteItem(ctx context.Context, data model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmDeleteItem.beforeDeleteItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteItem.afterDeleteItemCounter, 1)
+
+	mmDeleteItem.t.Helper()
+
+	if mmDeleteItem.inspectFuncDeleteItem != nil {
+		mmDeleteItem.inspectFuncDeleteItem(ctx, data)
+	}
+
+	mm_params := ServiceMockDeleteItemParams{ctx, data}
+
+	// Record call args
+	mmDeleteItem.DeleteItemMock.mutex.Lock()
+	mmDeleteItem.DeleteItemMock.callArgs = append(mmDeleteItem.DeleteItemMock.callArgs, &mm_params)
+	mmDeleteItem.DeleteItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteItem.DeleteItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteItem.DeleteItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteItem.DeleteItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteItem.DeleteItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteItem.DeleteItemMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockDeleteItemParams{ctx, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteItem.t.Errorf("ServiceMock.DeleteItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmDeleteItem.t.Errorf("ServiceMock.DeleteItem got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteItem.t.Errorf("ServiceMock.DeleteItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteItem.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteItem.DeleteItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteItem.t.Fatal("No results are set for the ServiceMock.DeleteItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteItem.funcDeleteItem != nil {
+		return mmDeleteItem.funcDeleteItem(ctx, data)
+	}
+	mmDeleteItem.t.Fatalf("Unexpected call to ServiceMock.DeleteItem. %v %v", ctx, data)
+	return
+}
+
+// DeleteItemAfterCounter returns a count of finished ServiceMock.DeleteItem invocations
+func (mmDeleteItem *ServiceMock) DeleteItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItem.afterDeleteItemCounter)
+}
+
+// DeleteItemBeforeCounter returns a count of ServiceMock.DeleteItem invocations
+func (mmDeleteItem *ServiceMock) DeleteItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItem.beforeDeleteItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.DeleteItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteItem *mServiceMockDeleteItem) Calls() []*ServiceMockDeleteItemParams {
+	mmDeleteItem.mutex.RLock()
+
+	argCopy := make([]*ServiceMockDeleteItemParams, len(mmDeleteItem.callArgs))
+	copy(argCopy, mmDeleteItem.callArgs)
+
+	mmDeleteItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteItemDone returns true if the count of the DeleteItem invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockDeleteItemDone() bool {
+	if m.DeleteItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteItemMock.invocationsDone()
+}
+
+// MinimockDeleteItemInspect logs each unmet expectation
+func (m *ServiceMock) MinimockDeleteItemInspect() {
+	for _, e := range m.DeleteItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteItemCounter := mm_atomic.LoadUint64(&m.afterDeleteItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteItemMock.defaultExpectation != nil && afterDeleteItemCounter < 1 {
+		if m.DeleteItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s", m.DeleteItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s with params: %#v", m.DeleteItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteItem != nil && afterDeleteItemCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.DeleteItem at\n%s", m.funcDeleteItemOrigin)
+	}
+
+	if !m.DeleteItemMock.invocationsDone() && afterDeleteItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.DeleteItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteItemMock.expectedInvocations), m.DeleteItemMock.expectedInvocationsOrigin, afterDeleteItemCounter)
+	}
+}
+
+type mServiceMockDeleteItemsByUserID struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockDeleteItemsByUserIDExpectation
+	expectations       []*ServiceMockDeleteItemsByUserIDExpectation
+
+	callArgs []*ServiceMockDeleteItemsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockDeleteItemsByUserIDExpectation specifies expectation struct of the Service.DeleteItemsByUserID
+type ServiceMockDeleteItemsByUserIDExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockDeleteItemsByUserIDParams
+	paramPtrs          *ServiceMockDeleteItemsByUserIDParamPtrs
+	expectationOrigins ServiceMockDeleteItemsByUserIDExpectationOrigins
+	results            *ServiceMockDeleteItemsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockDeleteItemsByUserIDParams contains parameters of the Service.DeleteItemsByUserID
+type ServiceMockDeleteItemsByUserIDParams struct {
+	ctx  context.Context
+	data model.RequestData
+}
+
+// ServiceMockDeleteItemsByUserIDParamPtrs contains pointers to parameters of the Service.DeleteItemsByUserID
+type ServiceMockDeleteItemsByUserIDParamPtrs struct {
+	ctx  *context.Context
+	data *model.RequestData
+}
+
+// ServiceMockDeleteItemsByUserIDResults contains results of the Service.DeleteItemsByUserID
+type ServiceMockDeleteItemsByUserIDResults struct {
+	err error
+}
+
+// ServiceMockDeleteItemsByUserIDOrigins contains origins of expectations of the Service.DeleteItemsByUserID
+type ServiceMockDeleteItemsByUserIDExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originData string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Optional() *mServiceMockDeleteItemsByUserID {
+	mmDeleteItemsByUserID.optional = true
+	return mmDeleteItemsByUserID
+}
+
+// Expect sets up expected params for Service.DeleteItemsByUserID
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Expect(ctx context.Context, data model.RequestData) *mServiceMockDeleteItemsByUserID {
+	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation == nil {
+		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{}
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation.paramPtrs != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteItemsByUserID.defaultExpectation.params = &ServiceMockDeleteItemsByUserIDParams{ctx, data}
+	mmDeleteItemsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteItemsByUserID.expectations {
+		if minimock.Equal(e.params, mmDeleteItemsByUserID.defaultExpectation.params) {
+			mmDeleteItemsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItemsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteItemsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.DeleteItemsByUserID
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteItemsByUserID {
+	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation == nil {
+		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{}
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation.params != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteItemsByUserID.defaultExpectation.paramPtrs = &ServiceMockDeleteItemsByUserIDParamPtrs{}
+	}
+	mmDeleteItemsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteItemsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteItemsByUserID
+}
+
+// ExpectDataParam2 sets up expected param data for Service.DeleteItemsByUserID
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) ExpectDataParam2(data model.RequestData) *mServiceMockDeleteItemsByUserID {
+	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation == nil {
+		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{}
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation.params != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Expect")
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation.paramPtrs == nil {
+		mmDeleteItemsByUserID.defaultExpectation.paramPtrs = &ServiceMockDeleteItemsByUserIDParamPtrs{}
+	}
+	mmDeleteItemsByUserID.defaultExpectation.paramPtrs.data = &data
+	mmDeleteItemsByUserID.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmDeleteItemsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.DeleteItemsByUserID
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockDeleteItemsByUserID {
+	if mmDeleteItemsByUserID.mock.inspectFuncDeleteItemsByUserID != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteItemsByUserID")
+	}
+
+	mmDeleteItemsByUserID.mock.inspectFuncDeleteItemsByUserID = f
+
+	return mmDeleteItemsByUserID
+}
+
+// Return sets up results that will be returned by Service.DeleteItemsByUserID
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Return(err error) *ServiceMock {
+	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+	}
+
+	if mmDeleteItemsByUserID.defaultExpectation == nil {
+		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{mock: mmDeleteItemsByUserID.mock}
+	}
+	mmDeleteItemsByUserID.defaultExpectation.results = &ServiceMockDeleteItemsByUserIDResults{err}
+	mmDeleteItemsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteItemsByUserID.mock
+}
+
+// Set uses given function f to mock the Service.DeleteItemsByUserID method
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
+	if mmDeleteItemsByUserID.defaultExpectation != nil {
+		mmDeleteItemsByUserID.mock.t.Fatalf("Default expectation is already set for the Service.DeleteItemsByUserID method")
+	}
+
+	if len(mmDeleteItemsByUserID.expectations) > 0 {
+		mmDeleteItemsByUserID.mock.t.Fatalf("Some expectations are already set for the Service.DeleteItemsByUserID method")
+	}
+
+	mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID = f
+	mmDeleteItemsByUserID.mock.funcDeleteItemsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmDeleteItemsByUserID.mock
+}
+
+// When sets expectation for the Service.DeleteItemsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) When(ctx context.Context, data model.RequestData) *ServiceMockDeleteItemsByUserIDExpectation {
+	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
 		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation == nil {
-		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{}
+	expectation := &ServiceMockDeleteItemsByUserIDExpectation{
+		mock:               mmDeleteItemsByUserID.mock,
+		params:             &ServiceMockDeleteItemsByUserIDParams{ctx, data},
+		expectationOrigins: ServiceMockDeleteItemsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteItemsByUserID.expectations = append(mmDeleteItemsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.DeleteItemsByUserID return parameters for the expectation previously defined by the When method
+func (e *ServiceMockDeleteItemsByUserIDExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockDeleteItemsByUserIDResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.DeleteItemsByUserID should be invoked
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Times(n uint64) *mServiceMockDeleteItemsByUserID {
+	if n == 0 {
+		mmDeleteItemsByUserID.mock.t.Fatalf("Times of ServiceMock.DeleteItemsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteItemsByUserID.expectedInvocations, n)
+	mmDeleteItemsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteItemsByUserID
+}
+
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) invocationsDone() bool {
+	if len(mmDeleteItemsByUserID.expectations) == 0 && mmDeleteItemsByUserID.defaultExpectation == nil && mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItemsByUserID.mock.afterDeleteItemsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItemsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteItemsByUserID implements mm_server.Service
+func (mmDeleteItemsByUserID *ServiceMock) DeleteItemsByUserID(ctx context.Context, data model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmDeleteItemsByUserID.beforeDeleteItemsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteItemsByUserID.afterDeleteItemsByUserIDCounter, 1)
+
+	mmDeleteItemsByUserID.t.Helper()
+
+	if mmDeleteItemsByUserID.inspectFuncDeleteItemsByUserID != nil {
+		mmDeleteItemsByUserID.inspectFuncDeleteItemsByUserID(ctx, data)
+	}
+
+	mm_params := ServiceMockDeleteItemsByUserIDParams{ctx, data}
+
+	// Record call args
+	mmDeleteItemsByUserID.DeleteItemsByUserIDMock.mutex.Lock()
+	mmDeleteItemsByUserID.DeleteItemsByUserIDMock.callArgs = append(mmDeleteItemsByUserID.DeleteItemsByUserIDMock.callArgs, &mm_params)
+	mmDeleteItemsByUserID.DeleteItemsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmDeleteItemsByUserID.DeleteItemsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockDeleteItemsByUserIDParams{ctx, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteItemsByUserID.t.Errorf("ServiceMock.DeleteItemsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmDeleteItemsByUserID.t.Errorf("ServiceMock.DeleteItemsByUserID got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteItemsByUserID.t.Errorf("ServiceMock.DeleteItemsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteItemsByUserID.t.Fatal("No results are set for the ServiceMock.DeleteItemsByUserID")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteItemsByUserID.funcDeleteItemsByUserID != nil {
+		return mmDeleteItemsByUserID.funcDeleteItemsByUserID(ctx, data)
+	}
+	mmDeleteItemsByUserID.t.Fatalf("Unexpected call to ServiceMock.DeleteItemsByUserID. %v %v", ctx, data)
+	return
+}
+
+// DeleteItemsByUserIDAfterCounter returns a count of finished ServiceMock.DeleteItemsByUserID invocations
+func (mmDeleteItemsByUserID *ServiceMock) DeleteItemsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItemsByUserID.afterDeleteItemsByUserIDCounter)
+}
+
+// DeleteItemsByUserIDBeforeCounter returns a count of ServiceMock.DeleteItemsByUserID invocations
+func (mmDeleteItemsByUserID *ServiceMock) DeleteItemsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItemsByUserID.beforeDeleteItemsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.DeleteItemsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Calls() []*ServiceMockDeleteItemsByUserIDParams {
+	mmDeleteItemsByUserID.mutex.RLock()
+
+	argCopy := make([]*ServiceMockDeleteItemsByUserIDParams, len(mmDeleteItemsByUserID.callArgs))
+	copy(argCopy, mmDeleteItemsByUserID.callArgs)
+
+	mmDeleteItemsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteItemsByUserIDDone returns true if the count of the DeleteItemsByUserID invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockDeleteItemsByUserIDDone() bool {
+	if m.DeleteItemsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteItemsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteItemsByUserIDMock.invocationsDone()
+}
+
+// MinimockDeleteItemsByUserIDInspect logs each unmet expectation
+func (m *ServiceMock) MinimockDeleteItemsByUserIDInspect() {
+	for _, e := range m.DeleteItemsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteItemsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteItemsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteItemsByUserIDMock.defaultExpectation != nil && afterDeleteItemsByUserIDCounter < 1 {
+		if m.DeleteItemsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s", m.DeleteItemsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s with params: %#v", m.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteItemsByUserID != nil && afterDeleteItemsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s", m.funcDeleteItemsByUserIDOrigin)
+	}
+
+	if !m.DeleteItemsByUserIDMock.invocationsDone() && afterDeleteItemsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.DeleteItemsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteItemsByUserIDMock.expectedInvocations), m.DeleteItemsByUserIDMock.expectedInvocationsOrigin, afterDeleteItemsByUserIDCounter)
+	}
+}
+
+type mServiceMockDeleteSavedItem struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockDeleteSavedItemExpectation
+	expectations       []*ServiceMockDeleteSavedItemExpectation
+
+	callArgs []*ServiceMockDeleteSavedItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockDeleteSavedItemExpectation specifies expectation struct of the Service.DeleteSavedItem
+type ServiceMockDeleteSavedItemExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockDeleteSavedItemParams
+	paramPtrs          *ServiceMockDeleteSavedItemParamPtrs
+	expectationOrigins ServiceMockDeleteSavedItemExpectationOrigins
+	results            *ServiceMockDeleteSavedItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockDeleteSavedItemParams contains parameters of the Service.DeleteSavedItem
+type ServiceMockDeleteSavedItemParams struct {
+	ctx  context.Context
+	data model.RequestData
+}
+
+// ServiceMockDeleteSavedItemParamPtrs contains pointers to parameters of the Service.DeleteSavedItem
+type ServiceMockDeleteSavedItemParamPtrs struct {
+	ctx  *context.Context
+	data *model.RequestData
+}
+
+// ServiceMockDeleteSavedItemResults contains results of the Service.DeleteSavedItem
+type ServiceMockDeleteSavedItemResults struct {
+	err error
+}
+
+// ServiceMockDeleteSavedItemOrigins contains origins of expectations of the Service.DeleteSavedItem
+type ServiceMockDeleteSavedItemExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originData string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) Optional() *mServiceMockDeleteSavedItem {
+	mmDeleteSavedItem.optional = true
+	return mmDeleteSavedItem
+}
+
+// Expect sets up expected params for Service.DeleteSavedItem
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) Expect(ctx context.Context, data model.RequestData) *mServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &ServiceMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSavedItem.defaultExpectation.params = &ServiceMockDeleteSavedItemParams{ctx, data}
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSavedItem.expectations {
+		if minimock.Equal(e.params, mmDeleteSavedItem.defaultExpectation.params) {
+			mmDeleteSavedItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSavedItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSavedItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.DeleteSavedItem
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) ExpectCtxParam1(ctx context.Context) *mServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &ServiceMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &ServiceMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// ExpectDataParam2 sets up expected param data for Service.DeleteSavedItem
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) ExpectDataParam2(data model.RequestData) *mServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &ServiceMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &ServiceMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.data = &data
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.DeleteSavedItem
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteSavedItem")
+	}
+
+	mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem = f
+
+	return mmDeleteSavedItem
+}
+
+// Return sets up results that will be returned by Service.DeleteSavedItem
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) Return(err error) *ServiceMock {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &ServiceMockDeleteSavedItemExpectation{mock: mmDeleteSavedItem.mock}
+	}
+	mmDeleteSavedItem.defaultExpectation.results = &ServiceMockDeleteSavedItemResults{err}
+	mmDeleteSavedItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// Set uses given function f to mock the Service.DeleteSavedItem method
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
+	if mmDeleteSavedItem.defaultExpectation != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Default expectation is already set for the Service.DeleteSavedItem method")
+	}
+
+	if len(mmDeleteSavedItem.expectations) > 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Some expectations are already set for the Service.DeleteSavedItem method")
+	}
+
+	mmDeleteSavedItem.mock.funcDeleteSavedItem = f
+	mmDeleteSavedItem.mock.funcDeleteSavedItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// When sets expectation for the Service.DeleteSavedItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) When(ctx context.Context, data model.RequestData) *ServiceMockDeleteSavedItemExpectation {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("ServiceMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	expectation := &ServiceMockDeleteSavedItemExpectation{
+		mock:               mmDeleteSavedItem.mock,
+		params:             &ServiceMockDeleteSavedItemParams{ctx, data},
+		expectationOrigins: ServiceMockDeleteSavedItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSavedItem.expectations = append(mmDeleteSavedItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.DeleteSavedItem return parameters for the expectation previously defined by the When method
+func (e *ServiceMockDeleteSavedItemExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockDeleteSavedItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.DeleteSavedItem should be invoked
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) Times(n uint64) *mServiceMockDeleteSavedItem {
+	if n == 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Times of ServiceMock.DeleteSavedItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSavedItem.expectedInvocations, n)
+	mmDeleteSavedItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem
+}
+
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) invocationsDone() bool {
+	if len(mmDeleteSavedItem.expectations) == 0 && mmDeleteSavedItem.defaultExpectation == nil && mmDeleteSavedItem.mock.funcDeleteSavedItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.mock.afterDeleteSavedItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSavedItem implements mm_server.Service
+func (mmDeleteSavedItem *ServiceMock) DeleteSavedItem(ctx context.Context, data model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter, 1)
+
+	mmDeleteSavedItem.t.Helper()
+
+	if mmDeleteSavedItem.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.inspectFuncDeleteSavedItem(ctx, data)
+	}
+
+	mm_params := ServiceMockDeleteSavedItemParams{ctx, data}
+
+	// Record call args
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Lock()
+	mmDeleteSavedItem.DeleteSavedItemMock.callArgs = append(mmDeleteSavedItem.DeleteSavedItemMock.callArgs, &mm_params)
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSavedItem.DeleteSavedItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockDeleteSavedItemParams{ctx, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSavedItem.t.Errorf("ServiceMock.DeleteSavedItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmDeleteSavedItem.t.Errorf("ServiceMock.DeleteSavedItem got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSavedItem.t.Errorf("ServiceMock.DeleteSavedItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSavedItem.t.Fatal("No results are set for the ServiceMock.DeleteSavedItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSavedItem.funcDeleteSavedItem != nil {
+		return mmDeleteSavedItem.funcDeleteSavedItem(ctx, data)
+	}
+	mmDeleteSavedItem.t.Fatalf("Unexpected call to ServiceMock.DeleteSavedItem. %v %v", ctx, data)
+	return
+}
+
+// DeleteSavedItemAfterCounter returns a count of finished ServiceMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *ServiceMock) DeleteSavedItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter)
+}
+
+// DeleteSavedItemBeforeCounter returns a count of ServiceMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *ServiceMock) DeleteSavedItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.DeleteSavedItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSavedItem *mServiceMockDeleteSavedItem) Calls() []*ServiceMockDeleteSavedItemParams {
+	mmDeleteSavedItem.mutex.RLock()
+
+	argCopy := make([]*ServiceMockDeleteSavedItemParams, len(mmDeleteSavedItem.callArgs))
+	copy(argCopy, mmDeleteSavedItem.callArgs)
+
+	mmDeleteSavedItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSavedItemDone returns true if the count of the DeleteSavedItem invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockDeleteSavedItemDone() bool {
+	if m.DeleteSavedItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSavedItemMock.invocationsDone()
+}
+
+// MinimockDeleteSavedItemInspect logs each unmet expectation
+func (m *ServiceMock) MinimockDeleteSavedItemInspect() {
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.DeleteSavedItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSavedItemCounter := mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedItemMock.defaultExpectation != nil && afterDeleteSavedItemCounter < 1 {
+		if m.DeleteSavedItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.DeleteSavedItem at\n%s", m.DeleteSavedItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.DeleteSavedItem at\n%s with params: %#v", m.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSavedItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedItem != nil && afterDeleteSavedItemCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.DeleteSavedItem at\n%s", m.funcDeleteSavedItemOrigin)
+	}
+
+	if !m.DeleteSavedItemMock.invocationsDone() && afterDeleteSavedItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.DeleteSavedItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSavedItemMock.expectedInvocations), m.DeleteSavedItemMock.expectedInvocationsOrigin, afterDeleteSavedItemCounter)
+	}
+}
+
+type mServiceMockGetCartVersion struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetCartVersionExpectation
+	expectations       []*ServiceMockGetCartVersionExpectation
+
+	callArgs []*ServiceMockGetCartVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetCartVersionExpectation specifies expectation struct of the Service.GetCartVersion
+type ServiceMockGetCartVersionExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetCartVersionParams
+	paramPtrs          *ServiceMockGetCartVersionParamPtrs
+	expectationOrigins ServiceMockGetCartVersionExpectationOrigins
+	results            *ServiceMockGetCartVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetCartVersionParams contains parameters of the Service.GetCartVersion
+type ServiceMockGetCartVersionParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// ServiceMockGetCartVersionParamPtrs contains pointers to parameters of the Service.GetCartVersion
+type ServiceMockGetCartVersionParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// ServiceMockGetCartVersionResults contains results of the Service.GetCartVersion
+type ServiceMockGetCartVersionResults struct {
+	u1  uint64
+	err error
+}
+
+// ServiceMockGetCartVersionOrigins contains origins of expectations of the Service.GetCartVersion
+type ServiceMockGetCartVersionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCartVersion *mServiceMockGetCartVersion) Optional() *mServiceMockGetCartVersion {
+	mmGetCartVersion.optional = true
+	return mmGetCartVersion
+}
+
+// Expect sets up expected params for Service.GetCartVersion
+func (mmGetCartVersion *mServiceMockGetCartVersion) Expect(ctx context.Context, userID int64) *mServiceMockGetCartVersion {
+	if mmGetCartVersion.mock.funcGetCartVersion != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by Set")
+	}
+
+	if mmGetCartVersion.defaultExpectation == nil {
+		mmGetCartVersion.defaultExpectation = &ServiceMockGetCartVersionExpectation{}
+	}
+
+	if mmGetCartVersion.defaultExpectation.paramPtrs != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetCartVersion.defaultExpectation.params = &ServiceMockGetCartVersionParams{ctx, userID}
+	mmGetCartVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCartVersion.expectations {
+		if minimock.Equal(e.params, mmGetCartVersion.defaultExpectation.params) {
+			mmGetCartVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCartVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCartVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetCartVersion
+func (mmGetCartVersion *mServiceMockGetCartVersion) ExpectCtxParam1(ctx context.Context) *mServiceMockGetCartVersion {
+	if mmGetCartVersion.mock.funcGetCartVersion != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by Set")
+	}
+
+	if mmGetCartVersion.defaultExpectation == nil {
+		mmGetCartVersion.defaultExpectation = &ServiceMockGetCartVersionExpectation{}
+	}
+
+	if mmGetCartVersion.defaultExpectation.params != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by Expect")
+	}
+
+	if mmGetCartVersion.defaultExpectation.paramPtrs == nil {
+		mmGetCartVersion.defaultExpectation.paramPtrs = &ServiceMockGetCartVersionParamPtrs{}
+	}
+	mmGetCartVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCartVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCartVersion
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.GetCartVersion
+func (mmGetCartVersion *mServiceMockGetCartVersion) ExpectUserIDParam2(userID int64) *mServiceMockGetCartVersion {
+	if mmGetCartVersion.mock.funcGetCartVersion != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by Set")
+	}
+
+	if mmGetCartVersion.defaultExpectation == nil {
+		mmGetCartVersion.defaultExpectation = &ServiceMockGetCartVersionExpectation{}
+	}
+
+	if mmGetCartVersion.defaultExpectation.params != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by Expect")
+	}
+
+	if mmGetCartVersion.defaultExpectation.paramPtrs == nil {
+		mmGetCartVersion.defaultExpectation.paramPtrs = &ServiceMockGetCartVersionParamPtrs{}
+	}
+	mmGetCartVersion.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCartVersion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCartVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetCartVersion
+func (mmGetCartVersion *mServiceMockGetCartVersion) Inspect(f func(ctx context.Context, userID int64)) *mServiceMockGetCartVersion {
+	if mmGetCartVersion.mock.inspectFuncGetCartVersion != nil {
+		mmGetCartVersion.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetCartVersion")
+	}
+
+	mmGetCartVersion.mock.inspectFuncGetCartVersion = f
+
+	return mmGetCartVersion
+}
+
+// Return sets up results that will be returned by Service.GetCartVersion
+func (mmGetCartVersion *mServiceMockGetCartVersion) Return(u1 uint64, err error) *ServiceMock {
+	if mmGetCartVersion.mock.funcGetCartVersion != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by Set")
+	}
+
+	if mmGetCartVersion.defaultExpectation == nil {
+		mmGetCartVersion.defaultExpectation = &ServiceMockGetCartVersionExpectation{mock: mmGetCartVersion.mock}
+	}
+	mmGetCartVersion.defaultExpectation.results = &ServiceMockGetCartVersionResults{u1, err}
+	mmGetCartVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCartVersion.mock
+}
+
+// Set uses given function f to mock the Service.GetCartVersion method
+func (mmGetCartVersion *mServiceMockGetCartVersion) Set(f func(ctx context.Context, userID int64) (u1 uint64, err error)) *ServiceMock {
+	if mmGetCartVersion.defaultExpectation != nil {
+		mmGetCartVersion.mock.t.Fatalf("Default expectation is already set for the Service.GetCartVersion method")
+	}
+
+	if len(mmGetCartVersion.expectations) > 0 {
+		mmGetCartVersion.mock.t.Fatalf("Some expectations are already set for the Service.GetCartVersion method")
+	}
+
+	mmGetCartVersion.mock.funcGetCartVersion = f
+	mmGetCartVersion.mock.funcGetCartVersionOrigin = minimock.CallerInfo(1)
+	return mmGetCartVersion.mock
+}
+
+// When sets expectation for the Service.GetCartVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetCartVersion *mServiceMockGetCartVersion) When(ctx context.Context, userID int64) *ServiceMockGetCartVersionExpectation {
+	if mmGetCartVersion.mock.funcGetCartVersion != nil {
+		mmGetCartVersion.mock.t.Fatalf("ServiceMock.GetCartVersion mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetCartVersionExpectation{
+		mock:               mmGetCartVersion.mock,
+		params:             &ServiceMockGetCartVersionParams{ctx, userID},
+		expectationOrigins: ServiceMockGetCartVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCartVersion.expectations = append(mmGetCartVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetCartVersion return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetCartVersionExpectation) Then(u1 uint64, err error) *ServiceMock {
+	e.results = &ServiceMockGetCartVersionResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetCartVersion should be invoked
+func (mmGetCartVersion *mServiceMockGetCartVersion) Times(n uint64) *mServiceMockGetCartVersion {
+	if n == 0 {
+		mmGetCartVersion.mock.t.Fatalf("Times of ServiceMock.GetCartVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCartVersion.expectedInvocations, n)
+	mmGetCartVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCartVersion
+}
+
+func (mmGetCartVersion *mServiceMockGetCartVersion) invocationsDone() bool {
+	if len(mmGetCartVersion.expectations) == 0 && mmGetCartVersion.defaultExpectation == nil && mmGetCartVersion.mock.funcGetCartVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCartVersion.mock.afterGetCartVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCartVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCartVersion implements mm_server.Service
+func (mmGetCartVersion *ServiceMock) GetCartVersion(ctx context.Context, userID int64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmGetCartVersion.beforeGetCartVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCartVersion.afterGetCartVersionCounter, 1)
+
+	mmGetCartVersion.t.Helper()
+
+	if mmGetCartVersion.inspectFuncGetCartVersion != nil {
+		mmGetCartVersion.inspectFuncGetCartVersion(ctx, userID)
+	}
+
+	mm_params := ServiceMockGetCartVersionParams{ctx, userID}
+
+	// Record call args
+	mmGetCartVersion.GetCartVersionMock.mutex.Lock()
+	mmGetCartVersion.GetCartVersionMock.callArgs = append(mmGetCartVersion.GetCartVersionMock.callArgs, &mm_params)
+	mmGetCartVersion.GetCartVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetCartVersion.GetCartVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetCartVersion.GetCartVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCartVersion.GetCartVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCartVersion.GetCartVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCartVersion.GetCartVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetCartVersionParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCartVersion.t.Errorf("ServiceMock.GetCartVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartVersion.GetCartVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCartVersion.t.Errorf("ServiceMock.GetCartVersion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCartVersion.GetCartVersionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCartVersion.t.Errorf("ServiceMock.GetCartVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCartVersion.GetCartVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCartVersion.GetCartVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCartVersion.t.Fatal("No results are set for the ServiceMock.GetCartVersion")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetCartVersion.funcGetCartVersion != nil {
+		return mmGetCartVersion.funcGetCartVersion(ctx, userID)
+	}
+	mmGetCartVersion.t.Fatalf("Unexpected call to ServiceMock.GetCartVersion. %v %v", ctx, userID)
+	return
+}
+
+// GetCartVersionAfterCounter returns a count of finished ServiceMock.GetCartVersion invocations
+func (mmGetCartVersion *ServiceMock) GetCartVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartVersion.afterGetCartVersionCounter)
+}
+
+// GetCartVersionBeforeCounter returns a count of ServiceMock.GetCartVersion invocations
+func (mmGetCartVersion *ServiceMock) GetCartVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCartVersion.beforeGetCartVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetCartVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCartVersion *mServiceMockGetCartVersion) Calls() []*ServiceMockGetCartVersionParams {
+	mmGetCartVersion.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetCartVersionParams, len(mmGetCartVersion.callArgs))
+	copy(argCopy, mmGetCartVersion.callArgs)
+
+	mmGetCartVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCartVersionDone returns true if the count of the GetCartVersion invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetCartVersionDone() bool {
+	if m.GetCartVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCartVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCartVersionMock.invocationsDone()
+}
+
+// MinimockGetCartVersionInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetCartVersionInspect() {
+	for _, e := range m.GetCartVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetCartVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCartVersionCounter := mm_atomic.LoadUint64(&m.afterGetCartVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCartVersionMock.defaultExpectation != nil && afterGetCartVersionCounter < 1 {
+		if m.GetCartVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetCartVersion at\n%s", m.GetCartVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetCartVersion at\n%s with params: %#v", m.GetCartVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetCartVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCartVersion != nil && afterGetCartVersionCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetCartVersion at\n%s", m.funcGetCartVersionOrigin)
+	}
+
+	if !m.GetCartVersionMock.invocationsDone() && afterGetCartVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetCartVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCartVersionMock.expectedInvocations), m.GetCartVersionMock.expectedInvocationsOrigin, afterGetCartVersionCounter)
+	}
+}
+
+type mServiceMockGetItemsFromCart struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetItemsFromCartExpectation
+	expectations       []*ServiceMockGetItemsFromCartExpectation
+
+	callArgs []*ServiceMockGetItemsFromCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetItemsFromCartExpectation specifies expectation struct of the Service.GetItemsFromCart
+type ServiceMockGetItemsFromCartExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetItemsFromCartParams
+	paramPtrs          *ServiceMockGetItemsFromCartParamPtrs
+	expectationOrigins ServiceMockGetItemsFromCartExpectationOrigins
+	results            *ServiceMockGetItemsFromCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetItemsFromCartParams contains parameters of the Service.GetItemsFromCart
+type ServiceMockGetItemsFromCartParams struct {
+	ctx  context.Context
+	data model.RequestData
+}
+
+// ServiceMockGetItemsFromCartParamPtrs contains pointers to parameters of the Service.GetItemsFromCart
+type ServiceMockGetItemsFromCartParamPtrs struct {
+	ctx  *context.Context
+	data *model.RequestData
+}
+
+// ServiceMockGetItemsFromCartResults contains results of the Service.GetItemsFromCart
+type ServiceMockGetItemsFromCartResults struct {
+	gp1 *model.GetItemsFromCartResponce
+	err error
+}
+
+// ServiceMockGetItemsFromCartOrigins contains origins of expectations of the Service.GetItemsFromCart
+type ServiceMockGetItemsFromCartExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originData string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Optional() *mServiceMockGetItemsFromCart {
+	mmGetItemsFromCart.optional = true
+	return mmGetItemsFromCart
+}
+
+// Expect sets up expected params for Service.GetItemsFromCart
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Expect(ctx context.Context, data model.RequestData) *mServiceMockGetItemsFromCart {
+	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+	}
+
+	if mmGetItemsFromCart.defaultExpectation == nil {
+		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{}
+	}
+
+	if mmGetItemsFromCart.defaultExpectation.paramPtrs != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by ExpectParams functions")
+	}
+
+	mmGetItemsFromCart.defaultExpectation.params = &ServiceMockGetItemsFromCartParams{ctx, data}
+	mmGetItemsFromCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetItemsFromCart.expectations {
+		if minimock.Equal(e.params, mmGetItemsFromCart.defaultExpectation.params) {
+			mmGetItemsFromCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsFromCart.defaultExpectation.params)
+		}
+	}
+
+	return mmGetItemsFromCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetItemsFromCart
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) ExpectCtxParam1(ctx context.Context) *mServiceMockGetItemsFromCart {
+	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+	}
+
+	if mmGetItemsFromCart.defaultExpectation == nil {
+		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{}
+	}
+
+	if mmGetItemsFromCart.defaultExpectation.params != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Expect")
+	}
+
+	if mmGetItemsFromCart.defaultExpectation.paramPtrs == nil {
+		mmGetItemsFromCart.defaultExpectation.paramPtrs = &ServiceMockGetItemsFromCartParamPtrs{}
+	}
+	mmGetItemsFromCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetItemsFromCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetItemsFromCart
+}
+
+// ExpectDataParam2 sets up expected param data for Service.GetItemsFromCart
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) ExpectDataParam2(data model.RequestData) *mServiceMockGetItemsFromCart {
+	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+	}
+
+	if mmGetItemsFromCart.defaultExpectation == nil {
+		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{}
+	}
+
+	if mmGetItemsFromCart.defaultExpectation.params != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Expect")
+	}
+
+	if mmGetItemsFromCart.defaultExpectation.paramPtrs == nil {
+		mmGetItemsFromCart.defaultExpectation.paramPtrs = &ServiceMockGetItemsFromCartParamPtrs{}
+	}
+	mmGetItemsFromCart.defaultExpectation.paramPtrs.data = &data
+	mmGetItemsFromCart.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmGetItemsFromCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetItemsFromCart
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockGetItemsFromCart {
+	if mmGetItemsFromCart.mock.inspectFuncGetItemsFromCart != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetItemsFromCart")
+	}
+
+	mmGetItemsFromCart.mock.inspectFuncGetItemsFromCart = f
+
+	return mmGetItemsFromCart
+}
+
+// Return sets up results that will be returned by Service.GetItemsFromCart
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Return(gp1 *model.GetItemsFromCartResponce, err error) *ServiceMock {
+	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+	}
+
+	if mmGetItemsFromCart.defaultExpectation == nil {
+		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{mock: mmGetItemsFromCart.mock}
+	}
+	mmGetItemsFromCart.defaultExpectation.results = &ServiceMockGetItemsFromCartResults{gp1, err}
+	mmGetItemsFromCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetItemsFromCart.mock
+}
+
+// Set uses given function f to mock the Service.GetItemsFromCart method
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Set(f func(ctx context.Context, data model.RequestData) (gp1 *model.GetItemsFromCartResponce, err error)) *ServiceMock {
+	if mmGetItemsFromCart.defaultExpectation != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("Default expectation is already set for the Service.GetItemsFromCart method")
+	}
+
+	if len(mmGetItemsFromCart.expectations) > 0 {
+		mmGetItemsFromCart.mock.t.Fatalf("Some expectations are already set for the Service.GetItemsFromCart method")
+	}
+
+	mmGetItemsFromCart.mock.funcGetItemsFromCart = f
+	mmGetItemsFromCart.mock.funcGetItemsFromCartOrigin = minimock.CallerInfo(1)
+	return mmGetItemsFromCart.mock
+}
+
+// When sets expectation for the Service.GetItemsFromCart which will trigger the result defined by the following
+// Then helper
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) When(ctx context.Context, data model.RequestData) *ServiceMockGetItemsFromCartExpectation {
+	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
+		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetItemsFromCartExpectation{
+		mock:               mmGetItemsFromCart.mock,
+		params:             &ServiceMockGetItemsFromCartParams{ctx, data},
+		expectationOrigins: ServiceMockGetItemsFromCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetItemsFromCart.expectations = append(mmGetItemsFromCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetItemsFromCart return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetItemsFromCartExpectation) Then(gp1 *model.GetItemsFromCartResponce, err error) *ServiceMock {
+	e.results = &ServiceMockGetItemsFromCartResults{gp1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetItemsFromCart should be invoked
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Times(n uint64) *mServiceMockGetItemsFromCart {
+	if n == 0 {
+		mmGetItemsFromCart.mock.t.Fatalf("Times of ServiceMock.GetItemsFromCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetItemsFromCart.expectedInvocations, n)
+	mmGetItemsFromCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetItemsFromCart
+}
+
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) invocationsDone() bool {
+	if len(mmGetItemsFromCart.expectations) == 0 && mmGetItemsFromCart.defaultExpectation == nil && mmGetItemsFromCart.mock.funcGetItemsFromCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsFromCart.mock.afterGetItemsFromCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsFromCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetItemsFromCart implements mm_server.Service
+func (mmGetItemsFromCart *ServiceMock) GetItemsFromCart(ctx context.Context, data model.RequestData) (gp1 *model.GetItemsFromCartResponce, err error) {
+	mm_atomic.AddUint64(&mmGetItemsFromCart.beforeGetItemsFromCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetItemsFromCart.afterGetItemsFromCartCounter, 1)
+
+	mmGetItemsFromCart.t.Helper()
+
+	if mmGetItemsFromCart.inspectFuncGetItemsFromCart != nil {
+		mmGetItemsFromCart.inspectFuncGetItemsFromCart(ctx, data)
+	}
+
+	mm_params := ServiceMockGetItemsFromCartParams{ctx, data}
+
+	// Record call args
+	mmGetItemsFromCart.GetItemsFromCartMock.mutex.Lock()
+	mmGetItemsFromCart.GetItemsFromCartMock.callArgs = append(mmGetItemsFromCart.GetItemsFromCartMock.callArgs, &mm_params)
+	mmGetItemsFromCart.GetItemsFromCartMock.mutex.Unlock()
+
+	for _, e := range mmGetItemsFromCart.GetItemsFromCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gp1, e.results.err
+		}
+	}
+
+	if mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.params
+		mm_want_ptrs := mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetItemsFromCartParams{ctx, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetItemsFromCart.t.Errorf("ServiceMock.GetItemsFromCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmGetItemsFromCart.t.Errorf("ServiceMock.GetItemsFromCart got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetItemsFromCart.t.Errorf("ServiceMock.GetItemsFromCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetItemsFromCart.t.Fatal("No results are set for the ServiceMock.GetItemsFromCart")
+		}
+		return (*mm_results).gp1, (*mm_results).err
+	}
+	if mmGetItemsFromCart.funcGetItemsFromCart != nil {
+		return mmGetItemsFromCart.funcGetItemsFromCart(ctx, data)
+	}
+	mmGetItemsFromCart.t.Fatalf("Unexpected call to ServiceMock.GetItemsFromCart. %v %v", ctx, data)
+	return
+}
+
+// GetItemsFromCartAfterCounter returns a count of finished ServiceMock.GetItemsFromCart invocations
+func (mmGetItemsFromCart *ServiceMock) GetItemsFromCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsFromCart.afterGetItemsFromCartCounter)
+}
+
+// GetItemsFromCartBeforeCounter returns a count of ServiceMock.GetItemsFromCart invocations
+func (mmGetItemsFromCart *ServiceMock) GetItemsFromCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsFromCart.beforeGetItemsFromCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetItemsFromCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Calls() []*ServiceMockGetItemsFromCartParams {
+	mmGetItemsFromCart.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetItemsFromCartParams, len(mmGetItemsFromCart.callArgs))
+	copy(argCopy, mmGetItemsFromCart.callArgs)
+
+	mmGetItemsFromCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetItemsFromCartDone returns true if the count of the GetItemsFromCart invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetItemsFromCartDone() bool {
+	if m.GetItemsFromCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetItemsFromCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetItemsFromCartMock.invocationsDone()
+}
+
+// MinimockGetItemsFromCartInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetItemsFromCartInspect() {
+	for _, e := range m.GetItemsFromCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetItemsFromCartCounter := mm_atomic.LoadUint64(&m.afterGetItemsFromCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetItemsFromCartMock.defaultExpectation != nil && afterGetItemsFromCartCounter < 1 {
+		if m.GetItemsFromCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s", m.GetItemsFromCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s with params: %#v", m.GetItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsFromCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetItemsFromCart != nil && afterGetItemsFromCartCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s", m.funcGetItemsFromCartOrigin)
+	}
+
+	if !m.GetItemsFromCartMock.invocationsDone() && afterGetItemsFromCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetItemsFromCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetItemsFromCartMock.expectedInvocations), m.GetItemsFromCartMock.expectedInvocationsOrigin, afterGetItemsFromCartCounter)
+	}
+}
+
+type mServiceMockGetSavedItems struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockGetSavedItemsExpectation
+	expectations       []*ServiceMockGetSavedItemsExpectation
+
+	callArgs []*ServiceMockGetSavedItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockGetSavedItemsExpectation specifies expectation struct of the Service.GetSavedItems
+type ServiceMockGetSavedItemsExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockGetSavedItemsParams
+	paramPtrs          *ServiceMockGetSavedItemsParamPtrs
+	expectationOrigins ServiceMockGetSavedItemsExpectationOrigins
+	results            *ServiceMockGetSavedItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockGetSavedItemsParams contains parameters of the Service.GetSavedItems
+type ServiceMockGetSavedItemsParams struct {
+	ctx  context.Context
+	data model.RequestData
+}
+
+// ServiceMockGetSavedItemsParamPtrs contains pointers to parameters of the Service.GetSavedItems
+type ServiceMockGetSavedItemsParamPtrs struct {
+	ctx  *context.Context
+	data *model.RequestData
+}
+
+// ServiceMockGetSavedItemsResults contains results of the Service.GetSavedItems
+type ServiceMockGetSavedItemsResults struct {
+	gp1 *model.GetSavedItemsResponse
+	err error
+}
+
+// ServiceMockGetSavedItemsOrigins contains origins of expectations of the Service.GetSavedItems
+type ServiceMockGetSavedItemsExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originData string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSavedItems *mServiceMockGetSavedItems) Optional() *mServiceMockGetSavedItems {
+	mmGetSavedItems.optional = true
+	return mmGetSavedItems
+}
+
+// Expect sets up expected params for Service.GetSavedItems
+func (mmGetSavedItems *mServiceMockGetSavedItems) Expect(ctx context.Context, data model.RequestData) *mServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &ServiceMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by ExpectParams functions")
+	}
+
+	mmGetSavedItems.defaultExpectation.params = &ServiceMockGetSavedItemsParams{ctx, data}
+	mmGetSavedItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSavedItems.expectations {
+		if minimock.Equal(e.params, mmGetSavedItems.defaultExpectation.params) {
+			mmGetSavedItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedItems.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSavedItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.GetSavedItems
+func (mmGetSavedItems *mServiceMockGetSavedItems) ExpectCtxParam1(ctx context.Context) *mServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &ServiceMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.params != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by Expect")
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItems.defaultExpectation.paramPtrs = &ServiceMockGetSavedItemsParamPtrs{}
+	}
+	mmGetSavedItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSavedItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSavedItems
+}
+
+// ExpectDataParam2 sets up expected param data for Service.GetSavedItems
+func (mmGetSavedItems *mServiceMockGetSavedItems) ExpectDataParam2(data model.RequestData) *mServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &ServiceMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.params != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by Expect")
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItems.defaultExpectation.paramPtrs = &ServiceMockGetSavedItemsParamPtrs{}
+	}
+	mmGetSavedItems.defaultExpectation.paramPtrs.data = &data
+	mmGetSavedItems.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmGetSavedItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.GetSavedItems
+func (mmGetSavedItems *mServiceMockGetSavedItems) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockGetSavedItems {
+	if mmGetSavedItems.mock.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetSavedItems")
+	}
+
+	mmGetSavedItems.mock.inspectFuncGetSavedItems = f
+
+	return mmGetSavedItems
+}
+
+// Return sets up results that will be returned by Service.GetSavedItems
+func (mmGetSavedItems *mServiceMockGetSavedItems) Return(gp1 *model.GetSavedItemsResponse, err error) *ServiceMock {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &ServiceMockGetSavedItemsExpectation{mock: mmGetSavedItems.mock}
+	}
+	mmGetSavedItems.defaultExpectation.results = &ServiceMockGetSavedItemsResults{gp1, err}
+	mmGetSavedItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems.mock
+}
+
+// Set uses given function f to mock the Service.GetSavedItems method
+func (mmGetSavedItems *mServiceMockGetSavedItems) Set(f func(ctx context.Context, data model.RequestData) (gp1 *model.GetSavedItemsResponse, err error)) *ServiceMock {
+	if mmGetSavedItems.defaultExpectation != nil {
+		mmGetSavedItems.mock.t.Fatalf("Default expectation is already set for the Service.GetSavedItems method")
+	}
+
+	if len(mmGetSavedItems.expectations) > 0 {
+		mmGetSavedItems.mock.t.Fatalf("Some expectations are already set for the Service.GetSavedItems method")
+	}
+
+	mmGetSavedItems.mock.funcGetSavedItems = f
+	mmGetSavedItems.mock.funcGetSavedItemsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems.mock
+}
+
+// When sets expectation for the Service.GetSavedItems which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedItems *mServiceMockGetSavedItems) When(ctx context.Context, data model.RequestData) *ServiceMockGetSavedItemsExpectation {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("ServiceMock.GetSavedItems mock is already set by Set")
+	}
+
+	expectation := &ServiceMockGetSavedItemsExpectation{
+		mock:               mmGetSavedItems.mock,
+		params:             &ServiceMockGetSavedItemsParams{ctx, data},
+		expectationOrigins: ServiceMockGetSavedItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSavedItems.expectations = append(mmGetSavedItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.GetSavedItems return parameters for the expectation previously defined by the When method
+func (e *ServiceMockGetSavedItemsExpectation) Then(gp1 *model.GetSavedItemsResponse, err error) *ServiceMock {
+	e.results = &ServiceMockGetSavedItemsResults{gp1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.GetSavedItems should be invoked
+func (mmGetSavedItems *mServiceMockGetSavedItems) Times(n uint64) *mServiceMockGetSavedItems {
+	if n == 0 {
+		mmGetSavedItems.mock.t.Fatalf("Times of ServiceMock.GetSavedItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSavedItems.expectedInvocations, n)
+	mmGetSavedItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems
+}
+
+func (mmGetSavedItems *mServiceMockGetSavedItems) invocationsDone() bool {
+	if len(mmGetSavedItems.expectations) == 0 && mmGetSavedItems.defaultExpectation == nil && mmGetSavedItems.mock.funcGetSavedItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSavedItems.mock.afterGetSavedItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSavedItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSavedItems implements mm_server.Service
+func (mmGetSavedItems *ServiceMock) GetSavedItems(ctx context.Context, data model.RequestData) (gp1 *model.GetSavedItemsResponse, err error) {
+	mm_atomic.AddUint64(&mmGetSavedItems.beforeGetSavedItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedItems.afterGetSavedItemsCounter, 1)
+
+	mmGetSavedItems.t.Helper()
+
+	if mmGetSavedItems.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.inspectFuncGetSavedItems(ctx, data)
+	}
+
+	mm_params := ServiceMockGetSavedItemsParams{ctx, data}
+
+	// Record call args
+	mmGetSavedItems.GetSavedItemsMock.mutex.Lock()
+	mmGetSavedItems.GetSavedItemsMock.callArgs = append(mmGetSavedItems.GetSavedItemsMock.callArgs, &mm_params)
+	mmGetSavedItems.GetSavedItemsMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedItems.GetSavedItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.gp1, e.results.err
+		}
+	}
+
+	if mmGetSavedItems.GetSavedItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedItems.GetSavedItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockGetSavedItemsParams{ctx, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSavedItems.t.Errorf("ServiceMock.GetSavedItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmGetSavedItems.t.Errorf("ServiceMock.GetSavedItems got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedItems.t.Errorf("ServiceMock.GetSavedItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedItems.t.Fatal("No results are set for the ServiceMock.GetSavedItems")
+		}
+		return (*mm_results).gp1, (*mm_results).err
+	}
+	if mmGetSavedItems.funcGetSavedItems != nil {
+		return mmGetSavedItems.funcGetSavedItems(ctx, data)
+	}
+	mmGetSavedItems.t.Fatalf("Unexpected call to ServiceMock.GetSavedItems. %v %v", ctx, data)
+	return
+}
+
+// GetSavedItemsAfterCounter returns a count of finished ServiceMock.GetSavedItems invocations
+func (mmGetSavedItems *ServiceMock) GetSavedItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.afterGetSavedItemsCounter)
+}
+
+// GetSavedItemsBeforeCounter returns a count of ServiceMock.GetSavedItems invocations
+func (mmGetSavedItems *ServiceMock) GetSavedItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.beforeGetSavedItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.GetSavedItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedItems *mServiceMockGetSavedItems) Calls() []*ServiceMockGetSavedItemsParams {
+	mmGetSavedItems.mutex.RLock()
+
+	argCopy := make([]*ServiceMockGetSavedItemsParams, len(mmGetSavedItems.callArgs))
+	copy(argCopy, mmGetSavedItems.callArgs)
+
+	mmGetSavedItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedItemsDone returns true if the count of the GetSavedItems invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockGetSavedItemsDone() bool {
+	if m.GetSavedItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSavedItemsMock.invocationsDone()
+}
+
+// MinimockGetSavedItemsInspect logs each unmet expectation
+func (m *ServiceMock) MinimockGetSavedItemsInspect() {
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.GetSavedItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSavedItemsCounter := mm_atomic.LoadUint64(&m.afterGetSavedItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemsMock.defaultExpectation != nil && afterGetSavedItemsCounter < 1 {
+		if m.GetSavedItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.GetSavedItems at\n%s", m.GetSavedItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.GetSavedItems at\n%s with params: %#v", m.GetSavedItemsMock.defaultExpectation.expectationOrigins.origin, *m.GetSavedItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItems != nil && afterGetSavedItemsCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.GetSavedItems at\n%s", m.funcGetSavedItemsOrigin)
+	}
+
+	if !m.GetSavedItemsMock.invocationsDone() && afterGetSavedItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.GetSavedItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSavedItemsMock.expectedInvocations), m.GetSavedItemsMock.expectedInvocationsOrigin, afterGetSavedItemsCounter)
+	}
+}
+
+type mServiceMockMergeCart struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockMergeCartExpectation
+	expectations       []*ServiceMockMergeCartExpectation
+
+	callArgs []*ServiceMockMergeCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockMergeCartExpectation specifies expectation struct of the Service.MergeCart
+type ServiceMockMergeCartExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockMergeCartParams
+	paramPtrs          *ServiceMockMergeCartParamPtrs
+	expectationOrigins ServiceMockMergeCartExpectationOrigins
+	results            *ServiceMockMergeCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockMergeCartParams contains parameters of the Service.MergeCart
+type ServiceMockMergeCartParams struct {
+	ctx          context.Context
+	targetUserID int64
+	sourceUserID int64
+}
+
+// ServiceMockMergeCartParamPtrs contains pointers to parameters of the Service.MergeCart
+type ServiceMockMergeCartParamPtrs struct {
+	ctx          *context.Context
+	targetUserID *int64
+	sourceUserID *int64
+}
+
+// ServiceMockMergeCartResults contains results of the Service.MergeCart
+type ServiceMockMergeCartResults struct {
+	mp1 *model.MergeCartResponse
+	err error
+}
+
+// ServiceMockMergeCartOrigins contains origins of expectations of the Service.MergeCart
+type ServiceMockMergeCartExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originTargetUserID string
+	originSourceUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMergeCart *mServiceMockMergeCart) Optional() *mServiceMockMergeCart {
+	mmMergeCart.optional = true
+	return mmMergeCart
+}
+
+// Expect sets up expected params for Service.MergeCart
+func (mmMergeCart *mServiceMockMergeCart) Expect(ctx context.Context, targetUserID int64, sourceUserID int64) *mServiceMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &ServiceMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by ExpectParams functions")
+	}
+
+	mmMergeCart.defaultExpectation.params = &ServiceMockMergeCartParams{ctx, targetUserID, sourceUserID}
+	mmMergeCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMergeCart.expectations {
+		if minimock.Equal(e.params, mmMergeCart.defaultExpectation.params) {
+			mmMergeCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMergeCart.defaultExpectation.params)
+		}
+	}
+
+	return mmMergeCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.MergeCart
+func (mmMergeCart *mServiceMockMergeCart) ExpectCtxParam1(ctx context.Context) *mServiceMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &ServiceMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.params != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Expect")
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs == nil {
+		mmMergeCart.defaultExpectation.paramPtrs = &ServiceMockMergeCartParamPtrs{}
+	}
+	mmMergeCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMergeCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMergeCart
+}
+
+// ExpectTargetUserIDParam2 sets up expected param targetUserID for Service.MergeCart
+func (mmMergeCart *mServiceMockMergeCart) ExpectTargetUserIDParam2(targetUserID int64) *mServiceMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &ServiceMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.params != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Expect")
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs == nil {
+		mmMergeCart.defaultExpectation.paramPtrs = &ServiceMockMergeCartParamPtrs{}
+	}
+	mmMergeCart.defaultExpectation.paramPtrs.targetUserID = &targetUserID
+	mmMergeCart.defaultExpectation.expectationOrigins.originTargetUserID = minimock.CallerInfo(1)
+
+	return mmMergeCart
+}
+
+// ExpectSourceUserIDParam3 sets up expected param sourceUserID for Service.MergeCart
+func (mmMergeCart *mServiceMockMergeCart) ExpectSourceUserIDParam3(sourceUserID int64) *mServiceMockMergeCart {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &ServiceMockMergeCartExpectation{}
+	}
+
+	if mmMergeCart.defaultExpectation.params != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Expect")
+	}
+
+	if mmMergeCart.defaultExpectation.paramPtrs == nil {
+		mmMergeCart.defaultExpectation.paramPtrs = &ServiceMockMergeCartParamPtrs{}
+	}
+	mmMergeCart.defaultExpectation.paramPtrs.sourceUserID = &sourceUserID
+	mmMergeCart.defaultExpectation.expectationOrigins.originSourceUserID = minimock.CallerInfo(1)
+
+	return mmMergeCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.MergeCart
+func (mmMergeCart *mServiceMockMergeCart) Inspect(f func(ctx context.Context, targetUserID int64, sourceUserID int64)) *mServiceMockMergeCart {
+	if mmMergeCart.mock.inspectFuncMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("Inspect function is already set for ServiceMock.MergeCart")
+	}
+
+	mmMergeCart.mock.inspectFuncMergeCart = f
+
+	return mmMergeCart
+}
+
+// Return sets up results that will be returned by Service.MergeCart
+func (mmMergeCart *mServiceMockMergeCart) Return(mp1 *model.MergeCartResponse, err error) *ServiceMock {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Set")
+	}
+
+	if mmMergeCart.defaultExpectation == nil {
+		mmMergeCart.defaultExpectation = &ServiceMockMergeCartExpectation{mock: mmMergeCart.mock}
+	}
+	mmMergeCart.defaultExpectation.results = &ServiceMockMergeCartResults{mp1, err}
+	mmMergeCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMergeCart.mock
+}
+
+// Set uses given function f to mock the Service.MergeCart method
+func (mmMergeCart *mServiceMockMergeCart) Set(f func(ctx context.Context, targetUserID int64, sourceUserID int64) (mp1 *model.MergeCartResponse, err error)) *ServiceMock {
+	if mmMergeCart.defaultExpectation != nil {
+		mmMergeCart.mock.t.Fatalf("Default expectation is already set for the Service.MergeCart method")
+	}
+
+	if len(mmMergeCart.expectations) > 0 {
+		mmMergeCart.mock.t.Fatalf("Some expectations are already set for the Service.MergeCart method")
+	}
+
+	mmMergeCart.mock.funcMergeCart = f
+	mmMergeCart.mock.funcMergeCartOrigin = minimock.CallerInfo(1)
+	return mmMergeCart.mock
+}
+
+// When sets expectation for the Service.MergeCart which will trigger the result defined by the following
+// Then helper
+func (mmMergeCart *mServiceMockMergeCart) When(ctx context.Context, targetUserID int64, sourceUserID int64) *ServiceMockMergeCartExpectation {
+	if mmMergeCart.mock.funcMergeCart != nil {
+		mmMergeCart.mock.t.Fatalf("ServiceMock.MergeCart mock is already set by Set")
+	}
+
+	expectation := &ServiceMockMergeCartExpectation{
+		mock:               mmMergeCart.mock,
+		params:             &ServiceMockMergeCartParams{ctx, targetUserID, sourceUserID},
+		expectationOrigins: ServiceMockMergeCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMergeCart.expectations = append(mmMergeCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.MergeCart return parameters for the expectation previously defined by the When method
+func (e *ServiceMockMergeCartExpectation) Then(mp1 *model.MergeCartResponse, err error) *ServiceMock {
+	e.results = &ServiceMockMergeCartResults{mp1, err}
+	return e.mock
+}
+
+// Times sets number of times Service.MergeCart should be invoked
+func (mmMergeCart *mServiceMockMergeCart) Times(n uint64) *mServiceMockMergeCart {
+	if n == 0 {
+		mmMergeCart.mock.t.Fatalf("Times of ServiceMock.MergeCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMergeCart.expectedInvocations, n)
+	mmMergeCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMergeCart
+}
+
+func (mmMergeCart *mServiceMockMergeCart) invocationsDone() bool {
+	if len(mmMergeCart.expectations) == 0 && mmMergeCart.defaultExpectation == nil && mmMergeCart.mock.funcMergeCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMergeCart.mock.afterMergeCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMergeCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MergeCart implements mm_server.Service
+func (mmMergeCart *ServiceMock) MergeCart(ctx context.Context, targetUserID int64, sourceUserID int64) (mp1 *model.MergeCartResponse, err error) {
+	mm_atomic.AddUint64(&mmMergeCart.beforeMergeCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmMergeCart.afterMergeCartCounter, 1)
+
+	mmMergeCart.t.Helper()
+
+	if mmMergeCart.inspectFuncMergeCart != nil {
+		mmMergeCart.inspectFuncMergeCart(ctx, targetUserID, sourceUserID)
+	}
+
+	mm_params := ServiceMockMergeCartParams{ctx, targetUserID, sourceUserID}
+
+	// Record call args
+	mmMergeCart.MergeCartMock.mutex.Lock()
+	mmMergeCart.MergeCartMock.callArgs = append(mmMergeCart.MergeCartMock.callArgs, &mm_params)
+	mmMergeCart.MergeCartMock.mutex.Unlock()
+
+	for _, e := range mmMergeCart.MergeCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.mp1, e.results.err
+		}
+	}
+
+	if mmMergeCart.MergeCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMergeCart.MergeCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmMergeCart.MergeCartMock.defaultExpectation.params
+		mm_want_ptrs := mmMergeCart.MergeCartMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockMergeCartParams{ctx, targetUserID, sourceUserID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMergeCart.t.Errorf("ServiceMock.MergeCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.targetUserID != nil && !minimock.Equal(*mm_want_ptrs.targetUserID, mm_got.targetUserID) {
+				mmMergeCart.t.Errorf("ServiceMock.MergeCart got unexpected parameter targetUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.originTargetUserID, *mm_want_ptrs.targetUserID, mm_got.targetUserID, minimock.Diff(*mm_want_ptrs.targetUserID, mm_got.targetUserID))
+			}
+
+			if mm_want_ptrs.sourceUserID != nil && !minimock.Equal(*mm_want_ptrs.sourceUserID, mm_got.sourceUserID) {
+				mmMergeCart.t.Errorf("ServiceMock.MergeCart got unexpected parameter sourceUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.originSourceUserID, *mm_want_ptrs.sourceUserID, mm_got.sourceUserID, minimock.Diff(*mm_want_ptrs.sourceUserID, mm_got.sourceUserID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMergeCart.t.Errorf("ServiceMock.MergeCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMergeCart.MergeCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMergeCart.MergeCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMergeCart.t.Fatal("No results are set for the ServiceMock.MergeCart")
+		}
+		return (*mm_results).mp1, (*mm_results).err
+	}
+	if mmMergeCart.funcMergeCart != nil {
+		return mmMergeCart.funcMergeCart(ctx, targetUserID, sourceUserID)
+	}
+	mmMergeCart.t.Fatalf("Unexpected call to ServiceMock.MergeCart. %v %v %v", ctx, targetUserID, sourceUserID)
+	return
+}
+
+// MergeCartAfterCounter returns a count of finished ServiceMock.MergeCart invocations
+func (mmMergeCart *ServiceMock) MergeCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCart.afterMergeCartCounter)
+}
+
+// MergeCartBeforeCounter returns a count of ServiceMock.MergeCart invocations
+func (mmMergeCart *ServiceMock) MergeCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMergeCart.beforeMergeCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.MergeCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMergeCart *mServiceMockMergeCart) Calls() []*ServiceMockMergeCartParams {
+	mmMergeCart.mutex.RLock()
+
+	argCopy := make([]*ServiceMockMergeCartParams, len(mmMergeCart.callArgs))
+	copy(argCopy, mmMergeCart.callArgs)
+
+	mmMergeCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeCartDone returns true if the count of the MergeCart invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockMergeCartDone() bool {
+	if m.MergeCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergeCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergeCartMock.invocationsDone()
+}
+
+// MinimockMergeCartInspect logs each unmet expectation
+func (m *ServiceMock) MinimockMergeCartInspect() {
+	for _, e := range m.MergeCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.MergeCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergeCartCounter := mm_atomic.LoadUint64(&m.afterMergeCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeCartMock.defaultExpectation != nil && afterMergeCartCounter < 1 {
+		if m.MergeCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.MergeCart at\n%s", m.MergeCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.MergeCart at\n%s with params: %#v", m.MergeCartMock.defaultExpectation.expectationOrigins.origin, *m.MergeCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMergeCart != nil && afterMergeCartCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.MergeCart at\n%s", m.funcMergeCartOrigin)
+	}
+
+	if !m.MergeCartMock.invocationsDone() && afterMergeCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.MergeCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergeCartMock.expectedInvocations), m.MergeCartMock.expectedInvocationsOrigin, afterMergeCartCounter)
+	}
+}
+
+type mServiceMockMoveToCart struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockMoveToCartExpectation
+	expectations       []*ServiceMockMoveToCartExpectation
+
+	callArgs []*ServiceMockMoveToCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockMoveToCartExpectation specifies expectation struct of the Service.MoveToCart
+type ServiceMockMoveToCartExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockMoveToCartParams
+	paramPtrs          *ServiceMockMoveToCartParamPtrs
+	expectationOrigins ServiceMockMoveToCartExpectationOrigins
+	results            *ServiceMockMoveToCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockMoveToCartParams contains parameters of the Service.MoveToCart
+type ServiceMockMoveToCartParams struct {
+	ctx  context.Context
+	data model.RequestData
+}
+
+// ServiceMockMoveToCartParamPtrs contains pointers to parameters of the Service.MoveToCart
+type ServiceMockMoveToCartParamPtrs struct {
+	ctx  *context.Context
+	data *model.RequestData
+}
+
+// ServiceMockMoveToCartResults contains results of the Service.MoveToCart
+type ServiceMockMoveToCartResults struct {
+	err error
+}
+
+// ServiceMockMoveToCartOrigins contains origins of expectations of the Service.MoveToCart
+type ServiceMockMoveToCartExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originData string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveToCart *mServiceMockMoveToCart) Optional() *mServiceMockMoveToCart {
+	mmMoveToCart.optional = true
+	return mmMoveToCart
+}
+
+// Expect sets up expected params for Service.MoveToCart
+func (mmMoveToCart *mServiceMockMoveToCart) Expect(ctx context.Context, data model.RequestData) *mServiceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &ServiceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by ExpectParams functions")
+	}
+
+	mmMoveToCart.defaultExpectation.params = &ServiceMockMoveToCartParams{ctx, data}
+	mmMoveToCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveToCart.expectations {
+		if minimock.Equal(e.params, mmMoveToCart.defaultExpectation.params) {
+			mmMoveToCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveToCart.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveToCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.MoveToCart
+func (mmMoveToCart *mServiceMockMoveToCart) ExpectCtxParam1(ctx context.Context) *mServiceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &ServiceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &ServiceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveToCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// ExpectDataParam2 sets up expected param data for Service.MoveToCart
+func (mmMoveToCart *mServiceMockMoveToCart) ExpectDataParam2(data model.RequestData) *mServiceMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &ServiceMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &ServiceMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.data = &data
+	mmMoveToCart.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.MoveToCart
+func (mmMoveToCart *mServiceMockMoveToCart) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockMoveToCart {
+	if mmMoveToCart.mock.inspectFuncMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("Inspect function is already set for ServiceMock.MoveToCart")
+	}
+
+	mmMoveToCart.mock.inspectFuncMoveToCart = f
+
+	return mmMoveToCart
+}
+
+// Return sets up results that will be returned by Service.MoveToCart
+func (mmMoveToCart *mServiceMockMoveToCart) Return(err error) *ServiceMock {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &ServiceMockMoveToCartExpectation{mock: mmMoveToCart.mock}
+	}
+	mmMoveToCart.defaultExpectation.results = &ServiceMockMoveToCartResults{err}
+	mmMoveToCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart.mock
+}
+
+// Set uses given function f to mock the Service.MoveToCart method
+func (mmMoveToCart *mServiceMockMoveToCart) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
+	if mmMoveToCart.defaultExpectation != nil {
+		mmMoveToCart.mock.t.Fatalf("Default expectation is already set for the Service.MoveToCart method")
+	}
+
+	if len(mmMoveToCart.expectations) > 0 {
+		mmMoveToCart.mock.t.Fatalf("Some expectations are already set for the Service.MoveToCart method")
+	}
+
+	mmMoveToCart.mock.funcMoveToCart = f
+	mmMoveToCart.mock.funcMoveToCartOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart.mock
+}
+
+// When sets expectation for the Service.MoveToCart which will trigger the result defined by the following
+// Then helper
+func (mmMoveToCart *mServiceMockMoveToCart) When(ctx context.Context, data model.RequestData) *ServiceMockMoveToCartExpectation {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("ServiceMock.MoveToCart mock is already set by Set")
+	}
+
+	expectation := &ServiceMockMoveToCartExpectation{
+		mock:               mmMoveToCart.mock,
+		params:             &ServiceMockMoveToCartParams{ctx, data},
+		expectationOrigins: ServiceMockMoveToCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveToCart.expectations = append(mmMoveToCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Service.MoveToCart return parameters for the expectation previously defined by the When method
+func (e *ServiceMockMoveToCartExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockMoveToCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times Service.MoveToCart should be invoked
+func (mmMoveToCart *mServiceMockMoveToCart) Times(n uint64) *mServiceMockMoveToCart {
+	if n == 0 {
+		mmMoveToCart.mock.t.Fatalf("Times of ServiceMock.MoveToCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveToCart.expectedInvocations, n)
+	mmMoveToCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart
+}
+
+func (mmMoveToCart *mServiceMockMoveToCart) invocationsDone() bool {
+	if len(mmMoveToCart.expectations) == 0 && mmMoveToCart.defaultExpectation == nil && mmMoveToCart.mock.funcMoveToCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveToCart.mock.afterMoveToCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveToCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveToCart implements mm_server.Service
+func (mmMoveToCart *ServiceMock) MoveToCart(ctx context.Context, data model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmMoveToCart.beforeMoveToCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveToCart.afterMoveToCartCounter, 1)
+
+	mmMoveToCart.t.Helper()
+
+	if mmMoveToCart.inspectFuncMoveToCart != nil {
+		mmMoveToCart.inspectFuncMoveToCart(ctx, data)
+	}
+
+	mm_params := ServiceMockMoveToCartParams{ctx, data}
+
+	// Record call args
+	mmMoveToCart.MoveToCartMock.mutex.Lock()
+	mmMoveToCart.MoveToCartMock.callArgs = append(mmMoveToCart.MoveToCartMock.callArgs, &mm_params)
+	mmMoveToCart.MoveToCartMock.mutex.Unlock()
+
+	for _, e := range mmMoveToCart.MoveToCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMoveToCart.MoveToCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveToCart.MoveToCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveToCart.MoveToCartMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveToCart.MoveToCartMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockMoveToCartParams{ctx, data}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveToCart.t.Errorf("ServiceMock.MoveToCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmMoveToCart.t.Errorf("ServiceMock.MoveToCart got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveToCart.t.Errorf("ServiceMock.MoveToCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveToCart.MoveToCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveToCart.t.Fatal("No results are set for the ServiceMock.MoveToCart")
+		}
+		return (*mm_results).err
+	}
+	if mmMoveToCart.funcMoveToCart != nil {
+		return mmMoveToCart.funcMoveToCart(ctx, data)
+	}
+	mmMoveToCart.t.Fatalf("Unexpected call to ServiceMock.MoveToCart. %v %v", ctx, data)
+	return
+}
+
+// MoveToCartAfterCounter returns a count of finished ServiceMock.MoveToCart invocations
+func (mmMoveToCart *ServiceMock) MoveToCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToCart.afterMoveToCartCounter)
+}
+
+// MoveToCartBeforeCounter returns a count of ServiceMock.MoveToCart invocations
+func (mmMoveToCart *ServiceMock) MoveToCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToCart.beforeMoveToCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.MoveToCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveToCart *mServiceMockMoveToCart) Calls() []*ServiceMockMoveToCartParams {
+	mmMoveToCart.mutex.RLock()
+
+	argCopy := make([]*ServiceMockMoveToCartParams, len(mmMoveToCart.callArgs))
+	copy(argCopy, mmMoveToCart.callArgs)
+
+	mmMoveToCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveToCartDone returns true if the count of the MoveToCart invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockMoveToCartDone() bool {
+	if m.MoveToCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveToCartMock.invocationsDone()
+}
+
+// MinimockMoveToCartInspect logs each unmet expectation
+func (m *ServiceMock) MinimockMoveToCartInspect() {
+	for _, e := range m.MoveToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.MoveToCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveToCartCounter := mm_atomic.LoadUint64(&m.afterMoveToCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveToCartMock.defaultExpectation != nil && afterMoveToCartCounter < 1 {
+		if m.MoveToCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.MoveToCart at\n%s", m.MoveToCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.MoveToCart at\n%s with params: %#v", m.MoveToCartMock.defaultExpectation.expectationOrigins.origin, *m.MoveToCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveToCart != nil && afterMoveToCartCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.MoveToCart at\n%s", m.funcMoveToCartOrigin)
+	}
+
+	if !m.MoveToCartMock.invocationsDone() && afterMoveToCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.MoveToCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveToCartMock.expectedInvocations), m.MoveToCartMock.expectedInvocationsOrigin, afterMoveToCartCounter)
+	}
+}
+
+type mServiceMockOrderCreate struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockOrderCreateExpectation
+	expectations       []*ServiceMockOrderCreateExpectation
+
+	callArgs []*ServiceMockOrderCreateParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockOrderCreateExpectation specifies expectation struct of the Service.OrderCreate
+type ServiceMockOrderCreateExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockOrderCreateParams
+	paramPtrs          *ServiceMockOrderCreateParamPtrs
+	expectationOrigins ServiceMockOrderCreateExpectationOrigins
+	results            *ServiceMockOrderCreateResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockOrderCreateParams contains parameters of the Service.OrderCreate
+type ServiceMockOrderCreateParams struct {
+	ctx    context.Context
+	UserID int64
+	items  *model.GetItemsFromCartResponce
+}
+
+// ServiceMockOrderCreateParamPtrs contains pointers to parameters of the Service.OrderCreate
+type ServiceMockOrderCreateParamPtrs struct {
+	ctx    *context.Context
+	UserID *int64
+	items  **model.GetItemsFromCartResponce
+}
+
+// ServiceMockOrderCreateResults contains results of the Service.OrderCreate
+type ServiceMockOrderCreateResults struct {
+	i1  int64
+	err error
+}
+
+// ServiceMockOrderCreateOrigins contains origins of expectations of the Service.OrderCreate
+type ServiceMockOrderCreateExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originItems  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmOrderCreate *mServiceMockOrderCreate) Optional() *mServiceMockOrderCreate {
+	mmOrderCreate.optional = true
+	return mmOrderCreate
+}
+
+// Expect sets up expected params for Service.OrderCreate
+func (mmOrderCreate *mServiceMockOrderCreate) Expect(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) *mServiceMockOrderCreate {
+	if mmOrderCreate.mock.funcOrderCreate != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+	}
+
+	if mmOrderCreate.defaultExpectation == nil {
+		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
+	}
+
+	if mmOrderCreate.defaultExpectation.paramPtrs != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by ExpectParams functions")
+	}
+
+	mmOrderCreate.defaultExpectation.params = &ServiceMockOrderCreateParams{ctx, UserID, items}
+	mmOrderCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmOrderCreate.expectations {
+		if minimock.Equal(e.params, mmOrderCreate.defaultExpectation.params) {
+			mmOrderCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOrderCreate.defaultExpectation.params)
+		}
+	}
+
+	return mmOrderCreate
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.OrderCreate
+func (mmOrderCreate *mServiceMockOrderCreate) ExpectCtxParam1(ctx context.Context) *mServiceMockOrderCreate {
+	if mmOrderCreate.mock.funcOrderCreate != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+	}
+
+	if mmOrderCreate.defaultExpectation == nil {
+		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
+	}
+
+	if mmOrderCreate.defaultExpectation.params != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Expect")
+	}
+
+	if mmOrderCreate.defaultExpectation.paramPtrs == nil {
+		mmOrderCreate.defaultExpectation.paramPtrs = &ServiceMockOrderCreateParamPtrs{}
+	}
+	mmOrderCreate.defaultExpectation.paramPtrs.ctx = &ctx
+	mmOrderCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmOrderCreate
+}
+
+// ExpectUserIDParam2 sets up expected param UserID for Service.OrderCreate
+func (mmOrderCreate *mServiceMockOrderCreate) ExpectUserIDParam2(UserID int64) *mServiceMockOrderCreate {
+	if mmOrderCreate.mock.funcOrderCreate != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+	}
+
+	if mmOrderCreate.defaultExpectation == nil {
+		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
+	}
+
+	if mmOrderCreate.defaultExpectation.params != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Expect")
+	}
+
+	if mmOrderCreate.defaultExpectation.paramPtrs == nil {
+		mmOrderCreate.defaultExpectation.paramPtrs = &ServiceMockOrderCreateParamPtrs{}
+	}
+	mmOrderCreate.defaultExpectation.paramPtrs.UserID = &UserID
+	mmOrderCreate.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmOrderCreate
+}
+
+// ExpectItemsParam3 sets up expected param items for Service.OrderCreate
+func (mmOrderCreate *mServiceMockOrderCreate) ExpectItemsParam3(items *model.GetItemsFromCartResponce) *mServiceMockOrderCreate {
+	if mmOrderCreate.mock.funcOrderCreate != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+	}
+
+	if mmOrderCreate.defaultExpectation == nil {
+		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
+	}
+
+	if mmOrderCreate.defaultExpectation.params != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Expect")
+	}
+
+	if mmOrderCreate.defaultExpectation.paramPtrs == nil {
+		mmOrderCreate.defaultExpectation.paramPtrs = &ServiceMockOrderCreateParamPtrs{}
+	}
+	mmOrderCreate.defaultExpectation.paramPtrs.items = &items
+	mmOrderCreate.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmOrderCreate
+}
+
+// Inspect accepts an inspector function that has same arguments as the Service.OrderCreate
+func (mmOrderCreate *mServiceMockOrderCreate) Inspect(f func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce)) *mServiceMockOrderCreate {
+	if mmOrderCreate.mock.inspectFuncOrderCreate != nil {
+		mmOrderCreate.mock.t.Fatalf("Inspect function is already set for ServiceMock.OrderCreate")
+	}
+
+	mmOrderCreate.mock.inspectFuncOrderCreate = f
+
+	return mmOrderCreate
+}
+
+// Return sets up results that will be returned by Service.OrderCreate
+func (mmOrderCreate *mServiceMockOrderCreate) Return(i1 int64, err error) *ServiceMock {
+	if mmOrderCreate.mock.funcOrderCreate != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+	}
+
+	if mmOrderCreate.defaultExpectation == nil {
+		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{mock: mmOrderCreate.mock}
+	}
+	mmOrderCreate.defaultExpectation.results = &ServiceMockOrderCreateResults{i1, err}
+	mmOrderCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmOrderCreate.mock
+}
+
+// Set uses given function f to mock the Service.OrderCreate method
+func (mmOrderCreate *mServiceMockOrderCreate) Set(f func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) (i1 int64, err error)) *ServiceMock {
+	if mmOrderCreate.defaultExpectation != nil {
+		mmOrderCreate.mock.t.Fatalf("Default expectation is already set for the Service.OrderCreate method")
+	}
+
+	if len(mmOrderCreate.expectations) > 0 {
+		mmOrderCreate.mock.t.Fatalf("Some expectations are already set for the Service.OrderCreate method")
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation.params != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Expect")
+	mmOrderCreate.mock.funcOrderCreate = f
+	mmOrderCreate.mock.funcOrderCreateOrigin = minimock.CallerInfo(1)
+	return mmOrderCreate.mock
+}
+
+// When sets expectation for the Service.OrderCreate which will trigger the result defined by the following
+// Then helper
+func (mmOrderCreate *mServiceMockOrderCreate) When(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) *ServiceMockOrderCreateExpectation {
+	if mmOrderCreate.mock.funcOrderCreate != nil {
+		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteItemsByUserID.defaultExpectation.paramPtrs = &ServiceMockDeleteItemsByUserIDParamPtrs{}
+	expectation := &ServiceMockOrderCreateExpectation{
+		mock:               mmOrderCreate.mock,
+		params:             &ServiceMockOrderCreateParams{ctx, UserID, items},
+		expectationOrigins: ServiceMockOrderCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteItemsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteItemsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmOrderCreate.expectations = append(mmOrderCreate.expectations, expectation)
+	return expectation
+}
 
-	return mmDeleteItemsByUserID
+// Then sets up Service.OrderCreate return parameters for the expectation previously defined by the When method
+func (e *ServiceMockOrderCreateExpectation) Then(i1 int64, err error) *ServiceMock {
+	e.results = &ServiceMockOrderCreateResults{i1, err}
+	return e.mock
 }
 
-// ExpectDataParam2 sets up expected param data for Service.DeleteItemsByUserID
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) ExpectDataParam2(data model.RequestData) *mServiceMockDeleteItemsByUserID {
-	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+// Times sets number of times Service.OrderCreate should be invoked
+func (mmOrderCreate *mServiceMockOrderCreate) Times(n uint64) *mServiceMockOrderCreate {
+	if n == 0 {
+		mmOrderCreate.mock.t.Fatalf("Times of ServiceMock.OrderCreate mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmOrderCreate.expectedInvocations, n)
+	mmOrderCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmOrderCreate
+}
+
+func (mmOrderCreate *mServiceMockOrderCreate) invocationsDone() bool {
+	if len(mmOrderCreate.expectations) == 0 && mmOrderCreate.defaultExpectation == nil && mmOrderCreate.mock.funcOrderCreate == nil {
+		return true
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation == nil {
-		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{}
+	totalInvocations := mm_atomic.LoadUint64(&mmOrderCreate.mock.afterOrderCreateCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmOrderCreate.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// OrderCreate implements mm_server.Service
+func (mmOrderCreate *ServiceMock) OrderCreate(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmOrderCreate.beforeOrderCreateCounter, 1)
+	defer mm_atomic.AddUint64(&mmOrderCreate.afterOrderCreateCounter, 1)
+
+	mmOrderCreate.t.Helper()
+
+	if mmOrderCreate.inspectFuncOrderCreate != nil {
+		mmOrderCreate.inspectFuncOrderCreate(ctx, UserID, items)
+	}
+
+	mm_params := ServiceMockOrderCreateParams{ctx, UserID, items}
+
+	// Record call args
+	mmOrderCreate.OrderCreateMock.mutex.Lock()
+	mmOrderCreate.OrderCreateMock.callArgs = append(mmOrderCreate.OrderCreateMock.callArgs, &mm_params)
+	mmOrderCreate.OrderCreateMock.mutex.Unlock()
+
+	for _, e := range mmOrderCreate.OrderCreateMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmOrderCreate.OrderCreateMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmOrderCreate.OrderCreateMock.defaultExpectation.Counter, 1)
+		mm_want := mmOrderCreate.OrderCreateMock.defaultExpectation.params
+		mm_want_ptrs := mmOrderCreate.OrderCreateMock.defaultExpectation.paramPtrs
+
+		mm_got := ServiceMockOrderCreateParams{ctx, UserID, items}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.UserID != nil && !minimock.Equal(*mm_want_ptrs.UserID, mm_got.UserID) {
+				mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameter UserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.UserID, mm_got.UserID, minimock.Diff(*mm_want_ptrs.UserID, mm_got.UserID))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmOrderCreate.OrderCreateMock.defaultExpectation.results
+		if mm_results == nil {
+			mmOrderCreate.t.Fatal("No results are set for the ServiceMock.OrderCreate")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmOrderCreate.funcOrderCreate != nil {
+		return mmOrderCreate.funcOrderCreate(ctx, UserID, items)
+	}
+	mmOrderCreate.t.Fatalf("Unexpected call to ServiceMock.OrderCreate. %v %v %v", ctx, UserID, items)
+	return
+}
+
+// OrderCreateAfterCounter returns a count of finished ServiceMock.OrderCreate invocations
+func (mmOrderCreate *ServiceMock) OrderCreateAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOrderCreate.afterOrderCreateCounter)
+}
+
+// OrderCreateBeforeCounter returns a count of ServiceMock.OrderCreate invocations
+func (mmOrderCreate *ServiceMock) OrderCreateBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmOrderCreate.beforeOrderCreateCounter)
+}
+
+// Calls returns a list of arguments used in each call to ServiceMock.OrderCreate.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmOrderCreate *mServiceMockOrderCreate) Calls() []*ServiceMockOrderCreateParams {
+	mmOrderCreate.mutex.RLock()
+
+	argCopy := make([]*ServiceMockOrderCreateParams, len(mmOrderCreate.callArgs))
+	copy(argCopy, mmOrderCreate.callArgs)
+
+	mmOrderCreate.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockOrderCreateDone returns true if the count of the OrderCreate invocations corresponds
+// the number of defined expectations
+func (m *ServiceMock) MinimockOrderCreateDone() bool {
+	if m.OrderCreateMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.OrderCreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.OrderCreateMock.invocationsDone()
+}
+
+// MinimockOrderCreateInspect logs each unmet expectation
+func (m *ServiceMock) MinimockOrderCreateInspect() {
+	for _, e := range m.OrderCreateMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterOrderCreateCounter := mm_atomic.LoadUint64(&m.afterOrderCreateCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.OrderCreateMock.defaultExpectation != nil && afterOrderCreateCounter < 1 {
+		if m.OrderCreateMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s", m.OrderCreateMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s with params: %#v", m.OrderCreateMock.defaultExpectation.expectationOrigins.origin, *m.OrderCreateMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcOrderCreate != nil && afterOrderCreateCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s", m.funcOrderCreateOrigin)
+	}
+
+	if !m.OrderCreateMock.invocationsDone() && afterOrderCreateCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.OrderCreate at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.OrderCreateMock.expectedInvocations), m.OrderCreateMock.expectedInvocationsOrigin, afterOrderCreateCounter)
+	}
+}
+
+type mServiceMockRemovePromoCode struct {
+	optional           bool
+	mock               *ServiceMock
+	defaultExpectation *ServiceMockRemovePromoCodeExpectation
+	expectations       []*ServiceMockRemovePromoCodeExpectation
+
+	callArgs []*ServiceMockRemovePromoCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ServiceMockRemovePromoCodeExpectation specifies expectation struct of the Service.RemovePromoCode
+type ServiceMockRemovePromoCodeExpectation struct {
+	mock               *ServiceMock
+	params             *ServiceMockRemovePromoCodeParams
+	paramPtrs          *ServiceMockRemovePromoCodeParamPtrs
+	expectationOrigins ServiceMockRemovePromoCodeExpectationOrigins
+	results            *ServiceMockRemovePromoCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ServiceMockRemovePromoCodeParams contains parameters of the Service.RemovePromoCode
+type ServiceMockRemovePromoCodeParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// ServiceMockRemovePromoCodeParamPtrs contains pointers to parameters of the Service.RemovePromoCode
+type ServiceMockRemovePromoCodeParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// ServiceMockRemovePromoCodeResults contains results of the Service.RemovePromoCode
+type ServiceMockRemovePromoCodeResults struct {
+	err error
+}
+
+// ServiceMockRemovePromoCodeOrigins contains origins of expectations of the Service.RemovePromoCode
+type ServiceMockRemovePromoCodeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) Optional() *mServiceMockRemovePromoCode {
+	mmRemovePromoCode.optional = true
+	return mmRemovePromoCode
+}
+
+// Expect sets up expected params for Service.RemovePromoCode
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) Expect(ctx context.Context, userID int64) *mServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &ServiceMockRemovePromoCodeExpectation{}
+	}
+
+	if mmRemovePromoCode.defaultExpectation.paramPtrs != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by ExpectParams functions")
+	}
+
+	mmRemovePromoCode.defaultExpectation.params = &ServiceMockRemovePromoCodeParams{ctx, userID}
+	mmRemovePromoCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmRemovePromoCode.expectations {
+		if minimock.Equal(e.params, mmRemovePromoCode.defaultExpectation.params) {
+			mmRemovePromoCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmRemovePromoCode.defaultExpectation.params)
+		}
+	}
+
+	return mmRemovePromoCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Service.RemovePromoCode
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) ExpectCtxParam1(ctx context.Context) *mServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &ServiceMockRemovePromoCodeExpectation{}
+	}
+
+	if mmRemovePromoCode.defaultExpectation.params != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by Expect")
+	}
+
+	if mmRemovePromoCode.defaultExpectation.paramPtrs == nil {
+		mmRemovePromoCode.defaultExpectation.paramPtrs = &ServiceMockRemovePromoCodeParamPtrs{}
+	}
+	mmRemovePromoCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmRemovePromoCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmRemovePromoCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Service.RemovePromoCode
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) ExpectUserIDParam2(userID int64) *mServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by Set")
+	}
+
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &ServiceMockRemovePromoCodeExpectation{}
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation.params != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Expect")
+	if mmRemovePromoCode.defaultExpectation.params != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by Expect")
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation.paramPtrs == nil {
-		mmDeleteItemsByUserID.defaultExpectation.paramPtrs = &ServiceMockDeleteItemsByUserIDParamPtrs{}
+	if mmRemovePromoCode.defaultExpectation.paramPtrs == nil {
+		mmRemovePromoCode.defaultExpectation.paramPtrs = &ServiceMockRemovePromoCodeParamPtrs{}
 	}
-	mmDeleteItemsByUserID.defaultExpectation.paramPtrs.data = &data
-	mmDeleteItemsByUserID.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+	mmRemovePromoCode.defaultExpectation.paramPtrs.userID = &userID
+	mmRemovePromoCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmDeleteItemsByUserID
+	return mmRemovePromoCode
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.DeleteItemsByUserID
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockDeleteItemsByUserID {
-	if mmDeleteItemsByUserID.mock.inspectFuncDeleteItemsByUserID != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("Inspect function is already set for ServiceMock.DeleteItemsByUserID")
+// Inspect accepts an inspector function that has same arguments as the Service.RemovePromoCode
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) Inspect(f func(ctx context.Context, userID int64)) *mServiceMockRemovePromoCode {
+	if mmRemovePromoCode.mock.inspectFuncRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("Inspect function is already set for ServiceMock.RemovePromoCode")
 	}
 
-	mmDeleteItemsByUserID.mock.inspectFuncDeleteItemsByUserID = f
+	mmRemovePromoCode.mock.inspectFuncRemovePromoCode = f
 
-	return mmDeleteItemsByUserID
+	return mmRemovePromoCode
 }
 
-// Return sets up results that will be returned by Service.DeleteItemsByUserID
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Return(err error) *ServiceMock {
-	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+// Return sets up results that will be returned by Service.RemovePromoCode
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) Return(err error) *ServiceMock {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by Set")
 	}
 
-	if mmDeleteItemsByUserID.defaultExpectation == nil {
-		mmDeleteItemsByUserID.defaultExpectation = &ServiceMockDeleteItemsByUserIDExpectation{mock: mmDeleteItemsByUserID.mock}
+	if mmRemovePromoCode.defaultExpectation == nil {
+		mmRemovePromoCode.defaultExpectation = &ServiceMockRemovePromoCodeExpectation{mock: mmRemovePromoCode.mock}
 	}
-	mmDeleteItemsByUserID.defaultExpectation.results = &ServiceMockDeleteItemsByUserIDResults{err}
-	mmDeleteItemsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteItemsByUserID.mock
+	mmRemovePromoCode.defaultExpectation.results = &ServiceMockRemovePromoCodeResults{err}
+	mmRemovePromoCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmRemovePromoCode.mock
 }
 
-// Set uses given function f to mock the Service.DeleteItemsByUserID method
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
-	if mmDeleteItemsByUserID.defaultExpectation != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("Default expectation is already set for the Service.DeleteItemsByUserID method")
+// Set uses given function f to mock the Service.RemovePromoCode method
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) Set(f func(ctx context.Context, userID int64) (err error)) *ServiceMock {
+	if mmRemovePromoCode.defaultExpectation != nil {
+		mmRemovePromoCode.mock.t.Fatalf("Default expectation is already set for the Service.RemovePromoCode method")
 	}
 
-	if len(mmDeleteItemsByUserID.expectations) > 0 {
-		mmDeleteItemsByUserID.mock.t.Fatalf("Some expectations are already set for the Service.DeleteItemsByUserID method")
+	if len(mmRemovePromoCode.expectations) > 0 {
+		mmRemovePromoCode.mock.t.Fatalf("Some expectations are already set for the Service.RemovePromoCode method")
 	}
 
-	mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID = f
-	mmDeleteItemsByUserID.mock.funcDeleteItemsByUserIDOrigin = minimock.CallerInfo(1)
-	return mmDeleteItemsByUserID.mock
+	mmRemovePromoCode.mock.funcRemovePromoCode = f
+	mmRemovePromoCode.mock.funcRemovePromoCodeOrigin = minimock.CallerInfo(1)
+	return mmRemovePromoCode.mock
 }
 
-// When sets expectation for the Service.DeleteItemsByUserID which will trigger the result defined by the following
+// When sets expectation for the Service.RemovePromoCode which will trigger the result defined by the following
 // Then helper
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) When(ctx context.Context, data model.RequestData) *ServiceMockDeleteItemsByUserIDExpectation {
-	if mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID != nil {
-		mmDeleteItemsByUserID.mock.t.Fatalf("ServiceMock.DeleteItemsByUserID mock is already set by Set")
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) When(ctx context.Context, userID int64) *ServiceMockRemovePromoCodeExpectation {
+	if mmRemovePromoCode.mock.funcRemovePromoCode != nil {
+		mmRemovePromoCode.mock.t.Fatalf("ServiceMock.RemovePromoCode mock is already set by Set")
 	}
 
-	expectation := &ServiceMockDeleteItemsByUserIDExpectation{
-		mock:               mmDeleteItemsByUserID.mock,
-		params:             &ServiceMockDeleteItemsByUserIDParams{ctx, data},
-		expectationOrigins: ServiceMockDeleteItemsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockRemovePromoCodeExpectation{
+		mock:               mmRemovePromoCode.mock,
+		params:             &ServiceMockRemovePromoCodeParams{ctx, userID},
+		expectationOrigins: ServiceMockRemovePromoCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteItemsByUserID.expectations = append(mmDeleteItemsByUserID.expectations, expectation)
+	mmRemovePromoCode.expectations = append(mmRemovePromoCode.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.DeleteItemsByUserID return parameters for the expectation previously defined by the When method
-func (e *ServiceMockDeleteItemsByUserIDExpectation) Then(err error) *ServiceMock {
-	e.results = &ServiceMockDeleteItemsByUserIDResults{err}
+// Then sets up Service.RemovePromoCode return parameters for the expectation previously defined by the When method
+func (e *ServiceMockRemovePromoCodeExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockRemovePromoCodeResults{err}
 	return e.mock
 }
 
-// Times sets number of times Service.DeleteItemsByUserID should be invoked
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Times(n uint64) *mServiceMockDeleteItemsByUserID {
+// Times sets number of times Service.RemovePromoCode should be invoked
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) Times(n uint64) *mServiceMockRemovePromoCode {
 	if n == 0 {
-		mmDeleteItemsByUserID.mock.t.Fatalf("Times of ServiceMock.DeleteItemsByUserID mock can not be zero")
+		mmRemovePromoCode.mock.t.Fatalf("Times of ServiceMock.RemovePromoCode mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteItemsByUserID.expectedInvocations, n)
-	mmDeleteItemsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteItemsByUserID
+	mm_atomic.StoreUint64(&mmRemovePromoCode.expectedInvocations, n)
+	mmRemovePromoCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmRemovePromoCode
 }
 
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) invocationsDone() bool {
-	if len(mmDeleteItemsByUserID.expectations) == 0 && mmDeleteItemsByUserID.defaultExpectation == nil && mmDeleteItemsByUserID.mock.funcDeleteItemsByUserID == nil {
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) invocationsDone() bool {
+	if len(mmRemovePromoCode.expectations) == 0 && mmRemovePromoCode.defaultExpectation == nil && mmRemovePromoCode.mock.funcRemovePromoCode == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItemsByUserID.mock.afterDeleteItemsByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItemsByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmRemovePromoCode.mock.afterRemovePromoCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmRemovePromoCode.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteItemsByUserID implements mm_server.Service
-func (mmDeleteItemsByUserID *ServiceMock) DeleteItemsByUserID(ctx context.Context, data model.RequestData) (err error) {
-	mm_atomic.AddUint64(&mmDeleteItemsByUserID.beforeDeleteItemsByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteItemsByUserID.afterDeleteItemsByUserIDCounter, 1)
+// RemovePromoCode implements mm_server.Service
+func (mmRemovePromoCode *ServiceMock) RemovePromoCode(ctx context.Context, userID int64) (err error) {
+	mm_atomic.AddUint64(&mmRemovePromoCode.beforeRemovePromoCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmRemovePromoCode.afterRemovePromoCodeCounter, 1)
 
-	mmDeleteItemsByUserID.t.Helper()
+	mmRemovePromoCode.t.Helper()
 
-	if mmDeleteItemsByUserID.inspectFuncDeleteItemsByUserID != nil {
-		mmDeleteItemsByUserID.inspectFuncDeleteItemsByUserID(ctx, data)
+	if mmRemovePromoCode.inspectFuncRemovePromoCode != nil {
+		mmRemovePromoCode.inspectFuncRemovePromoCode(ctx, userID)
 	}
 
-	mm_params := ServiceMockDeleteItemsByUserIDParams{ctx, data}
+	mm_params := ServiceMockRemovePromoCodeParams{ctx, userID}
 
 	// Record call args
-	mmDeleteItemsByUserID.DeleteItemsByUserIDMock.mutex.Lock()
-	mmDeleteItemsByUserID.DeleteItemsByUserIDMock.callArgs = append(mmDeleteItemsByUserID.DeleteItemsByUserIDMock.callArgs, &mm_params)
-	mmDeleteItemsByUserID.DeleteItemsByUserIDMock.mutex.Unlock()
+	mmRemovePromoCode.RemovePromoCodeMock.mutex.Lock()
+	mmRemovePromoCode.RemovePromoCodeMock.callArgs = append(mmRemovePromoCode.RemovePromoCodeMock.callArgs, &mm_params)
+	mmRemovePromoCode.RemovePromoCodeMock.mutex.Unlock()
 
-	for _, e := range mmDeleteItemsByUserID.DeleteItemsByUserIDMock.expectations {
+	for _, e := range mmRemovePromoCode.RemovePromoCodeMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.paramPtrs
+	if mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockDeleteItemsByUserIDParams{ctx, data}
+		mm_got := ServiceMockRemovePromoCodeParams{ctx, userID}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteItemsByUserID.t.Errorf("ServiceMock.DeleteItemsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmRemovePromoCode.t.Errorf("ServiceMock.RemovePromoCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
-				mmDeleteItemsByUserID.t.Errorf("ServiceMock.DeleteItemsByUserID got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmRemovePromoCode.t.Errorf("ServiceMock.RemovePromoCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteItemsByUserID.t.Errorf("ServiceMock.DeleteItemsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmRemovePromoCode.t.Errorf("ServiceMock.RemovePromoCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteItemsByUserID.DeleteItemsByUserIDMock.defaultExpectation.results
+		mm_results := mmRemovePromoCode.RemovePromoCodeMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteItemsByUserID.t.Fatal("No results are set for the ServiceMock.DeleteItemsByUserID")
+			mmRemovePromoCode.t.Fatal("No results are set for the ServiceMock.RemovePromoCode")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteItemsByUserID.funcDeleteItemsByUserID != nil {
-		return mmDeleteItemsByUserID.funcDeleteItemsByUserID(ctx, data)
+	if mmRemovePromoCode.funcRemovePromoCode != nil {
+		return mmRemovePromoCode.funcRemovePromoCode(ctx, userID)
 	}
-	mmDeleteItemsByUserID.t.Fatalf("Unexpected call to ServiceMock.DeleteItemsByUserID. %v %v", ctx, data)
+	mmRemovePromoCode.t.Fatalf("Unexpected call to ServiceMock.RemovePromoCode. %v %v", ctx, userID)
 	return
 }
 
-// DeleteItemsByUserIDAfterCounter returns a count of finished ServiceMock.DeleteItemsByUserID invocations
-func (mmDeleteItemsByUserID *ServiceMock) DeleteItemsByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItemsByUserID.afterDeleteItemsByUserIDCounter)
+// RemovePromoCodeAfterCounter returns a count of finished ServiceMock.RemovePromoCode invocations
+func (mmRemovePromoCode *ServiceMock) RemovePromoCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePromoCode.afterRemovePromoCodeCounter)
 }
 
-// DeleteItemsByUserIDBeforeCounter returns a count of ServiceMock.DeleteItemsByUserID invocations
-func (mmDeleteItemsByUserID *ServiceMock) DeleteItemsByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItemsByUserID.beforeDeleteItemsByUserIDCounter)
+// RemovePromoCodeBeforeCounter returns a count of ServiceMock.RemovePromoCode invocations
+func (mmRemovePromoCode *ServiceMock) RemovePromoCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmRemovePromoCode.beforeRemovePromoCodeCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.DeleteItemsByUserID.
+// Calls returns a list of arguments used in each call to ServiceMock.RemovePromoCode.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteItemsByUserID *mServiceMockDeleteItemsByUserID) Calls() []*ServiceMockDeleteItemsByUserIDParams {
-	mmDeleteItemsByUserID.mutex.RLock()
+func (mmRemovePromoCode *mServiceMockRemovePromoCode) Calls() []*ServiceMockRemovePromoCodeParams {
+	mmRemovePromoCode.mutex.RLock()
 
-	argCopy := make([]*ServiceMockDeleteItemsByUserIDParams, len(mmDeleteItemsByUserID.callArgs))
-	copy(argCopy, mmDeleteItemsByUserID.callArgs)
+	argCopy := make([]*ServiceMockRemovePromoCodeParams, len(mmRemovePromoCode.callArgs))
+	copy(argCopy, mmRemovePromoCode.callArgs)
 
-	mmDeleteItemsByUserID.mutex.RUnlock()
+	mmRemovePromoCode.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteItemsByUserIDDone returns true if the count of the DeleteItemsByUserID invocations corresponds
+// MinimockRemovePromoCodeDone returns true if the count of the RemovePromoCode invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockDeleteItemsByUserIDDone() bool {
-	if m.DeleteItemsByUserIDMock.optional {
+func (m *ServiceMock) MinimockRemovePromoCodeDone() bool {
+	if m.RemovePromoCodeMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteItemsByUserIDMock.expectations {
+	for _, e := range m.RemovePromoCodeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteItemsByUserIDMock.invocationsDone()
+	return m.RemovePromoCodeMock.invocationsDone()
 }
 
-// MinimockDeleteItemsByUserIDInspect logs each unmet expectation
-func (m *ServiceMock) MinimockDeleteItemsByUserIDInspect() {
-	for _, e := range m.DeleteItemsByUserIDMock.expectations {
+// MinimockRemovePromoCodeInspect logs each unmet expectation
+func (m *ServiceMock) MinimockRemovePromoCodeInspect() {
+	for _, e := range m.RemovePromoCodeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.RemovePromoCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteItemsByUserIDCounter := mm_atomic.LoadUint64(&m.afterDeleteItemsByUserIDCounter)
+	afterRemovePromoCodeCounter := mm_atomic.LoadUint64(&m.afterRemovePromoCodeCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteItemsByUserIDMock.defaultExpectation != nil && afterDeleteItemsByUserIDCounter < 1 {
-		if m.DeleteItemsByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s", m.DeleteItemsByUserIDMock.defaultExpectation.returnOrigin)
+	if m.RemovePromoCodeMock.defaultExpectation != nil && afterRemovePromoCodeCounter < 1 {
+		if m.RemovePromoCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.RemovePromoCode at\n%s", m.RemovePromoCodeMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s with params: %#v", m.DeleteItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemsByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.RemovePromoCode at\n%s with params: %#v", m.RemovePromoCodeMock.defaultExpectation.expectationOrigins.origin, *m.RemovePromoCodeMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteItemsByUserID != nil && afterDeleteItemsByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.DeleteItemsByUserID at\n%s", m.funcDeleteItemsByUserIDOrigin)
+	if m.funcRemovePromoCode != nil && afterRemovePromoCodeCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.RemovePromoCode at\n%s", m.funcRemovePromoCodeOrigin)
 	}
 
-	if !m.DeleteItemsByUserIDMock.invocationsDone() && afterDeleteItemsByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.DeleteItemsByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteItemsByUserIDMock.expectedInvocations), m.DeleteItemsByUserIDMock.expectedInvocationsOrigin, afterDeleteItemsByUserIDCounter)
+	if !m.RemovePromoCodeMock.invocationsDone() && afterRemovePromoCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.RemovePromoCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.RemovePromoCodeMock.expectedInvocations), m.RemovePromoCodeMock.expectedInvocationsOrigin, afterRemovePromoCodeCounter)
 	}
 }
 
-type mServiceMockGetItemsFromCart struct {
+type mServiceMockSaveForLater struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockGetItemsFromCartExpectation
-	expectations       []*ServiceMockGetItemsFromCartExpectation
+	defaultExpectation *ServiceMockSaveForLaterExpectation
+	expectations       []*ServiceMockSaveForLaterExpectation
 
-	callArgs []*ServiceMockGetItemsFromCartParams
+	callArgs []*ServiceMockSaveForLaterParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockGetItemsFromCartExpectation specifies expectation struct of the Service.GetItemsFromCart
-type ServiceMockGetItemsFromCartExpectation struct {
+// ServiceMockSaveForLaterExpectation specifies expectation struct of the Service.SaveForLater
+type ServiceMockSaveForLaterExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockGetItemsFromCartParams
-	paramPtrs          *ServiceMockGetItemsFromCartParamPtrs
-	expectationOrigins ServiceMockGetItemsFromCartExpectationOrigins
-	results            *ServiceMockGetItemsFromCartResults
+	params             *ServiceMockSaveForLaterParams
+	paramPtrs          *ServiceMockSaveForLaterParamPtrs
+	expectationOrigins ServiceMockSaveForLaterExpectationOrigins
+	results            *ServiceMockSaveForLaterResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockGetItemsFromCartParams contains parameters of the Service.GetItemsFromCart
-type ServiceMockGetItemsFromCartParams struct {
+// ServiceMockSaveForLaterParams contains parameters of the Service.SaveForLater
+type ServiceMockSaveForLaterParams struct {
 	ctx  context.Context
 	data model.RequestData
 }
 
-// ServiceMockGetItemsFromCartParamPtrs contains pointers to parameters of the Service.GetItemsFromCart
-type ServiceMockGetItemsFromCartParamPtrs struct {
+// ServiceMockSaveForLaterParamPtrs contains pointers to parameters of the Service.SaveForLater
+type ServiceMockSaveForLaterParamPtrs struct {
 	ctx  *context.Context
 	data *model.RequestData
 }
 
-// ServiceMockGetItemsFromCartResults contains results of the Service.GetItemsFromCart
-type ServiceMockGetItemsFromCartResults struct {
-	gp1 *model.GetItemsFromCartResponce
+// ServiceMockSaveForLaterResults contains results of the Service.SaveForLater
+type ServiceMockSaveForLaterResults struct {
 	err error
 }
 
-// ServiceMockGetItemsFromCartOrigins contains origins of expectations of the Service.GetItemsFromCart
-type ServiceMockGetItemsFromCartExpectationOrigins struct {
+// ServiceMockSaveForLaterOrigins contains origins of expectations of the Service.SaveForLater
+type ServiceMockSaveForLaterExpectationOrigins struct {
 	origin     string
 	originCtx  string
 	originData string
@@ -1163,345 +4843,341 @@ type ServiceMockGetItemsFromCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Optional() *mServiceMockGetItemsFromCart {
-	mmGetItemsFromCart.optional = true
-	return mmGetItemsFromCart
+func (mmSaveForLater *mServiceMockSaveForLater) Optional() *mServiceMockSaveForLater {
+	mmSaveForLater.optional = true
+	return mmSaveForLater
 }
 
-// Expect sets up expected params for Service.GetItemsFromCart
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Expect(ctx context.Context, data model.RequestData) *mServiceMockGetItemsFromCart {
-	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+// Expect sets up expected params for Service.SaveForLater
+func (mmSaveForLater *mServiceMockSaveForLater) Expect(ctx context.Context, data model.RequestData) *mServiceMockSaveForLater {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by Set")
 	}
 
-	if mmGetItemsFromCart.defaultExpectation == nil {
-		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{}
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &ServiceMockSaveForLaterExpectation{}
 	}
 
-	if mmGetItemsFromCart.defaultExpectation.paramPtrs != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by ExpectParams functions")
+	if mmSaveForLater.defaultExpectation.paramPtrs != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by ExpectParams functions")
 	}
 
-	mmGetItemsFromCart.defaultExpectation.params = &ServiceMockGetItemsFromCartParams{ctx, data}
-	mmGetItemsFromCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetItemsFromCart.expectations {
-		if minimock.Equal(e.params, mmGetItemsFromCart.defaultExpectation.params) {
-			mmGetItemsFromCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsFromCart.defaultExpectation.params)
+	mmSaveForLater.defaultExpectation.params = &ServiceMockSaveForLaterParams{ctx, data}
+	mmSaveForLater.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveForLater.expectations {
+		if minimock.Equal(e.params, mmSaveForLater.defaultExpectation.params) {
+			mmSaveForLater.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveForLater.defaultExpectation.params)
 		}
 	}
 
-	return mmGetItemsFromCart
+	return mmSaveForLater
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Service.GetItemsFromCart
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) ExpectCtxParam1(ctx context.Context) *mServiceMockGetItemsFromCart {
-	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.SaveForLater
+func (mmSaveForLater *mServiceMockSaveForLater) ExpectCtxParam1(ctx context.Context) *mServiceMockSaveForLater {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by Set")
 	}
 
-	if mmGetItemsFromCart.defaultExpectation == nil {
-		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{}
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &ServiceMockSaveForLaterExpectation{}
 	}
 
-	if mmGetItemsFromCart.defaultExpectation.params != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Expect")
+	if mmSaveForLater.defaultExpectation.params != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by Expect")
 	}
 
-	if mmGetItemsFromCart.defaultExpectation.paramPtrs == nil {
-		mmGetItemsFromCart.defaultExpectation.paramPtrs = &ServiceMockGetItemsFromCartParamPtrs{}
+	if mmSaveForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveForLater.defaultExpectation.paramPtrs = &ServiceMockSaveForLaterParamPtrs{}
 	}
-	mmGetItemsFromCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetItemsFromCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSaveForLater.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveForLater.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetItemsFromCart
+	return mmSaveForLater
 }
 
-// ExpectDataParam2 sets up expected param data for Service.GetItemsFromCart
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) ExpectDataParam2(data model.RequestData) *mServiceMockGetItemsFromCart {
-	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+// ExpectDataParam2 sets up expected param data for Service.SaveForLater
+func (mmSaveForLater *mServiceMockSaveForLater) ExpectDataParam2(data model.RequestData) *mServiceMockSaveForLater {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by Set")
 	}
 
-	if mmGetItemsFromCart.defaultExpectation == nil {
-		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{}
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &ServiceMockSaveForLaterExpectation{}
 	}
 
-	if mmGetItemsFromCart.defaultExpectation.params != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Expect")
+	if mmSaveForLater.defaultExpectation.params != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by Expect")
 	}
 
-	if mmGetItemsFromCart.defaultExpectation.paramPtrs == nil {
-		mmGetItemsFromCart.defaultExpectation.paramPtrs = &ServiceMockGetItemsFromCartParamPtrs{}
+	if mmSaveForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveForLater.defaultExpectation.paramPtrs = &ServiceMockSaveForLaterParamPtrs{}
 	}
-	mmGetItemsFromCart.defaultExpectation.paramPtrs.data = &data
-	mmGetItemsFromCart.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
+	mmSaveForLater.defaultExpectation.paramPtrs.data = &data
+	mmSaveForLater.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
 
-	return mmGetItemsFromCart
+	return mmSaveForLater
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.GetItemsFromCart
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockGetItemsFromCart {
-	if mmGetItemsFromCart.mock.inspectFuncGetItemsFromCart != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("Inspect function is already set for ServiceMock.GetItemsFromCart")
+// Inspect accepts an inspector function that has same arguments as the Service.SaveForLater
+func (mmSaveForLater *mServiceMockSaveForLater) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockSaveForLater {
+	if mmSaveForLater.mock.inspectFuncSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("Inspect function is already set for ServiceMock.SaveForLater")
 	}
 
-	mmGetItemsFromCart.mock.inspectFuncGetItemsFromCart = f
+	mmSaveForLater.mock.inspectFuncSaveForLater = f
 
-	return mmGetItemsFromCart
+	return mmSaveForLater
 }
 
-// Return sets up results that will be returned by Service.GetItemsFromCart
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Return(gp1 *model.GetItemsFromCartResponce, err error) *ServiceMock {
-	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+// Return sets up results that will be returned by Service.SaveForLater
+func (mmSaveForLater *mServiceMockSaveForLater) Return(err error) *ServiceMock {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by Set")
 	}
 
-	if mmGetItemsFromCart.defaultExpectation == nil {
-		mmGetItemsFromCart.defaultExpectation = &ServiceMockGetItemsFromCartExpectation{mock: mmGetItemsFromCart.mock}
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &ServiceMockSaveForLaterExpectation{mock: mmSaveForLater.mock}
 	}
-	mmGetItemsFromCart.defaultExpectation.results = &ServiceMockGetItemsFromCartResults{gp1, err}
-	mmGetItemsFromCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetItemsFromCart.mock
+	mmSaveForLater.defaultExpectation.results = &ServiceMockSaveForLaterResults{err}
+	mmSaveForLater.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveForLater.mock
 }
 
-// Set uses given function f to mock the Service.GetItemsFromCart method
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Set(f func(ctx context.Context, data model.RequestData) (gp1 *model.GetItemsFromCartResponce, err error)) *ServiceMock {
-	if mmGetItemsFromCart.defaultExpectation != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("Default expectation is already set for the Service.GetItemsFromCart method")
+// Set uses given function f to mock the Service.SaveForLater method
+func (mmSaveForLater *mServiceMockSaveForLater) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
+	if mmSaveForLater.defaultExpectation != nil {
+		mmSaveForLater.mock.t.Fatalf("Default expectation is already set for the Service.SaveForLater method")
 	}
 
-	if len(mmGetItemsFromCart.expectations) > 0 {
-		mmGetItemsFromCart.mock.t.Fatalf("Some expectations are already set for the Service.GetItemsFromCart method")
+	if len(mmSaveForLater.expectations) > 0 {
+		mmSaveForLater.mock.t.Fatalf("Some expectations are already set for the Service.SaveForLater method")
 	}
 
-	mmGetItemsFromCart.mock.funcGetItemsFromCart = f
-	mmGetItemsFromCart.mock.funcGetItemsFromCartOrigin = minimock.CallerInfo(1)
-	return mmGetItemsFromCart.mock
+	mmSaveForLater.mock.funcSaveForLater = f
+	mmSaveForLater.mock.funcSaveForLaterOrigin = minimock.CallerInfo(1)
+	return mmSaveForLater.mock
 }
 
-// When sets expectation for the Service.GetItemsFromCart which will trigger the result defined by the following
+// When sets expectation for the Service.SaveForLater which will trigger the result defined by the following
 // Then helper
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) When(ctx context.Context, data model.RequestData) *ServiceMockGetItemsFromCartExpectation {
-	if mmGetItemsFromCart.mock.funcGetItemsFromCart != nil {
-		mmGetItemsFromCart.mock.t.Fatalf("ServiceMock.GetItemsFromCart mock is already set by Set")
+func (mmSaveForLater *mServiceMockSaveForLater) When(ctx context.Context, data model.RequestData) *ServiceMockSaveForLaterExpectation {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("ServiceMock.SaveForLater mock is already set by Set")
 	}
 
-	expectation := &ServiceMockGetItemsFromCartExpectation{
-		mock:               mmGetItemsFromCart.mock,
-		params:             &ServiceMockGetItemsFromCartParams{ctx, data},
-		expectationOrigins: ServiceMockGetItemsFromCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockSaveForLaterExpectation{
+		mock:               mmSaveForLater.mock,
+		params:             &ServiceMockSaveForLaterParams{ctx, data},
+		expectationOrigins: ServiceMockSaveForLaterExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetItemsFromCart.expectations = append(mmGetItemsFromCart.expectations, expectation)
+	mmSaveForLater.expectations = append(mmSaveForLater.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.GetItemsFromCart return parameters for the expectation previously defined by the When method
-func (e *ServiceMockGetItemsFromCartExpectation) Then(gp1 *model.GetItemsFromCartResponce, err error) *ServiceMock {
-	e.results = &ServiceMockGetItemsFromCartResults{gp1, err}
+// Then sets up Service.SaveForLater return parameters for the expectation previously defined by the When method
+func (e *ServiceMockSaveForLaterExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockSaveForLaterResults{err}
 	return e.mock
 }
 
-// Times sets number of times Service.GetItemsFromCart should be invoked
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Times(n uint64) *mServiceMockGetItemsFromCart {
+// Times sets number of times Service.SaveForLater should be invoked
+func (mmSaveForLater *mServiceMockSaveForLater) Times(n uint64) *mServiceMockSaveForLater {
 	if n == 0 {
-		mmGetItemsFromCart.mock.t.Fatalf("Times of ServiceMock.GetItemsFromCart mock can not be zero")
+		mmSaveForLater.mock.t.Fatalf("Times of ServiceMock.SaveForLater mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetItemsFromCart.expectedInvocations, n)
-	mmGetItemsFromCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetItemsFromCart
+	mm_atomic.StoreUint64(&mmSaveForLater.expectedInvocations, n)
+	mmSaveForLater.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveForLater
 }
 
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) invocationsDone() bool {
-	if len(mmGetItemsFromCart.expectations) == 0 && mmGetItemsFromCart.defaultExpectation == nil && mmGetItemsFromCart.mock.funcGetItemsFromCart == nil {
+func (mmSaveForLater *mServiceMockSaveForLater) invocationsDone() bool {
+	if len(mmSaveForLater.expectations) == 0 && mmSaveForLater.defaultExpectation == nil && mmSaveForLater.mock.funcSaveForLater == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsFromCart.mock.afterGetItemsFromCartCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsFromCart.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveForLater.mock.afterSaveForLaterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveForLater.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetItemsFromCart implements mm_server.Service
-func (mmGetItemsFromCart *ServiceMock) GetItemsFromCart(ctx context.Context, data model.RequestData) (gp1 *model.GetItemsFromCartResponce, err error) {
-	mm_atomic.AddUint64(&mmGetItemsFromCart.beforeGetItemsFromCartCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetItemsFromCart.afterGetItemsFromCartCounter, 1)
+// SaveForLater implements mm_server.Service
+func (mmSaveForLater *ServiceMock) SaveForLater(ctx context.Context, data model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmSaveForLater.beforeSaveForLaterCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveForLater.afterSaveForLaterCounter, 1)
 
-	mmGetItemsFromCart.t.Helper()
+	mmSaveForLater.t.Helper()
 
-	if mmGetItemsFromCart.inspectFuncGetItemsFromCart != nil {
-		mmGetItemsFromCart.inspectFuncGetItemsFromCart(ctx, data)
+	if mmSaveForLater.inspectFuncSaveForLater != nil {
+		mmSaveForLater.inspectFuncSaveForLater(ctx, data)
 	}
 
-	mm_params := ServiceMockGetItemsFromCartParams{ctx, data}
+	mm_params := ServiceMockSaveForLaterParams{ctx, data}
 
 	// Record call args
-	mmGetItemsFromCart.GetItemsFromCartMock.mutex.Lock()
-	mmGetItemsFromCart.GetItemsFromCartMock.callArgs = append(mmGetItemsFromCart.GetItemsFromCartMock.callArgs, &mm_params)
-	mmGetItemsFromCart.GetItemsFromCartMock.mutex.Unlock()
+	mmSaveForLater.SaveForLaterMock.mutex.Lock()
+	mmSaveForLater.SaveForLaterMock.callArgs = append(mmSaveForLater.SaveForLaterMock.callArgs, &mm_params)
+	mmSaveForLater.SaveForLaterMock.mutex.Unlock()
 
-	for _, e := range mmGetItemsFromCart.GetItemsFromCartMock.expectations {
+	for _, e := range mmSaveForLater.SaveForLaterMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.gp1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.params
-		mm_want_ptrs := mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.paramPtrs
+	if mmSaveForLater.SaveForLaterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveForLater.SaveForLaterMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveForLater.SaveForLaterMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveForLater.SaveForLaterMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockGetItemsFromCartParams{ctx, data}
+		mm_got := ServiceMockSaveForLaterParams{ctx, data}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetItemsFromCart.t.Errorf("ServiceMock.GetItemsFromCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSaveForLater.t.Errorf("ServiceMock.SaveForLater got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveForLater.SaveForLaterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
-				mmGetItemsFromCart.t.Errorf("ServiceMock.GetItemsFromCart got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
+				mmSaveForLater.t.Errorf("ServiceMock.SaveForLater got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveForLater.SaveForLaterMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetItemsFromCart.t.Errorf("ServiceMock.GetItemsFromCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveForLater.t.Errorf("ServiceMock.SaveForLater got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveForLater.SaveForLaterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetItemsFromCart.GetItemsFromCartMock.defaultExpectation.results
+		mm_results := mmSaveForLater.SaveForLaterMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetItemsFromCart.t.Fatal("No results are set for the ServiceMock.GetItemsFromCart")
+			mmSaveForLater.t.Fatal("No results are set for the ServiceMock.SaveForLater")
 		}
-		return (*mm_results).gp1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetItemsFromCart.funcGetItemsFromCart != nil {
-		return mmGetItemsFromCart.funcGetItemsFromCart(ctx, data)
+	if mmSaveForLater.funcSaveForLater != nil {
+		return mmSaveForLater.funcSaveForLater(ctx, data)
 	}
-	mmGetItemsFromCart.t.Fatalf("Unexpected call to ServiceMock.GetItemsFromCart. %v %v", ctx, data)
+	mmSaveForLater.t.Fatalf("Unexpected call to ServiceMock.SaveForLater. %v %v", ctx, data)
 	return
 }
 
-// GetItemsFromCartAfterCounter returns a count of finished ServiceMock.GetItemsFromCart invocations
-func (mmGetItemsFromCart *ServiceMock) GetItemsFromCartAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemsFromCart.afterGetItemsFromCartCounter)
+// SaveForLaterAfterCounter returns a count of finished ServiceMock.SaveForLater invocations
+func (mmSaveForLater *ServiceMock) SaveForLaterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveForLater.afterSaveForLaterCounter)
 }
 
-// GetItemsFromCartBeforeCounter returns a count of ServiceMock.GetItemsFromCart invocations
-func (mmGetItemsFromCart *ServiceMock) GetItemsFromCartBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemsFromCart.beforeGetItemsFromCartCounter)
+// SaveForLaterBeforeCounter returns a count of ServiceMock.SaveForLater invocations
+func (mmSaveForLater *ServiceMock) SaveForLaterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveForLater.beforeSaveForLaterCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.GetItemsFromCart.
+// Calls returns a list of arguments used in each call to ServiceMock.SaveForLater.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetItemsFromCart *mServiceMockGetItemsFromCart) Calls() []*ServiceMockGetItemsFromCartParams {
-	mmGetItemsFromCart.mutex.RLock()
+func (mmSaveForLater *mServiceMockSaveForLater) Calls() []*ServiceMockSaveForLaterParams {
+	mmSaveForLater.mutex.RLock()
 
-	argCopy := make([]*ServiceMockGetItemsFromCartParams, len(mmGetItemsFromCart.callArgs))
-	copy(argCopy, mmGetItemsFromCart.callArgs)
+	argCopy := make([]*ServiceMockSaveForLaterParams, len(mmSaveForLater.callArgs))
+	copy(argCopy, mmSaveForLater.callArgs)
 
-	mmGetItemsFromCart.mutex.RUnlock()
+	mmSaveForLater.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetItemsFromCartDone returns true if the count of the GetItemsFromCart invocations corresponds
+// MinimockSaveForLaterDone returns true if the count of the SaveForLater invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockGetItemsFromCartDone() bool {
-	if m.GetItemsFromCartMock.optional {
+func (m *ServiceMock) MinimockSaveForLaterDone() bool {
+	if m.SaveForLaterMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetItemsFromCartMock.expectations {
+	for _, e := range m.SaveForLaterMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetItemsFromCartMock.invocationsDone()
+	return m.SaveForLaterMock.invocationsDone()
 }
 
-// MinimockGetItemsFromCartInspect logs each unmet expectation
-func (m *ServiceMock) MinimockGetItemsFromCartInspect() {
-	for _, e := range m.GetItemsFromCartMock.expectations {
+// MinimockSaveForLaterInspect logs each unmet expectation
+func (m *ServiceMock) MinimockSaveForLaterInspect() {
+	for _, e := range m.SaveForLaterMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.SaveForLater at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetItemsFromCartCounter := mm_atomic.LoadUint64(&m.afterGetItemsFromCartCounter)
+	afterSaveForLaterCounter := mm_atomic.LoadUint64(&m.afterSaveForLaterCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetItemsFromCartMock.defaultExpectation != nil && afterGetItemsFromCartCounter < 1 {
-		if m.GetItemsFromCartMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s", m.GetItemsFromCartMock.defaultExpectation.returnOrigin)
+	if m.SaveForLaterMock.defaultExpectation != nil && afterSaveForLaterCounter < 1 {
+		if m.SaveForLaterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.SaveForLater at\n%s", m.SaveForLaterMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s with params: %#v", m.GetItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsFromCartMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.SaveForLater at\n%s with params: %#v", m.SaveForLaterMock.defaultExpectation.expectationOrigins.origin, *m.SaveForLaterMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetItemsFromCart != nil && afterGetItemsFromCartCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.GetItemsFromCart at\n%s", m.funcGetItemsFromCartOrigin)
+	if m.funcSaveForLater != nil && afterSaveForLaterCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.SaveForLater at\n%s", m.funcSaveForLaterOrigin)
 	}
 
-	if !m.GetItemsFromCartMock.invocationsDone() && afterGetItemsFromCartCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.GetItemsFromCart at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetItemsFromCartMock.expectedInvocations), m.GetItemsFromCartMock.expectedInvocationsOrigin, afterGetItemsFromCartCounter)
+	if !m.SaveForLaterMock.invocationsDone() && afterSaveForLaterCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.SaveForLater at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveForLaterMock.expectedInvocations), m.SaveForLaterMock.expectedInvocationsOrigin, afterSaveForLaterCounter)
 	}
 }
 
-type mServiceMockOrderCreate struct {
+type mServiceMockSetItemCount struct {
 	optional           bool
 	mock               *ServiceMock
-	defaultExpectation *ServiceMockOrderCreateExpectation
-	expectations       []*ServiceMockOrderCreateExpectation
+	defaultExpectation *ServiceMockSetItemCountExpectation
+	expectations       []*ServiceMockSetItemCountExpectation
 
-	callArgs []*ServiceMockOrderCreateParams
+	callArgs []*ServiceMockSetItemCountParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// ServiceMockOrderCreateExpectation specifies expectation struct of the Service.OrderCreate
-type ServiceMockOrderCreateExpectation struct {
+// ServiceMockSetItemCountExpectation specifies expectation struct of the Service.SetItemCount
+type ServiceMockSetItemCountExpectation struct {
 	mock               *ServiceMock
-	params             *ServiceMockOrderCreateParams
-	paramPtrs          *ServiceMockOrderCreateParamPtrs
-	expectationOrigins ServiceMockOrderCreateExpectationOrigins
-	results            *ServiceMockOrderCreateResults
+	params             *ServiceMockSetItemCountParams
+	paramPtrs          *ServiceMockSetItemCountParamPtrs
+	expectationOrigins ServiceMockSetItemCountExpectationOrigins
+	results            *ServiceMockSetItemCountResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// ServiceMockOrderCreateParams contains parameters of the Service.OrderCreate
-type ServiceMockOrderCreateParams struct {
-	ctx    context.Context
-	UserID int64
-	items  *model.GetItemsFromCartResponce
+// ServiceMockSetItemCountParams contains parameters of the Service.SetItemCount
+type ServiceMockSetItemCountParams struct {
+	ctx  context.Context
+	data model.RequestData
 }
 
-// ServiceMockOrderCreateParamPtrs contains pointers to parameters of the Service.OrderCreate
-type ServiceMockOrderCreateParamPtrs struct {
-	ctx    *context.Context
-	UserID *int64
-	items  **model.GetItemsFromCartResponce
+// ServiceMockSetItemCountParamPtrs contains pointers to parameters of the Service.SetItemCount
+type ServiceMockSetItemCountParamPtrs struct {
+	ctx  *context.Context
+	data *model.RequestData
 }
 
-// ServiceMockOrderCreateResults contains results of the Service.OrderCreate
-type ServiceMockOrderCreateResults struct {
-	i1  int64
+// ServiceMockSetItemCountResults contains results of the Service.SetItemCount
+type ServiceMockSetItemCountResults struct {
 	err error
 }
 
-// ServiceMockOrderCreateOrigins contains origins of expectations of the Service.OrderCreate
-type ServiceMockOrderCreateExpectationOrigins struct {
-	origin       string
-	originCtx    string
-	originUserID string
-	originItems  string
+// ServiceMockSetItemCountOrigins contains origins of expectations of the Service.SetItemCount
+type ServiceMockSetItemCountExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originData string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1509,320 +5185,292 @@ type ServiceMockOrderCreateExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmOrderCreate *mServiceMockOrderCreate) Optional() *mServiceMockOrderCreate {
-	mmOrderCreate.optional = true
-	return mmOrderCreate
+func (mmSetItemCount *mServiceMockSetItemCount) Optional() *mServiceMockSetItemCount {
+	mmSetItemCount.optional = true
+	return mmSetItemCount
 }
 
-// Expect sets up expected params for Service.OrderCreate
-func (mmOrderCreate *mServiceMockOrderCreate) Expect(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) *mServiceMockOrderCreate {
-	if mmOrderCreate.mock.funcOrderCreate != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+// Expect sets up expected params for Service.SetItemCount
+func (mmSetItemCount *mServiceMockSetItemCount) Expect(ctx context.Context, data model.RequestData) *mServiceMockSetItemCount {
+	if mmSetItemCount.mock.funcSetItemCount != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by Set")
 	}
 
-	if mmOrderCreate.defaultExpectation == nil {
-		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
+	if mmSetItemCount.defaultExpectation == nil {
+		mmSetItemCount.defaultExpectation = &ServiceMockSetItemCountExpectation{}
 	}
 
-	if mmOrderCreate.defaultExpectation.paramPtrs != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by ExpectParams functions")
+	if mmSetItemCount.defaultExpectation.paramPtrs != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by ExpectParams functions")
 	}
 
-	mmOrderCreate.defaultExpectation.params = &ServiceMockOrderCreateParams{ctx, UserID, items}
-	mmOrderCreate.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmOrderCreate.expectations {
-		if minimock.Equal(e.params, mmOrderCreate.defaultExpectation.params) {
-			mmOrderCreate.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmOrderCreate.defaultExpectation.params)
+	mmSetItemCount.defaultExpectation.params = &ServiceMockSetItemCountParams{ctx, data}
+	mmSetItemCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetItemCount.expectations {
+		if minimock.Equal(e.params, mmSetItemCount.defaultExpectation.params) {
+			mmSetItemCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetItemCount.defaultExpectation.params)
 		}
 	}
 
-	return mmOrderCreate
-}
-
-// ExpectCtxParam1 sets up expected param ctx for Service.OrderCreate
-func (mmOrderCreate *mServiceMockOrderCreate) ExpectCtxParam1(ctx context.Context) *mServiceMockOrderCreate {
-	if mmOrderCreate.mock.funcOrderCreate != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
-	}
-
-	if mmOrderCreate.defaultExpectation == nil {
-		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
-	}
-
-	if mmOrderCreate.defaultExpectation.params != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Expect")
-	}
-
-	if mmOrderCreate.defaultExpectation.paramPtrs == nil {
-		mmOrderCreate.defaultExpectation.paramPtrs = &ServiceMockOrderCreateParamPtrs{}
-	}
-	mmOrderCreate.defaultExpectation.paramPtrs.ctx = &ctx
-	mmOrderCreate.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
-
-	return mmOrderCreate
+	return mmSetItemCount
 }
 
-// ExpectUserIDParam2 sets up expected param UserID for Service.OrderCreate
-func (mmOrderCreate *mServiceMockOrderCreate) ExpectUserIDParam2(UserID int64) *mServiceMockOrderCreate {
-	if mmOrderCreate.mock.funcOrderCreate != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Service.SetItemCount
+func (mmSetItemCount *mServiceMockSetItemCount) ExpectCtxParam1(ctx context.Context) *mServiceMockSetItemCount {
+	if mmSetItemCount.mock.funcSetItemCount != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by Set")
 	}
 
-	if mmOrderCreate.defaultExpectation == nil {
-		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
+	if mmSetItemCount.defaultExpectation == nil {
+		mmSetItemCount.defaultExpectation = &ServiceMockSetItemCountExpectation{}
 	}
 
-	if mmOrderCreate.defaultExpectation.params != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Expect")
+	if mmSetItemCount.defaultExpectation.params != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by Expect")
 	}
 
-	if mmOrderCreate.defaultExpectation.paramPtrs == nil {
-		mmOrderCreate.defaultExpectation.paramPtrs = &ServiceMockOrderCreateParamPtrs{}
+	if mmSetItemCount.defaultExpectation.paramPtrs == nil {
+		mmSetItemCount.defaultExpectation.paramPtrs = &ServiceMockSetItemCountParamPtrs{}
 	}
-	mmOrderCreate.defaultExpectation.paramPtrs.UserID = &UserID
-	mmOrderCreate.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+	mmSetItemCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetItemCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmOrderCreate
+	return mmSetItemCount
 }
 
-// ExpectItemsParam3 sets up expected param items for Service.OrderCreate
-func (mmOrderCreate *mServiceMockOrderCreate) ExpectItemsParam3(items *model.GetItemsFromCartResponce) *mServiceMockOrderCreate {
-	if mmOrderCreate.mock.funcOrderCreate != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+// ExpectDataParam2 sets up expected param data for Service.SetItemCount
+func (mmSetItemCount *mServiceMockSetItemCount) ExpectDataParam2(data model.RequestData) *mServiceMockSetItemCount {
+	if mmSetItemCount.mock.funcSetItemCount != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by Set")
 	}
 
-	if mmOrderCreate.defaultExpectation == nil {
-		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{}
+	if mmSetItemCount.defaultExpectation == nil {
+		mmSetItemCount.defaultExpectation = &ServiceMockSetItemCountExpectation{}
 	}
 
-	if mmOrderCreate.defaultExpectation.params != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Expect")
+	if mmSetItemCount.defaultExpectation.params != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by Expect")
 	}
 
-	if mmOrderCreate.defaultExpectation.paramPtrs == nil {
-		mmOrderCreate.defaultExpectation.paramPtrs = &ServiceMockOrderCreateParamPtrs{}
+	if mmSetItemCount.defaultExpectation.paramPtrs == nil {
+		mmSetItemCount.defaultExpectation.paramPtrs = &ServiceMockSetItemCountParamPtrs{}
 	}
-	mmOrderCreate.defaultExpectation.paramPtrs.items = &items
-	mmOrderCreate.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+	mmSetItemCount.defaultExpectation.paramPtrs.data = &data
+	mmSetItemCount.defaultExpectation.expectationOrigins.originData = minimock.CallerInfo(1)
 
-	return mmOrderCreate
+	return mmSetItemCount
 }
 
-// Inspect accepts an inspector function that has same arguments as the Service.OrderCreate
-func (mmOrderCreate *mServiceMockOrderCreate) Inspect(f func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce)) *mServiceMockOrderCreate {
-	if mmOrderCreate.mock.inspectFuncOrderCreate != nil {
-		mmOrderCreate.mock.t.Fatalf("Inspect function is already set for ServiceMock.OrderCreate")
+// Inspect accepts an inspector function that has same arguments as the Service.SetItemCount
+func (mmSetItemCount *mServiceMockSetItemCount) Inspect(f func(ctx context.Context, data model.RequestData)) *mServiceMockSetItemCount {
+	if mmSetItemCount.mock.inspectFuncSetItemCount != nil {
+		mmSetItemCount.mock.t.Fatalf("Inspect function is already set for ServiceMock.SetItemCount")
 	}
 
-	mmOrderCreate.mock.inspectFuncOrderCreate = f
+	mmSetItemCount.mock.inspectFuncSetItemCount = f
 
-	return mmOrderCreate
+	return mmSetItemCount
 }
 
-// Return sets up results that will be returned by Service.OrderCreate
-func (mmOrderCreate *mServiceMockOrderCreate) Return(i1 int64, err error) *ServiceMock {
-	if mmOrderCreate.mock.funcOrderCreate != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+// Return sets up results that will be returned by Service.SetItemCount
+func (mmSetItemCount *mServiceMockSetItemCount) Return(err error) *ServiceMock {
+	if mmSetItemCount.mock.funcSetItemCount != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by Set")
 	}
 
-	if mmOrderCreate.defaultExpectation == nil {
-		mmOrderCreate.defaultExpectation = &ServiceMockOrderCreateExpectation{mock: mmOrderCreate.mock}
+	if mmSetItemCount.defaultExpectation == nil {
+		mmSetItemCount.defaultExpectation = &ServiceMockSetItemCountExpectation{mock: mmSetItemCount.mock}
 	}
-	mmOrderCreate.defaultExpectation.results = &ServiceMockOrderCreateResults{i1, err}
-	mmOrderCreate.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmOrderCreate.mock
+	mmSetItemCount.defaultExpectation.results = &ServiceMockSetItemCountResults{err}
+	mmSetItemCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetItemCount.mock
 }
 
-// Set uses given function f to mock the Service.OrderCreate method
-func (mmOrderCreate *mServiceMockOrderCreate) Set(f func(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) (i1 int64, err error)) *ServiceMock {
-	if mmOrderCreate.defaultExpectation != nil {
-		mmOrderCreate.mock.t.Fatalf("Default expectation is already set for the Service.OrderCreate method")
+// Set uses given function f to mock the Service.SetItemCount method
+func (mmSetItemCount *mServiceMockSetItemCount) Set(f func(ctx context.Context, data model.RequestData) (err error)) *ServiceMock {
+	if mmSetItemCount.defaultExpectation != nil {
+		mmSetItemCount.mock.t.Fatalf("Default expectation is already set for the Service.SetItemCount method")
 	}
 
-	if len(mmOrderCreate.expectations) > 0 {
-		mmOrderCreate.mock.t.Fatalf("Some expectations are already set for the Service.OrderCreate method")
+	if len(mmSetItemCount.expectations) > 0 {
+		mmSetItemCount.mock.t.Fatalf("Some expectations are already set for the Service.SetItemCount method")
 	}
 
-	mmOrderCreate.mock.funcOrderCreate = f
-	mmOrderCreate.mock.funcOrderCreateOrigin = minimock.CallerInfo(1)
-	return mmOrderCreate.mock
+	mmSetItemCount.mock.funcSetItemCount = f
+	mmSetItemCount.mock.funcSetItemCountOrigin = minimock.CallerInfo(1)
+	return mmSetItemCount.mock
 }
 
-// When sets expectation for the Service.OrderCreate which will trigger the result defined by the following
+// When sets expectation for the Service.SetItemCount which will trigger the result defined by the following
 // Then helper
-func (mmOrderCreate *mServiceMockOrderCreate) When(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) *ServiceMockOrderCreateExpectation {
-	if mmOrderCreate.mock.funcOrderCreate != nil {
-		mmOrderCreate.mock.t.Fatalf("ServiceMock.OrderCreate mock is already set by Set")
+func (mmSetItemCount *mServiceMockSetItemCount) When(ctx context.Context, data model.RequestData) *ServiceMockSetItemCountExpectation {
+	if mmSetItemCount.mock.funcSetItemCount != nil {
+		mmSetItemCount.mock.t.Fatalf("ServiceMock.SetItemCount mock is already set by Set")
 	}
 
-	expectation := &ServiceMockOrderCreateExpectation{
-		mock:               mmOrderCreate.mock,
-		params:             &ServiceMockOrderCreateParams{ctx, UserID, items},
-		expectationOrigins: ServiceMockOrderCreateExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &ServiceMockSetItemCountExpectation{
+		mock:               mmSetItemCount.mock,
+		params:             &ServiceMockSetItemCountParams{ctx, data},
+		expectationOrigins: ServiceMockSetItemCountExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmOrderCreate.expectations = append(mmOrderCreate.expectations, expectation)
+	mmSetItemCount.expectations = append(mmSetItemCount.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Service.OrderCreate return parameters for the expectation previously defined by the When method
-func (e *ServiceMockOrderCreateExpectation) Then(i1 int64, err error) *ServiceMock {
-	e.results = &ServiceMockOrderCreateResults{i1, err}
+// Then sets up Service.SetItemCount return parameters for the expectation previously defined by the When method
+func (e *ServiceMockSetItemCountExpectation) Then(err error) *ServiceMock {
+	e.results = &ServiceMockSetItemCountResults{err}
 	return e.mock
 }
 
-// Times sets number of times Service.OrderCreate should be invoked
-func (mmOrderCreate *mServiceMockOrderCreate) Times(n uint64) *mServiceMockOrderCreate {
+// Times sets number of times Service.SetItemCount should be invoked
+func (mmSetItemCount *mServiceMockSetItemCount) Times(n uint64) *mServiceMockSetItemCount {
 	if n == 0 {
-		mmOrderCreate.mock.t.Fatalf("Times of ServiceMock.OrderCreate mock can not be zero")
+		mmSetItemCount.mock.t.Fatalf("Times of ServiceMock.SetItemCount mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmOrderCreate.expectedInvocations, n)
-	mmOrderCreate.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmOrderCreate
+	mm_atomic.StoreUint64(&mmSetItemCount.expectedInvocations, n)
+	mmSetItemCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetItemCount
 }
 
-func (mmOrderCreate *mServiceMockOrderCreate) invocationsDone() bool {
-	if len(mmOrderCreate.expectations) == 0 && mmOrderCreate.defaultExpectation == nil && mmOrderCreate.mock.funcOrderCreate == nil {
+func (mmSetItemCount *mServiceMockSetItemCount) invocationsDone() bool {
+	if len(mmSetItemCount.expectations) == 0 && mmSetItemCount.defaultExpectation == nil && mmSetItemCount.mock.funcSetItemCount == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmOrderCreate.mock.afterOrderCreateCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmOrderCreate.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetItemCount.mock.afterSetItemCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetItemCount.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// OrderCreate implements mm_server.Service
-func (mmOrderCreate *ServiceMock) OrderCreate(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) (i1 int64, err error) {
-	mm_atomic.AddUint64(&mmOrderCreate.beforeOrderCreateCounter, 1)
-	defer mm_atomic.AddUint64(&mmOrderCreate.afterOrderCreateCounter, 1)
+// SetItemCount implements mm_server.Service
+func (mmSetItemCount *ServiceMock) SetItemCount(ctx context.Context, data model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmSetItemCount.beforeSetItemCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetItemCount.afterSetItemCountCounter, 1)
 
-	mmOrderCreate.t.Helper()
+	mmSetItemCount.t.Helper()
 
-	if mmOrderCreate.inspectFuncOrderCreate != nil {
-		mmOrderCreate.inspectFuncOrderCreate(ctx, UserID, items)
+	if mmSetItemCount.inspectFuncSetItemCount != nil {
+		mmSetItemCount.inspectFuncSetItemCount(ctx, data)
 	}
 
-	mm_params := ServiceMockOrderCreateParams{ctx, UserID, items}
+	mm_params := ServiceMockSetItemCountParams{ctx, data}
 
 	// Record call args
-	mmOrderCreate.OrderCreateMock.mutex.Lock()
-	mmOrderCreate.OrderCreateMock.callArgs = append(mmOrderCreate.OrderCreateMock.callArgs, &mm_params)
-	mmOrderCreate.OrderCreateMock.mutex.Unlock()
+	mmSetItemCount.SetItemCountMock.mutex.Lock()
+	mmSetItemCount.SetItemCountMock.callArgs = append(mmSetItemCount.SetItemCountMock.callArgs, &mm_params)
+	mmSetItemCount.SetItemCountMock.mutex.Unlock()
 
-	for _, e := range mmOrderCreate.OrderCreateMock.expectations {
+	for _, e := range mmSetItemCount.SetItemCountMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.i1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmOrderCreate.OrderCreateMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmOrderCreate.OrderCreateMock.defaultExpectation.Counter, 1)
-		mm_want := mmOrderCreate.OrderCreateMock.defaultExpectation.params
-		mm_want_ptrs := mmOrderCreate.OrderCreateMock.defaultExpectation.paramPtrs
+	if mmSetItemCount.SetItemCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetItemCount.SetItemCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetItemCount.SetItemCountMock.defaultExpectation.params
+		mm_want_ptrs := mmSetItemCount.SetItemCountMock.defaultExpectation.paramPtrs
 
-		mm_got := ServiceMockOrderCreateParams{ctx, UserID, items}
+		mm_got := ServiceMockSetItemCountParams{ctx, data}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
-			}
-
-			if mm_want_ptrs.UserID != nil && !minimock.Equal(*mm_want_ptrs.UserID, mm_got.UserID) {
-				mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameter UserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.UserID, mm_got.UserID, minimock.Diff(*mm_want_ptrs.UserID, mm_got.UserID))
+				mmSetItemCount.t.Errorf("ServiceMock.SetItemCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetItemCount.SetItemCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
-				mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			if mm_want_ptrs.data != nil && !minimock.Equal(*mm_want_ptrs.data, mm_got.data) {
+				mmSetItemCount.t.Errorf("ServiceMock.SetItemCount got unexpected parameter data, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetItemCount.SetItemCountMock.defaultExpectation.expectationOrigins.originData, *mm_want_ptrs.data, mm_got.data, minimock.Diff(*mm_want_ptrs.data, mm_got.data))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmOrderCreate.t.Errorf("ServiceMock.OrderCreate got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmOrderCreate.OrderCreateMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetItemCount.t.Errorf("ServiceMock.SetItemCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetItemCount.SetItemCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmOrderCreate.OrderCreateMock.defaultExpectation.results
+		mm_results := mmSetItemCount.SetItemCountMock.defaultExpectation.results
 		if mm_results == nil {
-			mmOrderCreate.t.Fatal("No results are set for the ServiceMock.OrderCreate")
+			mmSetItemCount.t.Fatal("No results are set for the ServiceMock.SetItemCount")
 		}
-		return (*mm_results).i1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmOrderCreate.funcOrderCreate != nil {
-		return mmOrderCreate.funcOrderCreate(ctx, UserID, items)
+	if mmSetItemCount.funcSetItemCount != nil {
+		return mmSetItemCount.funcSetItemCount(ctx, data)
 	}
-	mmOrderCreate.t.Fatalf("Unexpected call to ServiceMock.OrderCreate. %v %v %v", ctx, UserID, items)
+	mmSetItemCount.t.Fatalf("Unexpected call to ServiceMock.SetItemCount. %v %v", ctx, data)
 	return
 }
 
-// OrderCreateAfterCounter returns a count of finished ServiceMock.OrderCreate invocations
-func (mmOrderCreate *ServiceMock) OrderCreateAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmOrderCreate.afterOrderCreateCounter)
+// SetItemCountAfterCounter returns a count of finished ServiceMock.SetItemCount invocations
+func (mmSetItemCount *ServiceMock) SetItemCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetItemCount.afterSetItemCountCounter)
 }
 
-// OrderCreateBeforeCounter returns a count of ServiceMock.OrderCreate invocations
-func (mmOrderCreate *ServiceMock) OrderCreateBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmOrderCreate.beforeOrderCreateCounter)
+// SetItemCountBeforeCounter returns a count of ServiceMock.SetItemCount invocations
+func (mmSetItemCount *ServiceMock) SetItemCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetItemCount.beforeSetItemCountCounter)
 }
 
-// Calls returns a list of arguments used in each call to ServiceMock.OrderCreate.
+// Calls returns a list of arguments used in each call to ServiceMock.SetItemCount.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmOrderCreate *mServiceMockOrderCreate) Calls() []*ServiceMockOrderCreateParams {
-	mmOrderCreate.mutex.RLock()
+func (mmSetItemCount *mServiceMockSetItemCount) Calls() []*ServiceMockSetItemCountParams {
+	mmSetItemCount.mutex.RLock()
 
-	argCopy := make([]*ServiceMockOrderCreateParams, len(mmOrderCreate.callArgs))
-	copy(argCopy, mmOrderCreate.callArgs)
+	argCopy := make([]*ServiceMockSetItemCountParams, len(mmSetItemCount.callArgs))
+	copy(argCopy, mmSetItemCount.callArgs)
 
-	mmOrderCreate.mutex.RUnlock()
+	mmSetItemCount.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockOrderCreateDone returns true if the count of the OrderCreate invocations corresponds
+// MinimockSetItemCountDone returns true if the count of the SetItemCount invocations corresponds
 // the number of defined expectations
-func (m *ServiceMock) MinimockOrderCreateDone() bool {
-	if m.OrderCreateMock.optional {
+func (m *ServiceMock) MinimockSetItemCountDone() bool {
+	if m.SetItemCountMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.OrderCreateMock.expectations {
+	for _, e := range m.SetItemCountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.OrderCreateMock.invocationsDone()
+	return m.SetItemCountMock.invocationsDone()
 }
 
-// MinimockOrderCreateInspect logs each unmet expectation
-func (m *ServiceMock) MinimockOrderCreateInspect() {
-	for _, e := range m.OrderCreateMock.expectations {
+// MinimockSetItemCountInspect logs each unmet expectation
+func (m *ServiceMock) MinimockSetItemCountInspect() {
+	for _, e := range m.SetItemCountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to ServiceMock.SetItemCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterOrderCreateCounter := mm_atomic.LoadUint64(&m.afterOrderCreateCounter)
+	afterSetItemCountCounter := mm_atomic.LoadUint64(&m.afterSetItemCountCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.OrderCreateMock.defaultExpectation != nil && afterOrderCreateCounter < 1 {
-		if m.OrderCreateMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s", m.OrderCreateMock.defaultExpectation.returnOrigin)
+	if m.SetItemCountMock.defaultExpectation != nil && afterSetItemCountCounter < 1 {
+		if m.SetItemCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ServiceMock.SetItemCount at\n%s", m.SetItemCountMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s with params: %#v", m.OrderCreateMock.defaultExpectation.expectationOrigins.origin, *m.OrderCreateMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to ServiceMock.SetItemCount at\n%s with params: %#v", m.SetItemCountMock.defaultExpectation.expectationOrigins.origin, *m.SetItemCountMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcOrderCreate != nil && afterOrderCreateCounter < 1 {
-		m.t.Errorf("Expected call to ServiceMock.OrderCreate at\n%s", m.funcOrderCreateOrigin)
+	if m.funcSetItemCount != nil && afterSetItemCountCounter < 1 {
+		m.t.Errorf("Expected call to ServiceMock.SetItemCount at\n%s", m.funcSetItemCountOrigin)
 	}
 
-	if !m.OrderCreateMock.invocationsDone() && afterOrderCreateCounter > 0 {
-		m.t.Errorf("Expected %d calls to ServiceMock.OrderCreate at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.OrderCreateMock.expectedInvocations), m.OrderCreateMock.expectedInvocationsOrigin, afterOrderCreateCounter)
+	if !m.SetItemCountMock.invocationsDone() && afterSetItemCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to ServiceMock.SetItemCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetItemCountMock.expectedInvocations), m.SetItemCountMock.expectedInvocationsOrigin, afterSetItemCountCounter)
 	}
 }
 
@@ -1832,13 +5480,33 @@ func (m *ServiceMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddItemInspect()
 
+			m.MinimockApplyPromoCodeInspect()
+
+			m.MinimockClaimCartInspect()
+
 			m.MinimockDeleteItemInspect()
 
 			m.MinimockDeleteItemsByUserIDInspect()
 
+			m.MinimockDeleteSavedItemInspect()
+
+			m.MinimockGetCartVersionInspect()
+
 			m.MinimockGetItemsFromCartInspect()
 
+			m.MinimockGetSavedItemsInspect()
+
+			m.MinimockMergeCartInspect()
+
+			m.MinimockMoveToCartInspect()
+
 			m.MinimockOrderCreateInspect()
+
+			m.MinimockRemovePromoCodeInspect()
+
+			m.MinimockSaveForLaterInspect()
+
+			m.MinimockSetItemCountInspect()
 		}
 	})
 }
@@ -1863,8 +5531,18 @@ func (m *ServiceMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddItemDone() &&
+		m.MinimockApplyPromoCodeDone() &&
+		m.MinimockClaimCartDone() &&
 		m.MinimockDeleteItemDone() &&
 		m.MinimockDeleteItemsByUserIDDone() &&
+		m.MinimockDeleteSavedItemDone() &&
+		m.MinimockGetCartVersionDone() &&
 		m.MinimockGetItemsFromCartDone() &&
-		m.MinimockOrderCreateDone()
+		m.MinimockGetSavedItemsDone() &&
+		m.MinimockMergeCartDone() &&
+		m.MinimockMoveToCartDone() &&
+		m.MinimockOrderCreateDone() &&
+		m.MinimockRemovePromoCodeDone() &&
+		m.MinimockSaveForLaterDone() &&
+		m.MinimockSetItemCountDone()
 }
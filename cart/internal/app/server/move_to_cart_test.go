@@ -0,0 +1,95 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler_MoveToCart(t *testing.T) {
+	const testURL = "/user/{user_id}/saved/{sku_id}/move"
+
+	testData := model.RequestData{UserID: 1, Sku: 1076963}
+
+	startSpan := func(tc testComponent) {
+		tc.tracer.StartMock.
+			Expect(
+				context.Background(),
+				model.MoveToCartURL,
+				trace.WithAttributes(
+					attribute.Int64("UserID", testData.UserID),
+					attribute.Int64("Sku", testData.Sku),
+				),
+			).
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+	}
+
+	tests := []struct {
+		name           string
+		serviceErr     error
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "success",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "card moved to cart successfully",
+		},
+		{
+			name:           "err item not saved",
+			serviceErr:     model.ErrNotFound,
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrNotFound),
+		},
+		{
+			name:           "err product not found",
+			serviceErr:     model.ErrProductNotFound,
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrSkuNotExists),
+		},
+		{
+			name:           "err more than in stock",
+			serviceErr:     model.ErrAddedMoreItemThanInStock,
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrAddedMoreItemThanInStock),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			startSpan(tc)
+			tc.mock.MoveToCartMock.
+				Expect(minimock.AnyContext, testData).
+				Return(tt.serviceErr)
+
+			// Execute
+			req := httptest.NewRequest(http.MethodPost, testURL, nil)
+			req.SetPathValue("sku_id", fmt.Sprintf("%d", testData.Sku))
+			req.SetPathValue("user_id", fmt.Sprintf("%d", testData.UserID))
+
+			w := httptest.NewRecorder()
+			tc.server.MoveToCart(w, req)
+
+			res := w.Result()
+			defer func() {
+				err := res.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
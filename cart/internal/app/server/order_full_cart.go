@@ -2,12 +2,16 @@
 package server
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
+	"strconv"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/idempotency"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
@@ -30,37 +34,110 @@ func (s *Server) OrderFullCart(w http.ResponseWriter, r *http.Request) {
 	)
 	defer span.End()
 
-	items, err := s.cartService.GetItemsFromCart(ctx, *data)
+	var orderID int64
+
+	key := r.Header.Get(model.IdempotencyKeyHeader)
+	if err = validationError((&pb.CheckoutRequest{UserId: data.UserID, IdempotencyKey: key}).Validate()); err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	switch {
+	case key == "" || s.idempotency == nil:
+		orderID, err = s.checkout(ctx, *data)
+	default:
+		orderID, err = s.idempotency.Do(ctx, data.UserID, key, CartFingerprint(s.cartService, data.UserID), func() (int64, error) {
+			return s.checkout(ctx, *data)
+		})
+	}
+
 	if err != nil {
-		if errors.Is(err, model.ErrNotFound) {
+		var stockErr *model.InsufficientStockError
+		if errors.As(err, &stockErr) {
+			makeStockShortageResponse(w, stockErr)
+			return
+		}
+		if errors.Is(err, model.ErrCartEmpty) {
 			MakeErrorResponse(w, model.ErrCartEmpty, http.StatusNotFound)
 			return
 		}
+		if errors.Is(err, model.ErrCartChanged) {
+			MakeErrorResponse(w, model.ErrCartChanged, http.StatusConflict)
+			return
+		}
+		if errors.Is(err, model.ErrIdempotencyKeyReused) {
+			MakeErrorResponse(w, model.ErrIdempotencyKeyReused, http.StatusUnprocessableEntity)
+			return
+		}
 		MakeErrorResponse(w, err, http.StatusInternalServerError)
 		return
 	}
 
-	orderID, err := s.cartService.OrderCreate(ctx, data.UserID, items)
-	if err != nil {
-		logger.Errorw(fmt.Sprintf("OrderCreate : %v", err), "span", span)
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(model.OrderID{OrderID: orderID}); err != nil {
 		MakeErrorResponse(w, err, http.StatusInternalServerError)
 		return
 	}
-	if err = s.cartService.DeleteItemsByUserID(ctx, *data); err != nil {
-		w.Header().Add("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
+}
 
-		if err := json.NewEncoder(w).Encode(model.OrderID{OrderID: orderID}); err != nil {
-			MakeErrorResponse(w, err, http.StatusInternalServerError)
-			return
+// checkout ...
+func (s *Server) checkout(ctx context.Context, data model.RequestData) (int64, error) {
+	return Checkout(ctx, s.cartService, data)
+}
+
+// Checkout оформляет заказ по всей корзине и помечает корзину оформленной.
+// Повторный checkout уже оформленной корзины возвращает тот же заказ
+func Checkout(ctx context.Context, cartService Service, data model.RequestData) (int64, error) {
+	items, err := cartService.GetItemsFromCart(ctx, data)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(items.Items) == 0 {
+		if items.CheckedOutOrderID > 0 {
+			return items.CheckedOutOrderID, nil
 		}
-		return
+		return 0, model.ErrCartEmpty
 	}
 
+	orderID, err := cartService.OrderCreate(ctx, data.UserID, items)
+	if err != nil {
+		logger.Errorw(fmt.Sprintf("OrderCreate : %v", err), "span", trace.SpanFromContext(ctx))
+		return 0, err
+	}
+
+	if err = cartService.ClaimCart(ctx, data.UserID, items, orderID); err != nil {
+		logger.Errorw(fmt.Sprintf("ClaimCart : %v", err), "span", trace.SpanFromContext(ctx))
+		return 0, err
+	}
+
+	return orderID, nil
+}
+
+// CartFingerprint отпечаток корзины для Idempotency-Key - ее версия
+func CartFingerprint(cartService Service, userID int64) idempotency.Fingerprint {
+	return func(ctx context.Context) (string, error) {
+		version, err := cartService.GetCartVersion(ctx, userID)
+		if err != nil {
+			return "", err
+		}
+
+		return strconv.FormatUint(version, 10), nil
+	}
+}
+
+// makeStockShortageResponse отдает 409 со списком позиций, которых не хватает в стоках
+func makeStockShortageResponse(w http.ResponseWriter, stockErr *model.InsufficientStockError) {
 	w.Header().Add("Content-Type", "application/json")
-	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(model.OrderID{OrderID: orderID}); err != nil {
-		MakeErrorResponse(w, err, http.StatusInternalServerError)
+	w.WriteHeader(http.StatusConflict)
+
+	resp := model.StockShortageResponse{
+		Message: stockErr.Error(),
+		Items:   stockErr.Items,
+	}
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		logger.Infow(fmt.Sprintf("Encode : %v", err))
 		return
 	}
 }
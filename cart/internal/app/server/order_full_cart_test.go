@@ -6,9 +6,12 @@ import (
 	"math/rand"
 	"net/http"
 	"net/http/httptest"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/idempotency"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -86,13 +89,85 @@ func TestHandler_OrderFullCart(t *testing.T) {
 					Expect(minimock.AnyContext, testData.UserID, &expectGetItemsFromCartResponce).
 					Return(expectOrderID, nil)
 
-				tc.mock.DeleteItemsByUserIDMock.
-					Expect(minimock.AnyContext, testData).
+				tc.mock.ClaimCartMock.
+					Expect(minimock.AnyContext, testData.UserID, &expectGetItemsFromCartResponce, expectOrderID).
 					Return(nil)
 			},
 			expectedStatus: http.StatusOK,
 			expectedBody:   fmt.Sprintf("{\"order_id\":%d}\n", expectOrderID),
 		},
+		{
+			name:     "success cart already checked out",
+			testData: testData,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				tc.tracer.StartMock.
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mock.GetItemsFromCartMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(&model.GetItemsFromCartResponce{
+						Items:             []model.Item{},
+						CheckedOutOrderID: expectOrderID,
+					}, nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   fmt.Sprintf("{\"order_id\":%d}\n", expectOrderID),
+		},
+		{
+			name:     "err cart changed",
+			testData: testData,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				tc.tracer.StartMock.
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mock.GetItemsFromCartMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(&expectGetItemsFromCartResponce, nil)
+
+				tc.mock.OrderCreateMock.
+					Expect(minimock.AnyContext, testData.UserID, &expectGetItemsFromCartResponce).
+					Return(expectOrderID, nil)
+
+				tc.mock.ClaimCartMock.
+					Expect(minimock.AnyContext, testData.UserID, &expectGetItemsFromCartResponce, expectOrderID).
+					Return(model.ErrCartChanged)
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrCartChanged.Error()),
+		},
+		{
+			name:     "err insufficient stock",
+			testData: testData,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				tc.tracer.StartMock.
+					Expect(
+						context.Background(),
+						model.OrderFullCartURL,
+						trace.WithAttributes(
+							attribute.Int64("UserID", testData.UserID),
+						),
+					).
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mock.GetItemsFromCartMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(&expectGetItemsFromCartResponce, nil)
+
+				tc.mock.OrderCreateMock.
+					Expect(minimock.AnyContext, testData.UserID, &expectGetItemsFromCartResponce).
+					Return(0, &model.InsufficientStockError{
+						Items: []model.StockShortage{
+							{Sku: expectItems[2].Sku, Requested: 3, Available: 1},
+						},
+					})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody: fmt.Sprintf(
+				"{\"Message\":\"%s\",\"items\":[{\"sku\":%d,\"requested\":3,\"available\":1}]}\n",
+				(&model.InsufficientStockError{Items: make([]model.StockShortage, 1)}).Error(),
+				expectItems[2].Sku,
+			),
+		},
 		{
 			name:     "err cart empty",
 			testData: testData,
@@ -109,7 +184,7 @@ func TestHandler_OrderFullCart(t *testing.T) {
 
 				tc.mock.GetItemsFromCartMock.
 					Expect(minimock.AnyContext, mockData).
-					Return(nil, model.ErrNotFound)
+					Return(&model.GetItemsFromCartResponce{Items: []model.Item{}}, nil)
 			},
 			expectedStatus: http.StatusNotFound,
 			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrCartEmpty.Error()),
@@ -143,11 +218,138 @@ func TestHandler_OrderFullCart(t *testing.T) {
 	}
 }
 
-func countTotalPrice(items []model.Item) uint32 {
-	var totalPrice uint32
+func countTotalPrice(items []model.Item) uint64 {
+	var totalPrice uint64
 	for _, item := range items {
-		totalPrice += (item.Price * item.Count)
+		totalPrice += uint64(item.Price) * uint64(item.Count)
 	}
 
 	return totalPrice
 }
+
+func TestHandler_OrderFullCart_Idempotency(t *testing.T) {
+	const (
+		testURL = "/checkout/{user_id}"
+		testKey = "b7d1c1f2-checkout"
+	)
+
+	testData := model.RequestData{
+		// nolint:gosec
+		UserID: rand.Int63(),
+	}
+	// nolint:gosec
+	expectOrderID := rand.Int63()
+
+	doRequest := func(tc testComponent, key string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodPost, testURL, nil)
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set(model.IdempotencyKeyHeader, key)
+		req.SetPathValue("user_id", fmt.Sprintf("%d", testData.UserID))
+
+		w := httptest.NewRecorder()
+		tc.server.OrderFullCart(w, req)
+
+		return w
+	}
+
+	t.Run("replay returns stored order without checkout", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.idempotency.DoMock.
+			Set(func(_ context.Context, userID int64, key string, _ idempotency.Fingerprint, _ func() (int64, error)) (int64, error) {
+				assert.Equal(t, testData.UserID, userID)
+				assert.Equal(t, testKey, key)
+				return expectOrderID, nil
+			})
+
+		w := doRequest(tc, testKey)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		assert.Equal(t, fmt.Sprintf("{\"order_id\":%d}\n", expectOrderID), w.Body.String())
+	})
+
+	t.Run("repeated request with real store creates one order", func(t *testing.T) {
+		tc := setupTest(t)
+		store := idempotency.NewStore(time.Minute, nil)
+		defer store.Close()
+		tc.server.idempotency = store
+
+		items := &model.GetItemsFromCartResponce{
+			Items: []model.Item{{Sku: 1, Name: "item", Count: 1, Price: 100}},
+		}
+
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, testData).
+			Return(items, nil)
+		tc.mock.OrderCreateMock.
+			Expect(minimock.AnyContext, testData.UserID, items).
+			Return(expectOrderID, nil)
+		tc.mock.ClaimCartMock.
+			Expect(minimock.AnyContext, testData.UserID, items, expectOrderID).
+			Return(nil)
+		tc.mock.GetCartVersionMock.
+			Expect(minimock.AnyContext, testData.UserID).
+			Return(5, nil)
+
+		for i := 0; i < 2; i++ {
+			w := doRequest(tc, testKey)
+			assert.Equal(t, http.StatusOK, w.Code)
+			assert.Equal(t, fmt.Sprintf("{\"order_id\":%d}\n", expectOrderID), w.Body.String())
+		}
+
+		assert.Equal(t, uint64(1), tc.mock.OrderCreateAfterCounter())
+	})
+
+	t.Run("err key reused after cart changed", func(t *testing.T) {
+		tc := setupTest(t)
+		store := idempotency.NewStore(time.Minute, nil)
+		defer store.Close()
+		tc.server.idempotency = store
+
+		items := &model.GetItemsFromCartResponce{
+			Items: []model.Item{{Sku: 1, Name: "item", Count: 1, Price: 100}},
+		}
+
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mock.GetItemsFromCartMock.
+			Expect(minimock.AnyContext, testData).
+			Return(items, nil)
+		tc.mock.OrderCreateMock.
+			Expect(minimock.AnyContext, testData.UserID, items).
+			Return(expectOrderID, nil)
+		tc.mock.ClaimCartMock.
+			Expect(minimock.AnyContext, testData.UserID, items, expectOrderID).
+			Return(nil)
+		// версия после оформления, затем после добавления товара в новую корзину
+		versions := []uint64{5, 6}
+		tc.mock.GetCartVersionMock.
+			Set(func(_ context.Context, _ int64) (uint64, error) {
+				version := versions[0]
+				versions = versions[1:]
+				return version, nil
+			})
+
+		w := doRequest(tc, testKey)
+		assert.Equal(t, http.StatusOK, w.Code)
+
+		w = doRequest(tc, testKey)
+		assert.Equal(t, http.StatusUnprocessableEntity, w.Code)
+		assert.Equal(t, fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrIdempotencyKeyReused), w.Body.String())
+		assert.Equal(t, uint64(1), tc.mock.OrderCreateAfterCounter())
+	})
+
+	t.Run("err key too long", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.tracer.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+		w := doRequest(tc, strings.Repeat("k", model.MaxIdempotencyKeyLen+1))
+
+		assert.Equal(t, http.StatusBadRequest, w.Code)
+		assert.Equal(t, fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrIdempotencyKeyTooLong), w.Body.String())
+	})
+}
@@ -2,10 +2,12 @@ package server
 
 import (
 	"encoding/json"
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
 )
 
 func parseRequest(r *http.Request, typeValid int) (*model.RequestData, error) {
@@ -26,6 +28,28 @@ func parseRequest(r *http.Request, typeValid int) (*model.RequestData, error) {
 		data.Sku = sku
 		data.UserID = userID
 
+	case int(model.ValidateSetCount):
+		userIDRaw := r.PathValue("user_id")
+		userID, _ := strconv.ParseInt(userIDRaw, 10, 64)
+
+		skuRaw := r.PathValue("sku_id")
+		sku, _ := strconv.ParseInt(skuRaw, 10, 64)
+
+		// count обязателен, но в отличие от добавления может быть 0
+		var body struct {
+			Count *uint32 `json:"count"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			return nil, err
+		}
+		if body.Count == nil {
+			return nil, errors.New(model.ErrCountRequired)
+		}
+
+		data.Sku = sku
+		data.UserID = userID
+		data.Count = *body.Count
+
 	case int(model.ValidateBySku):
 		userIDRaw := r.PathValue("user_id")
 		userID, _ := strconv.ParseInt(userIDRaw, 10, 64)
@@ -43,10 +67,47 @@ func parseRequest(r *http.Request, typeValid int) (*model.RequestData, error) {
 		data.UserID = userID
 	}
 
-	validData, err := validate(data, typeValid)
-	if err != nil {
+	if err := validateRequest(data, typeValid); err != nil {
 		return nil, err
 	}
 
-	return validData, nil
+	return &data, nil
+}
+
+// validateRequest проверяет запрос правилами из cart.proto: данные перекладываются в proto запрос
+// с теми же полями. Для SetItemCount count проверяется при разборе, 0 допустим
+func validateRequest(data model.RequestData, typeValid int) error {
+	var req interface{ Validate() error }
+
+	switch typeValid {
+	case int(model.ValidateFull):
+		req = &pb.AddItemRequest{UserId: data.UserID, Sku: data.Sku, Count: data.Count}
+	case int(model.ValidateBySku), int(model.ValidateSetCount):
+		req = &pb.DeleteItemRequest{UserId: data.UserID, Sku: data.Sku}
+	default:
+		req = &pb.ListCartRequest{UserId: data.UserID}
+	}
+
+	return validationError(req.Validate())
+}
+
+// validationError сообщение об ошибке валидации proto по полю, как в остальных ответах REST API
+func validationError(err error) error {
+	var fieldErr interface{ Field() string }
+	if !errors.As(err, &fieldErr) {
+		return err
+	}
+
+	switch fieldErr.Field() {
+	case "UserId":
+		return errors.New(model.ErrUserIDMoreThanZero)
+	case "Sku":
+		return errors.New(model.ErrSkuMoreThanZero)
+	case "Count":
+		return errors.New(model.ErrCounItemsMoreThanZero)
+	case "IdempotencyKey":
+		return errors.New(model.ErrIdempotencyKeyTooLong)
+	}
+
+	return err
 }
@@ -68,4 +68,88 @@ func TestHandler_ParseRequest(t *testing.T) {
 			assert.Equal(t, &tt.expectResult, result)
 		})
 	}
+
+	t.Run("ValidateSetCount", func(t *testing.T) {
+		reader := bytes.NewReader([]byte(`{"count":0}`))
+		req := httptest.NewRequest(http.MethodPut, testURL, reader)
+		req.SetPathValue("sku_id", fmt.Sprintf("%d", testSku))
+		req.SetPathValue("user_id", fmt.Sprintf("%d", testUserID))
+
+		result, err := parseRequest(req, int(model.ValidateSetCount))
+		assert.NoError(t, err)
+		assert.Equal(t, &model.RequestData{UserID: testUserID, Sku: testSku}, result)
+	})
+}
+
+func TestValidateRequest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		data      model.RequestData
+		typeValid int
+		expected  string
+	}{
+		{
+			name:      "success full",
+			data:      model.RequestData{UserID: 1, Sku: 1, Count: 1},
+			typeValid: int(model.ValidateFull),
+		},
+		{
+			name:      "success set count zero",
+			data:      model.RequestData{UserID: 1, Sku: 1},
+			typeValid: int(model.ValidateSetCount),
+		},
+		{
+			name:      "success by user id",
+			data:      model.RequestData{UserID: 1},
+			typeValid: int(model.ValidateByUserID),
+		},
+		{
+			name:      "err user id",
+			data:      model.RequestData{Sku: 1, Count: 1},
+			typeValid: int(model.ValidateFull),
+			expected:  model.ErrUserIDMoreThanZero,
+		},
+		{
+			name:      "err user id by user id",
+			data:      model.RequestData{},
+			typeValid: int(model.ValidateByUserID),
+			expected:  model.ErrUserIDMoreThanZero,
+		},
+		{
+			name:      "err sku",
+			data:      model.RequestData{UserID: 1, Count: 1},
+			typeValid: int(model.ValidateFull),
+			expected:  model.ErrSkuMoreThanZero,
+		},
+		{
+			name:      "err sku by sku",
+			data:      model.RequestData{UserID: 1},
+			typeValid: int(model.ValidateBySku),
+			expected:  model.ErrSkuMoreThanZero,
+		},
+		{
+			name:      "err count",
+			data:      model.RequestData{UserID: 1, Sku: 1},
+			typeValid: int(model.ValidateFull),
+			expected:  model.ErrCounItemsMoreThanZero,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute
+			err := validateRequest(tt.data, tt.typeValid)
+
+			// Verify
+			if tt.expected == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.EqualError(t, err, tt.expected)
+		})
+	}
 }
@@ -0,0 +1,34 @@
+package server
+
+import (
+	"net/http"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RemovePromoCode ...
+func (s *Server) RemovePromoCode(w http.ResponseWriter, r *http.Request) {
+	data, err := parseRequest(r, int(model.ValidateByUserID))
+	if err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	ctx, span := s.tracer.Start(
+		r.Context(),
+		model.RemovePromoCodeURL,
+		trace.WithAttributes(
+			attribute.Int64("UserID", data.UserID),
+		),
+	)
+	defer span.End()
+
+	if err := s.cartService.RemovePromoCode(ctx, data.UserID); err != nil {
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SaveForLater переносит товар из корзины в отложенные
+func (s *Server) SaveForLater(w http.ResponseWriter, r *http.Request) {
+	data, err := parseRequest(r, int(model.ValidateBySku))
+	if err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	ctx, span := s.tracer.Start(
+		r.Context(),
+		model.SaveForLaterURL,
+		trace.WithAttributes(
+			attribute.Int64("UserID", data.UserID),
+			attribute.Int64("Sku", data.Sku),
+		),
+	)
+	defer span.End()
+
+	if err = s.cartService.SaveForLater(ctx, *data); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			MakeErrorResponse(w, model.ErrNotFound, http.StatusNotFound)
+			return
+		}
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write([]byte("card saved for later successfully"))
+	if err != nil {
+		logger.Infow(fmt.Sprintf("err w.Write : %v", err))
+		return
+	}
+}
@@ -0,0 +1,114 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler_SaveForLater(t *testing.T) {
+	const testURL = "/user/{user_id}/cart/{sku_id}/save"
+
+	testData := model.RequestData{UserID: 1, Sku: 1076963}
+
+	startSpan := func(tc testComponent) {
+		tc.tracer.StartMock.
+			Expect(
+				context.Background(),
+				model.SaveForLaterURL,
+				trace.WithAttributes(
+					attribute.Int64("UserID", testData.UserID),
+					attribute.Int64("Sku", testData.Sku),
+				),
+			).
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+	}
+
+	tests := []struct {
+		name           string
+		testData       model.RequestData
+		setupMock      func(tc testComponent)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:     "success",
+			testData: testData,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.SaveForLaterMock.
+					Expect(minimock.AnyContext, testData).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "card saved for later successfully",
+		},
+		{
+			name:           "err sku",
+			testData:       model.RequestData{UserID: testData.UserID},
+			setupMock:      func(_ testComponent) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrSkuMoreThanZero),
+		},
+		{
+			name:     "err item not in cart",
+			testData: testData,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.SaveForLaterMock.
+					Expect(minimock.AnyContext, testData).
+					Return(model.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrNotFound),
+		},
+		{
+			name:     "err internal",
+			testData: testData,
+			setupMock: func(tc testComponent) {
+				startSpan(tc)
+				tc.mock.SaveForLaterMock.
+					Expect(minimock.AnyContext, testData).
+					Return(errors.New("test"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "{\"Message\":\"test\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tt.setupMock(tc)
+
+			// Execute
+			req := httptest.NewRequest(http.MethodPost, testURL, nil)
+			req.SetPathValue("sku_id", fmt.Sprintf("%d", tt.testData.Sku))
+			req.SetPathValue("user_id", fmt.Sprintf("%d", tt.testData.UserID))
+
+			w := httptest.NewRecorder()
+			tc.server.SaveForLater(w, req)
+
+			res := w.Result()
+			defer func() {
+				err := res.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/idempotency"
 )
 
 //go:generate minimock -i Service -o ./mocks/service_mock.go -n ProductMock -p ServiceMock
@@ -12,22 +13,39 @@ import (
 // Service ...
 type Service interface {
 	AddItem(ctx context.Context, datas model.RequestData) error
+	SetItemCount(ctx context.Context, data model.RequestData) error
 	DeleteItem(ctx context.Context, data model.RequestData) error
 	DeleteItemsByUserID(ctx context.Context, data model.RequestData) error
 	GetItemsFromCart(ctx context.Context, data model.RequestData) (*model.GetItemsFromCartResponce, error)
+	GetCartVersion(ctx context.Context, userID int64) (uint64, error)
 	OrderCreate(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) (int64, error)
+	ClaimCart(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64) error
+	ApplyPromoCode(ctx context.Context, userID int64, code string) (*model.GetItemsFromCartResponce, error)
+	RemovePromoCode(ctx context.Context, userID int64) error
+	SaveForLater(ctx context.Context, data model.RequestData) error
+	MoveToCart(ctx context.Context, data model.RequestData) error
+	GetSavedItems(ctx context.Context, data model.RequestData) (*model.GetSavedItemsResponse, error)
+	DeleteSavedItem(ctx context.Context, data model.RequestData) error
+	MergeCart(ctx context.Context, targetUserID, sourceUserID int64) (*model.MergeCartResponse, error)
+}
+
+// IdempotencyStore ...
+type IdempotencyStore interface {
+	Do(ctx context.Context, userID int64, key string, fingerprint idempotency.Fingerprint, fn func() (int64, error)) (int64, error)
 }
 
 // Server ...
 type Server struct {
 	cartService Service
 	tracer      service.Tracer
+	idempotency IdempotencyStore
 }
 
 // NewServer ...
-func NewServer(service Service, traser service.Tracer) *Server {
+func NewServer(service Service, traser service.Tracer, idempotency IdempotencyStore) *Server {
 	return &Server{
 		cartService: service,
 		tracer:      traser,
+		idempotency: idempotency,
 	}
 }
@@ -0,0 +1,54 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// SetItemCount ...
+func (s *Server) SetItemCount(w http.ResponseWriter, r *http.Request) {
+	data, err := parseRequest(r, int(model.ValidateSetCount))
+	if err != nil {
+		MakeErrorResponse(w, err, http.StatusBadRequest)
+		return
+	}
+
+	ctx, span := s.tracer.Start(
+		r.Context(),
+		model.SetItemCountURL,
+		trace.WithAttributes(
+			attribute.Int64("UserID", data.UserID),
+			attribute.Int64("Sku", data.Sku),
+			attribute.Int64("Count", int64(data.Count)),
+		),
+	)
+	defer span.End()
+
+	if err = s.cartService.SetItemCount(ctx, *data); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			MakeErrorResponse(w, model.ErrNotFound, http.StatusNotFound)
+			return
+		}
+		if errors.Is(err, model.ErrAddedMoreItemThanInStock) {
+			MakeErrorResponse(w, err, http.StatusPreconditionFailed)
+			return
+		}
+		MakeErrorResponse(w, err, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+
+	_, err = w.Write([]byte("card count set successfully"))
+	if err != nil {
+		logger.Infow(fmt.Sprintf("err w.Write : %v", err))
+		return
+	}
+}
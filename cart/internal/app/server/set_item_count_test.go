@@ -0,0 +1,168 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/gojuno/minimock/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestHandler_SetItemCount(t *testing.T) {
+	const testURL = "/user/{user_id}/cart/{sku_id}"
+
+	testData := model.RequestData{
+		// nolint:gosec
+		UserID: rand.Int63(),
+		Sku:    int64(1076963),
+		Count:  2,
+	}
+
+	startSpan := func(tc testComponent, data model.RequestData) {
+		tc.tracer.StartMock.
+			Expect(
+				context.Background(),
+				model.SetItemCountURL,
+				trace.WithAttributes(
+					attribute.Int64("UserID", data.UserID),
+					attribute.Int64("Sku", data.Sku),
+					attribute.Int64("Count", int64(data.Count)),
+				),
+			).
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+	}
+
+	tests := []struct {
+		name           string
+		testData       model.RequestData
+		testBody       string
+		setupMock      func(tc testComponent, mockData model.RequestData)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:     "success",
+			testData: testData,
+			testBody: fmt.Sprintf(`{"count":%d}`, testData.Count),
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc, mockData)
+				tc.mock.SetItemCountMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "card count set successfully",
+		},
+		{
+			name:     "success zero count",
+			testData: model.RequestData{UserID: testData.UserID, Sku: testData.Sku},
+			testBody: `{"count":0}`,
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc, mockData)
+				tc.mock.SetItemCountMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(nil)
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "card count set successfully",
+		},
+		{
+			name:           "err count required",
+			testData:       testData,
+			testBody:       `{}`,
+			setupMock:      func(_ testComponent, _ model.RequestData) {},
+			expectedStatus: http.StatusBadRequest,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrCountRequired),
+		},
+		{
+			name:     "err item not found",
+			testData: testData,
+			testBody: fmt.Sprintf(`{"count":%d}`, testData.Count),
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc, mockData)
+				tc.mock.SetItemCountMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(model.ErrNotFound)
+			},
+			expectedStatus: http.StatusNotFound,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrNotFound.Error()),
+		},
+		{
+			name:     "err more than in stock",
+			testData: testData,
+			testBody: fmt.Sprintf(`{"count":%d}`, testData.Count),
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc, mockData)
+				tc.mock.SetItemCountMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(model.ErrAddedMoreItemThanInStock)
+			},
+			expectedStatus: http.StatusPreconditionFailed,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrAddedMoreItemThanInStock.Error()),
+		},
+		{
+			name:     "err service unavailable",
+			testData: testData,
+			testBody: fmt.Sprintf(`{"count":%d}`, testData.Count),
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc, mockData)
+				tc.mock.SetItemCountMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(fmt.Errorf("GetStocksInfo: %w", model.ErrServiceUnavailable))
+			},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"GetStocksInfo: %s\"}\n", model.ErrServiceUnavailable),
+		},
+		{
+			name:     "err internal",
+			testData: testData,
+			testBody: fmt.Sprintf(`{"count":%d}`, testData.Count),
+			setupMock: func(tc testComponent, mockData model.RequestData) {
+				startSpan(tc, mockData)
+				tc.mock.SetItemCountMock.
+					Expect(minimock.AnyContext, mockData).
+					Return(errors.New("test"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "{\"Message\":\"test\"}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tt.setupMock(tc, tt.testData)
+
+			// Execute
+			reader := bytes.NewReader([]byte(tt.testBody))
+			req := httptest.NewRequest(http.MethodPut, testURL, reader)
+			req.Header.Set("Content-Type", "application/json")
+			req.SetPathValue("sku_id", fmt.Sprintf("%d", tt.testData.Sku))
+			req.SetPathValue("user_id", fmt.Sprintf("%d", tt.testData.UserID))
+
+			w := httptest.NewRecorder()
+			tc.server.SetItemCount(w, req)
+
+			res := w.Result()
+			defer func() {
+				err := res.Body.Close()
+				require.NoError(t, err)
+			}()
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -0,0 +1,77 @@
+package loms
+
+import (
+	"context"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/breaker"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/loms/api/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// cancelMaxAttempts сколько раз пробуется отменить заказ
+	cancelMaxAttempts = 5
+	// cancelBaseDelay пауза перед повтором отмены, удваивается с каждой попыткой
+	cancelBaseDelay = 100 * time.Millisecond
+)
+
+// WithBreaker Client за circuit breaker, при открытом breaker запросы в loms не выполняются
+type WithBreaker struct {
+	next        *Client
+	breaker     *breaker.Breaker
+	cancelDelay time.Duration
+}
+
+// NewWithBreaker ...
+func NewWithBreaker(next *Client, b *breaker.Breaker) *WithBreaker {
+	return &WithBreaker{
+		next:        next,
+		breaker:     b,
+		cancelDelay: cancelBaseDelay,
+	}
+}
+
+// CreateOrder ...
+func (c *WithBreaker) CreateOrder(ctx context.Context, req *pb.OrderCreateRequest) (*pb.OrderCreateResponse, error) {
+	return breaker.Call(c.breaker, func() (*pb.OrderCreateResponse, error) {
+		return c.next.CreateOrder(ctx, req)
+	})
+}
+
+// GetStocksInfo ...
+func (c *WithBreaker) GetStocksInfo(ctx context.Context, req *pb.StocksInfoRequest) (*pb.StocksInfoResponse, error) {
+	return breaker.Call(c.breaker, func() (*pb.StocksInfoResponse, error) {
+		return c.next.GetStocksInfo(ctx, req)
+	})
+}
+
+// CancelOrder отмена компенсирует уже созданный заказ, поэтому идет в обход breaker:
+// открытый breaker оставил бы товары зарезервированными. Ошибки недоступности loms повторяются
+func (c *WithBreaker) CancelOrder(ctx context.Context, req *pb.OrderCancelRequest) error {
+	delay := c.cancelDelay
+	for attempt := 1; ; attempt++ {
+		err := c.next.CancelOrder(ctx, req)
+		if err == nil || !IsFailure(err) || attempt == cancelMaxAttempts {
+			return err
+		}
+
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(delay):
+		}
+		delay *= 2
+	}
+}
+
+// IsFailure бизнес-ошибки loms (not found, invalid argument, failed precondition) не говорят о его недоступности
+func IsFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.ResourceExhausted, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,112 @@
+package loms
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/breaker"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/loms/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeLoms отвечает ошибками из errs по очереди, затем успехом
+type fakeLoms struct {
+	pb.LomsClient
+	errs    []error
+	creates int
+	cancels int
+}
+
+func (f *fakeLoms) next() error {
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+
+	return err
+}
+
+func (f *fakeLoms) OrderCreate(_ context.Context, _ *pb.OrderCreateRequest, _ ...grpc.CallOption) (*pb.OrderCreateResponse, error) {
+	f.creates++
+	if err := f.next(); err != nil {
+		return nil, err
+	}
+
+	return &pb.OrderCreateResponse{OrderID: 1}, nil
+}
+
+func (f *fakeLoms) OrderCancel(_ context.Context, _ *pb.OrderCancelRequest, _ ...grpc.CallOption) (*pb.OrderCancelResponse, error) {
+	f.cancels++
+
+	return &pb.OrderCancelResponse{}, f.next()
+}
+
+func TestWithBreaker_CancelOrder(t *testing.T) {
+	unavailable := status.Error(codes.Unavailable, "unavailable")
+	notFound := status.Error(codes.NotFound, "not found")
+
+	tests := []struct {
+		name            string
+		errs            []error
+		expectedErr     error
+		expectedCancels int
+	}{
+		{
+			name:            "open breaker does not block cancel",
+			expectedCancels: 1,
+		},
+		{
+			name:            "unavailable loms is retried",
+			errs:            []error{unavailable, unavailable},
+			expectedCancels: 3,
+		},
+		{
+			name:            "business error is not retried",
+			errs:            []error{notFound},
+			expectedErr:     notFound,
+			expectedCancels: 1,
+		},
+		{
+			name:            "gives up after max attempts",
+			errs:            []error{unavailable, unavailable, unavailable, unavailable, unavailable},
+			expectedErr:     unavailable,
+			expectedCancels: cancelMaxAttempts,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			fake := &fakeLoms{errs: []error{unavailable}}
+			b := breaker.New("loms-test", breaker.Config{FailureThreshold: 1, OpenTimeout: time.Hour}, IsFailure)
+			c := NewWithBreaker(NewLomsCliemt(fake), b)
+			c.cancelDelay = time.Millisecond
+
+			_, err := c.CreateOrder(context.Background(), &pb.OrderCreateRequest{})
+			require.Error(t, err)
+			require.Equal(t, breaker.StateOpen, b.State())
+			_, err = c.CreateOrder(context.Background(), &pb.OrderCreateRequest{})
+			require.ErrorIs(t, err, model.ErrServiceUnavailable)
+			fake.errs = tt.errs
+
+			// Execute
+			err = c.CancelOrder(context.Background(), &pb.OrderCancelRequest{OrderID: 1})
+
+			// Verify
+			if tt.expectedErr != nil {
+				assert.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCancels, fake.cancels)
+			assert.Equal(t, 1, fake.creates)
+		})
+	}
+}
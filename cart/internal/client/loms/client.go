@@ -38,3 +38,12 @@ func (c *Client) GetStocksInfo(ctx context.Context, req *pb.StocksInfoRequest) (
 
 	return resp, nil
 }
+
+// CancelOrder ...
+func (c *Client) CancelOrder(ctx context.Context, req *pb.OrderCancelRequest) error {
+	if _, err := c.client.OrderCancel(ctx, req); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package model
 
 import (
 	"errors"
+	"fmt"
 )
 
 var (
@@ -14,6 +15,13 @@ var (
 	ErrNotFound = errors.New("not found")
 	// ErrManyRequest ...
 	ErrManyRequest = errors.New("too many request")
+	// ErrServiceUnavailable внешний сервис недоступен, запрос не выполнялся
+	ErrServiceUnavailable = errors.New("service unavailable")
+
+	// ErrPromoCodeNotFound ...
+	ErrPromoCodeNotFound = errors.New("промокод не найден")
+	// ErrPromoNotApplicable промокод существует, но не подходит к содержимому корзины
+	ErrPromoNotApplicable = errors.New("промокод не применим к корзине")
 
 	// ErrProductNotFound product-service
 	ErrProductNotFound = errors.New("product not found")
@@ -26,6 +34,16 @@ var (
 	ErrSkuMoreThanZero = "SKU должен быть натуральным числом (больше нуля)"
 	// ErrCounItemsMoreThanZero ...
 	ErrCounItemsMoreThanZero = "Количество должно быть натуральным числом (больше нуля)"
+	// ErrCountRequired ...
+	ErrCountRequired = "Количество должно быть указано (0 удаляет товар из корзины)"
+	// ErrIdempotencyKeyTooLong ...
+	ErrIdempotencyKeyTooLong = "Idempotency-Key должен быть не длиннее 255 символов"
+	// ErrSourceUserIDMoreThanZero ...
+	ErrSourceUserIDMoreThanZero = "Идентификатор пользователя исходной корзины должен быть натуральным числом (больше нуля)"
+	// ErrMergeSameCart ...
+	ErrMergeSameCart = "Нельзя объединить корзину саму с собой"
+	// ErrPromoCodeRequired ...
+	ErrPromoCodeRequired = "Промокод должен быть указан"
 	// ErrSkuNotExists ...
 	ErrSkuNotExists = "SKU должен существовать в сервисе product-service"
 )
@@ -35,4 +53,63 @@ var (
 	ErrCartEmpty = errors.New("невозможно оформить заказ для пустой корзины")
 	// ErrAddedMoreItemThanInStock ...
 	ErrAddedMoreItemThanInStock = errors.New("невозможно добавить товара по количеству больше, чем есть в стоках")
+	// ErrCartChanged ...
+	ErrCartChanged = errors.New("корзина изменилась во время оформления заказа, заказ отменен")
+	// ErrUnauthorized нет токена или токен недействителен
+	ErrUnauthorized = errors.New("требуется действительный токен доступа")
+	// ErrForbidden токен выдан другому пользователю и не дает прав администратора
+	ErrForbidden = errors.New("нет доступа к корзине другого пользователя")
+	// ErrAdminRequired служебные ручки доступны только с токеном администратора
+	ErrAdminRequired = errors.New("требуется токен администратора")
+	// ErrIdempotencyKeyReused ключ уже использован для оформления корзины, которая с тех пор изменилась
+	ErrIdempotencyKeyReused = errors.New("ключ Idempotency-Key уже использован для другого содержимого корзины")
+	// ErrRateLimited клиент превысил лимит входящих запросов
+	ErrRateLimited = errors.New("слишком много запросов, повторите позже")
+	// ErrRequestTooLarge тело запроса больше допустимого
+	ErrRequestTooLarge = errors.New("слишком большое тело запроса")
+	// ErrInternal ответ на панику обработчика, подробности только в логе
+	ErrInternal = errors.New("внутренняя ошибка сервиса")
+	// ErrPricesNotConfirmed корзина собрана в деградированном режиме, оформлять заказ по неподтвержденным ценам нельзя
+	ErrPricesNotConfirmed = fmt.Errorf("невозможно оформить заказ: не удалось подтвердить цены товаров: %w", ErrServiceUnavailable)
 )
+
+// InsufficientStockError возвращается при оформлении заказа, если каких-то товаров не хватает в стоках
+type InsufficientStockError struct {
+	Items []StockShortage
+}
+
+// Error ...
+func (e *InsufficientStockError) Error() string {
+	return fmt.Sprintf("недостаточно товара в стоках для %d позиций корзины", len(e.Items))
+}
+
+// SkuQuantityLimitError количество товара в корзине больше допустимого
+type SkuQuantityLimitError struct {
+	Sku int64
+	Max uint32
+}
+
+// Error ...
+func (e *SkuQuantityLimitError) Error() string {
+	return fmt.Sprintf("количество товара %d в корзине не может быть больше %d", e.Sku, e.Max)
+}
+
+// CartLinesLimitError в корзине больше разных товаров, чем допустимо
+type CartLinesLimitError struct {
+	Max int
+}
+
+// Error ...
+func (e *CartLinesLimitError) Error() string {
+	return fmt.Sprintf("в корзине не может быть больше %d разных товаров", e.Max)
+}
+
+// CartTotalLimitError сумма корзины больше допустимой
+type CartTotalLimitError struct {
+	Max uint64
+}
+
+// Error ...
+func (e *CartTotalLimitError) Error() string {
+	return fmt.Sprintf("сумма корзины не может быть больше %d", e.Max)
+}
@@ -0,0 +1,33 @@
+package model
+
+import "time"
+
+const (
+	// CartEventAdd ...
+	CartEventAdd = "add"
+	// CartEventSetCount ...
+	CartEventSetCount = "set_count"
+	// CartEventRemove ...
+	CartEventRemove = "remove"
+	// CartEventClear ...
+	CartEventClear = "clear"
+	// CartEventCheckout ...
+	CartEventCheckout = "checkout"
+)
+
+// CartEvent изменение количества товара в корзине, Delta при удалении отрицательная
+type CartEvent struct {
+	UserID    int64
+	Sku       int64
+	Delta     int64
+	Timestamp time.Time
+	Reason    string
+}
+
+// AbandonedCart корзина, которая не менялась с UpdatedAt.
+// Последнее изменение корзины - самое позднее изменение ее позиций, удаление позиций корзину не обновляет
+type AbandonedCart struct {
+	UserID    int64
+	UpdatedAt time.Time
+	Items     []Cart
+}
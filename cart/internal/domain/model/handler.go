@@ -3,15 +3,45 @@ package model
 
 // RequestData ...
 type RequestData struct {
-	UserID int64  `json:"user_id" validate:"min=1"`
-	Sku    int64  `json:"sku" validate:"min=1"`
-	Count  uint32 `json:"count" validate:"min=1"`
+	UserID int64  `json:"user_id"`
+	Sku    int64  `json:"sku"`
+	Count  uint32 `json:"count"`
+	// Price цена товара в product-service на момент добавления в корзину, заполняется сервисом
+	Price uint32 `json:"-"`
 }
 
 // GetItemsFromCartResponce ...
 type GetItemsFromCartResponce struct {
-	Items      []Item `json:"items"`
-	TotalPrice uint32 `json:"total_price"`
+	Items []Item `json:"items"`
+	// Subtotal сумма корзины без скидок
+	Subtotal uint64 `json:"subtotal"`
+	// TotalPrice сумма корзины с учетом скидок по промокоду
+	TotalPrice uint64 `json:"total_price"`
+	// PromoCode промокод, примененный к корзине
+	PromoCode string         `json:"promo_code,omitempty"`
+	Discounts []DiscountLine `json:"discounts,omitempty"`
+	// PromoNotApplied почему промокод сейчас не дает скидку
+	PromoNotApplied string `json:"promo_not_applied,omitempty"`
+	// CheckedOutOrderID заказ, которым была оформлена корзина, если после этого в нее ничего не добавляли
+	CheckedOutOrderID int64 `json:"checked_out_order_id,omitempty"`
+	// PriceChanged цена хотя бы одной позиции изменилась с момента добавления в корзину
+	PriceChanged bool `json:"price_changed"`
+	// Degraded product-service недоступен, названия и цены взяты из последних известных данных и не подтверждены
+	Degraded bool `json:"degraded,omitempty"`
+}
+
+// DiscountLine ...
+type DiscountLine struct {
+	Code string `json:"code"`
+	// Sku товар, на который дана скидка, 0 - скидка на всю корзину
+	Sku         int64  `json:"sku,omitempty"`
+	Description string `json:"description"`
+	Amount      uint64 `json:"amount"`
+}
+
+// ApplyPromoCodeRequest ...
+type ApplyPromoCodeRequest struct {
+	Code string `json:"code"`
 }
 
 // Item ...
@@ -19,7 +49,54 @@ type Item struct {
 	Sku   int64  `json:"sku"`
 	Name  string `json:"name"`
 	Count uint32 `json:"count"`
+	// Price текущая цена в product-service
 	Price uint32 `json:"price"`
+	// SavedPrice цена на момент добавления товара в корзину
+	SavedPrice   uint32 `json:"saved_price"`
+	PriceChanged bool   `json:"price_changed"`
+	// Unavailable данных о товаре нет, цена указана на момент добавления в корзину
+	Unavailable bool `json:"unavailable,omitempty"`
+}
+
+// GetSavedItemsResponse отложенные товары, в сумму корзины и заказ не входят
+type GetSavedItemsResponse struct {
+	Items []Item `json:"items"`
+	// Degraded product-service недоступен, названия и цены взяты из последних известных данных и не подтверждены
+	Degraded bool `json:"degraded,omitempty"`
+}
+
+// MergeCartRequest ...
+type MergeCartRequest struct {
+	SourceUserID int64 `json:"source_user_id"`
+}
+
+// MergedLine позиция, перенесенная из исходной корзины
+type MergedLine struct {
+	Sku int64 `json:"sku"`
+	// Requested сумма количеств в обеих корзинах
+	Requested uint32 `json:"requested"`
+	// Merged количество в корзине после переноса
+	Merged uint32 `json:"merged"`
+}
+
+// MergeCartResponse ...
+type MergeCartResponse struct {
+	// Adjusted позиции, количество которых урезано до остатка в стоках или лимитов корзины,
+	// Merged 0 - позиция не перенесена
+	Adjusted []MergedLine `json:"adjusted"`
+}
+
+// StockShortage ...
+type StockShortage struct {
+	Sku       int64  `json:"sku"`
+	Requested uint32 `json:"requested"`
+	Available uint32 `json:"available"`
+}
+
+// StockShortageResponse ...
+type StockShortageResponse struct {
+	Message string          `json:"Message"`
+	Items   []StockShortage `json:"items"`
 }
 
 // OrderID ...
@@ -33,6 +110,8 @@ type ValidateTypeFull int //user + sku + count
 type ValidateTypeBySku int //user + sku
 // ValidateTypeByUserID ...
 type ValidateTypeByUserID int //user
+// ValidateTypeSetCount ...
+type ValidateTypeSetCount int //user + sku + count >= 0
 
 const (
 	// ValidateFull ...
@@ -41,15 +120,33 @@ const (
 	ValidateBySku ValidateTypeBySku = 2
 	// ValidateByUserID ...
 	ValidateByUserID ValidateTypeByUserID = 3
+	// ValidateSetCount ...
+	ValidateSetCount ValidateTypeSetCount = 4
 )
 
 var (
 	// AddItemURL ...
 	AddItemURL = "POST /user/{user_id}/cart/{sku_id}"
+	// SetItemCountURL ...
+	SetItemCountURL = "PUT /user/{user_id}/cart/{sku_id}"
 	// DeleteItemURL ...
 	DeleteItemURL = "DELETE /user/{user_id}/cart/{sku_id}"
 	// DeleteItemsByUserIDURL ...
 	DeleteItemsByUserIDURL = "DELETE /user/{user_id}/cart"
+	// MergeCartURL ...
+	MergeCartURL = "POST /user/{user_id}/cart/merge"
+	// SaveForLaterURL ...
+	SaveForLaterURL = "POST /user/{user_id}/cart/{sku_id}/save"
+	// MoveToCartURL ...
+	MoveToCartURL = "POST /user/{user_id}/saved/{sku_id}/move"
+	// DeleteSavedItemURL ...
+	DeleteSavedItemURL = "DELETE /user/{user_id}/saved/{sku_id}"
+	// GetSavedItemsURL ...
+	GetSavedItemsURL = "GET /user/{user_id}/saved"
+	// ApplyPromoCodeURL ...
+	ApplyPromoCodeURL = "PUT /user/{user_id}/cart/promo"
+	// RemovePromoCodeURL ...
+	RemovePromoCodeURL = "DELETE /user/{user_id}/cart/promo"
 	// GetItemsByUserIDURL ...
 	GetItemsByUserIDURL = "GET /user/{user_id}/cart"
 	// OrderFullCartURL ...
@@ -58,10 +155,19 @@ var (
 	GetMetricsURL = "GET /metrics"
 )
 
+const (
+	// IdempotencyKeyHeader ...
+	IdempotencyKeyHeader = "Idempotency-Key"
+	// MaxIdempotencyKeyLen совпадает с правилом idempotency_key в cart.proto
+	MaxIdempotencyKeyLen = 255
+)
+
 // ручки Product-service ...
 var (
 	// GetProductBySkuURL ...
 	GetProductBySkuURL = "GET /product/{sku_id}"
+	// GetProductsURL ...
+	GetProductsURL = "GET /product"
 )
 
 // ручки Loms GRPC ...
@@ -70,7 +176,24 @@ var (
 	OrderCreateGRPC = "OrderCreate"
 	// StocksInfoGRPC ...
 	StocksInfoGRPC = "StocksInfo"
+	// OrderCancelGRPC ...
+	OrderCancelGRPC = "OrderCancel"
 )
+
+// ручки Cart GRPC ...
+var (
+	// AddItemGRPC ...
+	AddItemGRPC = "/cart.v1.Cart/AddItem"
+	// DeleteItemGRPC ...
+	DeleteItemGRPC = "/cart.v1.Cart/DeleteItem"
+	// ClearCartGRPC ...
+	ClearCartGRPC = "/cart.v1.Cart/ClearCart"
+	// ListCartGRPC ...
+	ListCartGRPC = "/cart.v1.Cart/ListCart"
+	// CheckoutGRPC ...
+	CheckoutGRPC = "/cart.v1.Cart/Checkout"
+)
+
 var (
 	// DebugPprof ...
 	DebugPprof = "/debug/pprof/"
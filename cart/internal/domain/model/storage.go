@@ -1,11 +1,138 @@
 // Package model ...
 package model
 
+import (
+	"math"
+	"strings"
+	"time"
+)
+
 // Cart ...
 type Cart struct {
 	SkuID int64
 	Count uint32
+	// Price цена за единицу на момент добавления в корзину, 0 - цена не сохранена
+	Price uint32
+	// UpdatedAt время последнего изменения позиции
+	UpdatedAt time.Time
+}
+
+// CartLimits ограничения корзины, 0 - без ограничения
+type CartLimits struct {
+	// MaxSkuCount максимальное количество одного товара
+	MaxSkuCount uint32
+	// MaxLines максимальное количество разных товаров
+	MaxLines int
+	// MaxTotal максимальная сумма корзины по ценам на момент добавления
+	MaxTotal uint64
+}
+
+// Enabled ...
+func (l CartLimits) Enabled() bool {
+	return l.MaxSkuCount > 0 || l.MaxLines > 0 || l.MaxTotal > 0
 }
 
 // Storage ...
 type Storage = map[int64][]Cart
+
+// IdempotencyRecord запись Idempotency-Key в хранилище корзины
+type IdempotencyRecord struct {
+	// OrderID заказ, созданный первым запросом, 0 - запрос еще выполняется
+	OrderID int64
+	// Fingerprint отпечаток корзины после оформления заказа
+	Fingerprint string
+}
+
+// SameItems сравнивает содержимое двух корзин без учета порядка позиций
+func SameItems(a, b []Cart) bool {
+	if len(a) != len(b) {
+		return false
+	}
+
+	counts := make(map[int64]uint32, len(a))
+	for _, item := range a {
+		counts[item.SkuID] += item.Count
+	}
+
+	for _, item := range b {
+		count, ok := counts[item.SkuID]
+		if !ok || count != item.Count {
+			return false
+		}
+		delete(counts, item.SkuID)
+	}
+
+	return len(counts) == 0
+}
+
+// AddCounts сумма количеств, ограниченная максимумом uint32, чтобы количество не переполнялось
+// при отключенных лимитах корзины
+func AddCounts(a, b uint32) uint32 {
+	return uint32(min(uint64(a)+uint64(b), math.MaxUint32))
+}
+
+// MergeCounts количество позиции после переноса из другой корзины: сумма количеств,
+// ограниченная доступным остатком. То, что уже лежало в целевой корзине, не уменьшается
+func MergeCounts(target, source, available uint32) (requested, merged uint32) {
+	requested = AddCounts(target, source)
+	if requested <= available {
+		return requested, requested
+	}
+
+	return requested, max(available, target)
+}
+
+// MergeLines результат переноса позиций source в корзину target: количество каждой позиции считается
+// как в MergeCounts и дополнительно ограничивается лимитами корзины. Позиции переносятся по порядку source:
+// новые позиции сверх MaxLines не переносятся, а количество урезается так, чтобы сумма корзины не превысила MaxTotal.
+// У позиции, которая уже была в целевой корзине, сохраняется ее цена и количество не уменьшается
+func MergeLines(target, source []Cart, available map[int64]uint32, limits CartLimits) []MergedLine {
+	existing := make(map[int64]Cart, len(target))
+	var total uint64
+	for _, item := range target {
+		existing[item.SkuID] = item
+		total += uint64(item.Count) * uint64(item.Price)
+	}
+	lineCount := len(target)
+
+	lines := make([]MergedLine, 0, len(source))
+	for _, item := range source {
+		current, ok := existing[item.SkuID]
+		requested, merged := MergeCounts(current.Count, item.Count, available[item.SkuID])
+
+		if limits.MaxSkuCount > 0 && merged > limits.MaxSkuCount {
+			merged = max(limits.MaxSkuCount, current.Count)
+		}
+
+		if !ok && limits.MaxLines > 0 && lineCount >= limits.MaxLines {
+			merged = 0
+		}
+
+		price := item.Price
+		if ok {
+			price = current.Price
+		}
+		if limits.MaxTotal > 0 && price > 0 && merged > current.Count {
+			var room uint64
+			if total < limits.MaxTotal {
+				room = limits.MaxTotal - total
+			}
+			// nolint:gosec
+			merged = current.Count + uint32(min(uint64(merged-current.Count), room/uint64(price)))
+		}
+
+		if !ok && merged > 0 {
+			lineCount++
+		}
+		total += uint64(merged-current.Count) * uint64(price)
+
+		lines = append(lines, MergedLine{Sku: item.SkuID, Requested: requested, Merged: merged})
+	}
+
+	return lines
+}
+
+// NormalizePromoCode промокоды не зависят от регистра и пробелов по краям
+func NormalizePromoCode(code string) string {
+	return strings.ToUpper(strings.TrimSpace(code))
+}
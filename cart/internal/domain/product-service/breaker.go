@@ -0,0 +1,48 @@
+package productservice
+
+import (
+	"context"
+	"errors"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/breaker"
+)
+
+// productClient ...
+type productClient interface {
+	GetProductBySku(ctx context.Context, sku int64) (*model.GetProductResponse, error)
+	GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*model.GetProductResponse, error)
+}
+
+// WithBreaker ProductService за circuit breaker, при открытом breaker запросы в product-service не выполняются
+type WithBreaker struct {
+	next    productClient
+	breaker *breaker.Breaker
+}
+
+// NewWithBreaker ...
+func NewWithBreaker(next productClient, b *breaker.Breaker) *WithBreaker {
+	return &WithBreaker{
+		next:    next,
+		breaker: b,
+	}
+}
+
+// GetProductBySku ...
+func (ps *WithBreaker) GetProductBySku(ctx context.Context, sku int64) (*model.GetProductResponse, error) {
+	return breaker.Call(ps.breaker, func() (*model.GetProductResponse, error) {
+		return ps.next.GetProductBySku(ctx, sku)
+	})
+}
+
+// GetProductsBySkus ...
+func (ps *WithBreaker) GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*model.GetProductResponse, error) {
+	return breaker.Call(ps.breaker, func() (map[int64]*model.GetProductResponse, error) {
+		return ps.next.GetProductsBySkus(ctx, skus)
+	})
+}
+
+// IsFailure "товар не найден" и отмена запроса вызывающим не говорят о недоступности product-service
+func IsFailure(err error) bool {
+	return !errors.Is(err, model.ErrProductNotFound) && !errors.Is(err, context.Canceled)
+}
@@ -0,0 +1,300 @@
+// Package cache ...
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
+)
+
+const (
+	// DefaultTTL ...
+	DefaultTTL = 5 * time.Minute
+	// DefaultNegativeTTL ...
+	DefaultNegativeTTL = time.Minute
+	// DefaultStaleTTL ...
+	DefaultStaleTTL = 24 * time.Hour
+	// cleanupInterval ...
+	cleanupInterval = time.Minute
+	// fetchTimeout общий запрос в product-service не отменяется вместе с запросом, который его начал,
+	// иначе отмена одного клиента завершала бы ошибкой всех ожидающих
+	fetchTimeout = 10 * time.Second
+)
+
+// ProductService ...
+type ProductService interface {
+	GetProductBySku(ctx context.Context, sku int64) (*model.GetProductResponse, error)
+	GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*model.GetProductResponse, error)
+}
+
+// entry закешированный ответ product-service, для несуществующего товара product == nil.
+// После expiresAt товар запрашивается заново, но до staleAt отдается как последний известный
+type entry struct {
+	product   *model.GetProductResponse
+	expiresAt time.Time
+	staleAt   time.Time
+}
+
+// call запрос в product-service, который выполняется прямо сейчас, done закрывается после его завершения
+type call struct {
+	done    chan struct{}
+	product *model.GetProductResponse
+	err     error
+}
+
+// ProductCache кеширует ответы product-service по sku. Одновременные промахи по одному sku
+// схлопываются в один запрос
+type ProductCache struct {
+	next        ProductService
+	ttl         time.Duration
+	negativeTTL time.Duration
+	staleTTL    time.Duration
+	entries     map[int64]entry
+	calls       map[int64]*call
+	mx          sync.Mutex
+	done        chan struct{}
+	now         func() time.Time
+}
+
+// NewProductCache staleTTL сколько хранится последний известный ответ для работы при недоступном product-service
+func NewProductCache(next ProductService, ttl, negativeTTL, staleTTL time.Duration) *ProductCache {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	if negativeTTL <= 0 {
+		negativeTTL = DefaultNegativeTTL
+	}
+	if staleTTL <= 0 {
+		staleTTL = DefaultStaleTTL
+	}
+	staleTTL = max(staleTTL, ttl)
+
+	c := ProductCache{
+		next:        next,
+		ttl:         ttl,
+		negativeTTL: negativeTTL,
+		staleTTL:    staleTTL,
+		entries:     make(map[int64]entry),
+		calls:       make(map[int64]*call),
+		done:        make(chan struct{}),
+		now:         time.Now,
+	}
+
+	go func() {
+		t := time.NewTicker(cleanupInterval)
+		for {
+			select {
+			case <-t.C:
+				c.cleanup()
+			case <-c.done:
+				t.Stop()
+				return
+			}
+		}
+	}()
+
+	return &c
+}
+
+// GetProductBySku ...
+func (c *ProductCache) GetProductBySku(ctx context.Context, sku int64) (*model.GetProductResponse, error) {
+	c.mx.Lock()
+	if e, ok := c.entries[sku]; ok && c.now().Before(e.expiresAt) {
+		c.mx.Unlock()
+		metrics.IncProductCacheRequest(metrics.ProductCacheHit)
+		return copyProduct(e.product)
+	}
+	metrics.IncProductCacheRequest(metrics.ProductCacheMiss)
+
+	cl, ok := c.calls[sku]
+	if !ok {
+		cl = &call{done: make(chan struct{})}
+		c.calls[sku] = cl
+		go c.fetch(ctx, sku, cl)
+	}
+	c.mx.Unlock()
+
+	if err := wait(ctx, cl); err != nil {
+		return nil, err
+	}
+	if cl.err != nil {
+		return nil, cl.err
+	}
+
+	return copyProduct(cl.product)
+}
+
+// fetch запрашивает один sku в product-service и будит ожидающих
+func (c *ProductCache) fetch(ctx context.Context, sku int64, cl *call) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+	defer cancel()
+
+	product, err := c.next.GetProductBySku(ctx, sku)
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	c.finish(sku, cl, product, err)
+}
+
+// fetchBatch запрашивает sku из own одним батчем и будит ожидающих
+func (c *ProductCache) fetchBatch(ctx context.Context, own map[int64]*call, skus []int64) {
+	ctx, cancel := context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+	defer cancel()
+
+	fetched, err := c.next.GetProductsBySkus(ctx, skus)
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+	for sku, cl := range own {
+		switch product, ok := fetched[sku]; {
+		case err != nil:
+			c.finish(sku, cl, nil, err)
+		case ok:
+			c.finish(sku, cl, product, nil)
+		default:
+			c.finish(sku, cl, nil, model.ErrProductNotFound)
+		}
+	}
+}
+
+// GetProductsBySkus отдает закешированные товары, а промахи запрашивает одним батчем.
+// Sku, которые уже запрашиваются другим вызовом, дожидаются его результата.
+// Батч выполняется в фоне, поэтому отмена ctx не прерывает его для других ожидающих.
+// Несуществующих sku в ответе нет
+func (c *ProductCache) GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*model.GetProductResponse, error) {
+	products := make(map[int64]*model.GetProductResponse, len(skus))
+	waiting := make(map[int64]*call)
+	own := make(map[int64]*call)
+	seen := make(map[int64]struct{}, len(skus))
+	var fetch []int64
+
+	c.mx.Lock()
+	for _, sku := range skus {
+		if _, ok := seen[sku]; ok {
+			continue
+		}
+		seen[sku] = struct{}{}
+
+		if e, ok := c.entries[sku]; ok && c.now().Before(e.expiresAt) {
+			metrics.IncProductCacheRequest(metrics.ProductCacheHit)
+			if e.product != nil {
+				products[sku], _ = copyProduct(e.product)
+			}
+			continue
+		}
+		metrics.IncProductCacheRequest(metrics.ProductCacheMiss)
+
+		if cl, ok := c.calls[sku]; ok {
+			waiting[sku] = cl
+			continue
+		}
+
+		cl := &call{done: make(chan struct{})}
+		c.calls[sku] = cl
+		own[sku] = cl
+		fetch = append(fetch, sku)
+	}
+	c.mx.Unlock()
+
+	if len(fetch) > 0 {
+		go c.fetchBatch(ctx, own, fetch)
+		for sku, cl := range own {
+			waiting[sku] = cl
+		}
+	}
+
+	for sku, cl := range waiting {
+		if err := wait(ctx, cl); err != nil {
+			return nil, err
+		}
+
+		if cl.err != nil {
+			if errors.Is(cl.err, model.ErrProductNotFound) {
+				continue
+			}
+			return nil, cl.err
+		}
+		products[sku], _ = copyProduct(cl.product)
+	}
+
+	return products, nil
+}
+
+// GetLastKnownProducts последние полученные из product-service данные товаров, в том числе устаревшие.
+// В product-service не ходит, sku без сохраненных данных в ответе нет
+func (c *ProductCache) GetLastKnownProducts(skus []int64) map[int64]*model.GetProductResponse {
+	products := make(map[int64]*model.GetProductResponse, len(skus))
+
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := c.now()
+	for _, sku := range skus {
+		if e, ok := c.entries[sku]; ok && e.product != nil && now.Before(e.staleAt) {
+			products[sku], _ = copyProduct(e.product)
+		}
+	}
+
+	return products
+}
+
+// Close ...
+func (c *ProductCache) Close() {
+	c.done <- struct{}{}
+	close(c.done)
+}
+
+// finish сохраняет результат запроса и будит ожидающих, вызывается под мьютексом.
+// Кешируются успешные ответы и "товар не найден", остальные ошибки - нет
+func (c *ProductCache) finish(sku int64, cl *call, product *model.GetProductResponse, err error) {
+	cl.product, cl.err = product, err
+	delete(c.calls, sku)
+
+	now := c.now()
+	switch {
+	case err == nil:
+		c.entries[sku] = entry{product: product, expiresAt: now.Add(c.ttl), staleAt: now.Add(c.staleTTL)}
+	case errors.Is(err, model.ErrProductNotFound):
+		expiresAt := now.Add(c.negativeTTL)
+		c.entries[sku] = entry{expiresAt: expiresAt, staleAt: expiresAt}
+	}
+
+	close(cl.done)
+}
+
+// cleanup удаляет записи, которые нельзя отдать даже как последние известные
+func (c *ProductCache) cleanup() {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	now := c.now()
+	for sku, e := range c.entries {
+		if !now.Before(e.staleAt) {
+			delete(c.entries, sku)
+		}
+	}
+}
+
+// wait ...
+func wait(ctx context.Context, cl *call) error {
+	select {
+	case <-cl.done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// copyProduct отдает копию, чтобы вызывающий не мог изменить закешированный ответ
+func copyProduct(product *model.GetProductResponse) (*model.GetProductResponse, error) {
+	if product == nil {
+		return nil, model.ErrProductNotFound
+	}
+
+	p := *product
+	return &p, nil
+}
@@ -0,0 +1,408 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeProductService ...
+type fakeProductService struct {
+	calls int64
+	fn    func(sku int64) (*model.GetProductResponse, error)
+	mx    sync.Mutex
+	batch [][]int64
+}
+
+// GetProductBySku как и HTTP-клиент, завершается ошибкой, если ctx отменен
+func (f *fakeProductService) GetProductBySku(ctx context.Context, sku int64) (*model.GetProductResponse, error) {
+	atomic.AddInt64(&f.calls, 1)
+	product, err := f.fn(sku)
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	return product, err
+}
+
+// GetProductsBySkus ...
+func (f *fakeProductService) GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*model.GetProductResponse, error) {
+	atomic.AddInt64(&f.calls, 1)
+	f.mx.Lock()
+	f.batch = append(f.batch, skus)
+	f.mx.Unlock()
+
+	products := make(map[int64]*model.GetProductResponse, len(skus))
+	for _, sku := range skus {
+		product, err := f.fn(sku)
+		if errors.Is(err, model.ErrProductNotFound) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		products[sku] = product
+	}
+
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+
+	return products, nil
+}
+
+func setupCache(t *testing.T, fn func(sku int64) (*model.GetProductResponse, error)) (*ProductCache, *fakeProductService) {
+	t.Helper()
+
+	ps := &fakeProductService{fn: fn}
+	c := NewProductCache(ps, time.Minute, 10*time.Second, time.Hour)
+	t.Cleanup(c.Close)
+
+	return c, ps
+}
+
+func TestProductCache_GetProductBySku(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	product := func(sku int64) (*model.GetProductResponse, error) {
+		return &model.GetProductResponse{Sku: sku, Name: "item", Price: 100}, nil
+	}
+
+	t.Run("hit returns cached product", func(t *testing.T) {
+		t.Parallel()
+		c, ps := setupCache(t, product)
+
+		for i := 0; i < 3; i++ {
+			resp, err := c.GetProductBySku(ctx, 1)
+			require.NoError(t, err)
+			assert.Equal(t, &model.GetProductResponse{Sku: 1, Name: "item", Price: 100}, resp)
+		}
+		assert.Equal(t, int64(1), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("cached product can not be changed by caller", func(t *testing.T) {
+		t.Parallel()
+		c, _ := setupCache(t, product)
+
+		resp, err := c.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+		resp.Price = 1
+
+		resp, err = c.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(100), resp.Price)
+	})
+
+	t.Run("expired product is fetched again", func(t *testing.T) {
+		t.Parallel()
+		c, ps := setupCache(t, product)
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		_, err := c.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+
+		now = now.Add(time.Minute)
+
+		_, err = c.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("not found is cached with negative ttl", func(t *testing.T) {
+		t.Parallel()
+		c, ps := setupCache(t, func(_ int64) (*model.GetProductResponse, error) {
+			return nil, model.ErrProductNotFound
+		})
+		now := time.Now()
+		c.now = func() time.Time { return now }
+
+		for i := 0; i < 2; i++ {
+			_, err := c.GetProductBySku(ctx, 1)
+			require.ErrorIs(t, err, model.ErrProductNotFound)
+		}
+		assert.Equal(t, int64(1), atomic.LoadInt64(&ps.calls))
+
+		now = now.Add(10 * time.Second)
+
+		_, err := c.GetProductBySku(ctx, 1)
+		require.ErrorIs(t, err, model.ErrProductNotFound)
+		assert.Equal(t, int64(2), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("other errors are not cached", func(t *testing.T) {
+		t.Parallel()
+		c, ps := setupCache(t, func(_ int64) (*model.GetProductResponse, error) {
+			return nil, errors.New("test")
+		})
+
+		for i := 0; i < 2; i++ {
+			_, err := c.GetProductBySku(ctx, 1)
+			require.EqualError(t, err, "test")
+		}
+		assert.Equal(t, int64(2), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("concurrent misses are coalesced", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		c, ps := setupCache(t, func(sku int64) (*model.GetProductResponse, error) {
+			<-release
+			return product(sku)
+		})
+
+		const countGoroutines = 10
+
+		var wg sync.WaitGroup
+		errs := make([]error, countGoroutines)
+		for i := 0; i < countGoroutines; i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				_, errs[i] = c.GetProductBySku(ctx, 1)
+			}()
+		}
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt64(&ps.calls) == 1
+		}, time.Second, time.Millisecond)
+		time.Sleep(10 * time.Millisecond)
+		close(release)
+		wg.Wait()
+
+		for _, err := range errs {
+			require.NoError(t, err)
+		}
+		assert.Equal(t, int64(1), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("waiting respects context", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		defer close(release)
+		c, ps := setupCache(t, func(sku int64) (*model.GetProductResponse, error) {
+			<-release
+			return product(sku)
+		})
+
+		go func() {
+			//nolint:errcheck
+			c.GetProductBySku(ctx, 1)
+		}()
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt64(&ps.calls) == 1
+		}, time.Second, time.Millisecond)
+
+		waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		_, err := c.GetProductBySku(waitCtx, 1)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("cancelled first caller does not fail waiters", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		c, ps := setupCache(t, func(sku int64) (*model.GetProductResponse, error) {
+			<-release
+			return product(sku)
+		})
+
+		leaderCtx, cancel := context.WithCancel(ctx)
+		leaderErr := make(chan error, 1)
+		go func() {
+			_, err := c.GetProductBySku(leaderCtx, 1)
+			leaderErr <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt64(&ps.calls) == 1
+		}, time.Second, time.Millisecond)
+
+		go func() {
+			cancel()
+			assert.ErrorIs(t, <-leaderErr, context.Canceled)
+			close(release)
+		}()
+
+		waitCtx, waitCancel := context.WithTimeout(ctx, time.Second)
+		defer waitCancel()
+
+		got, err := c.GetProductBySku(waitCtx, 1)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), got.Sku)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&ps.calls))
+	})
+}
+
+func TestProductCache_GetProductsBySkus(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	product := func(sku int64) (*model.GetProductResponse, error) {
+		if sku == 3 {
+			return nil, model.ErrProductNotFound
+		}
+		return &model.GetProductResponse{Sku: sku, Name: "item", Price: 100}, nil
+	}
+
+	t.Run("only misses are fetched", func(t *testing.T) {
+		t.Parallel()
+		c, ps := setupCache(t, product)
+
+		_, err := c.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+
+		products, err := c.GetProductsBySkus(ctx, []int64{1, 2, 2, 3})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]*model.GetProductResponse{
+			1: {Sku: 1, Name: "item", Price: 100},
+			2: {Sku: 2, Name: "item", Price: 100},
+		}, products)
+		assert.Equal(t, [][]int64{{2, 3}}, ps.batch)
+
+		products, err = c.GetProductsBySkus(ctx, []int64{1, 2, 3})
+		require.NoError(t, err)
+		assert.Len(t, products, 2)
+		assert.Equal(t, int64(2), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("batch fills cache for single lookups", func(t *testing.T) {
+		t.Parallel()
+		c, ps := setupCache(t, product)
+
+		_, err := c.GetProductsBySkus(ctx, []int64{1, 3})
+		require.NoError(t, err)
+
+		_, err = c.GetProductBySku(ctx, 1)
+		require.NoError(t, err)
+		_, err = c.GetProductBySku(ctx, 3)
+		require.ErrorIs(t, err, model.ErrProductNotFound)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("error is not cached", func(t *testing.T) {
+		t.Parallel()
+		c, ps := setupCache(t, func(_ int64) (*model.GetProductResponse, error) {
+			return nil, errors.New("test")
+		})
+
+		for i := 0; i < 2; i++ {
+			_, err := c.GetProductsBySkus(ctx, []int64{1})
+			require.EqualError(t, err, "test")
+		}
+		assert.Equal(t, int64(2), atomic.LoadInt64(&ps.calls))
+	})
+
+	t.Run("in flight sku is awaited", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		c, ps := setupCache(t, func(sku int64) (*model.GetProductResponse, error) {
+			if sku == 1 {
+				<-release
+			}
+			return product(sku)
+		})
+
+		var wg sync.WaitGroup
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := c.GetProductBySku(ctx, 1)
+			assert.NoError(t, err)
+		}()
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt64(&ps.calls) == 1
+		}, time.Second, time.Millisecond)
+
+		go func() {
+			time.Sleep(10 * time.Millisecond)
+			close(release)
+		}()
+
+		products, err := c.GetProductsBySkus(ctx, []int64{1, 2})
+		require.NoError(t, err)
+		assert.Len(t, products, 2)
+		assert.Equal(t, [][]int64{{2}}, ps.batch)
+		wg.Wait()
+	})
+
+	t.Run("cancelled batch caller does not fail waiters", func(t *testing.T) {
+		t.Parallel()
+		release := make(chan struct{})
+		c, ps := setupCache(t, func(sku int64) (*model.GetProductResponse, error) {
+			<-release
+			return product(sku)
+		})
+
+		leaderCtx, cancel := context.WithCancel(ctx)
+		leaderErr := make(chan error, 1)
+		go func() {
+			_, err := c.GetProductsBySkus(leaderCtx, []int64{1, 2})
+			leaderErr <- err
+		}()
+
+		require.Eventually(t, func() bool {
+			return atomic.LoadInt64(&ps.calls) == 1
+		}, time.Second, time.Millisecond)
+
+		go func() {
+			cancel()
+			assert.ErrorIs(t, <-leaderErr, context.Canceled)
+			close(release)
+		}()
+
+		waitCtx, waitCancel := context.WithTimeout(ctx, time.Second)
+		defer waitCancel()
+
+		products, err := c.GetProductsBySkus(waitCtx, []int64{1, 2})
+		require.NoError(t, err)
+		assert.Len(t, products, 2)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&ps.calls))
+	})
+}
+
+func TestProductCache_GetLastKnownProducts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	var unavailable atomic.Bool
+	c, _ := setupCache(t, func(sku int64) (*model.GetProductResponse, error) {
+		if unavailable.Load() {
+			return nil, model.ErrServiceUnavailable
+		}
+		if sku == 2 {
+			return nil, model.ErrProductNotFound
+		}
+		return &model.GetProductResponse{Sku: sku, Name: "item", Price: 100}, nil
+	})
+	now := time.Now()
+	c.now = func() time.Time { return now }
+
+	_, err := c.GetProductsBySkus(ctx, []int64{1, 2})
+	require.NoError(t, err)
+
+	now = now.Add(2 * time.Minute)
+	unavailable.Store(true)
+
+	_, err = c.GetProductsBySkus(ctx, []int64{1, 2})
+	require.ErrorIs(t, err, model.ErrServiceUnavailable)
+
+	products := c.GetLastKnownProducts([]int64{1, 2, 3})
+	assert.Equal(t, map[int64]*model.GetProductResponse{
+		1: {Sku: 1, Name: "item", Price: 100},
+	}, products)
+
+	now = now.Add(time.Hour)
+	c.cleanup()
+	assert.Empty(t, c.GetLastKnownProducts([]int64{1}))
+}
@@ -7,9 +7,14 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"sync"
 	"time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/errgroup"
 	retryclient "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/http/retry_client"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
 )
@@ -17,22 +22,36 @@ import (
 const (
 	// ProductServiceTimeOut ...
 	ProductServiceTimeOut = 10 * time.Second
+	// MaxListCount максимальный размер страницы GET /product
+	MaxListCount = 100
+	// MaxListGap при большем расстоянии между sku страница тянула бы слишком много лишних товаров,
+	// такие sku запрашиваются по одному
+	MaxListGap = 10
+	// countGoroutines сколько запросов в product-service выполняется параллельно
+	countGoroutines = 3
 )
 
 // ErrNotOk ...
 var ErrNotOk = errors.New("status not ok")
 
+// Limiter ...
+type Limiter interface {
+	Wait(ctx context.Context) error
+}
+
 // ProductService ...
 type ProductService struct {
 	httpClient retryclient.HTTPClientConfig
+	limiter    Limiter
 	token      string
 	address    string
 }
 
 // NewProductService ...
-func NewProductService(httpClient retryclient.HTTPClientConfig, token string, address string) *ProductService {
+func NewProductService(httpClient retryclient.HTTPClientConfig, limiter Limiter, token string, address string) *ProductService {
 	return &ProductService{
 		httpClient: httpClient,
+		limiter:    limiter,
 		token:      token,
 		address:    address,
 	}
@@ -40,41 +59,163 @@ func NewProductService(httpClient retryclient.HTTPClientConfig, token string, ad
 
 // GetProductBySku ...
 func (ps *ProductService) GetProductBySku(ctx context.Context, sku int64) (*model.GetProductResponse, error) {
+	resp := &model.GetProductResponse{}
+
+	err := ps.get(ctx, model.GetProductBySkuURL, fmt.Sprintf("%s/product/%d", ps.address, sku), resp)
+	if err != nil {
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// GetProductsBySkus возвращает товары по списку sku. Близкие sku забираются одной страницей
+// GET /product?count=&start_after_sku=, одиночные - через GET /product/{sku}, группы запрашиваются параллельно.
+// Несуществующих sku в ответе нет.
+func (ps *ProductService) GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*model.GetProductResponse, error) {
+	groups := groupSkus(uniqueSorted(skus))
+	products := make(map[int64]*model.GetProductResponse, len(skus))
+
+	var mx sync.Mutex
+	ch := make(chan []int64, len(groups))
+	g, gCtx := errgroup.WithContext(ctx)
+
+	for i := 0; i < min(countGoroutines, len(groups)); i++ {
+		g.Go(func() error {
+			for group := range ch {
+				found, err := ps.fetchGroup(gCtx, group)
+				if err != nil {
+					return err
+				}
+
+				mx.Lock()
+				for _, product := range found {
+					products[product.Sku] = product
+				}
+				mx.Unlock()
+			}
+			return nil
+		})
+	}
+
+	for _, group := range groups {
+		ch <- group
+	}
+	close(ch)
+
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	return products, nil
+}
+
+// fetchGroup диапазон группы не длиннее MaxListCount, поэтому помещается в одну страницу
+func (ps *ProductService) fetchGroup(ctx context.Context, group []int64) ([]*model.GetProductResponse, error) {
+	if len(group) == 1 {
+		product, err := ps.GetProductBySku(ctx, group[0])
+		if errors.Is(err, model.ErrProductNotFound) {
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		return []*model.GetProductResponse{product}, nil
+	}
+
+	first, last := group[0], group[len(group)-1]
+	page, err := ps.listProducts(ctx, first-1, int(last-first+1))
+	if err != nil {
+		return nil, err
+	}
+
+	found := make([]*model.GetProductResponse, 0, len(group))
+	for _, product := range page {
+		i := sort.Search(len(group), func(i int) bool {
+			return group[i] >= product.Sku
+		})
+		if i < len(group) && group[i] == product.Sku {
+			found = append(found, product)
+		}
+	}
+
+	return found, nil
+}
+
+// groupSkus разбивает отсортированные sku на группы: соседние sku в группе отстоят не больше
+// чем на MaxListGap, весь диапазон группы не длиннее MaxListCount
+func groupSkus(skus []int64) [][]int64 {
+	var groups [][]int64
+
+	for i, sku := range skus {
+		if i > 0 {
+			group := groups[len(groups)-1]
+			if sku-skus[i-1] <= MaxListGap && sku-group[0] < MaxListCount {
+				groups[len(groups)-1] = append(group, sku)
+				continue
+			}
+		}
+		groups = append(groups, []int64{sku})
+	}
+
+	return groups
+}
+
+// listProducts ...
+func (ps *ProductService) listProducts(ctx context.Context, startAfterSku int64, count int) ([]*model.GetProductResponse, error) {
+	query := url.Values{}
+	query.Set("count", strconv.Itoa(count))
+	query.Set("start_after_sku", strconv.FormatInt(startAfterSku, 10))
+
+	var page []*model.GetProductResponse
+	if err := ps.get(ctx, model.GetProductsURL, fmt.Sprintf("%s/product?%s", ps.address, query.Encode()), &page); err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// get выполняет GET запрос в product-service и декодирует ответ в resp
+func (ps *ProductService) get(ctx context.Context, handler string, reqURL string, resp any) error {
 	var (
 		response   *http.Response
 		statusCode int
 	)
 
+	if err := ps.limiter.Wait(ctx); err != nil {
+		return fmt.Errorf("limiter.Wait: %w", err)
+	}
+
 	ctx, cancel := context.WithTimeout(ctx, ProductServiceTimeOut)
 	defer cancel()
 
 	req, err := http.NewRequestWithContext(
 		ctx,
 		http.MethodGet,
-		fmt.Sprintf("%s/product/%d", ps.address, sku),
+		reqURL,
 		http.NoBody,
 	)
 	if err != nil {
-		return nil, model.ErrNoContent
+		return model.ErrNoContent
 	}
 
 	req.Header.Add("X-API-KEY", ps.token)
 
 	start := time.Now()
 	defer func() {
-		metrics.RequestDuration(model.GetProductBySkuURL, statusCode, model.TypeExternal, time.Since(start))
+		metrics.RequestDuration(handler, statusCode, model.TypeExternal, time.Since(start))
 	}()
 
 	doRequest := ps.httpClient.RetryMiddleware()
-	metrics.IncRequestCount(model.GetProductBySkuURL, model.TypeExternal)
+	metrics.IncRequestCount(handler, model.TypeExternal)
 	response, err = doRequest(req)
 	if err != nil {
 		if errors.Is(err, model.ErrManyRequest) {
 			statusCode = http.StatusTooManyRequests
-			return nil, model.ErrManyRequest
+			return model.ErrManyRequest
 		}
 		statusCode = http.StatusForbidden
-		return nil, err
+		return err
 	}
 
 	defer func() {
@@ -89,17 +230,34 @@ func (ps *ProductService) GetProductBySku(ctx context.Context, sku int64) (*mode
 	}
 
 	if response.StatusCode == http.StatusNotFound {
-		return nil, model.ErrProductNotFound
+		return model.ErrProductNotFound
 	}
 
 	if response.StatusCode != http.StatusOK {
-		return nil, ErrNotOk
+		return ErrNotOk
 	}
 
-	resp := &model.GetProductResponse{}
 	if err := json.NewDecoder(response.Body).Decode(resp); err != nil {
-		return nil, fmt.Errorf("json.NewDecoder: %w", err)
+		return fmt.Errorf("json.NewDecoder: %w", err)
 	}
 
-	return resp, nil
+	return nil
+}
+
+// uniqueSorted ...
+func uniqueSorted(skus []int64) []int64 {
+	sorted := make([]int64, len(skus))
+	copy(sorted, skus)
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i] < sorted[j]
+	})
+
+	unique := sorted[:0]
+	for i, sku := range sorted {
+		if i == 0 || sku != sorted[i-1] {
+			unique = append(unique, sku)
+		}
+	}
+
+	return unique
 }
@@ -0,0 +1,207 @@
+package productservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	retryclient "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/http/retry_client"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+)
+
+const testToken = "testToken"
+
+// fakeProductServer эмулирует product-service: GET /product/{sku} и GET /product?count=&start_after_sku=
+type fakeProductServer struct {
+	catalog []model.GetProductResponse
+	calls   int64
+	lists   int64
+}
+
+func (f *fakeProductServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	atomic.AddInt64(&f.calls, 1)
+
+	if r.Header.Get("X-API-KEY") != testToken {
+		w.WriteHeader(http.StatusUnauthorized)
+		return
+	}
+
+	if sku, ok := strings.CutPrefix(r.URL.Path, "/product/"); ok {
+		for _, product := range f.catalog {
+			if strconv.FormatInt(product.Sku, 10) == sku {
+				//nolint:errcheck
+				json.NewEncoder(w).Encode(product)
+				return
+			}
+		}
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+
+	atomic.AddInt64(&f.lists, 1)
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	startAfter, err := strconv.ParseInt(r.URL.Query().Get("start_after_sku"), 10, 64)
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	page := []model.GetProductResponse{}
+	for _, product := range f.catalog {
+		if product.Sku > startAfter && len(page) < count {
+			page = append(page, product)
+		}
+	}
+
+	//nolint:errcheck
+	json.NewEncoder(w).Encode(page)
+}
+
+func setupProductService(t *testing.T, skus ...int64) (*ProductService, *fakeProductServer) {
+	t.Helper()
+
+	sort.Slice(skus, func(i, j int) bool { return skus[i] < skus[j] })
+
+	fake := &fakeProductServer{}
+	for _, sku := range skus {
+		fake.catalog = append(fake.catalog, model.GetProductResponse{
+			Sku:   sku,
+			Name:  fmt.Sprintf("item %d", sku),
+			Price: sku * 10,
+		})
+	}
+
+	srv := httptest.NewServer(fake)
+	t.Cleanup(srv.Close)
+
+	ps := NewProductService(
+		*retryclient.NewRetryClient(srv.Client(), retryclient.Policy{MaxAttempts: 1}),
+		rate.NewLimiter(rate.Inf, 1),
+		testToken,
+		srv.URL,
+	)
+
+	return ps, fake
+}
+
+func TestProductService_GetProductBySku(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	ps, _ := setupProductService(t, 1, 2)
+
+	product, err := ps.GetProductBySku(ctx, 2)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetProductResponse{Sku: 2, Name: "item 2", Price: 20}, product)
+
+	_, err = ps.GetProductBySku(ctx, 3)
+	require.ErrorIs(t, err, model.ErrProductNotFound)
+}
+
+func TestProductService_GetProductsBySkus(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("consecutive skus in one call", func(t *testing.T) {
+		t.Parallel()
+		ps, fake := setupProductService(t, 1, 2, 3, 4, 5)
+
+		products, err := ps.GetProductsBySkus(ctx, []int64{4, 2, 3, 3})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]*model.GetProductResponse{
+			2: {Sku: 2, Name: "item 2", Price: 20},
+			3: {Sku: 3, Name: "item 3", Price: 30},
+			4: {Sku: 4, Name: "item 4", Price: 40},
+		}, products)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&fake.calls))
+	})
+
+	t.Run("gaps between skus", func(t *testing.T) {
+		t.Parallel()
+		ps, fake := setupProductService(t, 1, 2, 10, 20, 21, 30)
+
+		products, err := ps.GetProductsBySkus(ctx, []int64{1, 2, 21, 30})
+		require.NoError(t, err)
+		assert.Len(t, products, 4)
+		// 1,2,10,20 -> 21,30
+		assert.Equal(t, int64(2), atomic.LoadInt64(&fake.calls))
+	})
+
+	t.Run("missing skus are skipped", func(t *testing.T) {
+		t.Parallel()
+		ps, fake := setupProductService(t, 1, 5)
+
+		products, err := ps.GetProductsBySkus(ctx, []int64{1, 3, 7})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]*model.GetProductResponse{
+			1: {Sku: 1, Name: "item 1", Price: 10},
+		}, products)
+		// 1,5,- -> - (страница неполная)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&fake.calls))
+	})
+
+	t.Run("sparse skus are fetched one by one", func(t *testing.T) {
+		t.Parallel()
+		ps, fake := setupProductService(t, 100, 1000, 5000, 9000, 9001)
+
+		products, err := ps.GetProductsBySkus(ctx, []int64{9000, 100, 5000, 1000, 7777})
+		require.NoError(t, err)
+		assert.Equal(t, map[int64]*model.GetProductResponse{
+			100:  {Sku: 100, Name: "item 100", Price: 1000},
+			1000: {Sku: 1000, Name: "item 1000", Price: 10000},
+			5000: {Sku: 5000, Name: "item 5000", Price: 50000},
+			9000: {Sku: 9000, Name: "item 9000", Price: 90000},
+		}, products)
+		assert.Equal(t, int64(5), atomic.LoadInt64(&fake.calls))
+		assert.Equal(t, int64(0), atomic.LoadInt64(&fake.lists))
+	})
+
+	t.Run("close and sparse skus are mixed", func(t *testing.T) {
+		t.Parallel()
+		ps, fake := setupProductService(t, 1, 3, 5, 500, 1000)
+
+		products, err := ps.GetProductsBySkus(ctx, []int64{1, 3, 5, 500, 1000})
+		require.NoError(t, err)
+		assert.Len(t, products, 5)
+		// 1,3,5 -> 500 -> 1000
+		assert.Equal(t, int64(3), atomic.LoadInt64(&fake.calls))
+		assert.Equal(t, int64(1), atomic.LoadInt64(&fake.lists))
+	})
+
+	t.Run("large batch is paged", func(t *testing.T) {
+		t.Parallel()
+		skus := make([]int64, 0, MaxListCount+10)
+		for sku := int64(1); sku <= MaxListCount+10; sku++ {
+			skus = append(skus, sku)
+		}
+		ps, fake := setupProductService(t, skus...)
+
+		products, err := ps.GetProductsBySkus(ctx, skus)
+		require.NoError(t, err)
+		assert.Len(t, products, len(skus))
+		assert.Equal(t, int64(2), atomic.LoadInt64(&fake.calls))
+	})
+
+	t.Run("err status not ok", func(t *testing.T) {
+		t.Parallel()
+		ps, _ := setupProductService(t, 1)
+		ps.token = "wrong"
+
+		_, err := ps.GetProductsBySkus(ctx, []int64{1})
+		require.ErrorIs(t, err, ErrNotOk)
+	})
+}
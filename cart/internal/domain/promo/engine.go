@@ -0,0 +1,197 @@
+// Package promo ...
+package promo
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	// TypePercentOff процент от суммы корзины
+	TypePercentOff = "percent_off"
+	// TypeFixedOff фиксированная сумма от корзины
+	TypeFixedOff = "fixed_off"
+	// TypeBuyNGetM при покупке Buy штук товара еще Get штук бесплатно
+	TypeBuyNGetM = "buy_n_get_m"
+	// TypeSkuPercentOff процент от стоимости отдельных товаров
+	TypeSkuPercentOff = "sku_percent_off"
+)
+
+// Rule правило промокода. Промокод применяется, только если сумма корзины не меньше MinTotal
+type Rule struct {
+	Code    string `yaml:"code"`
+	Type    string `yaml:"type"`
+	Percent uint64 `yaml:"percent"`
+	Amount  uint64 `yaml:"amount"`
+	Buy     uint32 `yaml:"buy"`
+	Get     uint32 `yaml:"get"`
+	// Skus товары, на которые действует правило, для buy_n_get_m пустой список - все товары
+	Skus     []int64 `yaml:"skus"`
+	MinTotal uint64  `yaml:"min_total"`
+}
+
+// Engine считает скидки по промокодам
+type Engine struct {
+	rules map[string]Rule
+}
+
+// LoadRules читает правила из yaml-файла со списком promo_codes, пустой путь - промокодов нет
+func LoadRules(path string) (*Engine, error) {
+	if path == "" {
+		return NewEngine(nil)
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("ReadFile: %w", err)
+	}
+
+	var file struct {
+		PromoCodes []Rule `yaml:"promo_codes"`
+	}
+	if err := yaml.Unmarshal(b, &file); err != nil {
+		return nil, fmt.Errorf("yaml.Unmarshal: %w", err)
+	}
+
+	return NewEngine(file.PromoCodes)
+}
+
+// NewEngine ...
+func NewEngine(rules []Rule) (*Engine, error) {
+	e := &Engine{rules: make(map[string]Rule, len(rules))}
+
+	for _, rule := range rules {
+		rule.Code = model.NormalizePromoCode(rule.Code)
+		if err := validateRule(rule); err != nil {
+			return nil, fmt.Errorf("promo code %q: %w", rule.Code, err)
+		}
+		if _, ok := e.rules[rule.Code]; ok {
+			return nil, fmt.Errorf("promo code %q: duplicate", rule.Code)
+		}
+		e.rules[rule.Code] = rule
+	}
+
+	return e, nil
+}
+
+// Apply строки скидки по промокоду для позиций корзины с суммой subtotal.
+// Суммарная скидка не больше subtotal
+func (e *Engine) Apply(code string, items []model.Item, subtotal uint64) ([]model.DiscountLine, error) {
+	rule, ok := e.rules[model.NormalizePromoCode(code)]
+	if !ok {
+		return nil, model.ErrPromoCodeNotFound
+	}
+
+	if subtotal < rule.MinTotal {
+		return nil, fmt.Errorf("%w: минимальная сумма корзины %d", model.ErrPromoNotApplicable, rule.MinTotal)
+	}
+
+	var lines []model.DiscountLine
+
+	switch rule.Type {
+	case TypePercentOff:
+		lines = append(lines, model.DiscountLine{
+			Code:        rule.Code,
+			Description: fmt.Sprintf("скидка %d%% на корзину", rule.Percent),
+			Amount:      subtotal * rule.Percent / 100,
+		})
+	case TypeFixedOff:
+		lines = append(lines, model.DiscountLine{
+			Code:        rule.Code,
+			Description: fmt.Sprintf("скидка %d на корзину", rule.Amount),
+			Amount:      rule.Amount,
+		})
+	case TypeBuyNGetM:
+		for _, item := range items {
+			if len(rule.Skus) > 0 && !rule.hasSku(item.Sku) {
+				continue
+			}
+			free := uint64(item.Count/(rule.Buy+rule.Get)) * uint64(rule.Get)
+			if free == 0 {
+				continue
+			}
+			lines = append(lines, model.DiscountLine{
+				Code:        rule.Code,
+				Sku:         item.Sku,
+				Description: fmt.Sprintf("%d+%d: %d шт. бесплатно", rule.Buy, rule.Get, free),
+				Amount:      free * uint64(item.Price),
+			})
+		}
+	case TypeSkuPercentOff:
+		for _, item := range items {
+			if !rule.hasSku(item.Sku) {
+				continue
+			}
+			lines = append(lines, model.DiscountLine{
+				Code:        rule.Code,
+				Sku:         item.Sku,
+				Description: fmt.Sprintf("скидка %d%% на товар", rule.Percent),
+				Amount:      uint64(item.Price) * uint64(item.Count) * rule.Percent / 100,
+			})
+		}
+	}
+
+	if len(lines) == 0 {
+		return nil, fmt.Errorf("%w: в корзине нет товаров, на которые действует промокод", model.ErrPromoNotApplicable)
+	}
+
+	capDiscount(lines, subtotal)
+
+	return lines, nil
+}
+
+// capDiscount урезает скидку так, чтобы итог не стал отрицательным
+func capDiscount(lines []model.DiscountLine, subtotal uint64) {
+	left := subtotal
+	for i := range lines {
+		lines[i].Amount = min(lines[i].Amount, left)
+		left -= lines[i].Amount
+	}
+}
+
+// hasSku ...
+func (r Rule) hasSku(sku int64) bool {
+	for _, s := range r.Skus {
+		if s == sku {
+			return true
+		}
+	}
+
+	return false
+}
+
+// validateRule ...
+func validateRule(rule Rule) error {
+	if rule.Code == "" {
+		return fmt.Errorf("code is empty")
+	}
+
+	switch rule.Type {
+	case TypePercentOff:
+		if rule.Percent < 1 || rule.Percent > 100 {
+			return fmt.Errorf("percent must be between 1 and 100")
+		}
+	case TypeFixedOff:
+		if rule.Amount < 1 {
+			return fmt.Errorf("amount must be positive")
+		}
+	case TypeBuyNGetM:
+		if rule.Buy < 1 || rule.Get < 1 {
+			return fmt.Errorf("buy and get must be positive")
+		}
+	case TypeSkuPercentOff:
+		if rule.Percent < 1 || rule.Percent > 100 {
+			return fmt.Errorf("percent must be between 1 and 100")
+		}
+		if len(rule.Skus) == 0 {
+			return fmt.Errorf("skus are empty")
+		}
+	default:
+		return fmt.Errorf("unknown type %q", rule.Type)
+	}
+
+	return nil
+}
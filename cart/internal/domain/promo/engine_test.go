@@ -0,0 +1,183 @@
+package promo
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEngine_Apply(t *testing.T) {
+	t.Parallel()
+
+	engine, err := NewEngine([]Rule{
+		{Code: "percent10", Type: TypePercentOff, Percent: 10},
+		{Code: "MINUS500", Type: TypeFixedOff, Amount: 500, MinTotal: 1000},
+		{Code: "MINUS5000", Type: TypeFixedOff, Amount: 5000},
+		{Code: "TWOPLUSONE", Type: TypeBuyNGetM, Buy: 2, Get: 1},
+		{Code: "SKU20", Type: TypeSkuPercentOff, Percent: 20, Skus: []int64{2}},
+	})
+	require.NoError(t, err)
+
+	items := []model.Item{
+		{Sku: 1, Count: 3, Price: 100},
+		{Sku: 2, Count: 2, Price: 1000},
+	}
+	const subtotal = uint64(2300)
+
+	tests := []struct {
+		name        string
+		code        string
+		items       []model.Item
+		subtotal    uint64
+		expected    []model.DiscountLine
+		expectedErr error
+	}{
+		{
+			name:     "percent off, code is case insensitive",
+			code:     " Percent10 ",
+			items:    items,
+			subtotal: subtotal,
+			expected: []model.DiscountLine{
+				{Code: "PERCENT10", Description: "скидка 10% на корзину", Amount: 230},
+			},
+		},
+		{
+			name:     "fixed off",
+			code:     "MINUS500",
+			items:    items,
+			subtotal: subtotal,
+			expected: []model.DiscountLine{
+				{Code: "MINUS500", Description: "скидка 500 на корзину", Amount: 500},
+			},
+		},
+		{
+			name:     "fixed off is capped by subtotal",
+			code:     "MINUS5000",
+			items:    items,
+			subtotal: subtotal,
+			expected: []model.DiscountLine{
+				{Code: "MINUS5000", Description: "скидка 5000 на корзину", Amount: subtotal},
+			},
+		},
+		{
+			name:     "buy n get m",
+			code:     "TWOPLUSONE",
+			items:    items,
+			subtotal: subtotal,
+			expected: []model.DiscountLine{
+				{Code: "TWOPLUSONE", Sku: 1, Description: "2+1: 1 шт. бесплатно", Amount: 100},
+			},
+		},
+		{
+			name:     "sku percent off",
+			code:     "SKU20",
+			items:    items,
+			subtotal: subtotal,
+			expected: []model.DiscountLine{
+				{Code: "SKU20", Sku: 2, Description: "скидка 20% на товар", Amount: 400},
+			},
+		},
+		{
+			name:        "err unknown code",
+			code:        "UNKNOWN",
+			items:       items,
+			subtotal:    subtotal,
+			expectedErr: model.ErrPromoCodeNotFound,
+		},
+		{
+			name:        "err min total",
+			code:        "MINUS500",
+			items:       items[:1],
+			subtotal:    300,
+			expectedErr: model.ErrPromoNotApplicable,
+		},
+		{
+			name:        "err no matching items",
+			code:        "SKU20",
+			items:       items[:1],
+			subtotal:    300,
+			expectedErr: model.ErrPromoNotApplicable,
+		},
+		{
+			name:        "err not enough items for buy n get m",
+			code:        "TWOPLUSONE",
+			items:       items[1:],
+			subtotal:    2000,
+			expectedErr: model.ErrPromoNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute
+			lines, err := engine.Apply(tt.code, tt.items, tt.subtotal)
+
+			// Verify
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, lines)
+		})
+	}
+}
+
+func TestNewEngine(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		rules []Rule
+	}{
+		{name: "empty code", rules: []Rule{{Type: TypeFixedOff, Amount: 1}}},
+		{name: "unknown type", rules: []Rule{{Code: "A", Type: "gift"}}},
+		{name: "percent out of range", rules: []Rule{{Code: "A", Type: TypePercentOff, Percent: 101}}},
+		{name: "buy n get m without get", rules: []Rule{{Code: "A", Type: TypeBuyNGetM, Buy: 2}}},
+		{name: "sku percent off without skus", rules: []Rule{{Code: "A", Type: TypeSkuPercentOff, Percent: 5}}},
+		{name: "duplicate", rules: []Rule{
+			{Code: "a", Type: TypeFixedOff, Amount: 1},
+			{Code: "A", Type: TypeFixedOff, Amount: 2},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewEngine(tt.rules)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "promo.yaml")
+	err := os.WriteFile(path, []byte(`promo_codes:
+  - code: welcome10
+    type: percent_off
+    percent: 10
+`), 0o600)
+	require.NoError(t, err)
+
+	engine, err := LoadRules(path)
+	require.NoError(t, err)
+
+	lines, err := engine.Apply("WELCOME10", nil, 1000)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(100), lines[0].Amount)
+
+	engine, err = LoadRules("")
+	require.NoError(t, err)
+
+	_, err = engine.Apply("WELCOME10", nil, 1000)
+	require.ErrorIs(t, err, model.ErrPromoCodeNotFound)
+}
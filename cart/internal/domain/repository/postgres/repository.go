@@ -0,0 +1,619 @@
+// Package postgres ...
+package postgres
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// timeUpdateMetricRepoSize ...
+	timeUpdateMetricRepoSize = 10
+)
+
+// Repository ...
+type Repository struct {
+	pool   *pgxpool.Pool
+	done   chan struct{}
+	tracer service.Tracer
+}
+
+// NewRepository ...
+func NewRepository(pool *pgxpool.Pool, tracer service.Tracer) *Repository {
+	repo := Repository{
+		pool:   pool,
+		done:   make(chan struct{}),
+		tracer: tracer,
+	}
+
+	go func() {
+		t := time.NewTicker(timeUpdateMetricRepoSize * time.Second)
+		for {
+			select {
+			case <-t.C:
+				repo.storeRepoSize()
+				repo.deleteExpiredIdempotencyKeys()
+			case <-repo.done:
+				t.Stop()
+				return
+			}
+		}
+	}()
+
+	return &repo
+}
+
+// Add ...
+func (r *Repository) Add(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:Add")
+	defer span.End()
+
+	// новая позиция начинает новую корзину, отметка об оформлении больше не актуальна
+	const query = `WITH cleared AS (DELETE FROM cart_checkouts WHERE user_id = $1)
+				   INSERT INTO cart_items (user_id, sku, count, price)
+				   VALUES ($1, $2, $3, $4)
+				   ON CONFLICT (user_id, sku)
+				   DO UPDATE SET count = LEAST(cart_items.count + EXCLUDED.count, 4294967295), price = EXCLUDED.price, updated_at = now();`
+
+	if _, err := r.pool.Exec(ctx, query,
+		cartItems.UserID, cartItems.Sku, int64(cartItems.Count), int64(cartItems.Price)); err != nil {
+		return fmt.Errorf("Add Exec: %w", err)
+	}
+
+	return nil
+}
+
+// SetCount ...
+func (r *Repository) SetCount(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:SetCount")
+	defer span.End()
+
+	if cartItems.Count == 0 {
+		const query = `DELETE FROM cart_items WHERE user_id = $1 AND sku = $2;`
+
+		if _, err := r.pool.Exec(ctx, query, cartItems.UserID, cartItems.Sku); err != nil {
+			return fmt.Errorf("SetCount Exec: %w", err)
+		}
+
+		return nil
+	}
+
+	const query = `UPDATE cart_items SET count = $3, updated_at = now() WHERE user_id = $1 AND sku = $2;`
+
+	tag, err := r.pool.Exec(ctx, query, cartItems.UserID, cartItems.Sku, int64(cartItems.Count))
+	if err != nil {
+		return fmt.Errorf("SetCount Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetItemsByUserID ...
+func (r *Repository) GetItemsByUserID(ctx context.Context, cartItems model.RequestData) ([]model.Cart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetItemsByUserID")
+	defer span.End()
+
+	const query = `SELECT sku, count, price, updated_at FROM cart_items WHERE user_id = $1 ORDER BY created_at, sku;`
+
+	rows, err := r.pool.Query(ctx, query, cartItems.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("GetItemsByUserID Query: %w", err)
+	}
+	defer rows.Close()
+
+	var items []model.Cart
+	for rows.Next() {
+		var item model.Cart
+		if err := rows.Scan(&item.SkuID, &item.Count, &item.Price, &item.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("GetItemsByUserID Scan: %w", err)
+		}
+		items = append(items, item)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("GetItemsByUserID Err: %w", err)
+	}
+
+	if len(items) < 1 {
+		return nil, model.ErrNotFound
+	}
+
+	return items, nil
+}
+
+// DeleteItemsBySku ...
+func (r *Repository) DeleteItemsBySku(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:DeleteItemsBySku")
+	defer span.End()
+
+	const query = `DELETE FROM cart_items WHERE user_id = $1 AND sku = $2;`
+
+	if _, err := r.pool.Exec(ctx, query, cartItems.UserID, cartItems.Sku); err != nil {
+		return fmt.Errorf("DeleteItemsBySku Exec: %w", err)
+	}
+
+	return model.ErrNoContent
+}
+
+// DeleteAllItemsFromCart ...
+func (r *Repository) DeleteAllItemsFromCart(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:DeleteAllItemsFromCart")
+	defer span.End()
+
+	const query = `DELETE FROM cart_items WHERE user_id = $1;`
+
+	if _, err := r.pool.Exec(ctx, query, cartItems.UserID); err != nil {
+		return fmt.Errorf("DeleteAllItemsFromCart Exec: %w", err)
+	}
+
+	return model.ErrNoContent
+}
+
+// Checkout в одной транзакции удаляет позиции корзины и запоминает заказ.
+// Если удаленные позиции не совпадают с оформленными, транзакция откатывается.
+func (r *Repository) Checkout(ctx context.Context, userID int64, items []model.Cart, orderID int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:Checkout")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("Checkout Begin: %w", err)
+	}
+	//nolint:errcheck
+	defer tx.Rollback(ctx)
+
+	const deleteQuery = `DELETE FROM cart_items WHERE user_id = $1 RETURNING sku, count;`
+
+	rows, err := tx.Query(ctx, deleteQuery, userID)
+	if err != nil {
+		return fmt.Errorf("Checkout Query: %w", err)
+	}
+
+	deleted, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Cart, error) {
+		var item model.Cart
+		err := row.Scan(&item.SkuID, &item.Count)
+		return item, err
+	})
+	if err != nil {
+		return fmt.Errorf("Checkout CollectRows: %w", err)
+	}
+
+	if !model.SameItems(deleted, items) {
+		return model.ErrCartChanged
+	}
+
+	const checkoutQuery = `INSERT INTO cart_checkouts (user_id, order_id)
+						   VALUES ($1, $2)
+						   ON CONFLICT (user_id)
+						   DO UPDATE SET order_id = EXCLUDED.order_id, checked_out_at = now();`
+
+	if _, err = tx.Exec(ctx, checkoutQuery, userID, orderID); err != nil {
+		return fmt.Errorf("Checkout Exec: %w", err)
+	}
+
+	const promoQuery = `DELETE FROM cart_promo_codes WHERE user_id = $1;`
+
+	if _, err = tx.Exec(ctx, promoQuery, userID); err != nil {
+		return fmt.Errorf("Checkout Exec: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return fmt.Errorf("Checkout Commit: %w", err)
+	}
+
+	return nil
+}
+
+// Merge в одной транзакции удаляет исходную корзину и переносит ее позиции в целевую.
+// Если удаленные позиции не совпадают с source, транзакция откатывается.
+// Количество ограничивается остатком из available и лимитами корзины.
+func (r *Repository) Merge(ctx context.Context, targetUserID, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) ([]model.MergedLine, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:Merge")
+	defer span.End()
+
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("Merge Begin: %w", err)
+	}
+	//nolint:errcheck
+	defer tx.Rollback(ctx)
+
+	const deleteQuery = `DELETE FROM cart_items WHERE user_id = $1 RETURNING sku, count, price;`
+
+	rows, err := tx.Query(ctx, deleteQuery, sourceUserID)
+	if err != nil {
+		return nil, fmt.Errorf("Merge Query: %w", err)
+	}
+
+	deleted, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Cart, error) {
+		var item model.Cart
+		err := row.Scan(&item.SkuID, &item.Count, &item.Price)
+		return item, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Merge CollectRows: %w", err)
+	}
+
+	if !model.SameItems(deleted, source) {
+		return nil, model.ErrCartChanged
+	}
+
+	const targetQuery = `SELECT sku, count, price FROM cart_items WHERE user_id = $1 FOR UPDATE;`
+
+	rows, err = tx.Query(ctx, targetQuery, targetUserID)
+	if err != nil {
+		return nil, fmt.Errorf("Merge Query: %w", err)
+	}
+
+	target, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Cart, error) {
+		var item model.Cart
+		err := row.Scan(&item.SkuID, &item.Count, &item.Price)
+		return item, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Merge CollectRows: %w", err)
+	}
+
+	// у позиции, которая уже была в целевой корзине, сохраняется ее цена
+	const upsertQuery = `INSERT INTO cart_items (user_id, sku, count, price)
+						 VALUES ($1, $2, $3, $4)
+						 ON CONFLICT (user_id, sku)
+						 DO UPDATE SET count = EXCLUDED.count, updated_at = now();`
+
+	lines := model.MergeLines(target, deleted, available, limits)
+	for i, item := range deleted {
+		merged := lines[i].Merged
+		if merged == 0 {
+			continue
+		}
+
+		if _, err = tx.Exec(ctx, upsertQuery, targetUserID, item.SkuID, int64(merged), int64(item.Price)); err != nil {
+			return nil, fmt.Errorf("Merge Exec: %w", err)
+		}
+	}
+
+	const clearCheckoutQuery = `DELETE FROM cart_checkouts WHERE user_id = $1;`
+
+	if _, err = tx.Exec(ctx, clearCheckoutQuery, targetUserID); err != nil {
+		return nil, fmt.Errorf("Merge Exec: %w", err)
+	}
+
+	if err = tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("Merge Commit: %w", err)
+	}
+
+	return lines, nil
+}
+
+// GetAbandonedCarts корзины, которые не менялись с before и о которых еще не отправлено событие, самые старые первыми
+func (r *Repository) GetAbandonedCarts(ctx context.Context, before time.Time, limit int) ([]model.AbandonedCart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetAbandonedCarts")
+	defer span.End()
+
+	// время изменения корзины обновляет триггер bump_cart_version, в том числе при удалении позиций
+	const query = `SELECT v.user_id, v.updated_at
+				   FROM cart_versions v
+				   LEFT JOIN cart_abandoned a ON a.user_id = v.user_id
+				   WHERE v.updated_at < $1
+				     AND (a.cart_updated_at IS NULL OR a.cart_updated_at < v.updated_at)
+				     AND EXISTS (SELECT 1 FROM cart_items i WHERE i.user_id = v.user_id)
+				   ORDER BY v.updated_at
+				   LIMIT $2;`
+
+	rows, err := r.pool.Query(ctx, query, before, limit)
+	if err != nil {
+		return nil, fmt.Errorf("GetAbandonedCarts Query: %w", err)
+	}
+
+	carts, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.AbandonedCart, error) {
+		var cart model.AbandonedCart
+		err := row.Scan(&cart.UserID, &cart.UpdatedAt)
+		return cart, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetAbandonedCarts CollectRows: %w", err)
+	}
+
+	return carts, nil
+}
+
+// MarkAbandonedReported запоминает, что о корзине с изменением updatedAt событие уже отправлено
+func (r *Repository) MarkAbandonedReported(ctx context.Context, userID int64, updatedAt time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:MarkAbandonedReported")
+	defer span.End()
+
+	const query = `INSERT INTO cart_abandoned (user_id, cart_updated_at)
+				   VALUES ($1, $2)
+				   ON CONFLICT (user_id)
+				   DO UPDATE SET cart_updated_at = EXCLUDED.cart_updated_at, reported_at = now();`
+
+	if _, err := r.pool.Exec(ctx, query, userID, updatedAt); err != nil {
+		return fmt.Errorf("MarkAbandonedReported Exec: %w", err)
+	}
+
+	return nil
+}
+
+// SetPromoCode пустой code убирает промокод
+func (r *Repository) SetPromoCode(ctx context.Context, userID int64, code string) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:SetPromoCode")
+	defer span.End()
+
+	if code == "" {
+		const query = `DELETE FROM cart_promo_codes WHERE user_id = $1;`
+
+		if _, err := r.pool.Exec(ctx, query, userID); err != nil {
+			return fmt.Errorf("SetPromoCode Exec: %w", err)
+		}
+
+		return nil
+	}
+
+	const query = `INSERT INTO cart_promo_codes (user_id, code)
+				   VALUES ($1, $2)
+				   ON CONFLICT (user_id)
+				   DO UPDATE SET code = EXCLUDED.code, applied_at = now();`
+
+	if _, err := r.pool.Exec(ctx, query, userID, code); err != nil {
+		return fmt.Errorf("SetPromoCode Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetPromoCode пустая строка - промокод не применен
+func (r *Repository) GetPromoCode(ctx context.Context, userID int64) (string, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetPromoCode")
+	defer span.End()
+
+	const query = `SELECT code FROM cart_promo_codes WHERE user_id = $1;`
+
+	var code string
+	if err := r.pool.QueryRow(ctx, query, userID).Scan(&code); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return "", nil
+		}
+		return "", fmt.Errorf("GetPromoCode Scan: %w", err)
+	}
+
+	return code, nil
+}
+
+// SaveForLater переносит позицию из корзины в отложенные, количество суммируется с уже отложенным
+func (r *Repository) SaveForLater(ctx context.Context, userID, sku int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:SaveForLater")
+	defer span.End()
+
+	const query = `WITH moved AS (DELETE FROM cart_items WHERE user_id = $1 AND sku = $2 RETURNING user_id, sku, count, price)
+				   INSERT INTO saved_items (user_id, sku, count, price)
+				   SELECT user_id, sku, count, price FROM moved
+				   ON CONFLICT (user_id, sku)
+				   DO UPDATE SET count = LEAST(saved_items.count + EXCLUDED.count, 4294967295), price = EXCLUDED.price, updated_at = now();`
+
+	tag, err := r.pool.Exec(ctx, query, userID, sku)
+	if err != nil {
+		return fmt.Errorf("SaveForLater Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	return nil
+}
+
+// MoveToCart переносит отложенную позицию в корзину по цене item.Price
+func (r *Repository) MoveToCart(ctx context.Context, item model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:MoveToCart")
+	defer span.End()
+
+	const query = `WITH moved AS (DELETE FROM saved_items WHERE user_id = $1 AND sku = $2 RETURNING user_id, sku, count),
+				   cleared AS (DELETE FROM cart_checkouts WHERE user_id IN (SELECT user_id FROM moved))
+				   INSERT INTO cart_items (user_id, sku, count, price)
+				   SELECT user_id, sku, count, $3 FROM moved
+				   ON CONFLICT (user_id, sku)
+				   DO UPDATE SET count = LEAST(cart_items.count + EXCLUDED.count, 4294967295), price = EXCLUDED.price, updated_at = now();`
+
+	tag, err := r.pool.Exec(ctx, query, item.UserID, item.Sku, int64(item.Price))
+	if err != nil {
+		return fmt.Errorf("MoveToCart Exec: %w", err)
+	}
+
+	if tag.RowsAffected() == 0 {
+		return model.ErrNotFound
+	}
+
+	return nil
+}
+
+// GetSavedItems ...
+func (r *Repository) GetSavedItems(ctx context.Context, userID int64) ([]model.Cart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetSavedItems")
+	defer span.End()
+
+	const query = `SELECT sku, count, price FROM saved_items WHERE user_id = $1 ORDER BY created_at, sku;`
+
+	rows, err := r.pool.Query(ctx, query, userID)
+	if err != nil {
+		return nil, fmt.Errorf("GetSavedItems Query: %w", err)
+	}
+
+	items, err := pgx.CollectRows(rows, func(row pgx.CollectableRow) (model.Cart, error) {
+		var item model.Cart
+		err := row.Scan(&item.SkuID, &item.Count, &item.Price)
+		return item, err
+	})
+	if err != nil {
+		return nil, fmt.Errorf("GetSavedItems CollectRows: %w", err)
+	}
+
+	if len(items) < 1 {
+		return nil, model.ErrNotFound
+	}
+
+	return items, nil
+}
+
+// DeleteSavedItem ...
+func (r *Repository) DeleteSavedItem(ctx context.Context, userID, sku int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:DeleteSavedItem")
+	defer span.End()
+
+	const query = `DELETE FROM saved_items WHERE user_id = $1 AND sku = $2;`
+
+	if _, err := r.pool.Exec(ctx, query, userID, sku); err != nil {
+		return fmt.Errorf("DeleteSavedItem Exec: %w", err)
+	}
+
+	return nil
+}
+
+// GetCheckoutOrderID ...
+func (r *Repository) GetCheckoutOrderID(ctx context.Context, userID int64) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetCheckoutOrderID")
+	defer span.End()
+
+	const query = `SELECT order_id FROM cart_checkouts WHERE user_id = $1;`
+
+	var orderID int64
+	if err := r.pool.QueryRow(ctx, query, userID).Scan(&orderID); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, model.ErrNotFound
+		}
+		return 0, fmt.Errorf("GetCheckoutOrderID Scan: %w", err)
+	}
+
+	return orderID, nil
+}
+
+// GetVersion версию увеличивают триггеры на cart_items, cart_promo_codes и cart_checkouts
+func (r *Repository) GetVersion(ctx context.Context, userID int64) (uint64, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetVersion")
+	defer span.End()
+
+	const query = `SELECT version FROM cart_versions WHERE user_id = $1;`
+
+	var version int64
+	if err := r.pool.QueryRow(ctx, query, userID).Scan(&version); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("GetVersion Scan: %w", err)
+	}
+
+	// nolint:gosec
+	return uint64(version), nil
+}
+
+// ReserveIdempotencyKey занимает ключ на ttl. Если ключ уже занят, возвращает его запись и false.
+// Запись с истекшим сроком занимается заново
+func (r *Repository) ReserveIdempotencyKey(ctx context.Context, userID int64, key string, ttl time.Duration) (model.IdempotencyRecord, bool, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:ReserveIdempotencyKey")
+	defer span.End()
+
+	const reserveQuery = `INSERT INTO cart_idempotency_keys (user_id, key, order_id, expires_at)
+						  VALUES ($1, $2, 0, now() + $3 * interval '1 millisecond')
+						  ON CONFLICT (user_id, key)
+						  DO UPDATE SET order_id = 0, fingerprint = '', expires_at = EXCLUDED.expires_at
+						  WHERE cart_idempotency_keys.expires_at <= now()
+						  RETURNING order_id;`
+
+	var orderID int64
+	err := r.pool.QueryRow(ctx, reserveQuery, userID, key, ttl.Milliseconds()).Scan(&orderID)
+	if err == nil {
+		return model.IdempotencyRecord{}, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("ReserveIdempotencyKey Scan: %w", err)
+	}
+
+	const selectQuery = `SELECT order_id, fingerprint FROM cart_idempotency_keys
+						 WHERE user_id = $1 AND key = $2 AND expires_at > now();`
+
+	// ключ могли освободить между запросами, тогда вызывающий попробует занять его снова
+	var record model.IdempotencyRecord
+	if err := r.pool.QueryRow(ctx, selectQuery, userID, key).Scan(&record.OrderID, &record.Fingerprint); err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("ReserveIdempotencyKey Scan: %w", err)
+	}
+
+	return record, false, nil
+}
+
+// CompleteIdempotencyKey сохраняет заказ, созданный по ключу, и отпечаток корзины на ttl
+func (r *Repository) CompleteIdempotencyKey(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:CompleteIdempotencyKey")
+	defer span.End()
+
+	const query = `INSERT INTO cart_idempotency_keys (user_id, key, order_id, fingerprint, expires_at)
+				   VALUES ($1, $2, $3, $4, now() + $5 * interval '1 millisecond')
+				   ON CONFLICT (user_id, key)
+				   DO UPDATE SET order_id = EXCLUDED.order_id, fingerprint = EXCLUDED.fingerprint, expires_at = EXCLUDED.expires_at;`
+
+	if _, err := r.pool.Exec(ctx, query, userID, key, orderID, fingerprint, ttl.Milliseconds()); err != nil {
+		return fmt.Errorf("CompleteIdempotencyKey Exec: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ, по которому заказ не был создан
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:ReleaseIdempotencyKey")
+	defer span.End()
+
+	const query = `DELETE FROM cart_idempotency_keys WHERE user_id = $1 AND key = $2 AND order_id = 0;`
+
+	if _, err := r.pool.Exec(ctx, query, userID, key); err != nil {
+		return fmt.Errorf("ReleaseIdempotencyKey Exec: %w", err)
+	}
+
+	return nil
+}
+
+// Close ...
+func (r *Repository) Close() {
+	r.done <- struct{}{}
+	close(r.done)
+	r.pool.Close()
+}
+
+// storeRepoSize обновляет метрику количества корзин
+func (r *Repository) storeRepoSize() {
+	ctx, cancel := context.WithTimeout(context.Background(), timeUpdateMetricRepoSize*time.Second)
+	defer cancel()
+
+	const query = `SELECT count(DISTINCT user_id) FROM cart_items;`
+
+	var size int64
+	if err := r.pool.QueryRow(ctx, query).Scan(&size); err != nil {
+		logger.Errorw(fmt.Sprintf("storeRepoSize Scan: %v", err))
+		return
+	}
+
+	metrics.StoreRepoSize(float64(size))
+}
+
+// deleteExpiredIdempotencyKeys ...
+func (r *Repository) deleteExpiredIdempotencyKeys() {
+	ctx, cancel := context.WithTimeout(context.Background(), timeUpdateMetricRepoSize*time.Second)
+	defer cancel()
+
+	const query = `DELETE FROM cart_idempotency_keys WHERE expires_at <= now();`
+
+	if _, err := r.pool.Exec(ctx, query); err != nil {
+		logger.Errorw(fmt.Sprintf("deleteExpiredIdempotencyKeys Exec: %v", err))
+	}
+}
@@ -0,0 +1,909 @@
+// Package redis ...
+package redis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	goredis "github.com/redis/go-redis/v9"
+)
+
+const (
+	// timeUpdateMetricRepoSize ...
+	timeUpdateMetricRepoSize = 10
+	// cartKeyPrefix ...
+	cartKeyPrefix = "cart:"
+	// priceKeyPrefix ...
+	priceKeyPrefix = "cart_price:"
+	// savedKeyPrefix ...
+	savedKeyPrefix = "saved:"
+	// savedPriceKeyPrefix ...
+	savedPriceKeyPrefix = "saved_price:"
+	// promoKeyPrefix ...
+	promoKeyPrefix = "cart_promo:"
+	// checkoutKeyPrefix ...
+	checkoutKeyPrefix = "checkout:"
+	// versionKeyPrefix ...
+	versionKeyPrefix = "cart_version:"
+	// idempotencyKeyPrefix ...
+	idempotencyKeyPrefix = "idempotency:"
+	// updatedKey sorted set, member - user_id, score - время последнего изменения корзины в мс
+	updatedKey = "cart_updated"
+	// scanCount ...
+	scanCount = 1000
+)
+
+// setCountScript меняет количество только у существующей позиции и продлевает TTL корзины и ее цен
+var setCountScript = goredis.NewScript(`
+if redis.call('HEXISTS', KEYS[1], ARGV[1]) == 0 then
+	return 0
+end
+redis.call('HSET', KEYS[1], ARGV[1], ARGV[2])
+if tonumber(ARGV[3]) > 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[3])
+	redis.call('PEXPIRE', KEYS[2], ARGV[3])
+end
+return 1
+`)
+
+// releaseIdempotencyScript удаляет ключ, только если заказ по нему еще не сохранен
+var releaseIdempotencyScript = goredis.NewScript(`
+if redis.call('GET', KEYS[1]) == '0' then
+	return redis.call('DEL', KEYS[1])
+end
+return 0
+`)
+
+// checkoutScript удаляет корзину вместе с ценами и промокодом и запоминает заказ,
+// только если содержимое корзины совпадает с оформленным.
+// ARGV: order_id, ttl в мс, затем пары sku, count
+var checkoutScript = goredis.NewScript(`
+if redis.call('HLEN', KEYS[1]) ~= (#ARGV - 2) / 2 then
+	return 0
+end
+for i = 3, #ARGV, 2 do
+	if redis.call('HGET', KEYS[1], ARGV[i]) ~= ARGV[i + 1] then
+		return 0
+	end
+end
+redis.call('DEL', KEYS[1], KEYS[3], KEYS[4])
+if tonumber(ARGV[2]) > 0 then
+	redis.call('SET', KEYS[2], ARGV[1], 'PX', ARGV[2])
+else
+	redis.call('SET', KEYS[2], ARGV[1])
+end
+return 1
+`)
+
+// mergeScript переносит позиции исходной корзины в целевую, только если содержимое исходной совпадает с переданным.
+// Количество ограничивается так же, как в model.MergeLines.
+// KEYS: исходная корзина, ее цены, целевая корзина, ее цены, отметка об оформлении целевой корзины.
+// ARGV: ttl в мс, лимиты max_sku_count, max_lines, max_total (0 - без ограничения), затем тройки sku, count, доступный остаток.
+// Возвращает пары requested, merged или false, если исходная корзина изменилась
+var mergeScript = goredis.NewScript(`
+if redis.call('HLEN', KEYS[1]) ~= (#ARGV - 4) / 3 then
+	return false
+end
+for i = 5, #ARGV, 3 do
+	if redis.call('HGET', KEYS[1], ARGV[i]) ~= ARGV[i + 1] then
+		return false
+	end
+end
+local maxSku, maxLines, maxTotal = tonumber(ARGV[2]), tonumber(ARGV[3]), tonumber(ARGV[4])
+local lines = redis.call('HLEN', KEYS[3])
+local total = 0
+local items = redis.call('HGETALL', KEYS[3])
+for i = 1, #items, 2 do
+	total = total + tonumber(items[i + 1]) * tonumber(redis.call('HGET', KEYS[4], items[i]) or '0')
+end
+local result = {}
+for i = 5, #ARGV, 3 do
+	local target = tonumber(redis.call('HGET', KEYS[3], ARGV[i]) or '0')
+	local requested = target + tonumber(ARGV[i + 1])
+	local merged = requested
+	if merged > tonumber(ARGV[i + 2]) then
+		merged = math.max(tonumber(ARGV[i + 2]), target)
+	end
+	if maxSku > 0 and merged > maxSku then
+		merged = math.max(maxSku, target)
+	end
+	if target == 0 and maxLines > 0 and lines >= maxLines then
+		merged = 0
+	end
+	local price
+	if target > 0 then
+		price = tonumber(redis.call('HGET', KEYS[4], ARGV[i]) or '0')
+	else
+		price = tonumber(redis.call('HGET', KEYS[2], ARGV[i]) or '0')
+	end
+	if maxTotal > 0 and price > 0 and merged > target then
+		merged = math.min(merged, target + math.floor(math.max(maxTotal - total, 0) / price))
+	end
+	if target == 0 and merged > 0 then
+		lines = lines + 1
+	end
+	total = total + (merged - target) * price
+	if merged > 0 then
+		redis.call('HSET', KEYS[3], ARGV[i], merged)
+		local sourcePrice = redis.call('HGET', KEYS[2], ARGV[i])
+		if target == 0 and sourcePrice then
+			redis.call('HSET', KEYS[4], ARGV[i], sourcePrice)
+		end
+	end
+	result[#result + 1] = requested
+	result[#result + 1] = merged
+end
+redis.call('DEL', KEYS[1], KEYS[2], KEYS[5])
+if tonumber(ARGV[1]) > 0 then
+	redis.call('PEXPIRE', KEYS[3], ARGV[1])
+	redis.call('PEXPIRE', KEYS[4], ARGV[1])
+end
+return result
+`)
+
+// addCountScript прибавляет количество к позиции, сумма ограничивается максимумом uint32.
+// KEYS: корзина. ARGV: sku, количество
+var addCountScript = goredis.NewScript(`
+if redis.call('HINCRBY', KEYS[1], ARGV[1], ARGV[2]) > 4294967295 then
+	redis.call('HSET', KEYS[1], ARGV[1], 4294967295)
+end
+return 1
+`)
+
+// moveScript переносит позицию из одного hash в другой вместе с ценой, количество суммируется
+// с ограничением максимумом uint32.
+// KEYS: откуда, цены откуда, куда, цены куда и необязательная отметка об оформлении, которая удаляется.
+// ARGV: sku, ttl в мс, цена; пустая цена - перенести сохраненную
+var moveScript = goredis.NewScript(`
+local count = redis.call('HGET', KEYS[1], ARGV[1])
+if not count then
+	return 0
+end
+local price = ARGV[3]
+if price == '' then
+	price = redis.call('HGET', KEYS[2], ARGV[1])
+end
+redis.call('HDEL', KEYS[1], ARGV[1])
+redis.call('HDEL', KEYS[2], ARGV[1])
+if redis.call('HINCRBY', KEYS[3], ARGV[1], count) > 4294967295 then
+	redis.call('HSET', KEYS[3], ARGV[1], 4294967295)
+end
+if price then
+	redis.call('HSET', KEYS[4], ARGV[1], price)
+end
+if #KEYS > 4 then
+	redis.call('DEL', KEYS[5])
+end
+if tonumber(ARGV[2]) > 0 then
+	redis.call('PEXPIRE', KEYS[3], ARGV[2])
+	redis.call('PEXPIRE', KEYS[4], ARGV[2])
+end
+return 1
+`)
+
+// markReportedScript убирает корзину из cart_updated, только если она не менялась после updatedAt.
+// Следующее изменение корзины снова добавит ее в cart_updated. ARGV: user_id, updatedAt в мс
+var markReportedScript = goredis.NewScript(`
+local score = redis.call('ZSCORE', KEYS[1], ARGV[1])
+if score and tonumber(score) == tonumber(ARGV[2]) then
+	redis.call('ZREM', KEYS[1], ARGV[1])
+end
+return 1
+`)
+
+// versionScript увеличивает версию корзины и продлевает ее TTL.
+// Отсутствующая версия начинается с текущего времени в мс, чтобы после истечения TTL не повторять старые значения.
+// ARGV: текущее время в мс, ttl в мс
+var versionScript = goredis.NewScript(`
+if redis.call('EXISTS', KEYS[1]) == 0 then
+	redis.call('SET', KEYS[1], ARGV[1])
+end
+local version = redis.call('INCR', KEYS[1])
+if tonumber(ARGV[2]) > 0 then
+	redis.call('PEXPIRE', KEYS[1], ARGV[2])
+end
+return version
+`)
+
+// Repository корзины хранятся в hash cart:{user_id}, поле - sku, значение - количество.
+// Цены на момент добавления лежат рядом в hash cart_price:{user_id} с тем же TTL.
+// Отложенные товары хранятся так же в saved:{user_id} и saved_price:{user_id}.
+// Время последнего изменения корзины, включая удаление позиций, хранится в sorted set cart_updated и одинаково для всех ее позиций.
+// Версия корзины хранится в cart_version:{user_id} и увеличивается после каждого изменения.
+// Idempotency-Key хранится в idempotency:{user_id}:{key}, значение - {order_id}:{отпечаток корзины}, 0 - запрос еще выполняется
+type Repository struct {
+	client *goredis.Client
+	ttl    time.Duration
+	done   chan struct{}
+	tracer service.Tracer
+}
+
+// NewRepository ...
+func NewRepository(client *goredis.Client, ttl time.Duration, tracer service.Tracer) *Repository {
+	repo := Repository{
+		client: client,
+		ttl:    ttl,
+		done:   make(chan struct{}),
+		tracer: tracer,
+	}
+
+	go func() {
+		t := time.NewTicker(timeUpdateMetricRepoSize * time.Second)
+		for {
+			select {
+			case <-t.C:
+				repo.storeRepoSize()
+			case <-repo.done:
+				t.Stop()
+				return
+			}
+		}
+	}()
+
+	return &repo
+}
+
+// Add ...
+func (r *Repository) Add(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:Add")
+	defer span.End()
+
+	key := cartKey(cartItems.UserID)
+	pKey := priceKey(cartItems.UserID)
+
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, checkoutKey(cartItems.UserID))
+		addCountScript.Eval(ctx, pipe, []string{key}, skuField(cartItems.Sku), cartItems.Count)
+		pipe.HSet(ctx, pKey, skuField(cartItems.Sku), cartItems.Price)
+		pipe.ZAdd(ctx, updatedKey, updatedMember(cartItems.UserID, time.Now()))
+		if r.ttl > 0 {
+			pipe.Expire(ctx, key, r.ttl)
+			pipe.Expire(ctx, pKey, r.ttl)
+			pipe.Expire(ctx, promoKey(cartItems.UserID), r.ttl)
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("Add TxPipelined: %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, cartItems.UserID); err != nil {
+		return fmt.Errorf("Add %w", err)
+	}
+
+	return nil
+}
+
+// SetCount ...
+func (r *Repository) SetCount(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:SetCount")
+	defer span.End()
+
+	key := cartKey(cartItems.UserID)
+
+	if cartItems.Count == 0 {
+		if err := r.deleteSku(ctx, cartKey(cartItems.UserID), priceKey(cartItems.UserID), cartItems.Sku); err != nil {
+			return fmt.Errorf("SetCount %w", err)
+		}
+		if err := r.touch(ctx, cartItems.UserID); err != nil {
+			return fmt.Errorf("SetCount %w", err)
+		}
+		if err := r.bumpVersion(ctx, cartItems.UserID); err != nil {
+			return fmt.Errorf("SetCount %w", err)
+		}
+		return nil
+	}
+
+	updated, err := setCountScript.Run(ctx, r.client, []string{key, priceKey(cartItems.UserID)},
+		skuField(cartItems.Sku), cartItems.Count, r.ttl.Milliseconds()).Int()
+	if err != nil {
+		return fmt.Errorf("SetCount Run: %w", err)
+	}
+
+	if updated == 0 {
+		return model.ErrNotFound
+	}
+
+	if err := r.touch(ctx, cartItems.UserID); err != nil {
+		return fmt.Errorf("SetCount %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, cartItems.UserID); err != nil {
+		return fmt.Errorf("SetCount %w", err)
+	}
+
+	return nil
+}
+
+// GetItemsByUserID ...
+func (r *Repository) GetItemsByUserID(ctx context.Context, cartItems model.RequestData) ([]model.Cart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetItemsByUserID")
+	defer span.End()
+
+	items, err := r.getItems(ctx, cartKey(cartItems.UserID), priceKey(cartItems.UserID), userMember(cartItems.UserID))
+	if err != nil {
+		return nil, fmt.Errorf("GetItemsByUserID %w", err)
+	}
+
+	if len(items) < 1 {
+		return nil, model.ErrNotFound
+	}
+
+	return items, nil
+}
+
+// DeleteItemsBySku ...
+func (r *Repository) DeleteItemsBySku(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:DeleteItemsBySku")
+	defer span.End()
+
+	if err := r.deleteSku(ctx, cartKey(cartItems.UserID), priceKey(cartItems.UserID), cartItems.Sku); err != nil {
+		return fmt.Errorf("DeleteItemsBySku %w", err)
+	}
+
+	if err := r.touch(ctx, cartItems.UserID); err != nil {
+		return fmt.Errorf("DeleteItemsBySku %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, cartItems.UserID); err != nil {
+		return fmt.Errorf("DeleteItemsBySku %w", err)
+	}
+
+	return model.ErrNoContent
+}
+
+// DeleteAllItemsFromCart ...
+func (r *Repository) DeleteAllItemsFromCart(ctx context.Context, cartItems model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:DeleteAllItemsFromCart")
+	defer span.End()
+
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.Del(ctx, cartKey(cartItems.UserID), priceKey(cartItems.UserID))
+		pipe.ZRem(ctx, updatedKey, userMember(cartItems.UserID))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("DeleteAllItemsFromCart TxPipelined: %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, cartItems.UserID); err != nil {
+		return fmt.Errorf("DeleteAllItemsFromCart %w", err)
+	}
+
+	return model.ErrNoContent
+}
+
+// Checkout ...
+func (r *Repository) Checkout(ctx context.Context, userID int64, items []model.Cart, orderID int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:Checkout")
+	defer span.End()
+
+	args := make([]interface{}, 0, 2+len(items)*2)
+	args = append(args, orderID, r.ttl.Milliseconds())
+	for _, item := range items {
+		args = append(args, skuField(item.SkuID), strconv.FormatUint(uint64(item.Count), 10))
+	}
+
+	claimed, err := checkoutScript.Run(ctx, r.client,
+		[]string{cartKey(userID), checkoutKey(userID), priceKey(userID), promoKey(userID)}, args...).Int()
+	if err != nil {
+		return fmt.Errorf("Checkout Run: %w", err)
+	}
+
+	if claimed == 0 {
+		return model.ErrCartChanged
+	}
+
+	if err := r.client.ZRem(ctx, updatedKey, userMember(userID)).Err(); err != nil {
+		return fmt.Errorf("Checkout ZRem: %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, userID); err != nil {
+		return fmt.Errorf("Checkout %w", err)
+	}
+
+	return nil
+}
+
+// Merge ...
+func (r *Repository) Merge(ctx context.Context, targetUserID, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) ([]model.MergedLine, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:Merge")
+	defer span.End()
+
+	args := make([]interface{}, 0, 4+len(source)*3)
+	args = append(args, r.ttl.Milliseconds(), limits.MaxSkuCount, limits.MaxLines, limits.MaxTotal)
+	for _, item := range source {
+		args = append(args, skuField(item.SkuID), strconv.FormatUint(uint64(item.Count), 10), available[item.SkuID])
+	}
+
+	keys := []string{
+		cartKey(sourceUserID), priceKey(sourceUserID),
+		cartKey(targetUserID), priceKey(targetUserID), checkoutKey(targetUserID),
+	}
+
+	counts, err := mergeScript.Run(ctx, r.client, keys, args...).Int64Slice()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return nil, model.ErrCartChanged
+		}
+		return nil, fmt.Errorf("Merge Run: %w", err)
+	}
+
+	if len(counts) != len(source)*2 {
+		return nil, fmt.Errorf("Merge Run: unexpected result length %d", len(counts))
+	}
+
+	_, err = r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.ZRem(ctx, updatedKey, userMember(sourceUserID))
+		pipe.ZAdd(ctx, updatedKey, updatedMember(targetUserID, time.Now()))
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Merge Pipelined: %w", err)
+	}
+
+	for _, userID := range []int64{targetUserID, sourceUserID} {
+		if err := r.bumpVersion(ctx, userID); err != nil {
+			return nil, fmt.Errorf("Merge %w", err)
+		}
+	}
+
+	lines := make([]model.MergedLine, 0, len(source))
+	for i, item := range source {
+		// nolint:gosec
+		lines = append(lines, model.MergedLine{
+			Sku:       item.SkuID,
+			Requested: uint32(min(counts[2*i], math.MaxUint32)),
+			Merged:    uint32(counts[2*i+1]),
+		})
+	}
+
+	return lines, nil
+}
+
+// GetCheckoutOrderID ...
+func (r *Repository) GetCheckoutOrderID(ctx context.Context, userID int64) (int64, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetCheckoutOrderID")
+	defer span.End()
+
+	orderID, err := r.client.Get(ctx, checkoutKey(userID)).Int64()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return 0, model.ErrNotFound
+		}
+		return 0, fmt.Errorf("GetCheckoutOrderID Get: %w", err)
+	}
+
+	return orderID, nil
+}
+
+// SetPromoCode промокод живет с тем же TTL, что и корзина, пустой code убирает промокод
+func (r *Repository) SetPromoCode(ctx context.Context, userID int64, code string) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:SetPromoCode")
+	defer span.End()
+
+	var err error
+	if code == "" {
+		err = r.client.Del(ctx, promoKey(userID)).Err()
+	} else {
+		err = r.client.Set(ctx, promoKey(userID), code, r.ttl).Err()
+	}
+	if err != nil {
+		return fmt.Errorf("SetPromoCode: %w", err)
+	}
+
+	if err := r.touch(ctx, userID); err != nil {
+		return fmt.Errorf("SetPromoCode %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, userID); err != nil {
+		return fmt.Errorf("SetPromoCode %w", err)
+	}
+
+	return nil
+}
+
+// GetPromoCode пустая строка - промокод не применен
+func (r *Repository) GetPromoCode(ctx context.Context, userID int64) (string, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetPromoCode")
+	defer span.End()
+
+	code, err := r.client.Get(ctx, promoKey(userID)).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return "", nil
+		}
+		return "", fmt.Errorf("GetPromoCode Get: %w", err)
+	}
+
+	return code, nil
+}
+
+// SaveForLater переносит позицию из корзины в отложенные, количество суммируется с уже отложенным
+func (r *Repository) SaveForLater(ctx context.Context, userID, sku int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:SaveForLater")
+	defer span.End()
+
+	keys := []string{cartKey(userID), priceKey(userID), savedKey(userID), savedPriceKey(userID)}
+
+	moved, err := moveScript.Run(ctx, r.client, keys, skuField(sku), r.ttl.Milliseconds(), "").Int()
+	if err != nil {
+		return fmt.Errorf("SaveForLater Run: %w", err)
+	}
+
+	if moved == 0 {
+		return model.ErrNotFound
+	}
+
+	if err := r.touch(ctx, userID); err != nil {
+		return fmt.Errorf("SaveForLater %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, userID); err != nil {
+		return fmt.Errorf("SaveForLater %w", err)
+	}
+
+	return nil
+}
+
+// MoveToCart переносит отложенную позицию в корзину по цене item.Price
+func (r *Repository) MoveToCart(ctx context.Context, item model.RequestData) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:MoveToCart")
+	defer span.End()
+
+	keys := []string{
+		savedKey(item.UserID), savedPriceKey(item.UserID),
+		cartKey(item.UserID), priceKey(item.UserID), checkoutKey(item.UserID),
+	}
+
+	moved, err := moveScript.Run(ctx, r.client, keys, skuField(item.Sku), r.ttl.Milliseconds(), item.Price).Int()
+	if err != nil {
+		return fmt.Errorf("MoveToCart Run: %w", err)
+	}
+
+	if moved == 0 {
+		return model.ErrNotFound
+	}
+
+	if err := r.touch(ctx, item.UserID); err != nil {
+		return fmt.Errorf("MoveToCart %w", err)
+	}
+
+	if err := r.bumpVersion(ctx, item.UserID); err != nil {
+		return fmt.Errorf("MoveToCart %w", err)
+	}
+
+	return nil
+}
+
+// GetSavedItems ...
+func (r *Repository) GetSavedItems(ctx context.Context, userID int64) ([]model.Cart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetSavedItems")
+	defer span.End()
+
+	items, err := r.getItems(ctx, savedKey(userID), savedPriceKey(userID), "")
+	if err != nil {
+		return nil, fmt.Errorf("GetSavedItems %w", err)
+	}
+
+	if len(items) < 1 {
+		return nil, model.ErrNotFound
+	}
+
+	return items, nil
+}
+
+// DeleteSavedItem ...
+func (r *Repository) DeleteSavedItem(ctx context.Context, userID, sku int64) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:DeleteSavedItem")
+	defer span.End()
+
+	if err := r.deleteSku(ctx, savedKey(userID), savedPriceKey(userID), sku); err != nil {
+		return fmt.Errorf("DeleteSavedItem %w", err)
+	}
+
+	return nil
+}
+
+// GetVersion версия корзины меняется при каждом изменении позиций, промокода и оформлении заказа
+func (r *Repository) GetVersion(ctx context.Context, userID int64) (uint64, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetVersion")
+	defer span.End()
+
+	version, err := r.client.Get(ctx, versionKey(userID)).Uint64()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return 0, nil
+		}
+		return 0, fmt.Errorf("GetVersion Get: %w", err)
+	}
+
+	return version, nil
+}
+
+// ReserveIdempotencyKey занимает ключ на ttl. Если ключ уже занят, возвращает его запись и false
+func (r *Repository) ReserveIdempotencyKey(ctx context.Context, userID int64, key string, ttl time.Duration) (model.IdempotencyRecord, bool, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:ReserveIdempotencyKey")
+	defer span.End()
+
+	k := idempotencyKey(userID, key)
+
+	reserved, err := r.client.SetNX(ctx, k, 0, ttl).Result()
+	if err != nil {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("ReserveIdempotencyKey SetNX: %w", err)
+	}
+	if reserved {
+		return model.IdempotencyRecord{}, true, nil
+	}
+
+	// ключ могли освободить между запросами, тогда вызывающий попробует занять его снова
+	value, err := r.client.Get(ctx, k).Result()
+	if err != nil {
+		if errors.Is(err, goredis.Nil) {
+			return model.IdempotencyRecord{}, false, nil
+		}
+		return model.IdempotencyRecord{}, false, fmt.Errorf("ReserveIdempotencyKey Get: %w", err)
+	}
+
+	orderID, fingerprint, _ := strings.Cut(value, ":")
+	record := model.IdempotencyRecord{Fingerprint: fingerprint}
+	if record.OrderID, err = strconv.ParseInt(orderID, 10, 64); err != nil {
+		return model.IdempotencyRecord{}, false, fmt.Errorf("ReserveIdempotencyKey ParseInt: %w", err)
+	}
+
+	return record, false, nil
+}
+
+// CompleteIdempotencyKey сохраняет заказ, созданный по ключу, и отпечаток корзины на ttl
+func (r *Repository) CompleteIdempotencyKey(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:CompleteIdempotencyKey")
+	defer span.End()
+
+	value := strconv.FormatInt(orderID, 10) + ":" + fingerprint
+	if err := r.client.Set(ctx, idempotencyKey(userID, key), value, ttl).Err(); err != nil {
+		return fmt.Errorf("CompleteIdempotencyKey Set: %w", err)
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ, по которому заказ не был создан
+func (r *Repository) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:ReleaseIdempotencyKey")
+	defer span.End()
+
+	if err := releaseIdempotencyScript.Run(ctx, r.client, []string{idempotencyKey(userID, key)}).Err(); err != nil {
+		return fmt.Errorf("ReleaseIdempotencyKey Run: %w", err)
+	}
+
+	return nil
+}
+
+// getItems читает позиции и их цены, отсортированные по sku.
+// Если передан member, позициям проставляется время изменения корзины из cart_updated
+func (r *Repository) getItems(ctx context.Context, key, pKey, member string) ([]model.Cart, error) {
+	var valuesCmd, pricesCmd *goredis.MapStringStringCmd
+	var updatedCmd *goredis.FloatCmd
+	_, err := r.client.Pipelined(ctx, func(pipe goredis.Pipeliner) error {
+		valuesCmd = pipe.HGetAll(ctx, key)
+		pricesCmd = pipe.HGetAll(ctx, pKey)
+		if member != "" {
+			updatedCmd = pipe.ZScore(ctx, updatedKey, member)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, goredis.Nil) {
+		return nil, fmt.Errorf("HGetAll: %w", err)
+	}
+
+	values, prices := valuesCmd.Val(), pricesCmd.Val()
+
+	var updatedAt time.Time
+	if updatedCmd != nil && updatedCmd.Err() == nil {
+		updatedAt = time.UnixMilli(int64(updatedCmd.Val()))
+	}
+
+	items := make([]model.Cart, 0, len(values))
+	for field, value := range values {
+		sku, err := strconv.ParseInt(field, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("ParseInt: %w", err)
+		}
+
+		count, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("ParseUint: %w", err)
+		}
+
+		var price uint64
+		if p, ok := prices[field]; ok {
+			price, err = strconv.ParseUint(p, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("ParseUint: %w", err)
+			}
+		}
+
+		items = append(items, model.Cart{
+			SkuID:     sku,
+			Count:     uint32(count),
+			Price:     uint32(price),
+			UpdatedAt: updatedAt,
+		})
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].SkuID < items[j].SkuID
+	})
+
+	return items, nil
+}
+
+// GetAbandonedCarts корзины, которые не менялись с before и о которых еще не отправлено событие, самые старые первыми.
+// Корзины, истекшие по TTL, остаются в cart_updated, пока о них не отправлено событие
+func (r *Repository) GetAbandonedCarts(ctx context.Context, before time.Time, limit int) ([]model.AbandonedCart, error) {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:GetAbandonedCarts")
+	defer span.End()
+
+	members, err := r.client.ZRangeByScoreWithScores(ctx, updatedKey, &goredis.ZRangeBy{
+		Min:   "-inf",
+		Max:   "(" + strconv.FormatInt(before.UnixMilli(), 10),
+		Count: int64(limit),
+	}).Result()
+	if err != nil {
+		return nil, fmt.Errorf("GetAbandonedCarts ZRangeByScore: %w", err)
+	}
+
+	carts := make([]model.AbandonedCart, 0, len(members))
+	for _, m := range members {
+		member, ok := m.Member.(string)
+		if !ok {
+			return nil, fmt.Errorf("GetAbandonedCarts: unexpected member %v", m.Member)
+		}
+
+		userID, err := strconv.ParseInt(member, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("GetAbandonedCarts ParseInt: %w", err)
+		}
+
+		carts = append(carts, model.AbandonedCart{UserID: userID, UpdatedAt: time.UnixMilli(int64(m.Score))})
+	}
+
+	return carts, nil
+}
+
+// MarkAbandonedReported убирает корзину из cart_updated, если она не менялась после updatedAt
+func (r *Repository) MarkAbandonedReported(ctx context.Context, userID int64, updatedAt time.Time) error {
+	ctx, span := r.tracer.Start(ctx, "CartRepo:MarkAbandonedReported")
+	defer span.End()
+
+	if err := markReportedScript.Run(ctx, r.client, []string{updatedKey}, userMember(userID), updatedAt.UnixMilli()).Err(); err != nil {
+		return fmt.Errorf("MarkAbandonedReported Run: %w", err)
+	}
+
+	return nil
+}
+
+// touch обновляет время изменения корзины
+func (r *Repository) touch(ctx context.Context, userID int64) error {
+	if err := r.client.ZAdd(ctx, updatedKey, updatedMember(userID, time.Now())).Err(); err != nil {
+		return fmt.Errorf("ZAdd: %w", err)
+	}
+
+	return nil
+}
+
+// bumpVersion увеличивает версию корзины, вызывается после изменения
+func (r *Repository) bumpVersion(ctx context.Context, userID int64) error {
+	err := versionScript.Run(ctx, r.client, []string{versionKey(userID)}, time.Now().UnixMilli(), r.ttl.Milliseconds()).Err()
+	if err != nil {
+		return fmt.Errorf("bumpVersion Run: %w", err)
+	}
+
+	return nil
+}
+
+// deleteSku удаляет позицию вместе с сохраненной ценой
+func (r *Repository) deleteSku(ctx context.Context, key, pKey string, sku int64) error {
+	_, err := r.client.TxPipelined(ctx, func(pipe goredis.Pipeliner) error {
+		pipe.HDel(ctx, key, skuField(sku))
+		pipe.HDel(ctx, pKey, skuField(sku))
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("HDel: %w", err)
+	}
+
+	return nil
+}
+
+// Close ...
+func (r *Repository) Close() {
+	r.done <- struct{}{}
+	close(r.done)
+	//nolint:errcheck, gosec
+	r.client.Close()
+}
+
+// storeRepoSize обновляет метрику количества корзин, истекшие по TTL корзины в неё не попадают
+func (r *Repository) storeRepoSize() {
+	ctx, cancel := context.WithTimeout(context.Background(), timeUpdateMetricRepoSize*time.Second)
+	defer cancel()
+
+	var size int
+	iter := r.client.Scan(ctx, 0, cartKeyPrefix+"*", scanCount).Iterator()
+	for iter.Next(ctx) {
+		size++
+	}
+
+	if err := iter.Err(); err != nil {
+		logger.Errorw(fmt.Sprintf("storeRepoSize Scan: %v", err))
+		return
+	}
+
+	metrics.StoreRepoSize(float64(size))
+}
+
+// cartKey ...
+func cartKey(userID int64) string {
+	return cartKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// priceKey ...
+func priceKey(userID int64) string {
+	return priceKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// checkoutKey ...
+func checkoutKey(userID int64) string {
+	return checkoutKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// promoKey ...
+func promoKey(userID int64) string {
+	return promoKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// savedKey ...
+func savedKey(userID int64) string {
+	return savedKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// savedPriceKey ...
+func savedPriceKey(userID int64) string {
+	return savedPriceKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// versionKey ...
+func versionKey(userID int64) string {
+	return versionKeyPrefix + strconv.FormatInt(userID, 10)
+}
+
+// idempotencyKey ...
+func idempotencyKey(userID int64, key string) string {
+	return idempotencyKeyPrefix + strconv.FormatInt(userID, 10) + ":" + key
+}
+
+// updatedMember ...
+func updatedMember(userID int64, updatedAt time.Time) goredis.Z {
+	return goredis.Z{Score: float64(updatedAt.UnixMilli()), Member: userMember(userID)}
+}
+
+// userMember ...
+func userMember(userID int64) string {
+	return strconv.FormatInt(userID, 10)
+}
+
+// skuField ...
+func skuField(sku int64) string {
+	return strconv.FormatInt(sku, 10)
+}
@@ -0,0 +1,613 @@
+package redis
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service/mocks"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	goredis "github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const testTTL = time.Hour
+
+func setupRepo(t *testing.T) (*Repository, *miniredis.Miniredis) {
+	mr := miniredis.RunT(t)
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	client := goredis.NewClient(&goredis.Options{Addr: mr.Addr()})
+	repo := NewRepository(client, testTTL, tracer)
+	t.Cleanup(repo.Close)
+
+	return repo, mr
+}
+
+func TestRepository_AddGetDelete(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 1, Sku: 2, Count: 1}
+	item2 := model.RequestData{UserID: 1, Sku: 1, Count: 3}
+
+	t.Run("add/get/delBySku", func(t *testing.T) {
+		repo, _ := setupRepo(t)
+
+		require.NoError(t, repo.Add(ctx, item))
+		require.NoError(t, repo.Add(ctx, item))
+		require.NoError(t, repo.Add(ctx, item2))
+
+		items, err := repo.GetItemsByUserID(ctx, item)
+		require.NoError(t, err)
+		require.Len(t, items, 2)
+		assert.Equal(t, item2.Sku, items[0].SkuID)
+		assert.Equal(t, item2.Count, items[0].Count)
+		assert.Equal(t, item.Sku, items[1].SkuID)
+		assert.Equal(t, uint32(2), items[1].Count)
+
+		err = repo.DeleteItemsBySku(ctx, item)
+		require.ErrorIs(t, err, model.ErrNoContent)
+
+		items, err = repo.GetItemsByUserID(ctx, item)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, item2.Sku, items[0].SkuID)
+	})
+
+	t.Run("add/get/delByUser", func(t *testing.T) {
+		repo, _ := setupRepo(t)
+
+		require.NoError(t, repo.Add(ctx, item))
+		require.NoError(t, repo.Add(ctx, item2))
+
+		err := repo.DeleteAllItemsFromCart(ctx, item)
+		require.ErrorIs(t, err, model.ErrNoContent)
+
+		_, err = repo.GetItemsByUserID(ctx, item)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestRepository_CountOverflow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 1, Sku: 1, Count: math.MaxUint32 - 1, Price: 100}
+	repo, _ := setupRepo(t)
+
+	require.NoError(t, repo.Add(ctx, item))
+	require.NoError(t, repo.Add(ctx, item))
+
+	items, err := repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: math.MaxUint32, Price: item.Price}}, withoutUpdatedAt(items))
+
+	require.NoError(t, repo.SaveForLater(ctx, item.UserID, item.Sku))
+	require.NoError(t, repo.Add(ctx, item))
+	require.NoError(t, repo.MoveToCart(ctx, item))
+
+	items, err = repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: math.MaxUint32, Price: item.Price}}, withoutUpdatedAt(items))
+}
+
+func TestRepository_SetCount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 5, Sku: 1, Count: 5}
+
+	repo, mr := setupRepo(t)
+
+	err := repo.SetCount(ctx, item)
+	require.ErrorIs(t, err, model.ErrNotFound)
+	assert.False(t, mr.Exists(cartKey(item.UserID)))
+
+	require.NoError(t, repo.Add(ctx, item))
+	mr.FastForward(time.Minute)
+
+	require.NoError(t, repo.SetCount(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Count: 2}))
+	assert.Equal(t, testTTL, mr.TTL(cartKey(item.UserID)))
+
+	items, err := repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	require.Len(t, items, 1)
+	assert.Equal(t, uint32(2), items[0].Count)
+
+	require.NoError(t, repo.SetCount(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku}))
+
+	_, err = repo.GetItemsByUserID(ctx, item)
+	require.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestRepository_Checkout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 7, Sku: 1, Count: 3}
+	other := model.RequestData{UserID: 7, Sku: 2, Count: 1}
+	ordered := []model.Cart{
+		{SkuID: item.Sku, Count: item.Count},
+		{SkuID: other.Sku, Count: other.Count},
+	}
+
+	repo, mr := setupRepo(t)
+
+	require.NoError(t, repo.Add(ctx, item))
+
+	err := repo.Checkout(ctx, item.UserID, ordered, 42)
+	require.ErrorIs(t, err, model.ErrCartChanged)
+	assert.True(t, mr.Exists(cartKey(item.UserID)))
+
+	require.NoError(t, repo.Add(ctx, other))
+	require.NoError(t, repo.Checkout(ctx, item.UserID, ordered, 42))
+
+	_, err = repo.GetItemsByUserID(ctx, item)
+	require.ErrorIs(t, err, model.ErrNotFound)
+	assert.Equal(t, testTTL, mr.TTL(checkoutKey(item.UserID)))
+
+	orderID, err := repo.GetCheckoutOrderID(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, int64(42), orderID)
+
+	require.NoError(t, repo.Add(ctx, item))
+
+	_, err = repo.GetCheckoutOrderID(ctx, item.UserID)
+	require.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestRepository_Price(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 8, Sku: 1, Count: 1, Price: 100}
+
+	repo, mr := setupRepo(t)
+
+	require.NoError(t, repo.Add(ctx, item))
+	assert.Equal(t, testTTL, mr.TTL(priceKey(item.UserID)))
+
+	items, err := repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: 1, Count: 1, Price: 100}}, withoutUpdatedAt(items))
+
+	item.Price = 120
+	require.NoError(t, repo.Add(ctx, item))
+
+	items, err = repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: 1, Count: 2, Price: 120}}, withoutUpdatedAt(items))
+
+	require.ErrorIs(t, repo.DeleteItemsBySku(ctx, item), model.ErrNoContent)
+	assert.False(t, mr.Exists(priceKey(item.UserID)))
+}
+
+func TestRepository_TTL(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 10, Sku: 1, Count: 1}
+
+	t.Run("cart expires after ttl", func(t *testing.T) {
+		repo, mr := setupRepo(t)
+
+		require.NoError(t, repo.Add(ctx, item))
+		assert.Equal(t, testTTL, mr.TTL(cartKey(item.UserID)))
+
+		mr.FastForward(testTTL)
+
+		_, err := repo.GetItemsByUserID(ctx, item)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("add refreshes ttl", func(t *testing.T) {
+		repo, mr := setupRepo(t)
+
+		require.NoError(t, repo.Add(ctx, item))
+		mr.FastForward(testTTL - time.Minute)
+
+		require.NoError(t, repo.Add(ctx, item))
+		assert.Equal(t, testTTL, mr.TTL(cartKey(item.UserID)))
+
+		mr.FastForward(testTTL - time.Minute)
+
+		items, err := repo.GetItemsByUserID(ctx, item)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, uint32(2), items[0].Count)
+	})
+}
+
+func TestRepository_StoreRepoSize(t *testing.T) {
+	ctx := context.Background()
+	repo, mr := setupRepo(t)
+
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 1, Sku: 1, Count: 1}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 2, Sku: 1, Count: 1}))
+	mr.Set("other:key", "value")
+
+	repo.storeRepoSize()
+
+	expected := `
+# HELP cart_repo_size_total Size of repo
+# TYPE cart_repo_size_total gauge
+cart_repo_size_total 2
+`
+	err := testutil.GatherAndCompare(prometheus.DefaultGatherer, strings.NewReader(expected), "cart_repo_size_total")
+	require.NoError(t, err)
+}
+
+func TestRepository_Merge(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const (
+		targetUserID = int64(1)
+		sourceUserID = int64(2)
+	)
+
+	repo, mr := setupRepo(t)
+
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: targetUserID, Sku: 1, Count: 2, Price: 100}))
+	require.NoError(t, mr.Set(checkoutKey(targetUserID), "42"))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 1, Count: 3, Price: 90}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 2, Count: 4, Price: 50}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 3, Count: 1, Price: 10}))
+
+	source, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: sourceUserID})
+	require.NoError(t, err)
+
+	_, err = repo.Merge(ctx, targetUserID, sourceUserID, source[:1], nil, model.CartLimits{})
+	require.ErrorIs(t, err, model.ErrCartChanged)
+	assert.True(t, mr.Exists(cartKey(sourceUserID)))
+
+	lines, err := repo.Merge(ctx, targetUserID, sourceUserID, source, map[int64]uint32{1: 4, 2: 10}, model.CartLimits{})
+	require.NoError(t, err)
+	assert.Equal(t, []model.MergedLine{
+		{Sku: 1, Requested: 5, Merged: 4},
+		{Sku: 2, Requested: 4, Merged: 4},
+		{Sku: 3, Requested: 1, Merged: 0},
+	}, lines)
+
+	items, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: targetUserID})
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{
+		{SkuID: 1, Count: 4, Price: 100},
+		{SkuID: 2, Count: 4, Price: 50},
+	}, withoutUpdatedAt(items))
+
+	assert.False(t, mr.Exists(cartKey(sourceUserID)))
+	assert.False(t, mr.Exists(priceKey(sourceUserID)))
+	assert.False(t, mr.Exists(checkoutKey(targetUserID)))
+	assert.Equal(t, testTTL, mr.TTL(cartKey(targetUserID)))
+	assert.Equal(t, testTTL, mr.TTL(priceKey(targetUserID)))
+}
+
+func TestRepository_MergeLimits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const (
+		targetUserID = int64(1)
+		sourceUserID = int64(2)
+	)
+
+	available := map[int64]uint32{1: 100, 2: 100, 3: 100}
+
+	tests := []struct {
+		name          string
+		limits        model.CartLimits
+		expectedLines []model.MergedLine
+		expectedItems []model.Cart
+	}{
+		{
+			name:   "sku count is capped",
+			limits: model.CartLimits{MaxSkuCount: 3},
+			expectedLines: []model.MergedLine{
+				{Sku: 1, Requested: 5, Merged: 3},
+				{Sku: 2, Requested: 4, Merged: 3},
+				{Sku: 3, Requested: 1, Merged: 1},
+			},
+			expectedItems: []model.Cart{
+				{SkuID: 1, Count: 3, Price: 100},
+				{SkuID: 2, Count: 3, Price: 50},
+				{SkuID: 3, Count: 1, Price: 10},
+			},
+		},
+		{
+			name:   "lines over limit are not merged",
+			limits: model.CartLimits{MaxLines: 2},
+			expectedLines: []model.MergedLine{
+				{Sku: 1, Requested: 5, Merged: 5},
+				{Sku: 2, Requested: 4, Merged: 4},
+				{Sku: 3, Requested: 1, Merged: 0},
+			},
+			expectedItems: []model.Cart{
+				{SkuID: 1, Count: 5, Price: 100},
+				{SkuID: 2, Count: 4, Price: 50},
+			},
+		},
+		{
+			name:   "counts are trimmed to total",
+			limits: model.CartLimits{MaxTotal: 600},
+			expectedLines: []model.MergedLine{
+				{Sku: 1, Requested: 5, Merged: 5},
+				{Sku: 2, Requested: 4, Merged: 2},
+				{Sku: 3, Requested: 1, Merged: 0},
+			},
+			expectedItems: []model.Cart{
+				{SkuID: 1, Count: 5, Price: 100},
+				{SkuID: 2, Count: 2, Price: 50},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			repo, _ := setupRepo(t)
+
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: targetUserID, Sku: 1, Count: 2, Price: 100}))
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 1, Count: 3, Price: 90}))
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 2, Count: 4, Price: 50}))
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 3, Count: 1, Price: 10}))
+
+			source, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: sourceUserID})
+			require.NoError(t, err)
+
+			// Execute
+			lines, err := repo.Merge(ctx, targetUserID, sourceUserID, source, available, tt.limits)
+
+			// Verify
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLines, lines)
+
+			items, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: targetUserID})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedItems, withoutUpdatedAt(items))
+		})
+	}
+}
+
+func TestRepository_SavedItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 3, Sku: 1, Count: 2, Price: 100}
+
+	repo, mr := setupRepo(t)
+
+	err := repo.SaveForLater(ctx, item.UserID, item.Sku)
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	require.NoError(t, repo.Add(ctx, item))
+	require.NoError(t, repo.SaveForLater(ctx, item.UserID, item.Sku))
+
+	_, err = repo.GetItemsByUserID(ctx, item)
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	saved, err := repo.GetSavedItems(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: item.Count, Price: item.Price}}, saved)
+	assert.Equal(t, testTTL, mr.TTL(savedKey(item.UserID)))
+
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Count: 1, Price: 100}))
+	require.NoError(t, mr.Set(checkoutKey(item.UserID), "42"))
+	require.NoError(t, repo.MoveToCart(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Price: 120}))
+
+	items, err := repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: 3, Price: 120}}, withoutUpdatedAt(items))
+	assert.False(t, mr.Exists(savedKey(item.UserID)))
+	assert.False(t, mr.Exists(checkoutKey(item.UserID)))
+
+	err = repo.MoveToCart(ctx, item)
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	require.NoError(t, repo.SaveForLater(ctx, item.UserID, item.Sku))
+	require.NoError(t, repo.DeleteSavedItem(ctx, item.UserID, item.Sku))
+
+	_, err = repo.GetSavedItems(ctx, item.UserID)
+	require.ErrorIs(t, err, model.ErrNotFound)
+}
+
+func TestRepository_PromoCode(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 8, Sku: 1, Count: 1}
+
+	repo, mr := setupRepo(t)
+
+	code, err := repo.GetPromoCode(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Empty(t, code)
+
+	require.NoError(t, repo.SetPromoCode(ctx, item.UserID, "WELCOME10"))
+	assert.Equal(t, testTTL, mr.TTL(promoKey(item.UserID)))
+
+	code, err = repo.GetPromoCode(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, "WELCOME10", code)
+
+	require.NoError(t, repo.Add(ctx, item))
+	require.NoError(t, repo.Checkout(ctx, item.UserID, []model.Cart{{SkuID: item.Sku, Count: item.Count}}, 42))
+	assert.False(t, mr.Exists(promoKey(item.UserID)))
+
+	require.NoError(t, repo.SetPromoCode(ctx, item.UserID, "WELCOME10"))
+	require.NoError(t, repo.SetPromoCode(ctx, item.UserID, ""))
+	assert.False(t, mr.Exists(promoKey(item.UserID)))
+}
+
+func TestRepository_Version(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	item := model.RequestData{UserID: 10, Sku: 1, Count: 1}
+
+	repo, mr := setupRepo(t)
+
+	version, err := repo.GetVersion(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Zero(t, version)
+
+	changes := []func() error{
+		func() error { return repo.Add(ctx, item) },
+		func() error {
+			return repo.SetCount(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Count: 2})
+		},
+		func() error { return repo.SetPromoCode(ctx, item.UserID, "WELCOME10") },
+		func() error { return repo.SaveForLater(ctx, item.UserID, item.Sku) },
+		func() error { return repo.MoveToCart(ctx, item) },
+		func() error { return repo.Checkout(ctx, item.UserID, []model.Cart{{SkuID: item.Sku, Count: 2}}, 42) },
+	}
+
+	for _, change := range changes {
+		require.NoError(t, change())
+
+		next, err := repo.GetVersion(ctx, item.UserID)
+		require.NoError(t, err)
+		assert.Greater(t, next, version)
+		version = next
+	}
+	assert.Equal(t, testTTL, mr.TTL(versionKey(item.UserID)))
+
+	t.Run("expired version starts from current time", func(t *testing.T) {
+		mr.FastForward(testTTL)
+
+		expired, err := repo.GetVersion(ctx, item.UserID)
+		require.NoError(t, err)
+		assert.Zero(t, expired)
+
+		// nolint:gosec
+		start := uint64(time.Now().UnixMilli())
+		require.NoError(t, repo.Add(ctx, item))
+
+		next, err := repo.GetVersion(ctx, item.UserID)
+		require.NoError(t, err)
+		assert.Greater(t, next, start)
+	})
+}
+
+func TestRepository_AbandonedCarts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	repo, mr := setupRepo(t)
+
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 1, Sku: 1, Count: 1}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 2, Sku: 1, Count: 1}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 3, Sku: 1, Count: 1}))
+	require.NoError(t, repo.Checkout(ctx, 3, []model.Cart{{SkuID: 1, Count: 1}}, 42))
+
+	carts, err := repo.GetAbandonedCarts(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, carts)
+
+	before := time.Now().Add(time.Hour)
+
+	carts, err = repo.GetAbandonedCarts(ctx, before, 10)
+	require.NoError(t, err)
+	require.Len(t, carts, 2)
+
+	items, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: carts[0].UserID})
+	require.NoError(t, err)
+	assert.Equal(t, carts[0].UpdatedAt, items[0].UpdatedAt)
+
+	require.NoError(t, repo.MarkAbandonedReported(ctx, 1, time.Time{}))
+	carts, err = repo.GetAbandonedCarts(ctx, before, 10)
+	require.NoError(t, err)
+	assert.Len(t, carts, 2, "cart changed after reported time is not marked")
+
+	for _, cart := range carts {
+		require.NoError(t, repo.MarkAbandonedReported(ctx, cart.UserID, cart.UpdatedAt))
+	}
+
+	carts, err = repo.GetAbandonedCarts(ctx, before, 10)
+	require.NoError(t, err)
+	assert.Empty(t, carts)
+	assert.False(t, mr.Exists(updatedKey))
+
+	require.NoError(t, repo.SetCount(ctx, model.RequestData{UserID: 1, Sku: 1, Count: 2}))
+
+	carts, err = repo.GetAbandonedCarts(ctx, before, 10)
+	require.NoError(t, err)
+	require.Len(t, carts, 1)
+	assert.Equal(t, int64(1), carts[0].UserID)
+
+	t.Run("deleted line counts as change", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 4, Sku: 1, Count: 1}))
+		require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 4, Sku: 2, Count: 1}))
+
+		// score хранится в мс
+		time.Sleep(2 * time.Millisecond)
+		deletedAfter := time.Now()
+		time.Sleep(2 * time.Millisecond)
+		require.ErrorIs(t, repo.DeleteItemsBySku(ctx, model.RequestData{UserID: 4, Sku: 2}), model.ErrNoContent)
+
+		got, err := repo.GetAbandonedCarts(ctx, deletedAfter, 10)
+		require.NoError(t, err)
+		for _, cart := range got {
+			assert.NotEqual(t, int64(4), cart.UserID)
+		}
+	})
+}
+
+func TestRepository_IdempotencyKey(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	repo, mr := setupRepo(t)
+
+	_, reserved, err := repo.ReserveIdempotencyKey(ctx, 1, "key", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	record, reserved, err := repo.ReserveIdempotencyKey(ctx, 1, "key", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Equal(t, model.IdempotencyRecord{}, record, "request is still in flight")
+
+	require.NoError(t, repo.ReleaseIdempotencyKey(ctx, 1, "key"))
+	_, reserved, err = repo.ReserveIdempotencyKey(ctx, 1, "key", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved)
+
+	require.NoError(t, repo.CompleteIdempotencyKey(ctx, 1, "key", 42, "7", time.Hour))
+	require.NoError(t, repo.ReleaseIdempotencyKey(ctx, 1, "key"))
+
+	record, reserved, err = repo.ReserveIdempotencyKey(ctx, 1, "key", time.Minute)
+	require.NoError(t, err)
+	assert.False(t, reserved)
+	assert.Equal(t, model.IdempotencyRecord{OrderID: 42, Fingerprint: "7"}, record)
+
+	_, reserved, err = repo.ReserveIdempotencyKey(ctx, 2, "key", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved, "keys are scoped by user")
+
+	mr.FastForward(time.Hour)
+	_, reserved, err = repo.ReserveIdempotencyKey(ctx, 1, "key", time.Minute)
+	require.NoError(t, err)
+	assert.True(t, reserved, "expired key is reserved again")
+}
+
+// withoutUpdatedAt ...
+func withoutUpdatedAt(items []model.Cart) []model.Cart {
+	for i := range items {
+		items[i].UpdatedAt = time.Time{}
+	}
+
+	return items
+}
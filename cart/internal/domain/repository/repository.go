@@ -3,6 +3,9 @@ package repository
 
 import (
 	"context"
+	"slices"
+	"sort"
+	"strconv"
 	"sync"
 	"time"
 
@@ -16,20 +19,45 @@ const (
 	timeUpdateMetricRepoSize = 10
 )
 
+// idempotencyKey ...
+type idempotencyKey struct {
+	record    model.IdempotencyRecord
+	expiresAt time.Time
+}
+
 // InMemoryRepository ...
 type InMemoryRepository struct {
-	storage model.Storage
-	mx      sync.RWMutex
-	done    chan struct{}
-	tracer  service.Tracer
+	storage   model.Storage
+	saved     model.Storage       // отложенные товары
+	checkouts map[int64]int64     // user_id -> заказ, которым была оформлена корзина
+	promos    map[int64]string    // user_id -> примененный промокод
+	abandoned map[int64]time.Time // user_id -> время изменения корзины, о которой уже отправлено событие
+	versions  map[int64]uint64    // user_id -> версия корзины
+	updated   map[int64]time.Time // user_id -> время последнего изменения корзины, в том числе удаления позиций
+	idemp     map[string]idempotencyKey
+	// versionBase с него начинаются версии корзин - время создания репозитория в нс.
+	// После перезапуска версии не повторяют выданные раньше, и старый ETag не совпадет с другой корзиной
+	versionBase uint64
+	mx          sync.RWMutex
+	done        chan struct{}
+	tracer      service.Tracer
 }
 
 // NewInMemoryRepository ...
 func NewInMemoryRepository(tracer service.Tracer) *InMemoryRepository {
 	repo := InMemoryRepository{
-		storage: make(model.Storage),
-		done:    make(chan struct{}),
-		tracer:  tracer,
+		storage:   make(model.Storage),
+		saved:     make(model.Storage),
+		checkouts: make(map[int64]int64),
+		promos:    make(map[int64]string),
+		abandoned: make(map[int64]time.Time),
+		versions:  make(map[int64]uint64),
+		updated:   make(map[int64]time.Time),
+		idemp:     make(map[string]idempotencyKey),
+		// nolint:gosec
+		versionBase: uint64(time.Now().UnixNano()),
+		done:        make(chan struct{}),
+		tracer:      tracer,
 	}
 
 	go func() {
@@ -37,9 +65,10 @@ func NewInMemoryRepository(tracer service.Tracer) *InMemoryRepository {
 		for {
 			select {
 			case <-t.C:
-				repo.mx.RLock()
+				repo.mx.Lock()
 				metrics.StoreRepoSize(float64(len(repo.storage)))
-				repo.mx.RUnlock()
+				repo.deleteExpiredIdempotencyKeys(time.Now())
+				repo.mx.Unlock()
 			case <-repo.done:
 				t.Stop()
 				return
@@ -58,24 +87,35 @@ func (r *InMemoryRepository) Add(ctx context.Context, cartItems model.RequestDat
 	r.mx.Lock()
 	defer r.mx.Unlock()
 
+	delete(r.checkouts, cartItems.UserID)
+	r.touch(cartItems.UserID)
+
+	now := time.Now()
+
 	if items, ok := r.storage[cartItems.UserID]; ok {
 		for i, item := range items {
 			if item.SkuID == cartItems.Sku {
-				items[i].Count += cartItems.Count
+				items[i].Count = model.AddCounts(items[i].Count, cartItems.Count)
+				items[i].Price = cartItems.Price
+				items[i].UpdatedAt = now
 				return nil
 			}
 		}
 
 		items = append(items, model.Cart{
-			SkuID: cartItems.Sku,
-			Count: cartItems.Count,
+			SkuID:     cartItems.Sku,
+			Count:     cartItems.Count,
+			Price:     cartItems.Price,
+			UpdatedAt: now,
 		})
 
 		r.storage[cartItems.UserID] = items
 	} else {
 		items := model.Cart{
-			SkuID: cartItems.Sku,
-			Count: cartItems.Count,
+			SkuID:     cartItems.Sku,
+			Count:     cartItems.Count,
+			Price:     cartItems.Price,
+			UpdatedAt: now,
 		}
 		r.storage[cartItems.UserID] = []model.Cart{items}
 	}
@@ -83,6 +123,35 @@ func (r *InMemoryRepository) Add(ctx context.Context, cartItems model.RequestDat
 	return nil
 }
 
+// SetCount ...
+func (r *InMemoryRepository) SetCount(ctx context.Context, cartItems model.RequestData) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:SetCount")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	items := r.storage[cartItems.UserID]
+	for i, item := range items {
+		if item.SkuID == cartItems.Sku {
+			r.touch(cartItems.UserID)
+			if cartItems.Count == 0 {
+				r.storage[cartItems.UserID] = deleteFromMemory(items, i)
+				return nil
+			}
+			items[i].Count = cartItems.Count
+			items[i].UpdatedAt = time.Now()
+			return nil
+		}
+	}
+
+	if cartItems.Count == 0 {
+		return nil
+	}
+
+	return model.ErrNotFound
+}
+
 // GetItemsByUserID ...
 func (r *InMemoryRepository) GetItemsByUserID(ctx context.Context, cartItems model.RequestData) ([]model.Cart, error) {
 	_, span := r.tracer.Start(ctx, "CartRepo:GetItemsByUserID")
@@ -115,6 +184,7 @@ func (r *InMemoryRepository) DeleteItemsBySku(ctx context.Context, cartItems mod
 			if items.SkuID == cartItems.Sku {
 				value = deleteFromMemory(value, i)
 				r.storage[cartItems.UserID] = value
+				r.touch(cartItems.UserID)
 				break
 			}
 		}
@@ -133,11 +203,350 @@ func (r *InMemoryRepository) DeleteAllItemsFromCart(ctx context.Context, cartIte
 
 	if _, ok := r.storage[cartItems.UserID]; ok {
 		r.storage[cartItems.UserID] = nil
+		r.touch(cartItems.UserID)
 	}
 
 	return model.ErrNoContent
 }
 
+// Checkout очищает корзину и запоминает заказ, если ее содержимое совпадает с оформленным
+func (r *InMemoryRepository) Checkout(ctx context.Context, userID int64, items []model.Cart, orderID int64) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:Checkout")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	if !model.SameItems(r.storage[userID], items) {
+		return model.ErrCartChanged
+	}
+
+	r.storage[userID] = nil
+	r.checkouts[userID] = orderID
+	delete(r.promos, userID)
+	r.touch(userID)
+
+	return nil
+}
+
+// Merge переносит позиции корзины sourceUserID в корзину targetUserID, ограничивая количество остатком
+// из available и лимитами корзины. Если исходная корзина не совпадает с source, ничего не меняется и возвращается ErrCartChanged
+func (r *InMemoryRepository) Merge(ctx context.Context, targetUserID, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) ([]model.MergedLine, error) {
+	_, span := r.tracer.Start(ctx, "CartRepo:Merge")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	sourceItems := r.storage[sourceUserID]
+	if !model.SameItems(sourceItems, source) {
+		return nil, model.ErrCartChanged
+	}
+
+	target := r.storage[targetUserID]
+	lines := model.MergeLines(target, sourceItems, available, limits)
+	now := time.Now()
+
+	for k, item := range sourceItems {
+		merged := lines[k].Merged
+		i := slices.IndexFunc(target, func(c model.Cart) bool { return c.SkuID == item.SkuID })
+
+		switch {
+		case i >= 0:
+			target[i].Count = merged
+			target[i].UpdatedAt = now
+		case merged > 0:
+			target = append(target, model.Cart{SkuID: item.SkuID, Count: merged, Price: item.Price, UpdatedAt: now})
+		}
+	}
+
+	r.storage[targetUserID] = target
+	delete(r.storage, sourceUserID)
+	delete(r.checkouts, targetUserID)
+	r.touch(targetUserID)
+	r.touch(sourceUserID)
+
+	return lines, nil
+}
+
+// GetCheckoutOrderID ...
+func (r *InMemoryRepository) GetCheckoutOrderID(ctx context.Context, userID int64) (int64, error) {
+	_, span := r.tracer.Start(ctx, "CartRepo:GetCheckoutOrderID")
+	defer span.End()
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	orderID, ok := r.checkouts[userID]
+	if !ok {
+		return 0, model.ErrNotFound
+	}
+
+	return orderID, nil
+}
+
+// SetPromoCode пустой code убирает промокод
+func (r *InMemoryRepository) SetPromoCode(ctx context.Context, userID int64, code string) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:SetPromoCode")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.touch(userID)
+
+	if code == "" {
+		delete(r.promos, userID)
+		return nil
+	}
+
+	r.promos[userID] = code
+
+	return nil
+}
+
+// GetPromoCode пустая строка - промокод не применен
+func (r *InMemoryRepository) GetPromoCode(ctx context.Context, userID int64) (string, error) {
+	_, span := r.tracer.Start(ctx, "CartRepo:GetPromoCode")
+	defer span.End()
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	return r.promos[userID], nil
+}
+
+// SaveForLater переносит позицию из корзины в отложенные, количество суммируется с уже отложенным
+func (r *InMemoryRepository) SaveForLater(ctx context.Context, userID, sku int64) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:SaveForLater")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	item, ok := takeFromMemory(r.storage, userID, sku)
+	if !ok {
+		return model.ErrNotFound
+	}
+
+	putToMemory(r.saved, userID, item)
+	r.touch(userID)
+
+	return nil
+}
+
+// MoveToCart переносит отложенную позицию в корзину по цене item.Price
+func (r *InMemoryRepository) MoveToCart(ctx context.Context, item model.RequestData) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:MoveToCart")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	saved, ok := takeFromMemory(r.saved, item.UserID, item.Sku)
+	if !ok {
+		return model.ErrNotFound
+	}
+
+	saved.Price = item.Price
+	putToMemory(r.storage, item.UserID, saved)
+	delete(r.checkouts, item.UserID)
+	r.touch(item.UserID)
+
+	return nil
+}
+
+// GetSavedItems ...
+func (r *InMemoryRepository) GetSavedItems(ctx context.Context, userID int64) ([]model.Cart, error) {
+	_, span := r.tracer.Start(ctx, "CartRepo:GetSavedItems")
+	defer span.End()
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	items := r.saved[userID]
+	if len(items) < 1 {
+		return nil, model.ErrNotFound
+	}
+
+	return slices.Clone(items), nil
+}
+
+// DeleteSavedItem ...
+func (r *InMemoryRepository) DeleteSavedItem(ctx context.Context, userID, sku int64) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:DeleteSavedItem")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	takeFromMemory(r.saved, userID, sku)
+
+	return nil
+}
+
+// GetVersion версия корзины меняется при каждом изменении позиций, промокода и оформлении заказа
+func (r *InMemoryRepository) GetVersion(ctx context.Context, userID int64) (uint64, error) {
+	_, span := r.tracer.Start(ctx, "CartRepo:GetVersion")
+	defer span.End()
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	return r.versions[userID], nil
+}
+
+// ReserveIdempotencyKey занимает ключ на ttl. Если ключ уже занят, возвращает его запись и false
+func (r *InMemoryRepository) ReserveIdempotencyKey(ctx context.Context, userID int64, key string, ttl time.Duration) (model.IdempotencyRecord, bool, error) {
+	_, span := r.tracer.Start(ctx, "CartRepo:ReserveIdempotencyKey")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	now := time.Now()
+	k := idempotencyStoreKey(userID, key)
+	if existing, ok := r.idemp[k]; ok && now.Before(existing.expiresAt) {
+		return existing.record, false, nil
+	}
+
+	r.idemp[k] = idempotencyKey{expiresAt: now.Add(ttl)}
+
+	return model.IdempotencyRecord{}, true, nil
+}
+
+// CompleteIdempotencyKey сохраняет заказ, созданный по ключу, и отпечаток корзины на ttl
+func (r *InMemoryRepository) CompleteIdempotencyKey(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:CompleteIdempotencyKey")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.idemp[idempotencyStoreKey(userID, key)] = idempotencyKey{
+		record:    model.IdempotencyRecord{OrderID: orderID, Fingerprint: fingerprint},
+		expiresAt: time.Now().Add(ttl),
+	}
+
+	return nil
+}
+
+// ReleaseIdempotencyKey освобождает ключ, по которому заказ не был создан
+func (r *InMemoryRepository) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:ReleaseIdempotencyKey")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	k := idempotencyStoreKey(userID, key)
+	if existing, ok := r.idemp[k]; ok && existing.record.OrderID == 0 {
+		delete(r.idemp, k)
+	}
+
+	return nil
+}
+
+// deleteExpiredIdempotencyKeys вызывается под r.mx
+func (r *InMemoryRepository) deleteExpiredIdempotencyKeys(now time.Time) {
+	for k, existing := range r.idemp {
+		if !now.Before(existing.expiresAt) {
+			delete(r.idemp, k)
+		}
+	}
+}
+
+// GetAbandonedCarts корзины, которые не менялись с before и о которых еще не отправлено событие, самые старые первыми
+func (r *InMemoryRepository) GetAbandonedCarts(ctx context.Context, before time.Time, limit int) ([]model.AbandonedCart, error) {
+	_, span := r.tracer.Start(ctx, "CartRepo:GetAbandonedCarts")
+	defer span.End()
+
+	r.mx.RLock()
+	defer r.mx.RUnlock()
+
+	var carts []model.AbandonedCart
+	for userID, items := range r.storage {
+		if len(items) < 1 {
+			continue
+		}
+
+		updatedAt := r.updated[userID]
+		if !updatedAt.Before(before) {
+			continue
+		}
+
+		if reported, ok := r.abandoned[userID]; ok && !reported.Before(updatedAt) {
+			continue
+		}
+
+		carts = append(carts, model.AbandonedCart{UserID: userID, UpdatedAt: updatedAt})
+	}
+
+	sort.Slice(carts, func(i, j int) bool {
+		return carts[i].UpdatedAt.Before(carts[j].UpdatedAt)
+	})
+
+	if limit > 0 && len(carts) > limit {
+		carts = carts[:limit]
+	}
+
+	return carts, nil
+}
+
+// MarkAbandonedReported запоминает, что о корзине с изменением updatedAt событие уже отправлено
+func (r *InMemoryRepository) MarkAbandonedReported(ctx context.Context, userID int64, updatedAt time.Time) error {
+	_, span := r.tracer.Start(ctx, "CartRepo:MarkAbandonedReported")
+	defer span.End()
+
+	r.mx.Lock()
+	defer r.mx.Unlock()
+
+	r.abandoned[userID] = updatedAt
+
+	return nil
+}
+
+// touch увеличивает версию корзины и время ее изменения, вызывается под r.mx
+func (r *InMemoryRepository) touch(userID int64) {
+	if _, ok := r.versions[userID]; !ok {
+		r.versions[userID] = r.versionBase
+	}
+	r.versions[userID]++
+	r.updated[userID] = time.Now()
+}
+
+// takeFromMemory удаляет позицию из списка пользователя и возвращает ее, вызывается под мьютексом
+func takeFromMemory(storage model.Storage, userID, sku int64) (model.Cart, bool) {
+	items := storage[userID]
+
+	i := slices.IndexFunc(items, func(c model.Cart) bool { return c.SkuID == sku })
+	if i < 0 {
+		return model.Cart{}, false
+	}
+
+	item := items[i]
+	storage[userID] = deleteFromMemory(items, i)
+
+	return item, true
+}
+
+// putToMemory добавляет позицию в список пользователя, количество суммируется, цена заменяется.
+// Вызывается под мьютексом
+func putToMemory(storage model.Storage, userID int64, item model.Cart) {
+	items := storage[userID]
+	item.UpdatedAt = time.Now()
+
+	i := slices.IndexFunc(items, func(c model.Cart) bool { return c.SkuID == item.SkuID })
+	if i < 0 {
+		storage[userID] = append(items, item)
+		return
+	}
+
+	items[i].Count = model.AddCounts(items[i].Count, item.Count)
+	items[i].Price = item.Price
+	items[i].UpdatedAt = item.UpdatedAt
+}
+
 func deleteFromMemory(items []model.Cart, i int) []model.Cart {
 	copy(items[i:], items[i+1:]) //i=2 1 2 3 4 5 -> 1 2 4 5 5
 	items = items[:len(items)-1]
@@ -150,3 +559,8 @@ func (r *InMemoryRepository) Close() {
 	r.done <- struct{}{}
 	close(r.done)
 }
+
+// idempotencyStoreKey ...
+func idempotencyStoreKey(userID int64, key string) string {
+	return strconv.FormatInt(userID, 10) + ":" + key
+}
@@ -4,9 +4,11 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"math/rand"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
@@ -308,6 +310,129 @@ func TestAdd(t *testing.T) {
 
 }
 
+func TestCountOverflow(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	item := model.RequestData{UserID: 1, Sku: 1, Count: math.MaxUint32 - 1, Price: 100}
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	require.NoError(t, repo.Add(ctx, item))
+	require.NoError(t, repo.Add(ctx, item))
+
+	items, err := repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: math.MaxUint32, Price: item.Price}}, withoutUpdatedAt(items))
+
+	require.NoError(t, repo.SaveForLater(ctx, item.UserID, item.Sku))
+	require.NoError(t, repo.Add(ctx, item))
+	require.NoError(t, repo.MoveToCart(ctx, item))
+
+	items, err = repo.GetItemsByUserID(ctx, item)
+	require.NoError(t, err)
+	assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: math.MaxUint32, Price: item.Price}}, withoutUpdatedAt(items))
+}
+
+func TestSetCount(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	item := model.RequestData{
+		UserID: 1,
+		Sku:    1,
+		Count:  5,
+	}
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	t.Run("set count for missing item", func(t *testing.T) {
+		err := repo.SetCount(ctx, item)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("set count for existing item", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, item))
+
+		err := repo.SetCount(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Count: 2})
+		require.NoError(t, err)
+
+		items, err := repo.GetItemsByUserID(ctx, item)
+		require.NoError(t, err)
+		require.Len(t, items, 1)
+		assert.Equal(t, uint32(2), items[0].Count)
+	})
+
+	t.Run("zero count removes item", func(t *testing.T) {
+		err := repo.SetCount(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku})
+		require.NoError(t, err)
+
+		_, err = repo.GetItemsByUserID(ctx, item)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestCheckout(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	item := model.RequestData{
+		UserID: 1,
+		Sku:    1,
+		Count:  5,
+	}
+	ordered := []model.Cart{{SkuID: item.Sku, Count: item.Count}}
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	t.Run("changed cart is not claimed", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, item))
+
+		err := repo.Checkout(ctx, item.UserID, []model.Cart{{SkuID: item.Sku, Count: 1}}, 42)
+		require.ErrorIs(t, err, model.ErrCartChanged)
+
+		items, err := repo.GetItemsByUserID(ctx, item)
+		require.NoError(t, err)
+		assert.Equal(t, ordered, withoutUpdatedAt(items))
+
+		_, err = repo.GetCheckoutOrderID(ctx, item.UserID)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("claimed cart is cleared and remembers order", func(t *testing.T) {
+		require.NoError(t, repo.Checkout(ctx, item.UserID, ordered, 42))
+
+		_, err := repo.GetItemsByUserID(ctx, item)
+		require.ErrorIs(t, err, model.ErrNotFound)
+
+		orderID, err := repo.GetCheckoutOrderID(ctx, item.UserID)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), orderID)
+
+		err = repo.Checkout(ctx, item.UserID, ordered, 43)
+		require.ErrorIs(t, err, model.ErrCartChanged)
+	})
+
+	t.Run("add resets checkout", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, item))
+
+		_, err := repo.GetCheckoutOrderID(ctx, item.UserID)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
 func TestRepo_Goroutine(t *testing.T) {
 	t.Parallel()
 	ctx := context.Background()
@@ -545,3 +670,379 @@ func TestRepo_Goroutine(t *testing.T) {
 func TestMain(m *testing.M) {
 	goleak.VerifyTestMain(m)
 }
+
+func TestMerge(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const (
+		targetUserID = int64(1)
+		sourceUserID = int64(2)
+	)
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: targetUserID, Sku: 1, Count: 2, Price: 100}))
+	repo.checkouts[targetUserID] = 42
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 1, Count: 3, Price: 90}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 2, Count: 4, Price: 50}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 3, Count: 1, Price: 10}))
+
+	source, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: sourceUserID})
+	require.NoError(t, err)
+
+	t.Run("changed source cart is not merged", func(t *testing.T) {
+		_, err := repo.Merge(ctx, targetUserID, sourceUserID, source[:1], nil, model.CartLimits{})
+		require.ErrorIs(t, err, model.ErrCartChanged)
+
+		items, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: sourceUserID})
+		require.NoError(t, err)
+		assert.Equal(t, source, items)
+	})
+
+	t.Run("counts are summed and capped by stock", func(t *testing.T) {
+		lines, err := repo.Merge(ctx, targetUserID, sourceUserID, source, map[int64]uint32{1: 4, 2: 10}, model.CartLimits{})
+		require.NoError(t, err)
+		assert.Equal(t, []model.MergedLine{
+			{Sku: 1, Requested: 5, Merged: 4},
+			{Sku: 2, Requested: 4, Merged: 4},
+			{Sku: 3, Requested: 1, Merged: 0},
+		}, lines)
+
+		items, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: targetUserID})
+		require.NoError(t, err)
+		assert.Equal(t, []model.Cart{
+			{SkuID: 1, Count: 4, Price: 100},
+			{SkuID: 2, Count: 4, Price: 50},
+		}, withoutUpdatedAt(items))
+
+		_, err = repo.GetItemsByUserID(ctx, model.RequestData{UserID: sourceUserID})
+		require.ErrorIs(t, err, model.ErrNotFound)
+
+		_, err = repo.GetCheckoutOrderID(ctx, targetUserID)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestMergeLimits(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	const (
+		targetUserID = int64(1)
+		sourceUserID = int64(2)
+	)
+
+	available := map[int64]uint32{1: 100, 2: 100, 3: 100}
+
+	tests := []struct {
+		name          string
+		limits        model.CartLimits
+		expectedLines []model.MergedLine
+		expectedItems []model.Cart
+	}{
+		{
+			name:   "sku count is capped",
+			limits: model.CartLimits{MaxSkuCount: 3},
+			expectedLines: []model.MergedLine{
+				{Sku: 1, Requested: 5, Merged: 3},
+				{Sku: 2, Requested: 4, Merged: 3},
+				{Sku: 3, Requested: 1, Merged: 1},
+			},
+			expectedItems: []model.Cart{
+				{SkuID: 1, Count: 3, Price: 100},
+				{SkuID: 2, Count: 3, Price: 50},
+				{SkuID: 3, Count: 1, Price: 10},
+			},
+		},
+		{
+			name:   "lines over limit are not merged",
+			limits: model.CartLimits{MaxLines: 2},
+			expectedLines: []model.MergedLine{
+				{Sku: 1, Requested: 5, Merged: 5},
+				{Sku: 2, Requested: 4, Merged: 4},
+				{Sku: 3, Requested: 1, Merged: 0},
+			},
+			expectedItems: []model.Cart{
+				{SkuID: 1, Count: 5, Price: 100},
+				{SkuID: 2, Count: 4, Price: 50},
+			},
+		},
+		{
+			name:   "counts are trimmed to total",
+			limits: model.CartLimits{MaxTotal: 600},
+			expectedLines: []model.MergedLine{
+				{Sku: 1, Requested: 5, Merged: 5},
+				{Sku: 2, Requested: 4, Merged: 2},
+				{Sku: 3, Requested: 1, Merged: 0},
+			},
+			expectedItems: []model.Cart{
+				{SkuID: 1, Count: 5, Price: 100},
+				{SkuID: 2, Count: 2, Price: 50},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			tracer := mocks.NewTracerMock(t)
+			tracer.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+			repo := NewInMemoryRepository(tracer)
+			defer repo.Close()
+
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: targetUserID, Sku: 1, Count: 2, Price: 100}))
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 1, Count: 3, Price: 90}))
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 2, Count: 4, Price: 50}))
+			require.NoError(t, repo.Add(ctx, model.RequestData{UserID: sourceUserID, Sku: 3, Count: 1, Price: 10}))
+
+			source, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: sourceUserID})
+			require.NoError(t, err)
+
+			// Execute
+			lines, err := repo.Merge(ctx, targetUserID, sourceUserID, source, available, tt.limits)
+
+			// Verify
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedLines, lines)
+
+			items, err := repo.GetItemsByUserID(ctx, model.RequestData{UserID: targetUserID})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedItems, withoutUpdatedAt(items))
+		})
+	}
+}
+
+func TestSavedItems(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	item := model.RequestData{UserID: 1, Sku: 1, Count: 2, Price: 100}
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	t.Run("save missing item", func(t *testing.T) {
+		err := repo.SaveForLater(ctx, item.UserID, item.Sku)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("save moves item out of cart", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, item))
+		require.NoError(t, repo.SaveForLater(ctx, item.UserID, item.Sku))
+
+		_, err := repo.GetItemsByUserID(ctx, item)
+		require.ErrorIs(t, err, model.ErrNotFound)
+
+		saved, err := repo.GetSavedItems(ctx, item.UserID)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: item.Count, Price: item.Price}}, withoutUpdatedAt(saved))
+	})
+
+	t.Run("move sums count and takes new price", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Count: 1, Price: 100}))
+		repo.checkouts[item.UserID] = 42
+
+		require.NoError(t, repo.MoveToCart(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Price: 120}))
+
+		items, err := repo.GetItemsByUserID(ctx, item)
+		require.NoError(t, err)
+		assert.Equal(t, []model.Cart{{SkuID: item.Sku, Count: 3, Price: 120}}, withoutUpdatedAt(items))
+
+		_, err = repo.GetSavedItems(ctx, item.UserID)
+		require.ErrorIs(t, err, model.ErrNotFound)
+
+		_, err = repo.GetCheckoutOrderID(ctx, item.UserID)
+		require.ErrorIs(t, err, model.ErrNotFound)
+
+		err = repo.MoveToCart(ctx, item)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+
+	t.Run("delete saved item", func(t *testing.T) {
+		require.NoError(t, repo.SaveForLater(ctx, item.UserID, item.Sku))
+		require.NoError(t, repo.DeleteSavedItem(ctx, item.UserID, item.Sku))
+
+		_, err := repo.GetSavedItems(ctx, item.UserID)
+		require.ErrorIs(t, err, model.ErrNotFound)
+	})
+}
+
+func TestPromoCode(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+	item := model.RequestData{UserID: 1, Sku: 1, Count: 1}
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	code, err := repo.GetPromoCode(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Empty(t, code)
+
+	require.NoError(t, repo.SetPromoCode(ctx, item.UserID, "WELCOME10"))
+	code, err = repo.GetPromoCode(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, "WELCOME10", code)
+
+	require.NoError(t, repo.SetPromoCode(ctx, item.UserID, ""))
+	code, err = repo.GetPromoCode(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Empty(t, code)
+
+	t.Run("checkout removes promo code", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, item))
+		require.NoError(t, repo.SetPromoCode(ctx, item.UserID, "WELCOME10"))
+		require.NoError(t, repo.Checkout(ctx, item.UserID, []model.Cart{{SkuID: item.Sku, Count: item.Count}}, 42))
+
+		code, err := repo.GetPromoCode(ctx, item.UserID)
+		require.NoError(t, err)
+		assert.Empty(t, code)
+	})
+}
+
+func TestVersion(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	item := model.RequestData{UserID: 1, Sku: 1, Count: 1}
+
+	version, err := repo.GetVersion(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Zero(t, version)
+
+	changes := []func() error{
+		func() error { return repo.Add(ctx, item) },
+		func() error {
+			return repo.SetCount(ctx, model.RequestData{UserID: item.UserID, Sku: item.Sku, Count: 2})
+		},
+		func() error { return repo.SetPromoCode(ctx, item.UserID, "WELCOME10") },
+		func() error { return repo.SaveForLater(ctx, item.UserID, item.Sku) },
+		func() error { return repo.MoveToCart(ctx, item) },
+		func() error { return repo.Checkout(ctx, item.UserID, []model.Cart{{SkuID: item.Sku, Count: 2}}, 42) },
+	}
+
+	for _, change := range changes {
+		require.NoError(t, change())
+
+		next, err := repo.GetVersion(ctx, item.UserID)
+		require.NoError(t, err)
+		assert.Greater(t, next, version)
+		version = next
+	}
+
+	_, err = repo.GetItemsByUserID(ctx, item)
+	require.ErrorIs(t, err, model.ErrNotFound)
+
+	next, err := repo.GetVersion(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Equal(t, version, next)
+
+	other, err := repo.GetVersion(ctx, 2)
+	require.NoError(t, err)
+	assert.Zero(t, other)
+
+	// после перезапуска версии не повторяют выданные раньше
+	restarted := NewInMemoryRepository(tracer)
+	defer restarted.Close()
+
+	require.NoError(t, restarted.Add(ctx, item))
+	next, err = restarted.GetVersion(ctx, item.UserID)
+	require.NoError(t, err)
+	assert.Greater(t, next, version)
+}
+
+func TestAbandonedCarts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	tracer := mocks.NewTracerMock(t)
+	tracer.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+	repo := NewInMemoryRepository(tracer)
+	defer repo.Close()
+
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 1, Sku: 1, Count: 1}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 2, Sku: 1, Count: 1}))
+	require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 3, Sku: 1, Count: 1}))
+	require.ErrorIs(t, repo.DeleteAllItemsFromCart(ctx, model.RequestData{UserID: 3}), model.ErrNoContent)
+
+	carts, err := repo.GetAbandonedCarts(ctx, time.Now().Add(-time.Hour), 10)
+	require.NoError(t, err)
+	assert.Empty(t, carts)
+
+	before := time.Now().Add(time.Hour)
+
+	carts, err = repo.GetAbandonedCarts(ctx, before, 10)
+	require.NoError(t, err)
+	require.Len(t, carts, 2)
+	assert.Equal(t, int64(1), carts[0].UserID)
+	assert.Equal(t, int64(2), carts[1].UserID)
+
+	carts, err = repo.GetAbandonedCarts(ctx, before, 1)
+	require.NoError(t, err)
+	require.Len(t, carts, 1)
+
+	t.Run("reported cart is skipped until it changes", func(t *testing.T) {
+		require.NoError(t, repo.MarkAbandonedReported(ctx, carts[0].UserID, carts[0].UpdatedAt))
+
+		got, err := repo.GetAbandonedCarts(ctx, before, 10)
+		require.NoError(t, err)
+		require.Len(t, got, 1)
+		assert.Equal(t, int64(2), got[0].UserID)
+
+		require.NoError(t, repo.SetCount(ctx, model.RequestData{UserID: 1, Sku: 1, Count: 2}))
+
+		got, err = repo.GetAbandonedCarts(ctx, before, 10)
+		require.NoError(t, err)
+		assert.Len(t, got, 2)
+	})
+
+	t.Run("deleted line counts as change", func(t *testing.T) {
+		require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 4, Sku: 1, Count: 1}))
+		require.NoError(t, repo.Add(ctx, model.RequestData{UserID: 4, Sku: 2, Count: 1}))
+
+		deletedAfter := time.Now()
+		time.Sleep(time.Millisecond)
+		require.ErrorIs(t, repo.DeleteItemsBySku(ctx, model.RequestData{UserID: 4, Sku: 2}), model.ErrNoContent)
+
+		got, err := repo.GetAbandonedCarts(ctx, deletedAfter, 10)
+		require.NoError(t, err)
+		for _, cart := range got {
+			assert.NotEqual(t, int64(4), cart.UserID)
+		}
+	})
+}
+
+// withoutUpdatedAt ...
+func withoutUpdatedAt(items []model.Cart) []model.Cart {
+	for i := range items {
+		items[i].UpdatedAt = time.Time{}
+	}
+
+	return items
+}
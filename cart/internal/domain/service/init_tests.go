@@ -3,17 +3,19 @@ package service
 import (
 	"testing"
 
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
 	mock "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service/mocks"
 	"github.com/gojuno/minimock/v3"
-	"golang.org/x/time/rate" //go 1.23.4 go get golang.org/x/time@v0.10.0
 )
 
 type testServiceComponent struct {
-	mockPS    *mock.ProductServiceMock
-	mockRepo  *mock.RepositoryMock
-	mockLoms  *mock.LomsMock
-	mockTrace *mock.TracerMock
-	service   *Service
+	mockPS     *mock.ProductServiceMock
+	mockRepo   *mock.RepositoryMock
+	mockLoms   *mock.LomsMock
+	mockTrace  *mock.TracerMock
+	mockPromo  *mock.PromoEngineMock
+	mockEvents *mock.EventProducerMock
+	service    *Service
 }
 
 func setupTest(t *testing.T) testServiceComponent {
@@ -21,17 +23,20 @@ func setupTest(t *testing.T) testServiceComponent {
 	mockPS := mock.NewProductServiceMock(mc)
 	mockRepo := mock.NewRepositoryMock(mc)
 	mockLoms := mock.NewLomsMock(mc)
-	limiterPS := rate.NewLimiter(rate.Limit(10), 10)
 
 	mockTrace := mock.NewTracerMock(mc)
+	mockPromo := mock.NewPromoEngineMock(mc)
+	mockEvents := mock.NewEventProducerMock(mc)
 
-	service := NewService(mockPS, mockRepo, mockLoms, limiterPS, mockTrace)
+	service := NewService(mockPS, mockRepo, mockLoms, mockTrace, model.CartLimits{}, nil, nil)
 
 	return testServiceComponent{
-		mockPS:    mockPS,
-		mockRepo:  mockRepo,
-		mockLoms:  mockLoms,
-		mockTrace: mockTrace,
-		service:   service,
+		mockPS:     mockPS,
+		mockRepo:   mockRepo,
+		mockLoms:   mockLoms,
+		mockTrace:  mockTrace,
+		mockPromo:  mockPromo,
+		mockEvents: mockEvents,
+		service:    service,
 	}
 }
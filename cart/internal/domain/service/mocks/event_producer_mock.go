@@ -0,0 +1,702 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.4). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service.EventProducer -o event_producer_mock.go -n EventProducerMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// EventProducerMock implements mm_service.EventProducer
+type EventProducerMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcSend          func(event model.CartEvent)
+	funcSendOrigin    string
+	inspectFuncSend   func(event model.CartEvent)
+	afterSendCounter  uint64
+	beforeSendCounter uint64
+	SendMock          mEventProducerMockSend
+
+	funcSendAbandonedCart          func(cart model.AbandonedCart) (err error)
+	funcSendAbandonedCartOrigin    string
+	inspectFuncSendAbandonedCart   func(cart model.AbandonedCart)
+	afterSendAbandonedCartCounter  uint64
+	beforeSendAbandonedCartCounter uint64
+	SendAbandonedCartMock          mEventProducerMockSendAbandonedCart
+}
+
+// NewEventProducerMock returns a mock for mm_service.EventProducer
+func NewEventProducerMock(t minimock.Tester) *EventProducerMock {
+	m := &EventProducerMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.SendMock = mEventProducerMockSend{mock: m}
+	m.SendMock.callArgs = []*EventProducerMockSendParams{}
+
+	m.SendAbandonedCartMock = mEventProducerMockSendAbandonedCart{mock: m}
+	m.SendAbandonedCartMock.callArgs = []*EventProducerMockSendAbandonedCartParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mEventProducerMockSend struct {
+	optional           bool
+	mock               *EventProducerMock
+	defaultExpectation *EventProducerMockSendExpectation
+	expectations       []*EventProducerMockSendExpectation
+
+	callArgs []*EventProducerMockSendParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventProducerMockSendExpectation specifies expectation struct of the EventProducer.Send
+type EventProducerMockSendExpectation struct {
+	mock               *EventProducerMock
+	params             *EventProducerMockSendParams
+	paramPtrs          *EventProducerMockSendParamPtrs
+	expectationOrigins EventProducerMockSendExpectationOrigins
+
+	returnOrigin string
+	Counter      uint64
+}
+
+// EventProducerMockSendParams contains parameters of the EventProducer.Send
+type EventProducerMockSendParams struct {
+	event model.CartEvent
+}
+
+// EventProducerMockSendParamPtrs contains pointers to parameters of the EventProducer.Send
+type EventProducerMockSendParamPtrs struct {
+	event *model.CartEvent
+}
+
+// EventProducerMockSendOrigins contains origins of expectations of the EventProducer.Send
+type EventProducerMockSendExpectationOrigins struct {
+	origin      string
+	originEvent string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSend *mEventProducerMockSend) Optional() *mEventProducerMockSend {
+	mmSend.optional = true
+	return mmSend
+}
+
+// Expect sets up expected params for EventProducer.Send
+func (mmSend *mEventProducerMockSend) Expect(event model.CartEvent) *mEventProducerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("EventProducerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &EventProducerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.paramPtrs != nil {
+		mmSend.mock.t.Fatalf("EventProducerMock.Send mock is already set by ExpectParams functions")
+	}
+
+	mmSend.defaultExpectation.params = &EventProducerMockSendParams{event}
+	mmSend.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSend.expectations {
+		if minimock.Equal(e.params, mmSend.defaultExpectation.params) {
+			mmSend.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSend.defaultExpectation.params)
+		}
+	}
+
+	return mmSend
+}
+
+// ExpectEventParam1 sets up expected param event for EventProducer.Send
+func (mmSend *mEventProducerMockSend) ExpectEventParam1(event model.CartEvent) *mEventProducerMockSend {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("EventProducerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &EventProducerMockSendExpectation{}
+	}
+
+	if mmSend.defaultExpectation.params != nil {
+		mmSend.mock.t.Fatalf("EventProducerMock.Send mock is already set by Expect")
+	}
+
+	if mmSend.defaultExpectation.paramPtrs == nil {
+		mmSend.defaultExpectation.paramPtrs = &EventProducerMockSendParamPtrs{}
+	}
+	mmSend.defaultExpectation.paramPtrs.event = &event
+	mmSend.defaultExpectation.expectationOrigins.originEvent = minimock.CallerInfo(1)
+
+	return mmSend
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventProducer.Send
+func (mmSend *mEventProducerMockSend) Inspect(f func(event model.CartEvent)) *mEventProducerMockSend {
+	if mmSend.mock.inspectFuncSend != nil {
+		mmSend.mock.t.Fatalf("Inspect function is already set for EventProducerMock.Send")
+	}
+
+	mmSend.mock.inspectFuncSend = f
+
+	return mmSend
+}
+
+// Return sets up results that will be returned by EventProducer.Send
+func (mmSend *mEventProducerMockSend) Return() *EventProducerMock {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("EventProducerMock.Send mock is already set by Set")
+	}
+
+	if mmSend.defaultExpectation == nil {
+		mmSend.defaultExpectation = &EventProducerMockSendExpectation{mock: mmSend.mock}
+	}
+
+	mmSend.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// Set uses given function f to mock the EventProducer.Send method
+func (mmSend *mEventProducerMockSend) Set(f func(event model.CartEvent)) *EventProducerMock {
+	if mmSend.defaultExpectation != nil {
+		mmSend.mock.t.Fatalf("Default expectation is already set for the EventProducer.Send method")
+	}
+
+	if len(mmSend.expectations) > 0 {
+		mmSend.mock.t.Fatalf("Some expectations are already set for the EventProducer.Send method")
+	}
+
+	mmSend.mock.funcSend = f
+	mmSend.mock.funcSendOrigin = minimock.CallerInfo(1)
+	return mmSend.mock
+}
+
+// When sets expectation for the EventProducer.Send which will trigger the result defined by the following
+// Then helper
+func (mmSend *mEventProducerMockSend) When(event model.CartEvent) *EventProducerMockSendExpectation {
+	if mmSend.mock.funcSend != nil {
+		mmSend.mock.t.Fatalf("EventProducerMock.Send mock is already set by Set")
+	}
+
+	expectation := &EventProducerMockSendExpectation{
+		mock:               mmSend.mock,
+		params:             &EventProducerMockSendParams{event},
+		expectationOrigins: EventProducerMockSendExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSend.expectations = append(mmSend.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventProducer.Send return parameters for the expectation previously defined by the When method
+
+func (e *EventProducerMockSendExpectation) Then() *EventProducerMock {
+	return e.mock
+}
+
+// Times sets number of times EventProducer.Send should be invoked
+func (mmSend *mEventProducerMockSend) Times(n uint64) *mEventProducerMockSend {
+	if n == 0 {
+		mmSend.mock.t.Fatalf("Times of EventProducerMock.Send mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSend.expectedInvocations, n)
+	mmSend.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSend
+}
+
+func (mmSend *mEventProducerMockSend) invocationsDone() bool {
+	if len(mmSend.expectations) == 0 && mmSend.defaultExpectation == nil && mmSend.mock.funcSend == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSend.mock.afterSendCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSend.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Send implements mm_service.EventProducer
+func (mmSend *EventProducerMock) Send(event model.CartEvent) {
+	mm_atomic.AddUint64(&mmSend.beforeSendCounter, 1)
+	defer mm_atomic.AddUint64(&mmSend.afterSendCounter, 1)
+
+	mmSend.t.Helper()
+
+	if mmSend.inspectFuncSend != nil {
+		mmSend.inspectFuncSend(event)
+	}
+
+	mm_params := EventProducerMockSendParams{event}
+
+	// Record call args
+	mmSend.SendMock.mutex.Lock()
+	mmSend.SendMock.callArgs = append(mmSend.SendMock.callArgs, &mm_params)
+	mmSend.SendMock.mutex.Unlock()
+
+	for _, e := range mmSend.SendMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return
+		}
+	}
+
+	if mmSend.SendMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSend.SendMock.defaultExpectation.Counter, 1)
+		mm_want := mmSend.SendMock.defaultExpectation.params
+		mm_want_ptrs := mmSend.SendMock.defaultExpectation.paramPtrs
+
+		mm_got := EventProducerMockSendParams{event}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.event != nil && !minimock.Equal(*mm_want_ptrs.event, mm_got.event) {
+				mmSend.t.Errorf("EventProducerMock.Send got unexpected parameter event, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSend.SendMock.defaultExpectation.expectationOrigins.originEvent, *mm_want_ptrs.event, mm_got.event, minimock.Diff(*mm_want_ptrs.event, mm_got.event))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSend.t.Errorf("EventProducerMock.Send got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSend.SendMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		return
+
+	}
+	if mmSend.funcSend != nil {
+		mmSend.funcSend(event)
+		return
+	}
+	mmSend.t.Fatalf("Unexpected call to EventProducerMock.Send. %v", event)
+
+}
+
+// SendAfterCounter returns a count of finished EventProducerMock.Send invocations
+func (mmSend *EventProducerMock) SendAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.afterSendCounter)
+}
+
+// SendBeforeCounter returns a count of EventProducerMock.Send invocations
+func (mmSend *EventProducerMock) SendBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSend.beforeSendCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventProducerMock.Send.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSend *mEventProducerMockSend) Calls() []*EventProducerMockSendParams {
+	mmSend.mutex.RLock()
+
+	argCopy := make([]*EventProducerMockSendParams, len(mmSend.callArgs))
+	copy(argCopy, mmSend.callArgs)
+
+	mmSend.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendDone returns true if the count of the Send invocations corresponds
+// the number of defined expectations
+func (m *EventProducerMock) MinimockSendDone() bool {
+	if m.SendMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendMock.invocationsDone()
+}
+
+// MinimockSendInspect logs each unmet expectation
+func (m *EventProducerMock) MinimockSendInspect() {
+	for _, e := range m.SendMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventProducerMock.Send at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendCounter := mm_atomic.LoadUint64(&m.afterSendCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendMock.defaultExpectation != nil && afterSendCounter < 1 {
+		if m.SendMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventProducerMock.Send at\n%s", m.SendMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventProducerMock.Send at\n%s with params: %#v", m.SendMock.defaultExpectation.expectationOrigins.origin, *m.SendMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSend != nil && afterSendCounter < 1 {
+		m.t.Errorf("Expected call to EventProducerMock.Send at\n%s", m.funcSendOrigin)
+	}
+
+	if !m.SendMock.invocationsDone() && afterSendCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventProducerMock.Send at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendMock.expectedInvocations), m.SendMock.expectedInvocationsOrigin, afterSendCounter)
+	}
+}
+
+type mEventProducerMockSendAbandonedCart struct {
+	optional           bool
+	mock               *EventProducerMock
+	defaultExpectation *EventProducerMockSendAbandonedCartExpectation
+	expectations       []*EventProducerMockSendAbandonedCartExpectation
+
+	callArgs []*EventProducerMockSendAbandonedCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// EventProducerMockSendAbandonedCartExpectation specifies expectation struct of the EventProducer.SendAbandonedCart
+type EventProducerMockSendAbandonedCartExpectation struct {
+	mock               *EventProducerMock
+	params             *EventProducerMockSendAbandonedCartParams
+	paramPtrs          *EventProducerMockSendAbandonedCartParamPtrs
+	expectationOrigins EventProducerMockSendAbandonedCartExpectationOrigins
+	results            *EventProducerMockSendAbandonedCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// EventProducerMockSendAbandonedCartParams contains parameters of the EventProducer.SendAbandonedCart
+type EventProducerMockSendAbandonedCartParams struct {
+	cart model.AbandonedCart
+}
+
+// EventProducerMockSendAbandonedCartParamPtrs contains pointers to parameters of the EventProducer.SendAbandonedCart
+type EventProducerMockSendAbandonedCartParamPtrs struct {
+	cart *model.AbandonedCart
+}
+
+// EventProducerMockSendAbandonedCartResults contains results of the EventProducer.SendAbandonedCart
+type EventProducerMockSendAbandonedCartResults struct {
+	err error
+}
+
+// EventProducerMockSendAbandonedCartOrigins contains origins of expectations of the EventProducer.SendAbandonedCart
+type EventProducerMockSendAbandonedCartExpectationOrigins struct {
+	origin     string
+	originCart string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) Optional() *mEventProducerMockSendAbandonedCart {
+	mmSendAbandonedCart.optional = true
+	return mmSendAbandonedCart
+}
+
+// Expect sets up expected params for EventProducer.SendAbandonedCart
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) Expect(cart model.AbandonedCart) *mEventProducerMockSendAbandonedCart {
+	if mmSendAbandonedCart.mock.funcSendAbandonedCart != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("EventProducerMock.SendAbandonedCart mock is already set by Set")
+	}
+
+	if mmSendAbandonedCart.defaultExpectation == nil {
+		mmSendAbandonedCart.defaultExpectation = &EventProducerMockSendAbandonedCartExpectation{}
+	}
+
+	if mmSendAbandonedCart.defaultExpectation.paramPtrs != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("EventProducerMock.SendAbandonedCart mock is already set by ExpectParams functions")
+	}
+
+	mmSendAbandonedCart.defaultExpectation.params = &EventProducerMockSendAbandonedCartParams{cart}
+	mmSendAbandonedCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSendAbandonedCart.expectations {
+		if minimock.Equal(e.params, mmSendAbandonedCart.defaultExpectation.params) {
+			mmSendAbandonedCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSendAbandonedCart.defaultExpectation.params)
+		}
+	}
+
+	return mmSendAbandonedCart
+}
+
+// ExpectCartParam1 sets up expected param cart for EventProducer.SendAbandonedCart
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) ExpectCartParam1(cart model.AbandonedCart) *mEventProducerMockSendAbandonedCart {
+	if mmSendAbandonedCart.mock.funcSendAbandonedCart != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("EventProducerMock.SendAbandonedCart mock is already set by Set")
+	}
+
+	if mmSendAbandonedCart.defaultExpectation == nil {
+		mmSendAbandonedCart.defaultExpectation = &EventProducerMockSendAbandonedCartExpectation{}
+	}
+
+	if mmSendAbandonedCart.defaultExpectation.params != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("EventProducerMock.SendAbandonedCart mock is already set by Expect")
+	}
+
+	if mmSendAbandonedCart.defaultExpectation.paramPtrs == nil {
+		mmSendAbandonedCart.defaultExpectation.paramPtrs = &EventProducerMockSendAbandonedCartParamPtrs{}
+	}
+	mmSendAbandonedCart.defaultExpectation.paramPtrs.cart = &cart
+	mmSendAbandonedCart.defaultExpectation.expectationOrigins.originCart = minimock.CallerInfo(1)
+
+	return mmSendAbandonedCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the EventProducer.SendAbandonedCart
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) Inspect(f func(cart model.AbandonedCart)) *mEventProducerMockSendAbandonedCart {
+	if mmSendAbandonedCart.mock.inspectFuncSendAbandonedCart != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("Inspect function is already set for EventProducerMock.SendAbandonedCart")
+	}
+
+	mmSendAbandonedCart.mock.inspectFuncSendAbandonedCart = f
+
+	return mmSendAbandonedCart
+}
+
+// Return sets up results that will be returned by EventProducer.SendAbandonedCart
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) Return(err error) *EventProducerMock {
+	if mmSendAbandonedCart.mock.funcSendAbandonedCart != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("EventProducerMock.SendAbandonedCart mock is already set by Set")
+	}
+
+	if mmSendAbandonedCart.defaultExpectation == nil {
+		mmSendAbandonedCart.defaultExpectation = &EventProducerMockSendAbandonedCartExpectation{mock: mmSendAbandonedCart.mock}
+	}
+	mmSendAbandonedCart.defaultExpectation.results = &EventProducerMockSendAbandonedCartResults{err}
+	mmSendAbandonedCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSendAbandonedCart.mock
+}
+
+// Set uses given function f to mock the EventProducer.SendAbandonedCart method
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) Set(f func(cart model.AbandonedCart) (err error)) *EventProducerMock {
+	if mmSendAbandonedCart.defaultExpectation != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("Default expectation is already set for the EventProducer.SendAbandonedCart method")
+	}
+
+	if len(mmSendAbandonedCart.expectations) > 0 {
+		mmSendAbandonedCart.mock.t.Fatalf("Some expectations are already set for the EventProducer.SendAbandonedCart method")
+	}
+
+	mmSendAbandonedCart.mock.funcSendAbandonedCart = f
+	mmSendAbandonedCart.mock.funcSendAbandonedCartOrigin = minimock.CallerInfo(1)
+	return mmSendAbandonedCart.mock
+}
+
+// When sets expectation for the EventProducer.SendAbandonedCart which will trigger the result defined by the following
+// Then helper
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) When(cart model.AbandonedCart) *EventProducerMockSendAbandonedCartExpectation {
+	if mmSendAbandonedCart.mock.funcSendAbandonedCart != nil {
+		mmSendAbandonedCart.mock.t.Fatalf("EventProducerMock.SendAbandonedCart mock is already set by Set")
+	}
+
+	expectation := &EventProducerMockSendAbandonedCartExpectation{
+		mock:               mmSendAbandonedCart.mock,
+		params:             &EventProducerMockSendAbandonedCartParams{cart},
+		expectationOrigins: EventProducerMockSendAbandonedCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmSendAbandonedCart.expectations = append(mmSendAbandonedCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up EventProducer.SendAbandonedCart return parameters for the expectation previously defined by the When method
+func (e *EventProducerMockSendAbandonedCartExpectation) Then(err error) *EventProducerMock {
+	e.results = &EventProducerMockSendAbandonedCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times EventProducer.SendAbandonedCart should be invoked
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) Times(n uint64) *mEventProducerMockSendAbandonedCart {
+	if n == 0 {
+		mmSendAbandonedCart.mock.t.Fatalf("Times of EventProducerMock.SendAbandonedCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmSendAbandonedCart.expectedInvocations, n)
+	mmSendAbandonedCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSendAbandonedCart
+}
+
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) invocationsDone() bool {
+	if len(mmSendAbandonedCart.expectations) == 0 && mmSendAbandonedCart.defaultExpectation == nil && mmSendAbandonedCart.mock.funcSendAbandonedCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmSendAbandonedCart.mock.afterSendAbandonedCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSendAbandonedCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// SendAbandonedCart implements mm_service.EventProducer
+func (mmSendAbandonedCart *EventProducerMock) SendAbandonedCart(cart model.AbandonedCart) (err error) {
+	mm_atomic.AddUint64(&mmSendAbandonedCart.beforeSendAbandonedCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmSendAbandonedCart.afterSendAbandonedCartCounter, 1)
+
+	mmSendAbandonedCart.t.Helper()
+
+	if mmSendAbandonedCart.inspectFuncSendAbandonedCart != nil {
+		mmSendAbandonedCart.inspectFuncSendAbandonedCart(cart)
+	}
+
+	mm_params := EventProducerMockSendAbandonedCartParams{cart}
+
+	// Record call args
+	mmSendAbandonedCart.SendAbandonedCartMock.mutex.Lock()
+	mmSendAbandonedCart.SendAbandonedCartMock.callArgs = append(mmSendAbandonedCart.SendAbandonedCartMock.callArgs, &mm_params)
+	mmSendAbandonedCart.SendAbandonedCartMock.mutex.Unlock()
+
+	for _, e := range mmSendAbandonedCart.SendAbandonedCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmSendAbandonedCart.SendAbandonedCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSendAbandonedCart.SendAbandonedCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmSendAbandonedCart.SendAbandonedCartMock.defaultExpectation.params
+		mm_want_ptrs := mmSendAbandonedCart.SendAbandonedCartMock.defaultExpectation.paramPtrs
+
+		mm_got := EventProducerMockSendAbandonedCartParams{cart}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.cart != nil && !minimock.Equal(*mm_want_ptrs.cart, mm_got.cart) {
+				mmSendAbandonedCart.t.Errorf("EventProducerMock.SendAbandonedCart got unexpected parameter cart, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSendAbandonedCart.SendAbandonedCartMock.defaultExpectation.expectationOrigins.originCart, *mm_want_ptrs.cart, mm_got.cart, minimock.Diff(*mm_want_ptrs.cart, mm_got.cart))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmSendAbandonedCart.t.Errorf("EventProducerMock.SendAbandonedCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSendAbandonedCart.SendAbandonedCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmSendAbandonedCart.SendAbandonedCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmSendAbandonedCart.t.Fatal("No results are set for the EventProducerMock.SendAbandonedCart")
+		}
+		return (*mm_results).err
+	}
+	if mmSendAbandonedCart.funcSendAbandonedCart != nil {
+		return mmSendAbandonedCart.funcSendAbandonedCart(cart)
+	}
+	mmSendAbandonedCart.t.Fatalf("Unexpected call to EventProducerMock.SendAbandonedCart. %v", cart)
+	return
+}
+
+// SendAbandonedCartAfterCounter returns a count of finished EventProducerMock.SendAbandonedCart invocations
+func (mmSendAbandonedCart *EventProducerMock) SendAbandonedCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendAbandonedCart.afterSendAbandonedCartCounter)
+}
+
+// SendAbandonedCartBeforeCounter returns a count of EventProducerMock.SendAbandonedCart invocations
+func (mmSendAbandonedCart *EventProducerMock) SendAbandonedCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSendAbandonedCart.beforeSendAbandonedCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to EventProducerMock.SendAbandonedCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmSendAbandonedCart *mEventProducerMockSendAbandonedCart) Calls() []*EventProducerMockSendAbandonedCartParams {
+	mmSendAbandonedCart.mutex.RLock()
+
+	argCopy := make([]*EventProducerMockSendAbandonedCartParams, len(mmSendAbandonedCart.callArgs))
+	copy(argCopy, mmSendAbandonedCart.callArgs)
+
+	mmSendAbandonedCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockSendAbandonedCartDone returns true if the count of the SendAbandonedCart invocations corresponds
+// the number of defined expectations
+func (m *EventProducerMock) MinimockSendAbandonedCartDone() bool {
+	if m.SendAbandonedCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.SendAbandonedCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.SendAbandonedCartMock.invocationsDone()
+}
+
+// MinimockSendAbandonedCartInspect logs each unmet expectation
+func (m *EventProducerMock) MinimockSendAbandonedCartInspect() {
+	for _, e := range m.SendAbandonedCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to EventProducerMock.SendAbandonedCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterSendAbandonedCartCounter := mm_atomic.LoadUint64(&m.afterSendAbandonedCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.SendAbandonedCartMock.defaultExpectation != nil && afterSendAbandonedCartCounter < 1 {
+		if m.SendAbandonedCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to EventProducerMock.SendAbandonedCart at\n%s", m.SendAbandonedCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to EventProducerMock.SendAbandonedCart at\n%s with params: %#v", m.SendAbandonedCartMock.defaultExpectation.expectationOrigins.origin, *m.SendAbandonedCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcSendAbandonedCart != nil && afterSendAbandonedCartCounter < 1 {
+		m.t.Errorf("Expected call to EventProducerMock.SendAbandonedCart at\n%s", m.funcSendAbandonedCartOrigin)
+	}
+
+	if !m.SendAbandonedCartMock.invocationsDone() && afterSendAbandonedCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to EventProducerMock.SendAbandonedCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SendAbandonedCartMock.expectedInvocations), m.SendAbandonedCartMock.expectedInvocationsOrigin, afterSendAbandonedCartCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *EventProducerMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockSendInspect()
+
+			m.MinimockSendAbandonedCartInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *EventProducerMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *EventProducerMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockSendDone() &&
+		m.MinimockSendAbandonedCartDone()
+}
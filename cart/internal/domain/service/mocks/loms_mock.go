@@ -19,6 +19,13 @@ type LomsMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcCancelOrder          func(ctx context.Context, req *pbLoms.OrderCancelRequest) (err error)
+	funcCancelOrderOrigin    string
+	inspectFuncCancelOrder   func(ctx context.Context, req *pbLoms.OrderCancelRequest)
+	afterCancelOrderCounter  uint64
+	beforeCancelOrderCounter uint64
+	CancelOrderMock          mLomsMockCancelOrder
+
 	funcCreateOrder          func(ctx context.Context, req *pbLoms.OrderCreateRequest) (op1 *pbLoms.OrderCreateResponse, err error)
 	funcCreateOrderOrigin    string
 	inspectFuncCreateOrder   func(ctx context.Context, req *pbLoms.OrderCreateRequest)
@@ -42,6 +49,9 @@ func NewLomsMock(t minimock.Tester) *LomsMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.CancelOrderMock = mLomsMockCancelOrder{mock: m}
+	m.CancelOrderMock.callArgs = []*LomsMockCancelOrderParams{}
+
 	m.CreateOrderMock = mLomsMockCreateOrder{mock: m}
 	m.CreateOrderMock.callArgs = []*LomsMockCreateOrderParams{}
 
@@ -53,6 +63,348 @@ func NewLomsMock(t minimock.Tester) *LomsMock {
 	return m
 }
 
+type mLomsMockCancelOrder struct {
+	optional           bool
+	mock               *LomsMock
+	defaultExpectation *LomsMockCancelOrderExpectation
+	expectations       []*LomsMockCancelOrderExpectation
+
+	callArgs []*LomsMockCancelOrderParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// LomsMockCancelOrderExpectation specifies expectation struct of the Loms.CancelOrder
+type LomsMockCancelOrderExpectation struct {
+	mock               *LomsMock
+	params             *LomsMockCancelOrderParams
+	paramPtrs          *LomsMockCancelOrderParamPtrs
+	expectationOrigins LomsMockCancelOrderExpectationOrigins
+	results            *LomsMockCancelOrderResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// LomsMockCancelOrderParams contains parameters of the Loms.CancelOrder
+type LomsMockCancelOrderParams struct {
+	ctx context.Context
+	req *pbLoms.OrderCancelRequest
+}
+
+// LomsMockCancelOrderParamPtrs contains pointers to parameters of the Loms.CancelOrder
+type LomsMockCancelOrderParamPtrs struct {
+	ctx *context.Context
+	req **pbLoms.OrderCancelRequest
+}
+
+// LomsMockCancelOrderResults contains results of the Loms.CancelOrder
+type LomsMockCancelOrderResults struct {
+	err error
+}
+
+// LomsMockCancelOrderOrigins contains origins of expectations of the Loms.CancelOrder
+type LomsMockCancelOrderExpectationOrigins struct {
+	origin    string
+	originCtx string
+	originReq string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCancelOrder *mLomsMockCancelOrder) Optional() *mLomsMockCancelOrder {
+	mmCancelOrder.optional = true
+	return mmCancelOrder
+}
+
+// Expect sets up expected params for Loms.CancelOrder
+func (mmCancelOrder *mLomsMockCancelOrder) Expect(ctx context.Context, req *pbLoms.OrderCancelRequest) *mLomsMockCancelOrder {
+	if mmCancelOrder.mock.funcCancelOrder != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by Set")
+	}
+
+	if mmCancelOrder.defaultExpectation == nil {
+		mmCancelOrder.defaultExpectation = &LomsMockCancelOrderExpectation{}
+	}
+
+	if mmCancelOrder.defaultExpectation.paramPtrs != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by ExpectParams functions")
+	}
+
+	mmCancelOrder.defaultExpectation.params = &LomsMockCancelOrderParams{ctx, req}
+	mmCancelOrder.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCancelOrder.expectations {
+		if minimock.Equal(e.params, mmCancelOrder.defaultExpectation.params) {
+			mmCancelOrder.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCancelOrder.defaultExpectation.params)
+		}
+	}
+
+	return mmCancelOrder
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Loms.CancelOrder
+func (mmCancelOrder *mLomsMockCancelOrder) ExpectCtxParam1(ctx context.Context) *mLomsMockCancelOrder {
+	if mmCancelOrder.mock.funcCancelOrder != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by Set")
+	}
+
+	if mmCancelOrder.defaultExpectation == nil {
+		mmCancelOrder.defaultExpectation = &LomsMockCancelOrderExpectation{}
+	}
+
+	if mmCancelOrder.defaultExpectation.params != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by Expect")
+	}
+
+	if mmCancelOrder.defaultExpectation.paramPtrs == nil {
+		mmCancelOrder.defaultExpectation.paramPtrs = &LomsMockCancelOrderParamPtrs{}
+	}
+	mmCancelOrder.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCancelOrder.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCancelOrder
+}
+
+// ExpectReqParam2 sets up expected param req for Loms.CancelOrder
+func (mmCancelOrder *mLomsMockCancelOrder) ExpectReqParam2(req *pbLoms.OrderCancelRequest) *mLomsMockCancelOrder {
+	if mmCancelOrder.mock.funcCancelOrder != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by Set")
+	}
+
+	if mmCancelOrder.defaultExpectation == nil {
+		mmCancelOrder.defaultExpectation = &LomsMockCancelOrderExpectation{}
+	}
+
+	if mmCancelOrder.defaultExpectation.params != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by Expect")
+	}
+
+	if mmCancelOrder.defaultExpectation.paramPtrs == nil {
+		mmCancelOrder.defaultExpectation.paramPtrs = &LomsMockCancelOrderParamPtrs{}
+	}
+	mmCancelOrder.defaultExpectation.paramPtrs.req = &req
+	mmCancelOrder.defaultExpectation.expectationOrigins.originReq = minimock.CallerInfo(1)
+
+	return mmCancelOrder
+}
+
+// Inspect accepts an inspector function that has same arguments as the Loms.CancelOrder
+func (mmCancelOrder *mLomsMockCancelOrder) Inspect(f func(ctx context.Context, req *pbLoms.OrderCancelRequest)) *mLomsMockCancelOrder {
+	if mmCancelOrder.mock.inspectFuncCancelOrder != nil {
+		mmCancelOrder.mock.t.Fatalf("Inspect function is already set for LomsMock.CancelOrder")
+	}
+
+	mmCancelOrder.mock.inspectFuncCancelOrder = f
+
+	return mmCancelOrder
+}
+
+// Return sets up results that will be returned by Loms.CancelOrder
+func (mmCancelOrder *mLomsMockCancelOrder) Return(err error) *LomsMock {
+	if mmCancelOrder.mock.funcCancelOrder != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by Set")
+	}
+
+	if mmCancelOrder.defaultExpectation == nil {
+		mmCancelOrder.defaultExpectation = &LomsMockCancelOrderExpectation{mock: mmCancelOrder.mock}
+	}
+	mmCancelOrder.defaultExpectation.results = &LomsMockCancelOrderResults{err}
+	mmCancelOrder.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCancelOrder.mock
+}
+
+// Set uses given function f to mock the Loms.CancelOrder method
+func (mmCancelOrder *mLomsMockCancelOrder) Set(f func(ctx context.Context, req *pbLoms.OrderCancelRequest) (err error)) *LomsMock {
+	if mmCancelOrder.defaultExpectation != nil {
+		mmCancelOrder.mock.t.Fatalf("Default expectation is already set for the Loms.CancelOrder method")
+	}
+
+	if len(mmCancelOrder.expectations) > 0 {
+		mmCancelOrder.mock.t.Fatalf("Some expectations are already set for the Loms.CancelOrder method")
+	}
+
+	mmCancelOrder.mock.funcCancelOrder = f
+	mmCancelOrder.mock.funcCancelOrderOrigin = minimock.CallerInfo(1)
+	return mmCancelOrder.mock
+}
+
+// When sets expectation for the Loms.CancelOrder which will trigger the result defined by the following
+// Then helper
+func (mmCancelOrder *mLomsMockCancelOrder) When(ctx context.Context, req *pbLoms.OrderCancelRequest) *LomsMockCancelOrderExpectation {
+	if mmCancelOrder.mock.funcCancelOrder != nil {
+		mmCancelOrder.mock.t.Fatalf("LomsMock.CancelOrder mock is already set by Set")
+	}
+
+	expectation := &LomsMockCancelOrderExpectation{
+		mock:               mmCancelOrder.mock,
+		params:             &LomsMockCancelOrderParams{ctx, req},
+		expectationOrigins: LomsMockCancelOrderExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCancelOrder.expectations = append(mmCancelOrder.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Loms.CancelOrder return parameters for the expectation previously defined by the When method
+func (e *LomsMockCancelOrderExpectation) Then(err error) *LomsMock {
+	e.results = &LomsMockCancelOrderResults{err}
+	return e.mock
+}
+
+// Times sets number of times Loms.CancelOrder should be invoked
+func (mmCancelOrder *mLomsMockCancelOrder) Times(n uint64) *mLomsMockCancelOrder {
+	if n == 0 {
+		mmCancelOrder.mock.t.Fatalf("Times of LomsMock.CancelOrder mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCancelOrder.expectedInvocations, n)
+	mmCancelOrder.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCancelOrder
+}
+
+func (mmCancelOrder *mLomsMockCancelOrder) invocationsDone() bool {
+	if len(mmCancelOrder.expectations) == 0 && mmCancelOrder.defaultExpectation == nil && mmCancelOrder.mock.funcCancelOrder == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCancelOrder.mock.afterCancelOrderCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCancelOrder.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CancelOrder implements mm_service.Loms
+func (mmCancelOrder *LomsMock) CancelOrder(ctx context.Context, req *pbLoms.OrderCancelRequest) (err error) {
+	mm_atomic.AddUint64(&mmCancelOrder.beforeCancelOrderCounter, 1)
+	defer mm_atomic.AddUint64(&mmCancelOrder.afterCancelOrderCounter, 1)
+
+	mmCancelOrder.t.Helper()
+
+	if mmCancelOrder.inspectFuncCancelOrder != nil {
+		mmCancelOrder.inspectFuncCancelOrder(ctx, req)
+	}
+
+	mm_params := LomsMockCancelOrderParams{ctx, req}
+
+	// Record call args
+	mmCancelOrder.CancelOrderMock.mutex.Lock()
+	mmCancelOrder.CancelOrderMock.callArgs = append(mmCancelOrder.CancelOrderMock.callArgs, &mm_params)
+	mmCancelOrder.CancelOrderMock.mutex.Unlock()
+
+	for _, e := range mmCancelOrder.CancelOrderMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCancelOrder.CancelOrderMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCancelOrder.CancelOrderMock.defaultExpectation.Counter, 1)
+		mm_want := mmCancelOrder.CancelOrderMock.defaultExpectation.params
+		mm_want_ptrs := mmCancelOrder.CancelOrderMock.defaultExpectation.paramPtrs
+
+		mm_got := LomsMockCancelOrderParams{ctx, req}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCancelOrder.t.Errorf("LomsMock.CancelOrder got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelOrder.CancelOrderMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.req != nil && !minimock.Equal(*mm_want_ptrs.req, mm_got.req) {
+				mmCancelOrder.t.Errorf("LomsMock.CancelOrder got unexpected parameter req, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCancelOrder.CancelOrderMock.defaultExpectation.expectationOrigins.originReq, *mm_want_ptrs.req, mm_got.req, minimock.Diff(*mm_want_ptrs.req, mm_got.req))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCancelOrder.t.Errorf("LomsMock.CancelOrder got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCancelOrder.CancelOrderMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCancelOrder.CancelOrderMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCancelOrder.t.Fatal("No results are set for the LomsMock.CancelOrder")
+		}
+		return (*mm_results).err
+	}
+	if mmCancelOrder.funcCancelOrder != nil {
+		return mmCancelOrder.funcCancelOrder(ctx, req)
+	}
+	mmCancelOrder.t.Fatalf("Unexpected call to LomsMock.CancelOrder. %v %v", ctx, req)
+	return
+}
+
+// CancelOrderAfterCounter returns a count of finished LomsMock.CancelOrder invocations
+func (mmCancelOrder *LomsMock) CancelOrderAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelOrder.afterCancelOrderCounter)
+}
+
+// CancelOrderBeforeCounter returns a count of LomsMock.CancelOrder invocations
+func (mmCancelOrder *LomsMock) CancelOrderBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCancelOrder.beforeCancelOrderCounter)
+}
+
+// Calls returns a list of arguments used in each call to LomsMock.CancelOrder.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCancelOrder *mLomsMockCancelOrder) Calls() []*LomsMockCancelOrderParams {
+	mmCancelOrder.mutex.RLock()
+
+	argCopy := make([]*LomsMockCancelOrderParams, len(mmCancelOrder.callArgs))
+	copy(argCopy, mmCancelOrder.callArgs)
+
+	mmCancelOrder.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCancelOrderDone returns true if the count of the CancelOrder invocations corresponds
+// the number of defined expectations
+func (m *LomsMock) MinimockCancelOrderDone() bool {
+	if m.CancelOrderMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CancelOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CancelOrderMock.invocationsDone()
+}
+
+// MinimockCancelOrderInspect logs each unmet expectation
+func (m *LomsMock) MinimockCancelOrderInspect() {
+	for _, e := range m.CancelOrderMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to LomsMock.CancelOrder at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCancelOrderCounter := mm_atomic.LoadUint64(&m.afterCancelOrderCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CancelOrderMock.defaultExpectation != nil && afterCancelOrderCounter < 1 {
+		if m.CancelOrderMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to LomsMock.CancelOrder at\n%s", m.CancelOrderMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to LomsMock.CancelOrder at\n%s with params: %#v", m.CancelOrderMock.defaultExpectation.expectationOrigins.origin, *m.CancelOrderMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCancelOrder != nil && afterCancelOrderCounter < 1 {
+		m.t.Errorf("Expected call to LomsMock.CancelOrder at\n%s", m.funcCancelOrderOrigin)
+	}
+
+	if !m.CancelOrderMock.invocationsDone() && afterCancelOrderCounter > 0 {
+		m.t.Errorf("Expected %d calls to LomsMock.CancelOrder at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CancelOrderMock.expectedInvocations), m.CancelOrderMock.expectedInvocationsOrigin, afterCancelOrderCounter)
+	}
+}
+
 type mLomsMockCreateOrder struct {
 	optional           bool
 	mock               *LomsMock
@@ -743,6 +1095,8 @@ func (m *LomsMock) MinimockGetStocksInfoInspect() {
 func (m *LomsMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockCancelOrderInspect()
+
 			m.MinimockCreateOrderInspect()
 
 			m.MinimockGetStocksInfoInspect()
@@ -769,6 +1123,7 @@ func (m *LomsMock) MinimockWait(timeout mm_time.Duration) {
 func (m *LomsMock) minimockDone() bool {
 	done := true
 	return done &&
+		m.MinimockCancelOrderDone() &&
 		m.MinimockCreateOrderDone() &&
 		m.MinimockGetStocksInfoDone()
 }
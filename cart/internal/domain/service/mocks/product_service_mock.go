@@ -19,12 +19,26 @@ type ProductServiceMock struct {
 	t          minimock.Tester
 	finishOnce sync.Once
 
+	funcGetLastKnownProducts          func(skus []int64) (m1 map[int64]*model.GetProductResponse)
+	funcGetLastKnownProductsOrigin    string
+	inspectFuncGetLastKnownProducts   func(skus []int64)
+	afterGetLastKnownProductsCounter  uint64
+	beforeGetLastKnownProductsCounter uint64
+	GetLastKnownProductsMock          mProductServiceMockGetLastKnownProducts
+
 	funcGetProductBySku          func(ctx context.Context, sku int64) (gp1 *model.GetProductResponse, err error)
 	funcGetProductBySkuOrigin    string
 	inspectFuncGetProductBySku   func(ctx context.Context, sku int64)
 	afterGetProductBySkuCounter  uint64
 	beforeGetProductBySkuCounter uint64
 	GetProductBySkuMock          mProductServiceMockGetProductBySku
+
+	funcGetProductsBySkus          func(ctx context.Context, skus []int64) (m1 map[int64]*model.GetProductResponse, err error)
+	funcGetProductsBySkusOrigin    string
+	inspectFuncGetProductsBySkus   func(ctx context.Context, skus []int64)
+	afterGetProductsBySkusCounter  uint64
+	beforeGetProductsBySkusCounter uint64
+	GetProductsBySkusMock          mProductServiceMockGetProductsBySkus
 }
 
 // NewProductServiceMock returns a mock for mm_service.ProductService
@@ -35,14 +49,331 @@ func NewProductServiceMock(t minimock.Tester) *ProductServiceMock {
 		controller.RegisterMocker(m)
 	}
 
+	m.GetLastKnownProductsMock = mProductServiceMockGetLastKnownProducts{mock: m}
+	m.GetLastKnownProductsMock.callArgs = []*ProductServiceMockGetLastKnownProductsParams{}
+
 	m.GetProductBySkuMock = mProductServiceMockGetProductBySku{mock: m}
 	m.GetProductBySkuMock.callArgs = []*ProductServiceMockGetProductBySkuParams{}
 
+	m.GetProductsBySkusMock = mProductServiceMockGetProductsBySkus{mock: m}
+	m.GetProductsBySkusMock.callArgs = []*ProductServiceMockGetProductsBySkusParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
 }
 
+type mProductServiceMockGetLastKnownProducts struct {
+	optional           bool
+	mock               *ProductServiceMock
+	defaultExpectation *ProductServiceMockGetLastKnownProductsExpectation
+	expectations       []*ProductServiceMockGetLastKnownProductsExpectation
+
+	callArgs []*ProductServiceMockGetLastKnownProductsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProductServiceMockGetLastKnownProductsExpectation specifies expectation struct of the ProductService.GetLastKnownProducts
+type ProductServiceMockGetLastKnownProductsExpectation struct {
+	mock               *ProductServiceMock
+	params             *ProductServiceMockGetLastKnownProductsParams
+	paramPtrs          *ProductServiceMockGetLastKnownProductsParamPtrs
+	expectationOrigins ProductServiceMockGetLastKnownProductsExpectationOrigins
+	results            *ProductServiceMockGetLastKnownProductsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProductServiceMockGetLastKnownProductsParams contains parameters of the ProductService.GetLastKnownProducts
+type ProductServiceMockGetLastKnownProductsParams struct {
+	skus []int64
+}
+
+// ProductServiceMockGetLastKnownProductsParamPtrs contains pointers to parameters of the ProductService.GetLastKnownProducts
+type ProductServiceMockGetLastKnownProductsParamPtrs struct {
+	skus *[]int64
+}
+
+// ProductServiceMockGetLastKnownProductsResults contains results of the ProductService.GetLastKnownProducts
+type ProductServiceMockGetLastKnownProductsResults struct {
+	m1 map[int64]*model.GetProductResponse
+}
+
+// ProductServiceMockGetLastKnownProductsOrigins contains origins of expectations of the ProductService.GetLastKnownProducts
+type ProductServiceMockGetLastKnownProductsExpectationOrigins struct {
+	origin     string
+	originSkus string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) Optional() *mProductServiceMockGetLastKnownProducts {
+	mmGetLastKnownProducts.optional = true
+	return mmGetLastKnownProducts
+}
+
+// Expect sets up expected params for ProductService.GetLastKnownProducts
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) Expect(skus []int64) *mProductServiceMockGetLastKnownProducts {
+	if mmGetLastKnownProducts.mock.funcGetLastKnownProducts != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("ProductServiceMock.GetLastKnownProducts mock is already set by Set")
+	}
+
+	if mmGetLastKnownProducts.defaultExpectation == nil {
+		mmGetLastKnownProducts.defaultExpectation = &ProductServiceMockGetLastKnownProductsExpectation{}
+	}
+
+	if mmGetLastKnownProducts.defaultExpectation.paramPtrs != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("ProductServiceMock.GetLastKnownProducts mock is already set by ExpectParams functions")
+	}
+
+	mmGetLastKnownProducts.defaultExpectation.params = &ProductServiceMockGetLastKnownProductsParams{skus}
+	mmGetLastKnownProducts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetLastKnownProducts.expectations {
+		if minimock.Equal(e.params, mmGetLastKnownProducts.defaultExpectation.params) {
+			mmGetLastKnownProducts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetLastKnownProducts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetLastKnownProducts
+}
+
+// ExpectSkusParam1 sets up expected param skus for ProductService.GetLastKnownProducts
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) ExpectSkusParam1(skus []int64) *mProductServiceMockGetLastKnownProducts {
+	if mmGetLastKnownProducts.mock.funcGetLastKnownProducts != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("ProductServiceMock.GetLastKnownProducts mock is already set by Set")
+	}
+
+	if mmGetLastKnownProducts.defaultExpectation == nil {
+		mmGetLastKnownProducts.defaultExpectation = &ProductServiceMockGetLastKnownProductsExpectation{}
+	}
+
+	if mmGetLastKnownProducts.defaultExpectation.params != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("ProductServiceMock.GetLastKnownProducts mock is already set by Expect")
+	}
+
+	if mmGetLastKnownProducts.defaultExpectation.paramPtrs == nil {
+		mmGetLastKnownProducts.defaultExpectation.paramPtrs = &ProductServiceMockGetLastKnownProductsParamPtrs{}
+	}
+	mmGetLastKnownProducts.defaultExpectation.paramPtrs.skus = &skus
+	mmGetLastKnownProducts.defaultExpectation.expectationOrigins.originSkus = minimock.CallerInfo(1)
+
+	return mmGetLastKnownProducts
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProductService.GetLastKnownProducts
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) Inspect(f func(skus []int64)) *mProductServiceMockGetLastKnownProducts {
+	if mmGetLastKnownProducts.mock.inspectFuncGetLastKnownProducts != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("Inspect function is already set for ProductServiceMock.GetLastKnownProducts")
+	}
+
+	mmGetLastKnownProducts.mock.inspectFuncGetLastKnownProducts = f
+
+	return mmGetLastKnownProducts
+}
+
+// Return sets up results that will be returned by ProductService.GetLastKnownProducts
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) Return(m1 map[int64]*model.GetProductResponse) *ProductServiceMock {
+	if mmGetLastKnownProducts.mock.funcGetLastKnownProducts != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("ProductServiceMock.GetLastKnownProducts mock is already set by Set")
+	}
+
+	if mmGetLastKnownProducts.defaultExpectation == nil {
+		mmGetLastKnownProducts.defaultExpectation = &ProductServiceMockGetLastKnownProductsExpectation{mock: mmGetLastKnownProducts.mock}
+	}
+	mmGetLastKnownProducts.defaultExpectation.results = &ProductServiceMockGetLastKnownProductsResults{m1}
+	mmGetLastKnownProducts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetLastKnownProducts.mock
+}
+
+// Set uses given function f to mock the ProductService.GetLastKnownProducts method
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) Set(f func(skus []int64) (m1 map[int64]*model.GetProductResponse)) *ProductServiceMock {
+	if mmGetLastKnownProducts.defaultExpectation != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("Default expectation is already set for the ProductService.GetLastKnownProducts method")
+	}
+
+	if len(mmGetLastKnownProducts.expectations) > 0 {
+		mmGetLastKnownProducts.mock.t.Fatalf("Some expectations are already set for the ProductService.GetLastKnownProducts method")
+	}
+
+	mmGetLastKnownProducts.mock.funcGetLastKnownProducts = f
+	mmGetLastKnownProducts.mock.funcGetLastKnownProductsOrigin = minimock.CallerInfo(1)
+	return mmGetLastKnownProducts.mock
+}
+
+// When sets expectation for the ProductService.GetLastKnownProducts which will trigger the result defined by the following
+// Then helper
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) When(skus []int64) *ProductServiceMockGetLastKnownProductsExpectation {
+	if mmGetLastKnownProducts.mock.funcGetLastKnownProducts != nil {
+		mmGetLastKnownProducts.mock.t.Fatalf("ProductServiceMock.GetLastKnownProducts mock is already set by Set")
+	}
+
+	expectation := &ProductServiceMockGetLastKnownProductsExpectation{
+		mock:               mmGetLastKnownProducts.mock,
+		params:             &ProductServiceMockGetLastKnownProductsParams{skus},
+		expectationOrigins: ProductServiceMockGetLastKnownProductsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetLastKnownProducts.expectations = append(mmGetLastKnownProducts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProductService.GetLastKnownProducts return parameters for the expectation previously defined by the When method
+func (e *ProductServiceMockGetLastKnownProductsExpectation) Then(m1 map[int64]*model.GetProductResponse) *ProductServiceMock {
+	e.results = &ProductServiceMockGetLastKnownProductsResults{m1}
+	return e.mock
+}
+
+// Times sets number of times ProductService.GetLastKnownProducts should be invoked
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) Times(n uint64) *mProductServiceMockGetLastKnownProducts {
+	if n == 0 {
+		mmGetLastKnownProducts.mock.t.Fatalf("Times of ProductServiceMock.GetLastKnownProducts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetLastKnownProducts.expectedInvocations, n)
+	mmGetLastKnownProducts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetLastKnownProducts
+}
+
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) invocationsDone() bool {
+	if len(mmGetLastKnownProducts.expectations) == 0 && mmGetLastKnownProducts.defaultExpectation == nil && mmGetLastKnownProducts.mock.funcGetLastKnownProducts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetLastKnownProducts.mock.afterGetLastKnownProductsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetLastKnownProducts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetLastKnownProducts implements mm_service.ProductService
+func (mmGetLastKnownProducts *ProductServiceMock) GetLastKnownProducts(skus []int64) (m1 map[int64]*model.GetProductResponse) {
+	mm_atomic.AddUint64(&mmGetLastKnownProducts.beforeGetLastKnownProductsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetLastKnownProducts.afterGetLastKnownProductsCounter, 1)
+
+	mmGetLastKnownProducts.t.Helper()
+
+	if mmGetLastKnownProducts.inspectFuncGetLastKnownProducts != nil {
+		mmGetLastKnownProducts.inspectFuncGetLastKnownProducts(skus)
+	}
+
+	mm_params := ProductServiceMockGetLastKnownProductsParams{skus}
+
+	// Record call args
+	mmGetLastKnownProducts.GetLastKnownProductsMock.mutex.Lock()
+	mmGetLastKnownProducts.GetLastKnownProductsMock.callArgs = append(mmGetLastKnownProducts.GetLastKnownProductsMock.callArgs, &mm_params)
+	mmGetLastKnownProducts.GetLastKnownProductsMock.mutex.Unlock()
+
+	for _, e := range mmGetLastKnownProducts.GetLastKnownProductsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1
+		}
+	}
+
+	if mmGetLastKnownProducts.GetLastKnownProductsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetLastKnownProducts.GetLastKnownProductsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetLastKnownProducts.GetLastKnownProductsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetLastKnownProducts.GetLastKnownProductsMock.defaultExpectation.paramPtrs
+
+		mm_got := ProductServiceMockGetLastKnownProductsParams{skus}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
+				mmGetLastKnownProducts.t.Errorf("ProductServiceMock.GetLastKnownProducts got unexpected parameter skus, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetLastKnownProducts.GetLastKnownProductsMock.defaultExpectation.expectationOrigins.originSkus, *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetLastKnownProducts.t.Errorf("ProductServiceMock.GetLastKnownProducts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetLastKnownProducts.GetLastKnownProductsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetLastKnownProducts.GetLastKnownProductsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetLastKnownProducts.t.Fatal("No results are set for the ProductServiceMock.GetLastKnownProducts")
+		}
+		return (*mm_results).m1
+	}
+	if mmGetLastKnownProducts.funcGetLastKnownProducts != nil {
+		return mmGetLastKnownProducts.funcGetLastKnownProducts(skus)
+	}
+	mmGetLastKnownProducts.t.Fatalf("Unexpected call to ProductServiceMock.GetLastKnownProducts. %v", skus)
+	return
+}
+
+// GetLastKnownProductsAfterCounter returns a count of finished ProductServiceMock.GetLastKnownProducts invocations
+func (mmGetLastKnownProducts *ProductServiceMock) GetLastKnownProductsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLastKnownProducts.afterGetLastKnownProductsCounter)
+}
+
+// GetLastKnownProductsBeforeCounter returns a count of ProductServiceMock.GetLastKnownProducts invocations
+func (mmGetLastKnownProducts *ProductServiceMock) GetLastKnownProductsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetLastKnownProducts.beforeGetLastKnownProductsCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProductServiceMock.GetLastKnownProducts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetLastKnownProducts *mProductServiceMockGetLastKnownProducts) Calls() []*ProductServiceMockGetLastKnownProductsParams {
+	mmGetLastKnownProducts.mutex.RLock()
+
+	argCopy := make([]*ProductServiceMockGetLastKnownProductsParams, len(mmGetLastKnownProducts.callArgs))
+	copy(argCopy, mmGetLastKnownProducts.callArgs)
+
+	mmGetLastKnownProducts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetLastKnownProductsDone returns true if the count of the GetLastKnownProducts invocations corresponds
+// the number of defined expectations
+func (m *ProductServiceMock) MinimockGetLastKnownProductsDone() bool {
+	if m.GetLastKnownProductsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetLastKnownProductsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetLastKnownProductsMock.invocationsDone()
+}
+
+// MinimockGetLastKnownProductsInspect logs each unmet expectation
+func (m *ProductServiceMock) MinimockGetLastKnownProductsInspect() {
+	for _, e := range m.GetLastKnownProductsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProductServiceMock.GetLastKnownProducts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetLastKnownProductsCounter := mm_atomic.LoadUint64(&m.afterGetLastKnownProductsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetLastKnownProductsMock.defaultExpectation != nil && afterGetLastKnownProductsCounter < 1 {
+		if m.GetLastKnownProductsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProductServiceMock.GetLastKnownProducts at\n%s", m.GetLastKnownProductsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProductServiceMock.GetLastKnownProducts at\n%s with params: %#v", m.GetLastKnownProductsMock.defaultExpectation.expectationOrigins.origin, *m.GetLastKnownProductsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetLastKnownProducts != nil && afterGetLastKnownProductsCounter < 1 {
+		m.t.Errorf("Expected call to ProductServiceMock.GetLastKnownProducts at\n%s", m.funcGetLastKnownProductsOrigin)
+	}
+
+	if !m.GetLastKnownProductsMock.invocationsDone() && afterGetLastKnownProductsCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProductServiceMock.GetLastKnownProducts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetLastKnownProductsMock.expectedInvocations), m.GetLastKnownProductsMock.expectedInvocationsOrigin, afterGetLastKnownProductsCounter)
+	}
+}
+
 type mProductServiceMockGetProductBySku struct {
 	optional           bool
 	mock               *ProductServiceMock
@@ -386,11 +717,358 @@ func (m *ProductServiceMock) MinimockGetProductBySkuInspect() {
 	}
 }
 
+type mProductServiceMockGetProductsBySkus struct {
+	optional           bool
+	mock               *ProductServiceMock
+	defaultExpectation *ProductServiceMockGetProductsBySkusExpectation
+	expectations       []*ProductServiceMockGetProductsBySkusExpectation
+
+	callArgs []*ProductServiceMockGetProductsBySkusParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// ProductServiceMockGetProductsBySkusExpectation specifies expectation struct of the ProductService.GetProductsBySkus
+type ProductServiceMockGetProductsBySkusExpectation struct {
+	mock               *ProductServiceMock
+	params             *ProductServiceMockGetProductsBySkusParams
+	paramPtrs          *ProductServiceMockGetProductsBySkusParamPtrs
+	expectationOrigins ProductServiceMockGetProductsBySkusExpectationOrigins
+	results            *ProductServiceMockGetProductsBySkusResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// ProductServiceMockGetProductsBySkusParams contains parameters of the ProductService.GetProductsBySkus
+type ProductServiceMockGetProductsBySkusParams struct {
+	ctx  context.Context
+	skus []int64
+}
+
+// ProductServiceMockGetProductsBySkusParamPtrs contains pointers to parameters of the ProductService.GetProductsBySkus
+type ProductServiceMockGetProductsBySkusParamPtrs struct {
+	ctx  *context.Context
+	skus *[]int64
+}
+
+// ProductServiceMockGetProductsBySkusResults contains results of the ProductService.GetProductsBySkus
+type ProductServiceMockGetProductsBySkusResults struct {
+	m1  map[int64]*model.GetProductResponse
+	err error
+}
+
+// ProductServiceMockGetProductsBySkusOrigins contains origins of expectations of the ProductService.GetProductsBySkus
+type ProductServiceMockGetProductsBySkusExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originSkus string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) Optional() *mProductServiceMockGetProductsBySkus {
+	mmGetProductsBySkus.optional = true
+	return mmGetProductsBySkus
+}
+
+// Expect sets up expected params for ProductService.GetProductsBySkus
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) Expect(ctx context.Context, skus []int64) *mProductServiceMockGetProductsBySkus {
+	if mmGetProductsBySkus.mock.funcGetProductsBySkus != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by Set")
+	}
+
+	if mmGetProductsBySkus.defaultExpectation == nil {
+		mmGetProductsBySkus.defaultExpectation = &ProductServiceMockGetProductsBySkusExpectation{}
+	}
+
+	if mmGetProductsBySkus.defaultExpectation.paramPtrs != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by ExpectParams functions")
+	}
+
+	mmGetProductsBySkus.defaultExpectation.params = &ProductServiceMockGetProductsBySkusParams{ctx, skus}
+	mmGetProductsBySkus.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetProductsBySkus.expectations {
+		if minimock.Equal(e.params, mmGetProductsBySkus.defaultExpectation.params) {
+			mmGetProductsBySkus.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetProductsBySkus.defaultExpectation.params)
+		}
+	}
+
+	return mmGetProductsBySkus
+}
+
+// ExpectCtxParam1 sets up expected param ctx for ProductService.GetProductsBySkus
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) ExpectCtxParam1(ctx context.Context) *mProductServiceMockGetProductsBySkus {
+	if mmGetProductsBySkus.mock.funcGetProductsBySkus != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by Set")
+	}
+
+	if mmGetProductsBySkus.defaultExpectation == nil {
+		mmGetProductsBySkus.defaultExpectation = &ProductServiceMockGetProductsBySkusExpectation{}
+	}
+
+	if mmGetProductsBySkus.defaultExpectation.params != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by Expect")
+	}
+
+	if mmGetProductsBySkus.defaultExpectation.paramPtrs == nil {
+		mmGetProductsBySkus.defaultExpectation.paramPtrs = &ProductServiceMockGetProductsBySkusParamPtrs{}
+	}
+	mmGetProductsBySkus.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetProductsBySkus.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetProductsBySkus
+}
+
+// ExpectSkusParam2 sets up expected param skus for ProductService.GetProductsBySkus
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) ExpectSkusParam2(skus []int64) *mProductServiceMockGetProductsBySkus {
+	if mmGetProductsBySkus.mock.funcGetProductsBySkus != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by Set")
+	}
+
+	if mmGetProductsBySkus.defaultExpectation == nil {
+		mmGetProductsBySkus.defaultExpectation = &ProductServiceMockGetProductsBySkusExpectation{}
+	}
+
+	if mmGetProductsBySkus.defaultExpectation.params != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by Expect")
+	}
+
+	if mmGetProductsBySkus.defaultExpectation.paramPtrs == nil {
+		mmGetProductsBySkus.defaultExpectation.paramPtrs = &ProductServiceMockGetProductsBySkusParamPtrs{}
+	}
+	mmGetProductsBySkus.defaultExpectation.paramPtrs.skus = &skus
+	mmGetProductsBySkus.defaultExpectation.expectationOrigins.originSkus = minimock.CallerInfo(1)
+
+	return mmGetProductsBySkus
+}
+
+// Inspect accepts an inspector function that has same arguments as the ProductService.GetProductsBySkus
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) Inspect(f func(ctx context.Context, skus []int64)) *mProductServiceMockGetProductsBySkus {
+	if mmGetProductsBySkus.mock.inspectFuncGetProductsBySkus != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("Inspect function is already set for ProductServiceMock.GetProductsBySkus")
+	}
+
+	mmGetProductsBySkus.mock.inspectFuncGetProductsBySkus = f
+
+	return mmGetProductsBySkus
+}
+
+// Return sets up results that will be returned by ProductService.GetProductsBySkus
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) Return(m1 map[int64]*model.GetProductResponse, err error) *ProductServiceMock {
+	if mmGetProductsBySkus.mock.funcGetProductsBySkus != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by Set")
+	}
+
+	if mmGetProductsBySkus.defaultExpectation == nil {
+		mmGetProductsBySkus.defaultExpectation = &ProductServiceMockGetProductsBySkusExpectation{mock: mmGetProductsBySkus.mock}
+	}
+	mmGetProductsBySkus.defaultExpectation.results = &ProductServiceMockGetProductsBySkusResults{m1, err}
+	mmGetProductsBySkus.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetProductsBySkus.mock
+}
+
+// Set uses given function f to mock the ProductService.GetProductsBySkus method
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) Set(f func(ctx context.Context, skus []int64) (m1 map[int64]*model.GetProductResponse, err error)) *ProductServiceMock {
+	if mmGetProductsBySkus.defaultExpectation != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("Default expectation is already set for the ProductService.GetProductsBySkus method")
+	}
+
+	if len(mmGetProductsBySkus.expectations) > 0 {
+		mmGetProductsBySkus.mock.t.Fatalf("Some expectations are already set for the ProductService.GetProductsBySkus method")
+	}
+
+	mmGetProductsBySkus.mock.funcGetProductsBySkus = f
+	mmGetProductsBySkus.mock.funcGetProductsBySkusOrigin = minimock.CallerInfo(1)
+	return mmGetProductsBySkus.mock
+}
+
+// When sets expectation for the ProductService.GetProductsBySkus which will trigger the result defined by the following
+// Then helper
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) When(ctx context.Context, skus []int64) *ProductServiceMockGetProductsBySkusExpectation {
+	if mmGetProductsBySkus.mock.funcGetProductsBySkus != nil {
+		mmGetProductsBySkus.mock.t.Fatalf("ProductServiceMock.GetProductsBySkus mock is already set by Set")
+	}
+
+	expectation := &ProductServiceMockGetProductsBySkusExpectation{
+		mock:               mmGetProductsBySkus.mock,
+		params:             &ProductServiceMockGetProductsBySkusParams{ctx, skus},
+		expectationOrigins: ProductServiceMockGetProductsBySkusExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetProductsBySkus.expectations = append(mmGetProductsBySkus.expectations, expectation)
+	return expectation
+}
+
+// Then sets up ProductService.GetProductsBySkus return parameters for the expectation previously defined by the When method
+func (e *ProductServiceMockGetProductsBySkusExpectation) Then(m1 map[int64]*model.GetProductResponse, err error) *ProductServiceMock {
+	e.results = &ProductServiceMockGetProductsBySkusResults{m1, err}
+	return e.mock
+}
+
+// Times sets number of times ProductService.GetProductsBySkus should be invoked
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) Times(n uint64) *mProductServiceMockGetProductsBySkus {
+	if n == 0 {
+		mmGetProductsBySkus.mock.t.Fatalf("Times of ProductServiceMock.GetProductsBySkus mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetProductsBySkus.expectedInvocations, n)
+	mmGetProductsBySkus.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetProductsBySkus
+}
+
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) invocationsDone() bool {
+	if len(mmGetProductsBySkus.expectations) == 0 && mmGetProductsBySkus.defaultExpectation == nil && mmGetProductsBySkus.mock.funcGetProductsBySkus == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetProductsBySkus.mock.afterGetProductsBySkusCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetProductsBySkus.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetProductsBySkus implements mm_service.ProductService
+func (mmGetProductsBySkus *ProductServiceMock) GetProductsBySkus(ctx context.Context, skus []int64) (m1 map[int64]*model.GetProductResponse, err error) {
+	mm_atomic.AddUint64(&mmGetProductsBySkus.beforeGetProductsBySkusCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetProductsBySkus.afterGetProductsBySkusCounter, 1)
+
+	mmGetProductsBySkus.t.Helper()
+
+	if mmGetProductsBySkus.inspectFuncGetProductsBySkus != nil {
+		mmGetProductsBySkus.inspectFuncGetProductsBySkus(ctx, skus)
+	}
+
+	mm_params := ProductServiceMockGetProductsBySkusParams{ctx, skus}
+
+	// Record call args
+	mmGetProductsBySkus.GetProductsBySkusMock.mutex.Lock()
+	mmGetProductsBySkus.GetProductsBySkusMock.callArgs = append(mmGetProductsBySkus.GetProductsBySkusMock.callArgs, &mm_params)
+	mmGetProductsBySkus.GetProductsBySkusMock.mutex.Unlock()
+
+	for _, e := range mmGetProductsBySkus.GetProductsBySkusMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.m1, e.results.err
+		}
+	}
+
+	if mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation.params
+		mm_want_ptrs := mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation.paramPtrs
+
+		mm_got := ProductServiceMockGetProductsBySkusParams{ctx, skus}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetProductsBySkus.t.Errorf("ProductServiceMock.GetProductsBySkus got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.skus != nil && !minimock.Equal(*mm_want_ptrs.skus, mm_got.skus) {
+				mmGetProductsBySkus.t.Errorf("ProductServiceMock.GetProductsBySkus got unexpected parameter skus, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation.expectationOrigins.originSkus, *mm_want_ptrs.skus, mm_got.skus, minimock.Diff(*mm_want_ptrs.skus, mm_got.skus))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetProductsBySkus.t.Errorf("ProductServiceMock.GetProductsBySkus got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetProductsBySkus.GetProductsBySkusMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetProductsBySkus.t.Fatal("No results are set for the ProductServiceMock.GetProductsBySkus")
+		}
+		return (*mm_results).m1, (*mm_results).err
+	}
+	if mmGetProductsBySkus.funcGetProductsBySkus != nil {
+		return mmGetProductsBySkus.funcGetProductsBySkus(ctx, skus)
+	}
+	mmGetProductsBySkus.t.Fatalf("Unexpected call to ProductServiceMock.GetProductsBySkus. %v %v", ctx, skus)
+	return
+}
+
+// GetProductsBySkusAfterCounter returns a count of finished ProductServiceMock.GetProductsBySkus invocations
+func (mmGetProductsBySkus *ProductServiceMock) GetProductsBySkusAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProductsBySkus.afterGetProductsBySkusCounter)
+}
+
+// GetProductsBySkusBeforeCounter returns a count of ProductServiceMock.GetProductsBySkus invocations
+func (mmGetProductsBySkus *ProductServiceMock) GetProductsBySkusBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetProductsBySkus.beforeGetProductsBySkusCounter)
+}
+
+// Calls returns a list of arguments used in each call to ProductServiceMock.GetProductsBySkus.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetProductsBySkus *mProductServiceMockGetProductsBySkus) Calls() []*ProductServiceMockGetProductsBySkusParams {
+	mmGetProductsBySkus.mutex.RLock()
+
+	argCopy := make([]*ProductServiceMockGetProductsBySkusParams, len(mmGetProductsBySkus.callArgs))
+	copy(argCopy, mmGetProductsBySkus.callArgs)
+
+	mmGetProductsBySkus.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetProductsBySkusDone returns true if the count of the GetProductsBySkus invocations corresponds
+// the number of defined expectations
+func (m *ProductServiceMock) MinimockGetProductsBySkusDone() bool {
+	if m.GetProductsBySkusMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetProductsBySkusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetProductsBySkusMock.invocationsDone()
+}
+
+// MinimockGetProductsBySkusInspect logs each unmet expectation
+func (m *ProductServiceMock) MinimockGetProductsBySkusInspect() {
+	for _, e := range m.GetProductsBySkusMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to ProductServiceMock.GetProductsBySkus at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetProductsBySkusCounter := mm_atomic.LoadUint64(&m.afterGetProductsBySkusCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetProductsBySkusMock.defaultExpectation != nil && afterGetProductsBySkusCounter < 1 {
+		if m.GetProductsBySkusMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to ProductServiceMock.GetProductsBySkus at\n%s", m.GetProductsBySkusMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to ProductServiceMock.GetProductsBySkus at\n%s with params: %#v", m.GetProductsBySkusMock.defaultExpectation.expectationOrigins.origin, *m.GetProductsBySkusMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetProductsBySkus != nil && afterGetProductsBySkusCounter < 1 {
+		m.t.Errorf("Expected call to ProductServiceMock.GetProductsBySkus at\n%s", m.funcGetProductsBySkusOrigin)
+	}
+
+	if !m.GetProductsBySkusMock.invocationsDone() && afterGetProductsBySkusCounter > 0 {
+		m.t.Errorf("Expected %d calls to ProductServiceMock.GetProductsBySkus at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetProductsBySkusMock.expectedInvocations), m.GetProductsBySkusMock.expectedInvocationsOrigin, afterGetProductsBySkusCounter)
+	}
+}
+
 // MinimockFinish checks that all mocked methods have been called the expected number of times
 func (m *ProductServiceMock) MinimockFinish() {
 	m.finishOnce.Do(func() {
 		if !m.minimockDone() {
+			m.MinimockGetLastKnownProductsInspect()
+
 			m.MinimockGetProductBySkuInspect()
+
+			m.MinimockGetProductsBySkusInspect()
 		}
 	})
 }
@@ -414,5 +1092,7 @@ func (m *ProductServiceMock) MinimockWait(timeout mm_time.Duration) {
 func (m *ProductServiceMock) minimockDone() bool {
 	done := true
 	return done &&
-		m.MinimockGetProductBySkuDone()
+		m.MinimockGetLastKnownProductsDone() &&
+		m.MinimockGetProductBySkuDone() &&
+		m.MinimockGetProductsBySkusDone()
 }
@@ -0,0 +1,448 @@
+// Code generated by http://github.com/gojuno/minimock (v3.4.4). DO NOT EDIT.
+
+package mocks
+
+//go:generate minimock -i github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service.PromoEngine -o promo_engine_mock.go -n PromoEngineMock -p mocks
+
+import (
+	"sync"
+	mm_atomic "sync/atomic"
+	mm_time "time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/gojuno/minimock/v3"
+)
+
+// PromoEngineMock implements mm_service.PromoEngine
+type PromoEngineMock struct {
+	t          minimock.Tester
+	finishOnce sync.Once
+
+	funcApply          func(code string, items []model.Item, subtotal uint64) (da1 []model.DiscountLine, err error)
+	funcApplyOrigin    string
+	inspectFuncApply   func(code string, items []model.Item, subtotal uint64)
+	afterApplyCounter  uint64
+	beforeApplyCounter uint64
+	ApplyMock          mPromoEngineMockApply
+}
+
+// NewPromoEngineMock returns a mock for mm_service.PromoEngine
+func NewPromoEngineMock(t minimock.Tester) *PromoEngineMock {
+	m := &PromoEngineMock{t: t}
+
+	if controller, ok := t.(minimock.MockController); ok {
+		controller.RegisterMocker(m)
+	}
+
+	m.ApplyMock = mPromoEngineMockApply{mock: m}
+	m.ApplyMock.callArgs = []*PromoEngineMockApplyParams{}
+
+	t.Cleanup(m.MinimockFinish)
+
+	return m
+}
+
+type mPromoEngineMockApply struct {
+	optional           bool
+	mock               *PromoEngineMock
+	defaultExpectation *PromoEngineMockApplyExpectation
+	expectations       []*PromoEngineMockApplyExpectation
+
+	callArgs []*PromoEngineMockApplyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// PromoEngineMockApplyExpectation specifies expectation struct of the PromoEngine.Apply
+type PromoEngineMockApplyExpectation struct {
+	mock               *PromoEngineMock
+	params             *PromoEngineMockApplyParams
+	paramPtrs          *PromoEngineMockApplyParamPtrs
+	expectationOrigins PromoEngineMockApplyExpectationOrigins
+	results            *PromoEngineMockApplyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// PromoEngineMockApplyParams contains parameters of the PromoEngine.Apply
+type PromoEngineMockApplyParams struct {
+	code     string
+	items    []model.Item
+	subtotal uint64
+}
+
+// PromoEngineMockApplyParamPtrs contains pointers to parameters of the PromoEngine.Apply
+type PromoEngineMockApplyParamPtrs struct {
+	code     *string
+	items    *[]model.Item
+	subtotal *uint64
+}
+
+// PromoEngineMockApplyResults contains results of the PromoEngine.Apply
+type PromoEngineMockApplyResults struct {
+	da1 []model.DiscountLine
+	err error
+}
+
+// PromoEngineMockApplyOrigins contains origins of expectations of the PromoEngine.Apply
+type PromoEngineMockApplyExpectationOrigins struct {
+	origin         string
+	originCode     string
+	originItems    string
+	originSubtotal string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmApply *mPromoEngineMockApply) Optional() *mPromoEngineMockApply {
+	mmApply.optional = true
+	return mmApply
+}
+
+// Expect sets up expected params for PromoEngine.Apply
+func (mmApply *mPromoEngineMockApply) Expect(code string, items []model.Item, subtotal uint64) *mPromoEngineMockApply {
+	if mmApply.mock.funcApply != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Set")
+	}
+
+	if mmApply.defaultExpectation == nil {
+		mmApply.defaultExpectation = &PromoEngineMockApplyExpectation{}
+	}
+
+	if mmApply.defaultExpectation.paramPtrs != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by ExpectParams functions")
+	}
+
+	mmApply.defaultExpectation.params = &PromoEngineMockApplyParams{code, items, subtotal}
+	mmApply.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmApply.expectations {
+		if minimock.Equal(e.params, mmApply.defaultExpectation.params) {
+			mmApply.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmApply.defaultExpectation.params)
+		}
+	}
+
+	return mmApply
+}
+
+// ExpectCodeParam1 sets up expected param code for PromoEngine.Apply
+func (mmApply *mPromoEngineMockApply) ExpectCodeParam1(code string) *mPromoEngineMockApply {
+	if mmApply.mock.funcApply != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Set")
+	}
+
+	if mmApply.defaultExpectation == nil {
+		mmApply.defaultExpectation = &PromoEngineMockApplyExpectation{}
+	}
+
+	if mmApply.defaultExpectation.params != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Expect")
+	}
+
+	if mmApply.defaultExpectation.paramPtrs == nil {
+		mmApply.defaultExpectation.paramPtrs = &PromoEngineMockApplyParamPtrs{}
+	}
+	mmApply.defaultExpectation.paramPtrs.code = &code
+	mmApply.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmApply
+}
+
+// ExpectItemsParam2 sets up expected param items for PromoEngine.Apply
+func (mmApply *mPromoEngineMockApply) ExpectItemsParam2(items []model.Item) *mPromoEngineMockApply {
+	if mmApply.mock.funcApply != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Set")
+	}
+
+	if mmApply.defaultExpectation == nil {
+		mmApply.defaultExpectation = &PromoEngineMockApplyExpectation{}
+	}
+
+	if mmApply.defaultExpectation.params != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Expect")
+	}
+
+	if mmApply.defaultExpectation.paramPtrs == nil {
+		mmApply.defaultExpectation.paramPtrs = &PromoEngineMockApplyParamPtrs{}
+	}
+	mmApply.defaultExpectation.paramPtrs.items = &items
+	mmApply.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmApply
+}
+
+// ExpectSubtotalParam3 sets up expected param subtotal for PromoEngine.Apply
+func (mmApply *mPromoEngineMockApply) ExpectSubtotalParam3(subtotal uint64) *mPromoEngineMockApply {
+	if mmApply.mock.funcApply != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Set")
+	}
+
+	if mmApply.defaultExpectation == nil {
+		mmApply.defaultExpectation = &PromoEngineMockApplyExpectation{}
+	}
+
+	if mmApply.defaultExpectation.params != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Expect")
+	}
+
+	if mmApply.defaultExpectation.paramPtrs == nil {
+		mmApply.defaultExpectation.paramPtrs = &PromoEngineMockApplyParamPtrs{}
+	}
+	mmApply.defaultExpectation.paramPtrs.subtotal = &subtotal
+	mmApply.defaultExpectation.expectationOrigins.originSubtotal = minimock.CallerInfo(1)
+
+	return mmApply
+}
+
+// Inspect accepts an inspector function that has same arguments as the PromoEngine.Apply
+func (mmApply *mPromoEngineMockApply) Inspect(f func(code string, items []model.Item, subtotal uint64)) *mPromoEngineMockApply {
+	if mmApply.mock.inspectFuncApply != nil {
+		mmApply.mock.t.Fatalf("Inspect function is already set for PromoEngineMock.Apply")
+	}
+
+	mmApply.mock.inspectFuncApply = f
+
+	return mmApply
+}
+
+// Return sets up results that will be returned by PromoEngine.Apply
+func (mmApply *mPromoEngineMockApply) Return(da1 []model.DiscountLine, err error) *PromoEngineMock {
+	if mmApply.mock.funcApply != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Set")
+	}
+
+	if mmApply.defaultExpectation == nil {
+		mmApply.defaultExpectation = &PromoEngineMockApplyExpectation{mock: mmApply.mock}
+	}
+	mmApply.defaultExpectation.results = &PromoEngineMockApplyResults{da1, err}
+	mmApply.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmApply.mock
+}
+
+// Set uses given function f to mock the PromoEngine.Apply method
+func (mmApply *mPromoEngineMockApply) Set(f func(code string, items []model.Item, subtotal uint64) (da1 []model.DiscountLine, err error)) *PromoEngineMock {
+	if mmApply.defaultExpectation != nil {
+		mmApply.mock.t.Fatalf("Default expectation is already set for the PromoEngine.Apply method")
+	}
+
+	if len(mmApply.expectations) > 0 {
+		mmApply.mock.t.Fatalf("Some expectations are already set for the PromoEngine.Apply method")
+	}
+
+	mmApply.mock.funcApply = f
+	mmApply.mock.funcApplyOrigin = minimock.CallerInfo(1)
+	return mmApply.mock
+}
+
+// When sets expectation for the PromoEngine.Apply which will trigger the result defined by the following
+// Then helper
+func (mmApply *mPromoEngineMockApply) When(code string, items []model.Item, subtotal uint64) *PromoEngineMockApplyExpectation {
+	if mmApply.mock.funcApply != nil {
+		mmApply.mock.t.Fatalf("PromoEngineMock.Apply mock is already set by Set")
+	}
+
+	expectation := &PromoEngineMockApplyExpectation{
+		mock:               mmApply.mock,
+		params:             &PromoEngineMockApplyParams{code, items, subtotal},
+		expectationOrigins: PromoEngineMockApplyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmApply.expectations = append(mmApply.expectations, expectation)
+	return expectation
+}
+
+// Then sets up PromoEngine.Apply return parameters for the expectation previously defined by the When method
+func (e *PromoEngineMockApplyExpectation) Then(da1 []model.DiscountLine, err error) *PromoEngineMock {
+	e.results = &PromoEngineMockApplyResults{da1, err}
+	return e.mock
+}
+
+// Times sets number of times PromoEngine.Apply should be invoked
+func (mmApply *mPromoEngineMockApply) Times(n uint64) *mPromoEngineMockApply {
+	if n == 0 {
+		mmApply.mock.t.Fatalf("Times of PromoEngineMock.Apply mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmApply.expectedInvocations, n)
+	mmApply.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmApply
+}
+
+func (mmApply *mPromoEngineMockApply) invocationsDone() bool {
+	if len(mmApply.expectations) == 0 && mmApply.defaultExpectation == nil && mmApply.mock.funcApply == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmApply.mock.afterApplyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmApply.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Apply implements mm_service.PromoEngine
+func (mmApply *PromoEngineMock) Apply(code string, items []model.Item, subtotal uint64) (da1 []model.DiscountLine, err error) {
+	mm_atomic.AddUint64(&mmApply.beforeApplyCounter, 1)
+	defer mm_atomic.AddUint64(&mmApply.afterApplyCounter, 1)
+
+	mmApply.t.Helper()
+
+	if mmApply.inspectFuncApply != nil {
+		mmApply.inspectFuncApply(code, items, subtotal)
+	}
+
+	mm_params := PromoEngineMockApplyParams{code, items, subtotal}
+
+	// Record call args
+	mmApply.ApplyMock.mutex.Lock()
+	mmApply.ApplyMock.callArgs = append(mmApply.ApplyMock.callArgs, &mm_params)
+	mmApply.ApplyMock.mutex.Unlock()
+
+	for _, e := range mmApply.ApplyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.da1, e.results.err
+		}
+	}
+
+	if mmApply.ApplyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmApply.ApplyMock.defaultExpectation.Counter, 1)
+		mm_want := mmApply.ApplyMock.defaultExpectation.params
+		mm_want_ptrs := mmApply.ApplyMock.defaultExpectation.paramPtrs
+
+		mm_got := PromoEngineMockApplyParams{code, items, subtotal}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmApply.t.Errorf("PromoEngineMock.Apply got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApply.ApplyMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmApply.t.Errorf("PromoEngineMock.Apply got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApply.ApplyMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.subtotal != nil && !minimock.Equal(*mm_want_ptrs.subtotal, mm_got.subtotal) {
+				mmApply.t.Errorf("PromoEngineMock.Apply got unexpected parameter subtotal, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmApply.ApplyMock.defaultExpectation.expectationOrigins.originSubtotal, *mm_want_ptrs.subtotal, mm_got.subtotal, minimock.Diff(*mm_want_ptrs.subtotal, mm_got.subtotal))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmApply.t.Errorf("PromoEngineMock.Apply got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmApply.ApplyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmApply.ApplyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmApply.t.Fatal("No results are set for the PromoEngineMock.Apply")
+		}
+		return (*mm_results).da1, (*mm_results).err
+	}
+	if mmApply.funcApply != nil {
+		return mmApply.funcApply(code, items, subtotal)
+	}
+	mmApply.t.Fatalf("Unexpected call to PromoEngineMock.Apply. %v %v %v", code, items, subtotal)
+	return
+}
+
+// ApplyAfterCounter returns a count of finished PromoEngineMock.Apply invocations
+func (mmApply *PromoEngineMock) ApplyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApply.afterApplyCounter)
+}
+
+// ApplyBeforeCounter returns a count of PromoEngineMock.Apply invocations
+func (mmApply *PromoEngineMock) ApplyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmApply.beforeApplyCounter)
+}
+
+// Calls returns a list of arguments used in each call to PromoEngineMock.Apply.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmApply *mPromoEngineMockApply) Calls() []*PromoEngineMockApplyParams {
+	mmApply.mutex.RLock()
+
+	argCopy := make([]*PromoEngineMockApplyParams, len(mmApply.callArgs))
+	copy(argCopy, mmApply.callArgs)
+
+	mmApply.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockApplyDone returns true if the count of the Apply invocations corresponds
+// the number of defined expectations
+func (m *PromoEngineMock) MinimockApplyDone() bool {
+	if m.ApplyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ApplyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ApplyMock.invocationsDone()
+}
+
+// MinimockApplyInspect logs each unmet expectation
+func (m *PromoEngineMock) MinimockApplyInspect() {
+	for _, e := range m.ApplyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to PromoEngineMock.Apply at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterApplyCounter := mm_atomic.LoadUint64(&m.afterApplyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ApplyMock.defaultExpectation != nil && afterApplyCounter < 1 {
+		if m.ApplyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to PromoEngineMock.Apply at\n%s", m.ApplyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to PromoEngineMock.Apply at\n%s with params: %#v", m.ApplyMock.defaultExpectation.expectationOrigins.origin, *m.ApplyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcApply != nil && afterApplyCounter < 1 {
+		m.t.Errorf("Expected call to PromoEngineMock.Apply at\n%s", m.funcApplyOrigin)
+	}
+
+	if !m.ApplyMock.invocationsDone() && afterApplyCounter > 0 {
+		m.t.Errorf("Expected %d calls to PromoEngineMock.Apply at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ApplyMock.expectedInvocations), m.ApplyMock.expectedInvocationsOrigin, afterApplyCounter)
+	}
+}
+
+// MinimockFinish checks that all mocked methods have been called the expected number of times
+func (m *PromoEngineMock) MinimockFinish() {
+	m.finishOnce.Do(func() {
+		if !m.minimockDone() {
+			m.MinimockApplyInspect()
+		}
+	})
+}
+
+// MinimockWait waits for all mocked methods to be called the expected number of times
+func (m *PromoEngineMock) MinimockWait(timeout mm_time.Duration) {
+	timeoutCh := mm_time.After(timeout)
+	for {
+		if m.minimockDone() {
+			return
+		}
+		select {
+		case <-timeoutCh:
+			m.MinimockFinish()
+			return
+		case <-mm_time.After(10 * mm_time.Millisecond):
+		}
+	}
+}
+
+func (m *PromoEngineMock) minimockDone() bool {
+	done := true
+	return done &&
+		m.MinimockApplyDone()
+}
@@ -8,6 +8,7 @@ import (
 	"context"
 	"sync"
 	mm_atomic "sync/atomic"
+	"time"
 	mm_time "time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
@@ -26,6 +27,13 @@ type RepositoryMock struct {
 	beforeAddCounter uint64
 	AddMock          mRepositoryMockAdd
 
+	funcCheckout          func(ctx context.Context, userID int64, items []model.Cart, orderID int64) (err error)
+	funcCheckoutOrigin    string
+	inspectFuncCheckout   func(ctx context.Context, userID int64, items []model.Cart, orderID int64)
+	afterCheckoutCounter  uint64
+	beforeCheckoutCounter uint64
+	CheckoutMock          mRepositoryMockCheckout
+
 	funcClose          func()
 	funcCloseOrigin    string
 	inspectFuncClose   func()
@@ -33,6 +41,13 @@ type RepositoryMock struct {
 	beforeCloseCounter uint64
 	CloseMock          mRepositoryMockClose
 
+	funcCompleteIdempotencyKey          func(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) (err error)
+	funcCompleteIdempotencyKeyOrigin    string
+	inspectFuncCompleteIdempotencyKey   func(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration)
+	afterCompleteIdempotencyKeyCounter  uint64
+	beforeCompleteIdempotencyKeyCounter uint64
+	CompleteIdempotencyKeyMock          mRepositoryMockCompleteIdempotencyKey
+
 	funcDeleteAllItemsFromCart          func(ctx context.Context, cartItems model.RequestData) (err error)
 	funcDeleteAllItemsFromCartOrigin    string
 	inspectFuncDeleteAllItemsFromCart   func(ctx context.Context, cartItems model.RequestData)
@@ -47,12 +62,110 @@ type RepositoryMock struct {
 	beforeDeleteItemsBySkuCounter uint64
 	DeleteItemsBySkuMock          mRepositoryMockDeleteItemsBySku
 
+	funcDeleteSavedItem          func(ctx context.Context, userID int64, sku int64) (err error)
+	funcDeleteSavedItemOrigin    string
+	inspectFuncDeleteSavedItem   func(ctx context.Context, userID int64, sku int64)
+	afterDeleteSavedItemCounter  uint64
+	beforeDeleteSavedItemCounter uint64
+	DeleteSavedItemMock          mRepositoryMockDeleteSavedItem
+
+	funcGetAbandonedCarts          func(ctx context.Context, before time.Time, limit int) (aa1 []model.AbandonedCart, err error)
+	funcGetAbandonedCartsOrigin    string
+	inspectFuncGetAbandonedCarts   func(ctx context.Context, before time.Time, limit int)
+	afterGetAbandonedCartsCounter  uint64
+	beforeGetAbandonedCartsCounter uint64
+	GetAbandonedCartsMock          mRepositoryMockGetAbandonedCarts
+
+	funcGetCheckoutOrderID          func(ctx context.Context, userID int64) (i1 int64, err error)
+	funcGetCheckoutOrderIDOrigin    string
+	inspectFuncGetCheckoutOrderID   func(ctx context.Context, userID int64)
+	afterGetCheckoutOrderIDCounter  uint64
+	beforeGetCheckoutOrderIDCounter uint64
+	GetCheckoutOrderIDMock          mRepositoryMockGetCheckoutOrderID
+
 	funcGetItemsByUserID          func(ctx context.Context, cartItems model.RequestData) (ca1 []model.Cart, err error)
 	funcGetItemsByUserIDOrigin    string
 	inspectFuncGetItemsByUserID   func(ctx context.Context, cartItems model.RequestData)
 	afterGetItemsByUserIDCounter  uint64
 	beforeGetItemsByUserIDCounter uint64
 	GetItemsByUserIDMock          mRepositoryMockGetItemsByUserID
+
+	funcGetPromoCode          func(ctx context.Context, userID int64) (s1 string, err error)
+	funcGetPromoCodeOrigin    string
+	inspectFuncGetPromoCode   func(ctx context.Context, userID int64)
+	afterGetPromoCodeCounter  uint64
+	beforeGetPromoCodeCounter uint64
+	GetPromoCodeMock          mRepositoryMockGetPromoCode
+
+	funcGetSavedItems          func(ctx context.Context, userID int64) (ca1 []model.Cart, err error)
+	funcGetSavedItemsOrigin    string
+	inspectFuncGetSavedItems   func(ctx context.Context, userID int64)
+	afterGetSavedItemsCounter  uint64
+	beforeGetSavedItemsCounter uint64
+	GetSavedItemsMock          mRepositoryMockGetSavedItems
+
+	funcGetVersion          func(ctx context.Context, userID int64) (u1 uint64, err error)
+	funcGetVersionOrigin    string
+	inspectFuncGetVersion   func(ctx context.Context, userID int64)
+	afterGetVersionCounter  uint64
+	beforeGetVersionCounter uint64
+	GetVersionMock          mRepositoryMockGetVersion
+
+	funcMarkAbandonedReported          func(ctx context.Context, userID int64, updatedAt time.Time) (err error)
+	funcMarkAbandonedReportedOrigin    string
+	inspectFuncMarkAbandonedReported   func(ctx context.Context, userID int64, updatedAt time.Time)
+	afterMarkAbandonedReportedCounter  uint64
+	beforeMarkAbandonedReportedCounter uint64
+	MarkAbandonedReportedMock          mRepositoryMockMarkAbandonedReported
+
+	funcMerge          func(ctx context.Context, targetUserID int64, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) (ma1 []model.MergedLine, err error)
+	funcMergeOrigin    string
+	inspectFuncMerge   func(ctx context.Context, targetUserID int64, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits)
+	afterMergeCounter  uint64
+	beforeMergeCounter uint64
+	MergeMock          mRepositoryMockMerge
+
+	funcMoveToCart          func(ctx context.Context, item model.RequestData) (err error)
+	funcMoveToCartOrigin    string
+	inspectFuncMoveToCart   func(ctx context.Context, item model.RequestData)
+	afterMoveToCartCounter  uint64
+	beforeMoveToCartCounter uint64
+	MoveToCartMock          mRepositoryMockMoveToCart
+
+	funcReleaseIdempotencyKey          func(ctx context.Context, userID int64, key string) (err error)
+	funcReleaseIdempotencyKeyOrigin    string
+	inspectFuncReleaseIdempotencyKey   func(ctx context.Context, userID int64, key string)
+	afterReleaseIdempotencyKeyCounter  uint64
+	beforeReleaseIdempotencyKeyCounter uint64
+	ReleaseIdempotencyKeyMock          mRepositoryMockReleaseIdempotencyKey
+
+	funcReserveIdempotencyKey          func(ctx context.Context, userID int64, key string, ttl time.Duration) (i1 model.IdempotencyRecord, b1 bool, err error)
+	funcReserveIdempotencyKeyOrigin    string
+	inspectFuncReserveIdempotencyKey   func(ctx context.Context, userID int64, key string, ttl time.Duration)
+	afterReserveIdempotencyKeyCounter  uint64
+	beforeReserveIdempotencyKeyCounter uint64
+	ReserveIdempotencyKeyMock          mRepositoryMockReserveIdempotencyKey
+
+	funcSaveForLater          func(ctx context.Context, userID int64, sku int64) (err error)
+	funcSaveForLaterOrigin    string
+	inspectFuncSaveForLater   func(ctx context.Context, userID int64, sku int64)
+	afterSaveForLaterCounter  uint64
+	beforeSaveForLaterCounter uint64
+	SaveForLaterMock          mRepositoryMockSaveForLater
+
+	funcSetCount          func(ctx context.Context, cartItems model.RequestData) (err error)
+	funcSetCountOrigin    string
+	inspectFuncSetCount   func(ctx context.Context, cartItems model.RequestData)
+	afterSetCountCounter  uint64
+	beforeSetCountCounter uint64
+	SetCountMock          mRepositoryMockSetCount
+
+	funcSetPromoCode          func(ctx context.Context, userID int64, code string) (err error)
+	funcSetPromoCodeOrigin    string
+	inspectFuncSetPromoCode   func(ctx context.Context, userID int64, code string)
+	afterSetPromoCodeCounter  uint64
+	beforeSetPromoCodeCounter uint64
+	SetPromoCodeMock          mRepositoryMockSetPromoCode
 }
 
 // NewRepositoryMock returns a mock for mm_service.Repository
@@ -66,17 +179,65 @@ func NewRepositoryMock(t minimock.Tester) *RepositoryMock {
 	m.AddMock = mRepositoryMockAdd{mock: m}
 	m.AddMock.callArgs = []*RepositoryMockAddParams{}
 
+	m.CheckoutMock = mRepositoryMockCheckout{mock: m}
+	m.CheckoutMock.callArgs = []*RepositoryMockCheckoutParams{}
+
 	m.CloseMock = mRepositoryMockClose{mock: m}
 
+	m.CompleteIdempotencyKeyMock = mRepositoryMockCompleteIdempotencyKey{mock: m}
+	m.CompleteIdempotencyKeyMock.callArgs = []*RepositoryMockCompleteIdempotencyKeyParams{}
+
 	m.DeleteAllItemsFromCartMock = mRepositoryMockDeleteAllItemsFromCart{mock: m}
 	m.DeleteAllItemsFromCartMock.callArgs = []*RepositoryMockDeleteAllItemsFromCartParams{}
 
 	m.DeleteItemsBySkuMock = mRepositoryMockDeleteItemsBySku{mock: m}
 	m.DeleteItemsBySkuMock.callArgs = []*RepositoryMockDeleteItemsBySkuParams{}
 
+	m.DeleteSavedItemMock = mRepositoryMockDeleteSavedItem{mock: m}
+	m.DeleteSavedItemMock.callArgs = []*RepositoryMockDeleteSavedItemParams{}
+
+	m.GetAbandonedCartsMock = mRepositoryMockGetAbandonedCarts{mock: m}
+	m.GetAbandonedCartsMock.callArgs = []*RepositoryMockGetAbandonedCartsParams{}
+
+	m.GetCheckoutOrderIDMock = mRepositoryMockGetCheckoutOrderID{mock: m}
+	m.GetCheckoutOrderIDMock.callArgs = []*RepositoryMockGetCheckoutOrderIDParams{}
+
 	m.GetItemsByUserIDMock = mRepositoryMockGetItemsByUserID{mock: m}
 	m.GetItemsByUserIDMock.callArgs = []*RepositoryMockGetItemsByUserIDParams{}
 
+	m.GetPromoCodeMock = mRepositoryMockGetPromoCode{mock: m}
+	m.GetPromoCodeMock.callArgs = []*RepositoryMockGetPromoCodeParams{}
+
+	m.GetSavedItemsMock = mRepositoryMockGetSavedItems{mock: m}
+	m.GetSavedItemsMock.callArgs = []*RepositoryMockGetSavedItemsParams{}
+
+	m.GetVersionMock = mRepositoryMockGetVersion{mock: m}
+	m.GetVersionMock.callArgs = []*RepositoryMockGetVersionParams{}
+
+	m.MarkAbandonedReportedMock = mRepositoryMockMarkAbandonedReported{mock: m}
+	m.MarkAbandonedReportedMock.callArgs = []*RepositoryMockMarkAbandonedReportedParams{}
+
+	m.MergeMock = mRepositoryMockMerge{mock: m}
+	m.MergeMock.callArgs = []*RepositoryMockMergeParams{}
+
+	m.MoveToCartMock = mRepositoryMockMoveToCart{mock: m}
+	m.MoveToCartMock.callArgs = []*RepositoryMockMoveToCartParams{}
+
+	m.ReleaseIdempotencyKeyMock = mRepositoryMockReleaseIdempotencyKey{mock: m}
+	m.ReleaseIdempotencyKeyMock.callArgs = []*RepositoryMockReleaseIdempotencyKeyParams{}
+
+	m.ReserveIdempotencyKeyMock = mRepositoryMockReserveIdempotencyKey{mock: m}
+	m.ReserveIdempotencyKeyMock.callArgs = []*RepositoryMockReserveIdempotencyKeyParams{}
+
+	m.SaveForLaterMock = mRepositoryMockSaveForLater{mock: m}
+	m.SaveForLaterMock.callArgs = []*RepositoryMockSaveForLaterParams{}
+
+	m.SetCountMock = mRepositoryMockSetCount{mock: m}
+	m.SetCountMock.callArgs = []*RepositoryMockSetCountParams{}
+
+	m.SetPromoCodeMock = mRepositoryMockSetPromoCode{mock: m}
+	m.SetPromoCodeMock.callArgs = []*RepositoryMockSetPromoCodeParams{}
+
 	t.Cleanup(m.MinimockFinish)
 
 	return m
@@ -424,6 +585,410 @@ func (m *RepositoryMock) MinimockAddInspect() {
 	}
 }
 
+type mRepositoryMockCheckout struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockCheckoutExpectation
+	expectations       []*RepositoryMockCheckoutExpectation
+
+	callArgs []*RepositoryMockCheckoutParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockCheckoutExpectation specifies expectation struct of the Repository.Checkout
+type RepositoryMockCheckoutExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockCheckoutParams
+	paramPtrs          *RepositoryMockCheckoutParamPtrs
+	expectationOrigins RepositoryMockCheckoutExpectationOrigins
+	results            *RepositoryMockCheckoutResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockCheckoutParams contains parameters of the Repository.Checkout
+type RepositoryMockCheckoutParams struct {
+	ctx     context.Context
+	userID  int64
+	items   []model.Cart
+	orderID int64
+}
+
+// RepositoryMockCheckoutParamPtrs contains pointers to parameters of the Repository.Checkout
+type RepositoryMockCheckoutParamPtrs struct {
+	ctx     *context.Context
+	userID  *int64
+	items   *[]model.Cart
+	orderID *int64
+}
+
+// RepositoryMockCheckoutResults contains results of the Repository.Checkout
+type RepositoryMockCheckoutResults struct {
+	err error
+}
+
+// RepositoryMockCheckoutOrigins contains origins of expectations of the Repository.Checkout
+type RepositoryMockCheckoutExpectationOrigins struct {
+	origin        string
+	originCtx     string
+	originUserID  string
+	originItems   string
+	originOrderID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmCheckout *mRepositoryMockCheckout) Optional() *mRepositoryMockCheckout {
+	mmCheckout.optional = true
+	return mmCheckout
+}
+
+// Expect sets up expected params for Repository.Checkout
+func (mmCheckout *mRepositoryMockCheckout) Expect(ctx context.Context, userID int64, items []model.Cart, orderID int64) *mRepositoryMockCheckout {
+	if mmCheckout.mock.funcCheckout != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Set")
+	}
+
+	if mmCheckout.defaultExpectation == nil {
+		mmCheckout.defaultExpectation = &RepositoryMockCheckoutExpectation{}
+	}
+
+	if mmCheckout.defaultExpectation.paramPtrs != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by ExpectParams functions")
+	}
+
+	mmCheckout.defaultExpectation.params = &RepositoryMockCheckoutParams{ctx, userID, items, orderID}
+	mmCheckout.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCheckout.expectations {
+		if minimock.Equal(e.params, mmCheckout.defaultExpectation.params) {
+			mmCheckout.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCheckout.defaultExpectation.params)
+		}
+	}
+
+	return mmCheckout
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Checkout
+func (mmCheckout *mRepositoryMockCheckout) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCheckout {
+	if mmCheckout.mock.funcCheckout != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Set")
+	}
+
+	if mmCheckout.defaultExpectation == nil {
+		mmCheckout.defaultExpectation = &RepositoryMockCheckoutExpectation{}
+	}
+
+	if mmCheckout.defaultExpectation.params != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Expect")
+	}
+
+	if mmCheckout.defaultExpectation.paramPtrs == nil {
+		mmCheckout.defaultExpectation.paramPtrs = &RepositoryMockCheckoutParamPtrs{}
+	}
+	mmCheckout.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCheckout.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCheckout
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.Checkout
+func (mmCheckout *mRepositoryMockCheckout) ExpectUserIDParam2(userID int64) *mRepositoryMockCheckout {
+	if mmCheckout.mock.funcCheckout != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Set")
+	}
+
+	if mmCheckout.defaultExpectation == nil {
+		mmCheckout.defaultExpectation = &RepositoryMockCheckoutExpectation{}
+	}
+
+	if mmCheckout.defaultExpectation.params != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Expect")
+	}
+
+	if mmCheckout.defaultExpectation.paramPtrs == nil {
+		mmCheckout.defaultExpectation.paramPtrs = &RepositoryMockCheckoutParamPtrs{}
+	}
+	mmCheckout.defaultExpectation.paramPtrs.userID = &userID
+	mmCheckout.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCheckout
+}
+
+// ExpectItemsParam3 sets up expected param items for Repository.Checkout
+func (mmCheckout *mRepositoryMockCheckout) ExpectItemsParam3(items []model.Cart) *mRepositoryMockCheckout {
+	if mmCheckout.mock.funcCheckout != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Set")
+	}
+
+	if mmCheckout.defaultExpectation == nil {
+		mmCheckout.defaultExpectation = &RepositoryMockCheckoutExpectation{}
+	}
+
+	if mmCheckout.defaultExpectation.params != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Expect")
+	}
+
+	if mmCheckout.defaultExpectation.paramPtrs == nil {
+		mmCheckout.defaultExpectation.paramPtrs = &RepositoryMockCheckoutParamPtrs{}
+	}
+	mmCheckout.defaultExpectation.paramPtrs.items = &items
+	mmCheckout.defaultExpectation.expectationOrigins.originItems = minimock.CallerInfo(1)
+
+	return mmCheckout
+}
+
+// ExpectOrderIDParam4 sets up expected param orderID for Repository.Checkout
+func (mmCheckout *mRepositoryMockCheckout) ExpectOrderIDParam4(orderID int64) *mRepositoryMockCheckout {
+	if mmCheckout.mock.funcCheckout != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Set")
+	}
+
+	if mmCheckout.defaultExpectation == nil {
+		mmCheckout.defaultExpectation = &RepositoryMockCheckoutExpectation{}
+	}
+
+	if mmCheckout.defaultExpectation.params != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Expect")
+	}
+
+	if mmCheckout.defaultExpectation.paramPtrs == nil {
+		mmCheckout.defaultExpectation.paramPtrs = &RepositoryMockCheckoutParamPtrs{}
+	}
+	mmCheckout.defaultExpectation.paramPtrs.orderID = &orderID
+	mmCheckout.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmCheckout
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Checkout
+func (mmCheckout *mRepositoryMockCheckout) Inspect(f func(ctx context.Context, userID int64, items []model.Cart, orderID int64)) *mRepositoryMockCheckout {
+	if mmCheckout.mock.inspectFuncCheckout != nil {
+		mmCheckout.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Checkout")
+	}
+
+	mmCheckout.mock.inspectFuncCheckout = f
+
+	return mmCheckout
+}
+
+// Return sets up results that will be returned by Repository.Checkout
+func (mmCheckout *mRepositoryMockCheckout) Return(err error) *RepositoryMock {
+	if mmCheckout.mock.funcCheckout != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Set")
+	}
+
+	if mmCheckout.defaultExpectation == nil {
+		mmCheckout.defaultExpectation = &RepositoryMockCheckoutExpectation{mock: mmCheckout.mock}
+	}
+	mmCheckout.defaultExpectation.results = &RepositoryMockCheckoutResults{err}
+	mmCheckout.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCheckout.mock
+}
+
+// Set uses given function f to mock the Repository.Checkout method
+func (mmCheckout *mRepositoryMockCheckout) Set(f func(ctx context.Context, userID int64, items []model.Cart, orderID int64) (err error)) *RepositoryMock {
+	if mmCheckout.defaultExpectation != nil {
+		mmCheckout.mock.t.Fatalf("Default expectation is already set for the Repository.Checkout method")
+	}
+
+	if len(mmCheckout.expectations) > 0 {
+		mmCheckout.mock.t.Fatalf("Some expectations are already set for the Repository.Checkout method")
+	}
+
+	mmCheckout.mock.funcCheckout = f
+	mmCheckout.mock.funcCheckoutOrigin = minimock.CallerInfo(1)
+	return mmCheckout.mock
+}
+
+// When sets expectation for the Repository.Checkout which will trigger the result defined by the following
+// Then helper
+func (mmCheckout *mRepositoryMockCheckout) When(ctx context.Context, userID int64, items []model.Cart, orderID int64) *RepositoryMockCheckoutExpectation {
+	if mmCheckout.mock.funcCheckout != nil {
+		mmCheckout.mock.t.Fatalf("RepositoryMock.Checkout mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCheckoutExpectation{
+		mock:               mmCheckout.mock,
+		params:             &RepositoryMockCheckoutParams{ctx, userID, items, orderID},
+		expectationOrigins: RepositoryMockCheckoutExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCheckout.expectations = append(mmCheckout.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Checkout return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCheckoutExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCheckoutResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Checkout should be invoked
+func (mmCheckout *mRepositoryMockCheckout) Times(n uint64) *mRepositoryMockCheckout {
+	if n == 0 {
+		mmCheckout.mock.t.Fatalf("Times of RepositoryMock.Checkout mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCheckout.expectedInvocations, n)
+	mmCheckout.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCheckout
+}
+
+func (mmCheckout *mRepositoryMockCheckout) invocationsDone() bool {
+	if len(mmCheckout.expectations) == 0 && mmCheckout.defaultExpectation == nil && mmCheckout.mock.funcCheckout == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCheckout.mock.afterCheckoutCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCheckout.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Checkout implements mm_service.Repository
+func (mmCheckout *RepositoryMock) Checkout(ctx context.Context, userID int64, items []model.Cart, orderID int64) (err error) {
+	mm_atomic.AddUint64(&mmCheckout.beforeCheckoutCounter, 1)
+	defer mm_atomic.AddUint64(&mmCheckout.afterCheckoutCounter, 1)
+
+	mmCheckout.t.Helper()
+
+	if mmCheckout.inspectFuncCheckout != nil {
+		mmCheckout.inspectFuncCheckout(ctx, userID, items, orderID)
+	}
+
+	mm_params := RepositoryMockCheckoutParams{ctx, userID, items, orderID}
+
+	// Record call args
+	mmCheckout.CheckoutMock.mutex.Lock()
+	mmCheckout.CheckoutMock.callArgs = append(mmCheckout.CheckoutMock.callArgs, &mm_params)
+	mmCheckout.CheckoutMock.mutex.Unlock()
+
+	for _, e := range mmCheckout.CheckoutMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCheckout.CheckoutMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCheckout.CheckoutMock.defaultExpectation.Counter, 1)
+		mm_want := mmCheckout.CheckoutMock.defaultExpectation.params
+		mm_want_ptrs := mmCheckout.CheckoutMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCheckoutParams{ctx, userID, items, orderID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCheckout.t.Errorf("RepositoryMock.Checkout got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckout.CheckoutMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCheckout.t.Errorf("RepositoryMock.Checkout got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckout.CheckoutMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.items != nil && !minimock.Equal(*mm_want_ptrs.items, mm_got.items) {
+				mmCheckout.t.Errorf("RepositoryMock.Checkout got unexpected parameter items, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckout.CheckoutMock.defaultExpectation.expectationOrigins.originItems, *mm_want_ptrs.items, mm_got.items, minimock.Diff(*mm_want_ptrs.items, mm_got.items))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmCheckout.t.Errorf("RepositoryMock.Checkout got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCheckout.CheckoutMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCheckout.t.Errorf("RepositoryMock.Checkout got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCheckout.CheckoutMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCheckout.CheckoutMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCheckout.t.Fatal("No results are set for the RepositoryMock.Checkout")
+		}
+		return (*mm_results).err
+	}
+	if mmCheckout.funcCheckout != nil {
+		return mmCheckout.funcCheckout(ctx, userID, items, orderID)
+	}
+	mmCheckout.t.Fatalf("Unexpected call to RepositoryMock.Checkout. %v %v %v %v", ctx, userID, items, orderID)
+	return
+}
+
+// CheckoutAfterCounter returns a count of finished RepositoryMock.Checkout invocations
+func (mmCheckout *RepositoryMock) CheckoutAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckout.afterCheckoutCounter)
+}
+
+// CheckoutBeforeCounter returns a count of RepositoryMock.Checkout invocations
+func (mmCheckout *RepositoryMock) CheckoutBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCheckout.beforeCheckoutCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Checkout.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCheckout *mRepositoryMockCheckout) Calls() []*RepositoryMockCheckoutParams {
+	mmCheckout.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCheckoutParams, len(mmCheckout.callArgs))
+	copy(argCopy, mmCheckout.callArgs)
+
+	mmCheckout.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCheckoutDone returns true if the count of the Checkout invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCheckoutDone() bool {
+	if m.CheckoutMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CheckoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CheckoutMock.invocationsDone()
+}
+
+// MinimockCheckoutInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCheckoutInspect() {
+	for _, e := range m.CheckoutMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Checkout at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCheckoutCounter := mm_atomic.LoadUint64(&m.afterCheckoutCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CheckoutMock.defaultExpectation != nil && afterCheckoutCounter < 1 {
+		if m.CheckoutMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Checkout at\n%s", m.CheckoutMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Checkout at\n%s with params: %#v", m.CheckoutMock.defaultExpectation.expectationOrigins.origin, *m.CheckoutMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCheckout != nil && afterCheckoutCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Checkout at\n%s", m.funcCheckoutOrigin)
+	}
+
+	if !m.CheckoutMock.invocationsDone() && afterCheckoutCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Checkout at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CheckoutMock.expectedInvocations), m.CheckoutMock.expectedInvocationsOrigin, afterCheckoutCounter)
+	}
+}
+
 type mRepositoryMockClose struct {
 	optional           bool
 	mock               *RepositoryMock
@@ -602,52 +1167,64 @@ func (m *RepositoryMock) MinimockCloseInspect() {
 	}
 }
 
-type mRepositoryMockDeleteAllItemsFromCart struct {
+type mRepositoryMockCompleteIdempotencyKey struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockDeleteAllItemsFromCartExpectation
-	expectations       []*RepositoryMockDeleteAllItemsFromCartExpectation
+	defaultExpectation *RepositoryMockCompleteIdempotencyKeyExpectation
+	expectations       []*RepositoryMockCompleteIdempotencyKeyExpectation
 
-	callArgs []*RepositoryMockDeleteAllItemsFromCartParams
+	callArgs []*RepositoryMockCompleteIdempotencyKeyParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockDeleteAllItemsFromCartExpectation specifies expectation struct of the Repository.DeleteAllItemsFromCart
-type RepositoryMockDeleteAllItemsFromCartExpectation struct {
+// RepositoryMockCompleteIdempotencyKeyExpectation specifies expectation struct of the Repository.CompleteIdempotencyKey
+type RepositoryMockCompleteIdempotencyKeyExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockDeleteAllItemsFromCartParams
-	paramPtrs          *RepositoryMockDeleteAllItemsFromCartParamPtrs
-	expectationOrigins RepositoryMockDeleteAllItemsFromCartExpectationOrigins
-	results            *RepositoryMockDeleteAllItemsFromCartResults
+	params             *RepositoryMockCompleteIdempotencyKeyParams
+	paramPtrs          *RepositoryMockCompleteIdempotencyKeyParamPtrs
+	expectationOrigins RepositoryMockCompleteIdempotencyKeyExpectationOrigins
+	results            *RepositoryMockCompleteIdempotencyKeyResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockDeleteAllItemsFromCartParams contains parameters of the Repository.DeleteAllItemsFromCart
-type RepositoryMockDeleteAllItemsFromCartParams struct {
-	ctx       context.Context
-	cartItems model.RequestData
+// RepositoryMockCompleteIdempotencyKeyParams contains parameters of the Repository.CompleteIdempotencyKey
+type RepositoryMockCompleteIdempotencyKeyParams struct {
+	ctx         context.Context
+	userID      int64
+	key         string
+	orderID     int64
+	fingerprint string
+	ttl         time.Duration
 }
 
-// RepositoryMockDeleteAllItemsFromCartParamPtrs contains pointers to parameters of the Repository.DeleteAllItemsFromCart
-type RepositoryMockDeleteAllItemsFromCartParamPtrs struct {
-	ctx       *context.Context
-	cartItems *model.RequestData
+// RepositoryMockCompleteIdempotencyKeyParamPtrs contains pointers to parameters of the Repository.CompleteIdempotencyKey
+type RepositoryMockCompleteIdempotencyKeyParamPtrs struct {
+	ctx         *context.Context
+	userID      *int64
+	key         *string
+	orderID     *int64
+	fingerprint *string
+	ttl         *time.Duration
 }
 
-// RepositoryMockDeleteAllItemsFromCartResults contains results of the Repository.DeleteAllItemsFromCart
-type RepositoryMockDeleteAllItemsFromCartResults struct {
+// RepositoryMockCompleteIdempotencyKeyResults contains results of the Repository.CompleteIdempotencyKey
+type RepositoryMockCompleteIdempotencyKeyResults struct {
 	err error
 }
 
-// RepositoryMockDeleteAllItemsFromCartOrigins contains origins of expectations of the Repository.DeleteAllItemsFromCart
-type RepositoryMockDeleteAllItemsFromCartExpectationOrigins struct {
-	origin          string
-	originCtx       string
-	originCartItems string
+// RepositoryMockCompleteIdempotencyKeyOrigins contains origins of expectations of the Repository.CompleteIdempotencyKey
+type RepositoryMockCompleteIdempotencyKeyExpectationOrigins struct {
+	origin            string
+	originCtx         string
+	originUserID      string
+	originKey         string
+	originOrderID     string
+	originFingerprint string
+	originTtl         string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -655,338 +1232,5930 @@ type RepositoryMockDeleteAllItemsFromCartExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Optional() *mRepositoryMockDeleteAllItemsFromCart {
-	mmDeleteAllItemsFromCart.optional = true
-	return mmDeleteAllItemsFromCart
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) Optional() *mRepositoryMockCompleteIdempotencyKey {
+	mmCompleteIdempotencyKey.optional = true
+	return mmCompleteIdempotencyKey
 }
 
-// Expect sets up expected params for Repository.DeleteAllItemsFromCart
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Expect(ctx context.Context, cartItems model.RequestData) *mRepositoryMockDeleteAllItemsFromCart {
-	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+// Expect sets up expected params for Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) Expect(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
-		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{}
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{}
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by ExpectParams functions")
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteAllItemsFromCart.defaultExpectation.params = &RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems}
-	mmDeleteAllItemsFromCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteAllItemsFromCart.expectations {
-		if minimock.Equal(e.params, mmDeleteAllItemsFromCart.defaultExpectation.params) {
-			mmDeleteAllItemsFromCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAllItemsFromCart.defaultExpectation.params)
+	mmCompleteIdempotencyKey.defaultExpectation.params = &RepositoryMockCompleteIdempotencyKeyParams{ctx, userID, key, orderID, fingerprint, ttl}
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmCompleteIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmCompleteIdempotencyKey.defaultExpectation.params) {
+			mmCompleteIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmCompleteIdempotencyKey.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteAllItemsFromCart
+	return mmCompleteIdempotencyKey
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteAllItemsFromCart
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteAllItemsFromCart {
+// ExpectCtxParam1 sets up expected param ctx for Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{}
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockCompleteIdempotencyKeyParamPtrs{}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmCompleteIdempotencyKey
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) ExpectUserIDParam2(userID int64) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{}
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockCompleteIdempotencyKeyParamPtrs{}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.userID = &userID
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmCompleteIdempotencyKey
+}
+
+// ExpectKeyParam3 sets up expected param key for Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) ExpectKeyParam3(key string) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{}
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockCompleteIdempotencyKeyParamPtrs{}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmCompleteIdempotencyKey
+}
+
+// ExpectOrderIDParam4 sets up expected param orderID for Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) ExpectOrderIDParam4(orderID int64) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{}
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockCompleteIdempotencyKeyParamPtrs{}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.orderID = &orderID
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originOrderID = minimock.CallerInfo(1)
+
+	return mmCompleteIdempotencyKey
+}
+
+// ExpectFingerprintParam5 sets up expected param fingerprint for Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) ExpectFingerprintParam5(fingerprint string) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{}
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockCompleteIdempotencyKeyParamPtrs{}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.fingerprint = &fingerprint
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originFingerprint = minimock.CallerInfo(1)
+
+	return mmCompleteIdempotencyKey
+}
+
+// ExpectTtlParam6 sets up expected param ttl for Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) ExpectTtlParam6(ttl time.Duration) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{}
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.params != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmCompleteIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockCompleteIdempotencyKeyParamPtrs{}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.paramPtrs.ttl = &ttl
+	mmCompleteIdempotencyKey.defaultExpectation.expectationOrigins.originTtl = minimock.CallerInfo(1)
+
+	return mmCompleteIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) Inspect(f func(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration)) *mRepositoryMockCompleteIdempotencyKey {
+	if mmCompleteIdempotencyKey.mock.inspectFuncCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Inspect function is already set for RepositoryMock.CompleteIdempotencyKey")
+	}
+
+	mmCompleteIdempotencyKey.mock.inspectFuncCompleteIdempotencyKey = f
+
+	return mmCompleteIdempotencyKey
+}
+
+// Return sets up results that will be returned by Repository.CompleteIdempotencyKey
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) Return(err error) *RepositoryMock {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	if mmCompleteIdempotencyKey.defaultExpectation == nil {
+		mmCompleteIdempotencyKey.defaultExpectation = &RepositoryMockCompleteIdempotencyKeyExpectation{mock: mmCompleteIdempotencyKey.mock}
+	}
+	mmCompleteIdempotencyKey.defaultExpectation.results = &RepositoryMockCompleteIdempotencyKeyResults{err}
+	mmCompleteIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmCompleteIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the Repository.CompleteIdempotencyKey method
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) Set(f func(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) (err error)) *RepositoryMock {
+	if mmCompleteIdempotencyKey.defaultExpectation != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the Repository.CompleteIdempotencyKey method")
+	}
+
+	if len(mmCompleteIdempotencyKey.expectations) > 0 {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the Repository.CompleteIdempotencyKey method")
+	}
+
+	mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey = f
+	mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmCompleteIdempotencyKey.mock
+}
+
+// When sets expectation for the Repository.CompleteIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) When(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) *RepositoryMockCompleteIdempotencyKeyExpectation {
+	if mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("RepositoryMock.CompleteIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockCompleteIdempotencyKeyExpectation{
+		mock:               mmCompleteIdempotencyKey.mock,
+		params:             &RepositoryMockCompleteIdempotencyKeyParams{ctx, userID, key, orderID, fingerprint, ttl},
+		expectationOrigins: RepositoryMockCompleteIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmCompleteIdempotencyKey.expectations = append(mmCompleteIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.CompleteIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockCompleteIdempotencyKeyExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockCompleteIdempotencyKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.CompleteIdempotencyKey should be invoked
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) Times(n uint64) *mRepositoryMockCompleteIdempotencyKey {
+	if n == 0 {
+		mmCompleteIdempotencyKey.mock.t.Fatalf("Times of RepositoryMock.CompleteIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmCompleteIdempotencyKey.expectedInvocations, n)
+	mmCompleteIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmCompleteIdempotencyKey
+}
+
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) invocationsDone() bool {
+	if len(mmCompleteIdempotencyKey.expectations) == 0 && mmCompleteIdempotencyKey.defaultExpectation == nil && mmCompleteIdempotencyKey.mock.funcCompleteIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.mock.afterCompleteIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// CompleteIdempotencyKey implements mm_service.Repository
+func (mmCompleteIdempotencyKey *RepositoryMock) CompleteIdempotencyKey(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) (err error) {
+	mm_atomic.AddUint64(&mmCompleteIdempotencyKey.beforeCompleteIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmCompleteIdempotencyKey.afterCompleteIdempotencyKeyCounter, 1)
+
+	mmCompleteIdempotencyKey.t.Helper()
+
+	if mmCompleteIdempotencyKey.inspectFuncCompleteIdempotencyKey != nil {
+		mmCompleteIdempotencyKey.inspectFuncCompleteIdempotencyKey(ctx, userID, key, orderID, fingerprint, ttl)
+	}
+
+	mm_params := RepositoryMockCompleteIdempotencyKeyParams{ctx, userID, key, orderID, fingerprint, ttl}
+
+	// Record call args
+	mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.mutex.Lock()
+	mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.callArgs = append(mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.callArgs, &mm_params)
+	mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockCompleteIdempotencyKeyParams{ctx, userID, key, orderID, fingerprint, ttl}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmCompleteIdempotencyKey.t.Errorf("RepositoryMock.CompleteIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmCompleteIdempotencyKey.t.Errorf("RepositoryMock.CompleteIdempotencyKey got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmCompleteIdempotencyKey.t.Errorf("RepositoryMock.CompleteIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.orderID != nil && !minimock.Equal(*mm_want_ptrs.orderID, mm_got.orderID) {
+				mmCompleteIdempotencyKey.t.Errorf("RepositoryMock.CompleteIdempotencyKey got unexpected parameter orderID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originOrderID, *mm_want_ptrs.orderID, mm_got.orderID, minimock.Diff(*mm_want_ptrs.orderID, mm_got.orderID))
+			}
+
+			if mm_want_ptrs.fingerprint != nil && !minimock.Equal(*mm_want_ptrs.fingerprint, mm_got.fingerprint) {
+				mmCompleteIdempotencyKey.t.Errorf("RepositoryMock.CompleteIdempotencyKey got unexpected parameter fingerprint, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originFingerprint, *mm_want_ptrs.fingerprint, mm_got.fingerprint, minimock.Diff(*mm_want_ptrs.fingerprint, mm_got.fingerprint))
+			}
+
+			if mm_want_ptrs.ttl != nil && !minimock.Equal(*mm_want_ptrs.ttl, mm_got.ttl) {
+				mmCompleteIdempotencyKey.t.Errorf("RepositoryMock.CompleteIdempotencyKey got unexpected parameter ttl, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.originTtl, *mm_want_ptrs.ttl, mm_got.ttl, minimock.Diff(*mm_want_ptrs.ttl, mm_got.ttl))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmCompleteIdempotencyKey.t.Errorf("RepositoryMock.CompleteIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmCompleteIdempotencyKey.CompleteIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmCompleteIdempotencyKey.t.Fatal("No results are set for the RepositoryMock.CompleteIdempotencyKey")
+		}
+		return (*mm_results).err
+	}
+	if mmCompleteIdempotencyKey.funcCompleteIdempotencyKey != nil {
+		return mmCompleteIdempotencyKey.funcCompleteIdempotencyKey(ctx, userID, key, orderID, fingerprint, ttl)
+	}
+	mmCompleteIdempotencyKey.t.Fatalf("Unexpected call to RepositoryMock.CompleteIdempotencyKey. %v %v %v %v %v %v", ctx, userID, key, orderID, fingerprint, ttl)
+	return
+}
+
+// CompleteIdempotencyKeyAfterCounter returns a count of finished RepositoryMock.CompleteIdempotencyKey invocations
+func (mmCompleteIdempotencyKey *RepositoryMock) CompleteIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.afterCompleteIdempotencyKeyCounter)
+}
+
+// CompleteIdempotencyKeyBeforeCounter returns a count of RepositoryMock.CompleteIdempotencyKey invocations
+func (mmCompleteIdempotencyKey *RepositoryMock) CompleteIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmCompleteIdempotencyKey.beforeCompleteIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.CompleteIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmCompleteIdempotencyKey *mRepositoryMockCompleteIdempotencyKey) Calls() []*RepositoryMockCompleteIdempotencyKeyParams {
+	mmCompleteIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockCompleteIdempotencyKeyParams, len(mmCompleteIdempotencyKey.callArgs))
+	copy(argCopy, mmCompleteIdempotencyKey.callArgs)
+
+	mmCompleteIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockCompleteIdempotencyKeyDone returns true if the count of the CompleteIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockCompleteIdempotencyKeyDone() bool {
+	if m.CompleteIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.CompleteIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.CompleteIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockCompleteIdempotencyKeyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockCompleteIdempotencyKeyInspect() {
+	for _, e := range m.CompleteIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.CompleteIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterCompleteIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterCompleteIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.CompleteIdempotencyKeyMock.defaultExpectation != nil && afterCompleteIdempotencyKeyCounter < 1 {
+		if m.CompleteIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.CompleteIdempotencyKey at\n%s", m.CompleteIdempotencyKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.CompleteIdempotencyKey at\n%s with params: %#v", m.CompleteIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.CompleteIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcCompleteIdempotencyKey != nil && afterCompleteIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.CompleteIdempotencyKey at\n%s", m.funcCompleteIdempotencyKeyOrigin)
+	}
+
+	if !m.CompleteIdempotencyKeyMock.invocationsDone() && afterCompleteIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.CompleteIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.CompleteIdempotencyKeyMock.expectedInvocations), m.CompleteIdempotencyKeyMock.expectedInvocationsOrigin, afterCompleteIdempotencyKeyCounter)
+	}
+}
+
+type mRepositoryMockDeleteAllItemsFromCart struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteAllItemsFromCartExpectation
+	expectations       []*RepositoryMockDeleteAllItemsFromCartExpectation
+
+	callArgs []*RepositoryMockDeleteAllItemsFromCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteAllItemsFromCartExpectation specifies expectation struct of the Repository.DeleteAllItemsFromCart
+type RepositoryMockDeleteAllItemsFromCartExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteAllItemsFromCartParams
+	paramPtrs          *RepositoryMockDeleteAllItemsFromCartParamPtrs
+	expectationOrigins RepositoryMockDeleteAllItemsFromCartExpectationOrigins
+	results            *RepositoryMockDeleteAllItemsFromCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteAllItemsFromCartParams contains parameters of the Repository.DeleteAllItemsFromCart
+type RepositoryMockDeleteAllItemsFromCartParams struct {
+	ctx       context.Context
+	cartItems model.RequestData
+}
+
+// RepositoryMockDeleteAllItemsFromCartParamPtrs contains pointers to parameters of the Repository.DeleteAllItemsFromCart
+type RepositoryMockDeleteAllItemsFromCartParamPtrs struct {
+	ctx       *context.Context
+	cartItems *model.RequestData
+}
+
+// RepositoryMockDeleteAllItemsFromCartResults contains results of the Repository.DeleteAllItemsFromCart
+type RepositoryMockDeleteAllItemsFromCartResults struct {
+	err error
+}
+
+// RepositoryMockDeleteAllItemsFromCartOrigins contains origins of expectations of the Repository.DeleteAllItemsFromCart
+type RepositoryMockDeleteAllItemsFromCartExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originCartItems string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Optional() *mRepositoryMockDeleteAllItemsFromCart {
+	mmDeleteAllItemsFromCart.optional = true
+	return mmDeleteAllItemsFromCart
+}
+
+// Expect sets up expected params for Repository.DeleteAllItemsFromCart
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Expect(ctx context.Context, cartItems model.RequestData) *mRepositoryMockDeleteAllItemsFromCart {
 	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
 		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
-		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{}
+	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
+		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{}
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteAllItemsFromCart.defaultExpectation.params = &RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems}
+	mmDeleteAllItemsFromCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteAllItemsFromCart.expectations {
+		if minimock.Equal(e.params, mmDeleteAllItemsFromCart.defaultExpectation.params) {
+			mmDeleteAllItemsFromCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteAllItemsFromCart.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteAllItemsFromCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteAllItemsFromCart
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteAllItemsFromCart {
+	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
+		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{}
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation.params != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Expect")
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs == nil {
+		mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs = &RepositoryMockDeleteAllItemsFromCartParamPtrs{}
+	}
+	mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteAllItemsFromCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteAllItemsFromCart
+}
+
+// ExpectCartItemsParam2 sets up expected param cartItems for Repository.DeleteAllItemsFromCart
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) ExpectCartItemsParam2(cartItems model.RequestData) *mRepositoryMockDeleteAllItemsFromCart {
+	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
+		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{}
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation.params != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Expect")
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs == nil {
+		mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs = &RepositoryMockDeleteAllItemsFromCartParamPtrs{}
+	}
+	mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs.cartItems = &cartItems
+	mmDeleteAllItemsFromCart.defaultExpectation.expectationOrigins.originCartItems = minimock.CallerInfo(1)
+
+	return mmDeleteAllItemsFromCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteAllItemsFromCart
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Inspect(f func(ctx context.Context, cartItems model.RequestData)) *mRepositoryMockDeleteAllItemsFromCart {
+	if mmDeleteAllItemsFromCart.mock.inspectFuncDeleteAllItemsFromCart != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteAllItemsFromCart")
+	}
+
+	mmDeleteAllItemsFromCart.mock.inspectFuncDeleteAllItemsFromCart = f
+
+	return mmDeleteAllItemsFromCart
+}
+
+// Return sets up results that will be returned by Repository.DeleteAllItemsFromCart
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Return(err error) *RepositoryMock {
+	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+	}
+
+	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
+		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{mock: mmDeleteAllItemsFromCart.mock}
+	}
+	mmDeleteAllItemsFromCart.defaultExpectation.results = &RepositoryMockDeleteAllItemsFromCartResults{err}
+	mmDeleteAllItemsFromCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteAllItemsFromCart.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteAllItemsFromCart method
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Set(f func(ctx context.Context, cartItems model.RequestData) (err error)) *RepositoryMock {
+	if mmDeleteAllItemsFromCart.defaultExpectation != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteAllItemsFromCart method")
+	}
+
+	if len(mmDeleteAllItemsFromCart.expectations) > 0 {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteAllItemsFromCart method")
+	}
+
+	mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart = f
+	mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCartOrigin = minimock.CallerInfo(1)
+	return mmDeleteAllItemsFromCart.mock
+}
+
+// When sets expectation for the Repository.DeleteAllItemsFromCart which will trigger the result defined by the following
+// Then helper
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) When(ctx context.Context, cartItems model.RequestData) *RepositoryMockDeleteAllItemsFromCartExpectation {
+	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteAllItemsFromCartExpectation{
+		mock:               mmDeleteAllItemsFromCart.mock,
+		params:             &RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems},
+		expectationOrigins: RepositoryMockDeleteAllItemsFromCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteAllItemsFromCart.expectations = append(mmDeleteAllItemsFromCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteAllItemsFromCart return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteAllItemsFromCartExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteAllItemsFromCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteAllItemsFromCart should be invoked
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Times(n uint64) *mRepositoryMockDeleteAllItemsFromCart {
+	if n == 0 {
+		mmDeleteAllItemsFromCart.mock.t.Fatalf("Times of RepositoryMock.DeleteAllItemsFromCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteAllItemsFromCart.expectedInvocations, n)
+	mmDeleteAllItemsFromCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteAllItemsFromCart
+}
+
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) invocationsDone() bool {
+	if len(mmDeleteAllItemsFromCart.expectations) == 0 && mmDeleteAllItemsFromCart.defaultExpectation == nil && mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.mock.afterDeleteAllItemsFromCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteAllItemsFromCart implements mm_service.Repository
+func (mmDeleteAllItemsFromCart *RepositoryMock) DeleteAllItemsFromCart(ctx context.Context, cartItems model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmDeleteAllItemsFromCart.beforeDeleteAllItemsFromCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteAllItemsFromCart.afterDeleteAllItemsFromCartCounter, 1)
+
+	mmDeleteAllItemsFromCart.t.Helper()
+
+	if mmDeleteAllItemsFromCart.inspectFuncDeleteAllItemsFromCart != nil {
+		mmDeleteAllItemsFromCart.inspectFuncDeleteAllItemsFromCart(ctx, cartItems)
+	}
+
+	mm_params := RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems}
+
+	// Record call args
+	mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.mutex.Lock()
+	mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.callArgs = append(mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.callArgs, &mm_params)
+	mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.mutex.Unlock()
+
+	for _, e := range mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteAllItemsFromCart.t.Errorf("RepositoryMock.DeleteAllItemsFromCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cartItems != nil && !minimock.Equal(*mm_want_ptrs.cartItems, mm_got.cartItems) {
+				mmDeleteAllItemsFromCart.t.Errorf("RepositoryMock.DeleteAllItemsFromCart got unexpected parameter cartItems, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.originCartItems, *mm_want_ptrs.cartItems, mm_got.cartItems, minimock.Diff(*mm_want_ptrs.cartItems, mm_got.cartItems))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteAllItemsFromCart.t.Errorf("RepositoryMock.DeleteAllItemsFromCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteAllItemsFromCart.t.Fatal("No results are set for the RepositoryMock.DeleteAllItemsFromCart")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteAllItemsFromCart.funcDeleteAllItemsFromCart != nil {
+		return mmDeleteAllItemsFromCart.funcDeleteAllItemsFromCart(ctx, cartItems)
+	}
+	mmDeleteAllItemsFromCart.t.Fatalf("Unexpected call to RepositoryMock.DeleteAllItemsFromCart. %v %v", ctx, cartItems)
+	return
+}
+
+// DeleteAllItemsFromCartAfterCounter returns a count of finished RepositoryMock.DeleteAllItemsFromCart invocations
+func (mmDeleteAllItemsFromCart *RepositoryMock) DeleteAllItemsFromCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.afterDeleteAllItemsFromCartCounter)
+}
+
+// DeleteAllItemsFromCartBeforeCounter returns a count of RepositoryMock.DeleteAllItemsFromCart invocations
+func (mmDeleteAllItemsFromCart *RepositoryMock) DeleteAllItemsFromCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.beforeDeleteAllItemsFromCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteAllItemsFromCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Calls() []*RepositoryMockDeleteAllItemsFromCartParams {
+	mmDeleteAllItemsFromCart.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteAllItemsFromCartParams, len(mmDeleteAllItemsFromCart.callArgs))
+	copy(argCopy, mmDeleteAllItemsFromCart.callArgs)
+
+	mmDeleteAllItemsFromCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteAllItemsFromCartDone returns true if the count of the DeleteAllItemsFromCart invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteAllItemsFromCartDone() bool {
+	if m.DeleteAllItemsFromCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteAllItemsFromCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteAllItemsFromCartMock.invocationsDone()
+}
+
+// MinimockDeleteAllItemsFromCartInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteAllItemsFromCartInspect() {
+	for _, e := range m.DeleteAllItemsFromCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteAllItemsFromCartCounter := mm_atomic.LoadUint64(&m.afterDeleteAllItemsFromCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteAllItemsFromCartMock.defaultExpectation != nil && afterDeleteAllItemsFromCartCounter < 1 {
+		if m.DeleteAllItemsFromCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s", m.DeleteAllItemsFromCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s with params: %#v", m.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAllItemsFromCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteAllItemsFromCart != nil && afterDeleteAllItemsFromCartCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s", m.funcDeleteAllItemsFromCartOrigin)
+	}
+
+	if !m.DeleteAllItemsFromCartMock.invocationsDone() && afterDeleteAllItemsFromCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteAllItemsFromCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteAllItemsFromCartMock.expectedInvocations), m.DeleteAllItemsFromCartMock.expectedInvocationsOrigin, afterDeleteAllItemsFromCartCounter)
+	}
+}
+
+type mRepositoryMockDeleteItemsBySku struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteItemsBySkuExpectation
+	expectations       []*RepositoryMockDeleteItemsBySkuExpectation
+
+	callArgs []*RepositoryMockDeleteItemsBySkuParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteItemsBySkuExpectation specifies expectation struct of the Repository.DeleteItemsBySku
+type RepositoryMockDeleteItemsBySkuExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteItemsBySkuParams
+	paramPtrs          *RepositoryMockDeleteItemsBySkuParamPtrs
+	expectationOrigins RepositoryMockDeleteItemsBySkuExpectationOrigins
+	results            *RepositoryMockDeleteItemsBySkuResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteItemsBySkuParams contains parameters of the Repository.DeleteItemsBySku
+type RepositoryMockDeleteItemsBySkuParams struct {
+	ctx       context.Context
+	cartItems model.RequestData
+}
+
+// RepositoryMockDeleteItemsBySkuParamPtrs contains pointers to parameters of the Repository.DeleteItemsBySku
+type RepositoryMockDeleteItemsBySkuParamPtrs struct {
+	ctx       *context.Context
+	cartItems *model.RequestData
+}
+
+// RepositoryMockDeleteItemsBySkuResults contains results of the Repository.DeleteItemsBySku
+type RepositoryMockDeleteItemsBySkuResults struct {
+	err error
+}
+
+// RepositoryMockDeleteItemsBySkuOrigins contains origins of expectations of the Repository.DeleteItemsBySku
+type RepositoryMockDeleteItemsBySkuExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originCartItems string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Optional() *mRepositoryMockDeleteItemsBySku {
+	mmDeleteItemsBySku.optional = true
+	return mmDeleteItemsBySku
+}
+
+// Expect sets up expected params for Repository.DeleteItemsBySku
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Expect(ctx context.Context, cartItems model.RequestData) *mRepositoryMockDeleteItemsBySku {
+	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation == nil {
+		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{}
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation.paramPtrs != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteItemsBySku.defaultExpectation.params = &RepositoryMockDeleteItemsBySkuParams{ctx, cartItems}
+	mmDeleteItemsBySku.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteItemsBySku.expectations {
+		if minimock.Equal(e.params, mmDeleteItemsBySku.defaultExpectation.params) {
+			mmDeleteItemsBySku.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItemsBySku.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteItemsBySku
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteItemsBySku
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteItemsBySku {
+	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation == nil {
+		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{}
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation.params != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Expect")
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation.paramPtrs == nil {
+		mmDeleteItemsBySku.defaultExpectation.paramPtrs = &RepositoryMockDeleteItemsBySkuParamPtrs{}
+	}
+	mmDeleteItemsBySku.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteItemsBySku.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteItemsBySku
+}
+
+// ExpectCartItemsParam2 sets up expected param cartItems for Repository.DeleteItemsBySku
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) ExpectCartItemsParam2(cartItems model.RequestData) *mRepositoryMockDeleteItemsBySku {
+	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation == nil {
+		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{}
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation.params != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Expect")
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation.paramPtrs == nil {
+		mmDeleteItemsBySku.defaultExpectation.paramPtrs = &RepositoryMockDeleteItemsBySkuParamPtrs{}
+	}
+	mmDeleteItemsBySku.defaultExpectation.paramPtrs.cartItems = &cartItems
+	mmDeleteItemsBySku.defaultExpectation.expectationOrigins.originCartItems = minimock.CallerInfo(1)
+
+	return mmDeleteItemsBySku
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteItemsBySku
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Inspect(f func(ctx context.Context, cartItems model.RequestData)) *mRepositoryMockDeleteItemsBySku {
+	if mmDeleteItemsBySku.mock.inspectFuncDeleteItemsBySku != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteItemsBySku")
+	}
+
+	mmDeleteItemsBySku.mock.inspectFuncDeleteItemsBySku = f
+
+	return mmDeleteItemsBySku
+}
+
+// Return sets up results that will be returned by Repository.DeleteItemsBySku
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Return(err error) *RepositoryMock {
+	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+	}
+
+	if mmDeleteItemsBySku.defaultExpectation == nil {
+		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{mock: mmDeleteItemsBySku.mock}
+	}
+	mmDeleteItemsBySku.defaultExpectation.results = &RepositoryMockDeleteItemsBySkuResults{err}
+	mmDeleteItemsBySku.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteItemsBySku.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteItemsBySku method
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Set(f func(ctx context.Context, cartItems model.RequestData) (err error)) *RepositoryMock {
+	if mmDeleteItemsBySku.defaultExpectation != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteItemsBySku method")
+	}
+
+	if len(mmDeleteItemsBySku.expectations) > 0 {
+		mmDeleteItemsBySku.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteItemsBySku method")
+	}
+
+	mmDeleteItemsBySku.mock.funcDeleteItemsBySku = f
+	mmDeleteItemsBySku.mock.funcDeleteItemsBySkuOrigin = minimock.CallerInfo(1)
+	return mmDeleteItemsBySku.mock
+}
+
+// When sets expectation for the Repository.DeleteItemsBySku which will trigger the result defined by the following
+// Then helper
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) When(ctx context.Context, cartItems model.RequestData) *RepositoryMockDeleteItemsBySkuExpectation {
+	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
+		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteItemsBySkuExpectation{
+		mock:               mmDeleteItemsBySku.mock,
+		params:             &RepositoryMockDeleteItemsBySkuParams{ctx, cartItems},
+		expectationOrigins: RepositoryMockDeleteItemsBySkuExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteItemsBySku.expectations = append(mmDeleteItemsBySku.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteItemsBySku return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteItemsBySkuExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteItemsBySkuResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteItemsBySku should be invoked
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Times(n uint64) *mRepositoryMockDeleteItemsBySku {
+	if n == 0 {
+		mmDeleteItemsBySku.mock.t.Fatalf("Times of RepositoryMock.DeleteItemsBySku mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteItemsBySku.expectedInvocations, n)
+	mmDeleteItemsBySku.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteItemsBySku
+}
+
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) invocationsDone() bool {
+	if len(mmDeleteItemsBySku.expectations) == 0 && mmDeleteItemsBySku.defaultExpectation == nil && mmDeleteItemsBySku.mock.funcDeleteItemsBySku == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItemsBySku.mock.afterDeleteItemsBySkuCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItemsBySku.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteItemsBySku implements mm_service.Repository
+func (mmDeleteItemsBySku *RepositoryMock) DeleteItemsBySku(ctx context.Context, cartItems model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmDeleteItemsBySku.beforeDeleteItemsBySkuCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteItemsBySku.afterDeleteItemsBySkuCounter, 1)
+
+	mmDeleteItemsBySku.t.Helper()
+
+	if mmDeleteItemsBySku.inspectFuncDeleteItemsBySku != nil {
+		mmDeleteItemsBySku.inspectFuncDeleteItemsBySku(ctx, cartItems)
+	}
+
+	mm_params := RepositoryMockDeleteItemsBySkuParams{ctx, cartItems}
+
+	// Record call args
+	mmDeleteItemsBySku.DeleteItemsBySkuMock.mutex.Lock()
+	mmDeleteItemsBySku.DeleteItemsBySkuMock.callArgs = append(mmDeleteItemsBySku.DeleteItemsBySkuMock.callArgs, &mm_params)
+	mmDeleteItemsBySku.DeleteItemsBySkuMock.mutex.Unlock()
+
+	for _, e := range mmDeleteItemsBySku.DeleteItemsBySkuMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteItemsBySkuParams{ctx, cartItems}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteItemsBySku.t.Errorf("RepositoryMock.DeleteItemsBySku got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cartItems != nil && !minimock.Equal(*mm_want_ptrs.cartItems, mm_got.cartItems) {
+				mmDeleteItemsBySku.t.Errorf("RepositoryMock.DeleteItemsBySku got unexpected parameter cartItems, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.originCartItems, *mm_want_ptrs.cartItems, mm_got.cartItems, minimock.Diff(*mm_want_ptrs.cartItems, mm_got.cartItems))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteItemsBySku.t.Errorf("RepositoryMock.DeleteItemsBySku got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteItemsBySku.t.Fatal("No results are set for the RepositoryMock.DeleteItemsBySku")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteItemsBySku.funcDeleteItemsBySku != nil {
+		return mmDeleteItemsBySku.funcDeleteItemsBySku(ctx, cartItems)
+	}
+	mmDeleteItemsBySku.t.Fatalf("Unexpected call to RepositoryMock.DeleteItemsBySku. %v %v", ctx, cartItems)
+	return
+}
+
+// DeleteItemsBySkuAfterCounter returns a count of finished RepositoryMock.DeleteItemsBySku invocations
+func (mmDeleteItemsBySku *RepositoryMock) DeleteItemsBySkuAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItemsBySku.afterDeleteItemsBySkuCounter)
+}
+
+// DeleteItemsBySkuBeforeCounter returns a count of RepositoryMock.DeleteItemsBySku invocations
+func (mmDeleteItemsBySku *RepositoryMock) DeleteItemsBySkuBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteItemsBySku.beforeDeleteItemsBySkuCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteItemsBySku.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Calls() []*RepositoryMockDeleteItemsBySkuParams {
+	mmDeleteItemsBySku.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteItemsBySkuParams, len(mmDeleteItemsBySku.callArgs))
+	copy(argCopy, mmDeleteItemsBySku.callArgs)
+
+	mmDeleteItemsBySku.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteItemsBySkuDone returns true if the count of the DeleteItemsBySku invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteItemsBySkuDone() bool {
+	if m.DeleteItemsBySkuMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteItemsBySkuMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteItemsBySkuMock.invocationsDone()
+}
+
+// MinimockDeleteItemsBySkuInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteItemsBySkuInspect() {
+	for _, e := range m.DeleteItemsBySkuMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteItemsBySkuCounter := mm_atomic.LoadUint64(&m.afterDeleteItemsBySkuCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteItemsBySkuMock.defaultExpectation != nil && afterDeleteItemsBySkuCounter < 1 {
+		if m.DeleteItemsBySkuMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s", m.DeleteItemsBySkuMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s with params: %#v", m.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemsBySkuMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteItemsBySku != nil && afterDeleteItemsBySkuCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s", m.funcDeleteItemsBySkuOrigin)
+	}
+
+	if !m.DeleteItemsBySkuMock.invocationsDone() && afterDeleteItemsBySkuCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteItemsBySku at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteItemsBySkuMock.expectedInvocations), m.DeleteItemsBySkuMock.expectedInvocationsOrigin, afterDeleteItemsBySkuCounter)
+	}
+}
+
+type mRepositoryMockDeleteSavedItem struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockDeleteSavedItemExpectation
+	expectations       []*RepositoryMockDeleteSavedItemExpectation
+
+	callArgs []*RepositoryMockDeleteSavedItemParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockDeleteSavedItemExpectation specifies expectation struct of the Repository.DeleteSavedItem
+type RepositoryMockDeleteSavedItemExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockDeleteSavedItemParams
+	paramPtrs          *RepositoryMockDeleteSavedItemParamPtrs
+	expectationOrigins RepositoryMockDeleteSavedItemExpectationOrigins
+	results            *RepositoryMockDeleteSavedItemResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockDeleteSavedItemParams contains parameters of the Repository.DeleteSavedItem
+type RepositoryMockDeleteSavedItemParams struct {
+	ctx    context.Context
+	userID int64
+	sku    int64
+}
+
+// RepositoryMockDeleteSavedItemParamPtrs contains pointers to parameters of the Repository.DeleteSavedItem
+type RepositoryMockDeleteSavedItemParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	sku    *int64
+}
+
+// RepositoryMockDeleteSavedItemResults contains results of the Repository.DeleteSavedItem
+type RepositoryMockDeleteSavedItemResults struct {
+	err error
+}
+
+// RepositoryMockDeleteSavedItemOrigins contains origins of expectations of the Repository.DeleteSavedItem
+type RepositoryMockDeleteSavedItemExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSku    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) Optional() *mRepositoryMockDeleteSavedItem {
+	mmDeleteSavedItem.optional = true
+	return mmDeleteSavedItem
+}
+
+// Expect sets up expected params for Repository.DeleteSavedItem
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) Expect(ctx context.Context, userID int64, sku int64) *mRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &RepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by ExpectParams functions")
+	}
+
+	mmDeleteSavedItem.defaultExpectation.params = &RepositoryMockDeleteSavedItemParams{ctx, userID, sku}
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmDeleteSavedItem.expectations {
+		if minimock.Equal(e.params, mmDeleteSavedItem.defaultExpectation.params) {
+			mmDeleteSavedItem.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteSavedItem.defaultExpectation.params)
+		}
+	}
+
+	return mmDeleteSavedItem
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteSavedItem
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &RepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &RepositoryMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.ctx = &ctx
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.DeleteSavedItem
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) ExpectUserIDParam2(userID int64) *mRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &RepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &RepositoryMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.userID = &userID
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// ExpectSkuParam3 sets up expected param sku for Repository.DeleteSavedItem
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) ExpectSkuParam3(sku int64) *mRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &RepositoryMockDeleteSavedItemExpectation{}
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.params != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Expect")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation.paramPtrs == nil {
+		mmDeleteSavedItem.defaultExpectation.paramPtrs = &RepositoryMockDeleteSavedItemParamPtrs{}
+	}
+	mmDeleteSavedItem.defaultExpectation.paramPtrs.sku = &sku
+	mmDeleteSavedItem.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
+
+	return mmDeleteSavedItem
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.DeleteSavedItem
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) Inspect(f func(ctx context.Context, userID int64, sku int64)) *mRepositoryMockDeleteSavedItem {
+	if mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteSavedItem")
+	}
+
+	mmDeleteSavedItem.mock.inspectFuncDeleteSavedItem = f
+
+	return mmDeleteSavedItem
+}
+
+// Return sets up results that will be returned by Repository.DeleteSavedItem
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) Return(err error) *RepositoryMock {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	if mmDeleteSavedItem.defaultExpectation == nil {
+		mmDeleteSavedItem.defaultExpectation = &RepositoryMockDeleteSavedItemExpectation{mock: mmDeleteSavedItem.mock}
+	}
+	mmDeleteSavedItem.defaultExpectation.results = &RepositoryMockDeleteSavedItemResults{err}
+	mmDeleteSavedItem.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// Set uses given function f to mock the Repository.DeleteSavedItem method
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) Set(f func(ctx context.Context, userID int64, sku int64) (err error)) *RepositoryMock {
+	if mmDeleteSavedItem.defaultExpectation != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteSavedItem method")
+	}
+
+	if len(mmDeleteSavedItem.expectations) > 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteSavedItem method")
+	}
+
+	mmDeleteSavedItem.mock.funcDeleteSavedItem = f
+	mmDeleteSavedItem.mock.funcDeleteSavedItemOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem.mock
+}
+
+// When sets expectation for the Repository.DeleteSavedItem which will trigger the result defined by the following
+// Then helper
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) When(ctx context.Context, userID int64, sku int64) *RepositoryMockDeleteSavedItemExpectation {
+	if mmDeleteSavedItem.mock.funcDeleteSavedItem != nil {
+		mmDeleteSavedItem.mock.t.Fatalf("RepositoryMock.DeleteSavedItem mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockDeleteSavedItemExpectation{
+		mock:               mmDeleteSavedItem.mock,
+		params:             &RepositoryMockDeleteSavedItemParams{ctx, userID, sku},
+		expectationOrigins: RepositoryMockDeleteSavedItemExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmDeleteSavedItem.expectations = append(mmDeleteSavedItem.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.DeleteSavedItem return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockDeleteSavedItemExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockDeleteSavedItemResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.DeleteSavedItem should be invoked
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) Times(n uint64) *mRepositoryMockDeleteSavedItem {
+	if n == 0 {
+		mmDeleteSavedItem.mock.t.Fatalf("Times of RepositoryMock.DeleteSavedItem mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmDeleteSavedItem.expectedInvocations, n)
+	mmDeleteSavedItem.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmDeleteSavedItem
+}
+
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) invocationsDone() bool {
+	if len(mmDeleteSavedItem.expectations) == 0 && mmDeleteSavedItem.defaultExpectation == nil && mmDeleteSavedItem.mock.funcDeleteSavedItem == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.mock.afterDeleteSavedItemCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteSavedItem.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// DeleteSavedItem implements mm_service.Repository
+func (mmDeleteSavedItem *RepositoryMock) DeleteSavedItem(ctx context.Context, userID int64, sku int64) (err error) {
+	mm_atomic.AddUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter, 1)
+	defer mm_atomic.AddUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter, 1)
+
+	mmDeleteSavedItem.t.Helper()
+
+	if mmDeleteSavedItem.inspectFuncDeleteSavedItem != nil {
+		mmDeleteSavedItem.inspectFuncDeleteSavedItem(ctx, userID, sku)
+	}
+
+	mm_params := RepositoryMockDeleteSavedItemParams{ctx, userID, sku}
+
+	// Record call args
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Lock()
+	mmDeleteSavedItem.DeleteSavedItemMock.callArgs = append(mmDeleteSavedItem.DeleteSavedItemMock.callArgs, &mm_params)
+	mmDeleteSavedItem.DeleteSavedItemMock.mutex.Unlock()
+
+	for _, e := range mmDeleteSavedItem.DeleteSavedItemMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.Counter, 1)
+		mm_want := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.params
+		mm_want_ptrs := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockDeleteSavedItemParams{ctx, userID, sku}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmDeleteSavedItem.t.Errorf("RepositoryMock.DeleteSavedItem got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmDeleteSavedItem.t.Errorf("RepositoryMock.DeleteSavedItem got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmDeleteSavedItem.t.Errorf("RepositoryMock.DeleteSavedItem got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmDeleteSavedItem.t.Errorf("RepositoryMock.DeleteSavedItem got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmDeleteSavedItem.DeleteSavedItemMock.defaultExpectation.results
+		if mm_results == nil {
+			mmDeleteSavedItem.t.Fatal("No results are set for the RepositoryMock.DeleteSavedItem")
+		}
+		return (*mm_results).err
+	}
+	if mmDeleteSavedItem.funcDeleteSavedItem != nil {
+		return mmDeleteSavedItem.funcDeleteSavedItem(ctx, userID, sku)
+	}
+	mmDeleteSavedItem.t.Fatalf("Unexpected call to RepositoryMock.DeleteSavedItem. %v %v %v", ctx, userID, sku)
+	return
+}
+
+// DeleteSavedItemAfterCounter returns a count of finished RepositoryMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *RepositoryMock) DeleteSavedItemAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.afterDeleteSavedItemCounter)
+}
+
+// DeleteSavedItemBeforeCounter returns a count of RepositoryMock.DeleteSavedItem invocations
+func (mmDeleteSavedItem *RepositoryMock) DeleteSavedItemBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmDeleteSavedItem.beforeDeleteSavedItemCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.DeleteSavedItem.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmDeleteSavedItem *mRepositoryMockDeleteSavedItem) Calls() []*RepositoryMockDeleteSavedItemParams {
+	mmDeleteSavedItem.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockDeleteSavedItemParams, len(mmDeleteSavedItem.callArgs))
+	copy(argCopy, mmDeleteSavedItem.callArgs)
+
+	mmDeleteSavedItem.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockDeleteSavedItemDone returns true if the count of the DeleteSavedItem invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockDeleteSavedItemDone() bool {
+	if m.DeleteSavedItemMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.DeleteSavedItemMock.invocationsDone()
+}
+
+// MinimockDeleteSavedItemInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockDeleteSavedItemInspect() {
+	for _, e := range m.DeleteSavedItemMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSavedItem at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterDeleteSavedItemCounter := mm_atomic.LoadUint64(&m.afterDeleteSavedItemCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.DeleteSavedItemMock.defaultExpectation != nil && afterDeleteSavedItemCounter < 1 {
+		if m.DeleteSavedItemMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSavedItem at\n%s", m.DeleteSavedItemMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.DeleteSavedItem at\n%s with params: %#v", m.DeleteSavedItemMock.defaultExpectation.expectationOrigins.origin, *m.DeleteSavedItemMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcDeleteSavedItem != nil && afterDeleteSavedItemCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.DeleteSavedItem at\n%s", m.funcDeleteSavedItemOrigin)
+	}
+
+	if !m.DeleteSavedItemMock.invocationsDone() && afterDeleteSavedItemCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteSavedItem at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.DeleteSavedItemMock.expectedInvocations), m.DeleteSavedItemMock.expectedInvocationsOrigin, afterDeleteSavedItemCounter)
+	}
+}
+
+type mRepositoryMockGetAbandonedCarts struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetAbandonedCartsExpectation
+	expectations       []*RepositoryMockGetAbandonedCartsExpectation
+
+	callArgs []*RepositoryMockGetAbandonedCartsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetAbandonedCartsExpectation specifies expectation struct of the Repository.GetAbandonedCarts
+type RepositoryMockGetAbandonedCartsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetAbandonedCartsParams
+	paramPtrs          *RepositoryMockGetAbandonedCartsParamPtrs
+	expectationOrigins RepositoryMockGetAbandonedCartsExpectationOrigins
+	results            *RepositoryMockGetAbandonedCartsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetAbandonedCartsParams contains parameters of the Repository.GetAbandonedCarts
+type RepositoryMockGetAbandonedCartsParams struct {
+	ctx    context.Context
+	before time.Time
+	limit  int
+}
+
+// RepositoryMockGetAbandonedCartsParamPtrs contains pointers to parameters of the Repository.GetAbandonedCarts
+type RepositoryMockGetAbandonedCartsParamPtrs struct {
+	ctx    *context.Context
+	before *time.Time
+	limit  *int
+}
+
+// RepositoryMockGetAbandonedCartsResults contains results of the Repository.GetAbandonedCarts
+type RepositoryMockGetAbandonedCartsResults struct {
+	aa1 []model.AbandonedCart
+	err error
+}
+
+// RepositoryMockGetAbandonedCartsOrigins contains origins of expectations of the Repository.GetAbandonedCarts
+type RepositoryMockGetAbandonedCartsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originBefore string
+	originLimit  string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) Optional() *mRepositoryMockGetAbandonedCarts {
+	mmGetAbandonedCarts.optional = true
+	return mmGetAbandonedCarts
+}
+
+// Expect sets up expected params for Repository.GetAbandonedCarts
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) Expect(ctx context.Context, before time.Time, limit int) *mRepositoryMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &RepositoryMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by ExpectParams functions")
+	}
+
+	mmGetAbandonedCarts.defaultExpectation.params = &RepositoryMockGetAbandonedCartsParams{ctx, before, limit}
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetAbandonedCarts.expectations {
+		if minimock.Equal(e.params, mmGetAbandonedCarts.defaultExpectation.params) {
+			mmGetAbandonedCarts.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetAbandonedCarts.defaultExpectation.params)
+		}
+	}
+
+	return mmGetAbandonedCarts
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetAbandonedCarts
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &RepositoryMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.params != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Expect")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmGetAbandonedCarts.defaultExpectation.paramPtrs = &RepositoryMockGetAbandonedCartsParamPtrs{}
+	}
+	mmGetAbandonedCarts.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetAbandonedCarts
+}
+
+// ExpectBeforeParam2 sets up expected param before for Repository.GetAbandonedCarts
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) ExpectBeforeParam2(before time.Time) *mRepositoryMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &RepositoryMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.params != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Expect")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmGetAbandonedCarts.defaultExpectation.paramPtrs = &RepositoryMockGetAbandonedCartsParamPtrs{}
+	}
+	mmGetAbandonedCarts.defaultExpectation.paramPtrs.before = &before
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.originBefore = minimock.CallerInfo(1)
+
+	return mmGetAbandonedCarts
+}
+
+// ExpectLimitParam3 sets up expected param limit for Repository.GetAbandonedCarts
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) ExpectLimitParam3(limit int) *mRepositoryMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &RepositoryMockGetAbandonedCartsExpectation{}
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.params != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Expect")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation.paramPtrs == nil {
+		mmGetAbandonedCarts.defaultExpectation.paramPtrs = &RepositoryMockGetAbandonedCartsParamPtrs{}
+	}
+	mmGetAbandonedCarts.defaultExpectation.paramPtrs.limit = &limit
+	mmGetAbandonedCarts.defaultExpectation.expectationOrigins.originLimit = minimock.CallerInfo(1)
+
+	return mmGetAbandonedCarts
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetAbandonedCarts
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) Inspect(f func(ctx context.Context, before time.Time, limit int)) *mRepositoryMockGetAbandonedCarts {
+	if mmGetAbandonedCarts.mock.inspectFuncGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetAbandonedCarts")
+	}
+
+	mmGetAbandonedCarts.mock.inspectFuncGetAbandonedCarts = f
+
+	return mmGetAbandonedCarts
+}
+
+// Return sets up results that will be returned by Repository.GetAbandonedCarts
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) Return(aa1 []model.AbandonedCart, err error) *RepositoryMock {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	if mmGetAbandonedCarts.defaultExpectation == nil {
+		mmGetAbandonedCarts.defaultExpectation = &RepositoryMockGetAbandonedCartsExpectation{mock: mmGetAbandonedCarts.mock}
+	}
+	mmGetAbandonedCarts.defaultExpectation.results = &RepositoryMockGetAbandonedCartsResults{aa1, err}
+	mmGetAbandonedCarts.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetAbandonedCarts.mock
+}
+
+// Set uses given function f to mock the Repository.GetAbandonedCarts method
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) Set(f func(ctx context.Context, before time.Time, limit int) (aa1 []model.AbandonedCart, err error)) *RepositoryMock {
+	if mmGetAbandonedCarts.defaultExpectation != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("Default expectation is already set for the Repository.GetAbandonedCarts method")
+	}
+
+	if len(mmGetAbandonedCarts.expectations) > 0 {
+		mmGetAbandonedCarts.mock.t.Fatalf("Some expectations are already set for the Repository.GetAbandonedCarts method")
+	}
+
+	mmGetAbandonedCarts.mock.funcGetAbandonedCarts = f
+	mmGetAbandonedCarts.mock.funcGetAbandonedCartsOrigin = minimock.CallerInfo(1)
+	return mmGetAbandonedCarts.mock
+}
+
+// When sets expectation for the Repository.GetAbandonedCarts which will trigger the result defined by the following
+// Then helper
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) When(ctx context.Context, before time.Time, limit int) *RepositoryMockGetAbandonedCartsExpectation {
+	if mmGetAbandonedCarts.mock.funcGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.mock.t.Fatalf("RepositoryMock.GetAbandonedCarts mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetAbandonedCartsExpectation{
+		mock:               mmGetAbandonedCarts.mock,
+		params:             &RepositoryMockGetAbandonedCartsParams{ctx, before, limit},
+		expectationOrigins: RepositoryMockGetAbandonedCartsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetAbandonedCarts.expectations = append(mmGetAbandonedCarts.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetAbandonedCarts return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetAbandonedCartsExpectation) Then(aa1 []model.AbandonedCart, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetAbandonedCartsResults{aa1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetAbandonedCarts should be invoked
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) Times(n uint64) *mRepositoryMockGetAbandonedCarts {
+	if n == 0 {
+		mmGetAbandonedCarts.mock.t.Fatalf("Times of RepositoryMock.GetAbandonedCarts mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetAbandonedCarts.expectedInvocations, n)
+	mmGetAbandonedCarts.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetAbandonedCarts
+}
+
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) invocationsDone() bool {
+	if len(mmGetAbandonedCarts.expectations) == 0 && mmGetAbandonedCarts.defaultExpectation == nil && mmGetAbandonedCarts.mock.funcGetAbandonedCarts == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetAbandonedCarts.mock.afterGetAbandonedCartsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetAbandonedCarts.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetAbandonedCarts implements mm_service.Repository
+func (mmGetAbandonedCarts *RepositoryMock) GetAbandonedCarts(ctx context.Context, before time.Time, limit int) (aa1 []model.AbandonedCart, err error) {
+	mm_atomic.AddUint64(&mmGetAbandonedCarts.beforeGetAbandonedCartsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetAbandonedCarts.afterGetAbandonedCartsCounter, 1)
+
+	mmGetAbandonedCarts.t.Helper()
+
+	if mmGetAbandonedCarts.inspectFuncGetAbandonedCarts != nil {
+		mmGetAbandonedCarts.inspectFuncGetAbandonedCarts(ctx, before, limit)
+	}
+
+	mm_params := RepositoryMockGetAbandonedCartsParams{ctx, before, limit}
+
+	// Record call args
+	mmGetAbandonedCarts.GetAbandonedCartsMock.mutex.Lock()
+	mmGetAbandonedCarts.GetAbandonedCartsMock.callArgs = append(mmGetAbandonedCarts.GetAbandonedCartsMock.callArgs, &mm_params)
+	mmGetAbandonedCarts.GetAbandonedCartsMock.mutex.Unlock()
+
+	for _, e := range mmGetAbandonedCarts.GetAbandonedCartsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.aa1, e.results.err
+		}
+	}
+
+	if mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetAbandonedCartsParams{ctx, before, limit}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetAbandonedCarts.t.Errorf("RepositoryMock.GetAbandonedCarts got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.before != nil && !minimock.Equal(*mm_want_ptrs.before, mm_got.before) {
+				mmGetAbandonedCarts.t.Errorf("RepositoryMock.GetAbandonedCarts got unexpected parameter before, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.originBefore, *mm_want_ptrs.before, mm_got.before, minimock.Diff(*mm_want_ptrs.before, mm_got.before))
+			}
+
+			if mm_want_ptrs.limit != nil && !minimock.Equal(*mm_want_ptrs.limit, mm_got.limit) {
+				mmGetAbandonedCarts.t.Errorf("RepositoryMock.GetAbandonedCarts got unexpected parameter limit, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.originLimit, *mm_want_ptrs.limit, mm_got.limit, minimock.Diff(*mm_want_ptrs.limit, mm_got.limit))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetAbandonedCarts.t.Errorf("RepositoryMock.GetAbandonedCarts got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetAbandonedCarts.GetAbandonedCartsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetAbandonedCarts.t.Fatal("No results are set for the RepositoryMock.GetAbandonedCarts")
+		}
+		return (*mm_results).aa1, (*mm_results).err
+	}
+	if mmGetAbandonedCarts.funcGetAbandonedCarts != nil {
+		return mmGetAbandonedCarts.funcGetAbandonedCarts(ctx, before, limit)
+	}
+	mmGetAbandonedCarts.t.Fatalf("Unexpected call to RepositoryMock.GetAbandonedCarts. %v %v %v", ctx, before, limit)
+	return
+}
+
+// GetAbandonedCartsAfterCounter returns a count of finished RepositoryMock.GetAbandonedCarts invocations
+func (mmGetAbandonedCarts *RepositoryMock) GetAbandonedCartsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAbandonedCarts.afterGetAbandonedCartsCounter)
+}
+
+// GetAbandonedCartsBeforeCounter returns a count of RepositoryMock.GetAbandonedCarts invocations
+func (mmGetAbandonedCarts *RepositoryMock) GetAbandonedCartsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetAbandonedCarts.beforeGetAbandonedCartsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetAbandonedCarts.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetAbandonedCarts *mRepositoryMockGetAbandonedCarts) Calls() []*RepositoryMockGetAbandonedCartsParams {
+	mmGetAbandonedCarts.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetAbandonedCartsParams, len(mmGetAbandonedCarts.callArgs))
+	copy(argCopy, mmGetAbandonedCarts.callArgs)
+
+	mmGetAbandonedCarts.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetAbandonedCartsDone returns true if the count of the GetAbandonedCarts invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetAbandonedCartsDone() bool {
+	if m.GetAbandonedCartsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetAbandonedCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetAbandonedCartsMock.invocationsDone()
+}
+
+// MinimockGetAbandonedCartsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetAbandonedCartsInspect() {
+	for _, e := range m.GetAbandonedCartsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetAbandonedCarts at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetAbandonedCartsCounter := mm_atomic.LoadUint64(&m.afterGetAbandonedCartsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetAbandonedCartsMock.defaultExpectation != nil && afterGetAbandonedCartsCounter < 1 {
+		if m.GetAbandonedCartsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetAbandonedCarts at\n%s", m.GetAbandonedCartsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetAbandonedCarts at\n%s with params: %#v", m.GetAbandonedCartsMock.defaultExpectation.expectationOrigins.origin, *m.GetAbandonedCartsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetAbandonedCarts != nil && afterGetAbandonedCartsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetAbandonedCarts at\n%s", m.funcGetAbandonedCartsOrigin)
+	}
+
+	if !m.GetAbandonedCartsMock.invocationsDone() && afterGetAbandonedCartsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetAbandonedCarts at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetAbandonedCartsMock.expectedInvocations), m.GetAbandonedCartsMock.expectedInvocationsOrigin, afterGetAbandonedCartsCounter)
+	}
+}
+
+type mRepositoryMockGetCheckoutOrderID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetCheckoutOrderIDExpectation
+	expectations       []*RepositoryMockGetCheckoutOrderIDExpectation
+
+	callArgs []*RepositoryMockGetCheckoutOrderIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetCheckoutOrderIDExpectation specifies expectation struct of the Repository.GetCheckoutOrderID
+type RepositoryMockGetCheckoutOrderIDExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetCheckoutOrderIDParams
+	paramPtrs          *RepositoryMockGetCheckoutOrderIDParamPtrs
+	expectationOrigins RepositoryMockGetCheckoutOrderIDExpectationOrigins
+	results            *RepositoryMockGetCheckoutOrderIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetCheckoutOrderIDParams contains parameters of the Repository.GetCheckoutOrderID
+type RepositoryMockGetCheckoutOrderIDParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// RepositoryMockGetCheckoutOrderIDParamPtrs contains pointers to parameters of the Repository.GetCheckoutOrderID
+type RepositoryMockGetCheckoutOrderIDParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// RepositoryMockGetCheckoutOrderIDResults contains results of the Repository.GetCheckoutOrderID
+type RepositoryMockGetCheckoutOrderIDResults struct {
+	i1  int64
+	err error
+}
+
+// RepositoryMockGetCheckoutOrderIDOrigins contains origins of expectations of the Repository.GetCheckoutOrderID
+type RepositoryMockGetCheckoutOrderIDExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) Optional() *mRepositoryMockGetCheckoutOrderID {
+	mmGetCheckoutOrderID.optional = true
+	return mmGetCheckoutOrderID
+}
+
+// Expect sets up expected params for Repository.GetCheckoutOrderID
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) Expect(ctx context.Context, userID int64) *mRepositoryMockGetCheckoutOrderID {
+	if mmGetCheckoutOrderID.mock.funcGetCheckoutOrderID != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by Set")
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation == nil {
+		mmGetCheckoutOrderID.defaultExpectation = &RepositoryMockGetCheckoutOrderIDExpectation{}
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation.paramPtrs != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by ExpectParams functions")
+	}
+
+	mmGetCheckoutOrderID.defaultExpectation.params = &RepositoryMockGetCheckoutOrderIDParams{ctx, userID}
+	mmGetCheckoutOrderID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetCheckoutOrderID.expectations {
+		if minimock.Equal(e.params, mmGetCheckoutOrderID.defaultExpectation.params) {
+			mmGetCheckoutOrderID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetCheckoutOrderID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetCheckoutOrderID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetCheckoutOrderID
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetCheckoutOrderID {
+	if mmGetCheckoutOrderID.mock.funcGetCheckoutOrderID != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by Set")
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation == nil {
+		mmGetCheckoutOrderID.defaultExpectation = &RepositoryMockGetCheckoutOrderIDExpectation{}
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation.params != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by Expect")
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation.paramPtrs == nil {
+		mmGetCheckoutOrderID.defaultExpectation.paramPtrs = &RepositoryMockGetCheckoutOrderIDParamPtrs{}
+	}
+	mmGetCheckoutOrderID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetCheckoutOrderID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetCheckoutOrderID
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetCheckoutOrderID
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) ExpectUserIDParam2(userID int64) *mRepositoryMockGetCheckoutOrderID {
+	if mmGetCheckoutOrderID.mock.funcGetCheckoutOrderID != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by Set")
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation == nil {
+		mmGetCheckoutOrderID.defaultExpectation = &RepositoryMockGetCheckoutOrderIDExpectation{}
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation.params != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by Expect")
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation.paramPtrs == nil {
+		mmGetCheckoutOrderID.defaultExpectation.paramPtrs = &RepositoryMockGetCheckoutOrderIDParamPtrs{}
+	}
+	mmGetCheckoutOrderID.defaultExpectation.paramPtrs.userID = &userID
+	mmGetCheckoutOrderID.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetCheckoutOrderID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetCheckoutOrderID
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) Inspect(f func(ctx context.Context, userID int64)) *mRepositoryMockGetCheckoutOrderID {
+	if mmGetCheckoutOrderID.mock.inspectFuncGetCheckoutOrderID != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetCheckoutOrderID")
+	}
+
+	mmGetCheckoutOrderID.mock.inspectFuncGetCheckoutOrderID = f
+
+	return mmGetCheckoutOrderID
+}
+
+// Return sets up results that will be returned by Repository.GetCheckoutOrderID
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) Return(i1 int64, err error) *RepositoryMock {
+	if mmGetCheckoutOrderID.mock.funcGetCheckoutOrderID != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by Set")
+	}
+
+	if mmGetCheckoutOrderID.defaultExpectation == nil {
+		mmGetCheckoutOrderID.defaultExpectation = &RepositoryMockGetCheckoutOrderIDExpectation{mock: mmGetCheckoutOrderID.mock}
+	}
+	mmGetCheckoutOrderID.defaultExpectation.results = &RepositoryMockGetCheckoutOrderIDResults{i1, err}
+	mmGetCheckoutOrderID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetCheckoutOrderID.mock
+}
+
+// Set uses given function f to mock the Repository.GetCheckoutOrderID method
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) Set(f func(ctx context.Context, userID int64) (i1 int64, err error)) *RepositoryMock {
+	if mmGetCheckoutOrderID.defaultExpectation != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("Default expectation is already set for the Repository.GetCheckoutOrderID method")
+	}
+
+	if len(mmGetCheckoutOrderID.expectations) > 0 {
+		mmGetCheckoutOrderID.mock.t.Fatalf("Some expectations are already set for the Repository.GetCheckoutOrderID method")
+	}
+
+	mmGetCheckoutOrderID.mock.funcGetCheckoutOrderID = f
+	mmGetCheckoutOrderID.mock.funcGetCheckoutOrderIDOrigin = minimock.CallerInfo(1)
+	return mmGetCheckoutOrderID.mock
+}
+
+// When sets expectation for the Repository.GetCheckoutOrderID which will trigger the result defined by the following
+// Then helper
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) When(ctx context.Context, userID int64) *RepositoryMockGetCheckoutOrderIDExpectation {
+	if mmGetCheckoutOrderID.mock.funcGetCheckoutOrderID != nil {
+		mmGetCheckoutOrderID.mock.t.Fatalf("RepositoryMock.GetCheckoutOrderID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetCheckoutOrderIDExpectation{
+		mock:               mmGetCheckoutOrderID.mock,
+		params:             &RepositoryMockGetCheckoutOrderIDParams{ctx, userID},
+		expectationOrigins: RepositoryMockGetCheckoutOrderIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetCheckoutOrderID.expectations = append(mmGetCheckoutOrderID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetCheckoutOrderID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetCheckoutOrderIDExpectation) Then(i1 int64, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetCheckoutOrderIDResults{i1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetCheckoutOrderID should be invoked
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) Times(n uint64) *mRepositoryMockGetCheckoutOrderID {
+	if n == 0 {
+		mmGetCheckoutOrderID.mock.t.Fatalf("Times of RepositoryMock.GetCheckoutOrderID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetCheckoutOrderID.expectedInvocations, n)
+	mmGetCheckoutOrderID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetCheckoutOrderID
+}
+
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) invocationsDone() bool {
+	if len(mmGetCheckoutOrderID.expectations) == 0 && mmGetCheckoutOrderID.defaultExpectation == nil && mmGetCheckoutOrderID.mock.funcGetCheckoutOrderID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetCheckoutOrderID.mock.afterGetCheckoutOrderIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetCheckoutOrderID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetCheckoutOrderID implements mm_service.Repository
+func (mmGetCheckoutOrderID *RepositoryMock) GetCheckoutOrderID(ctx context.Context, userID int64) (i1 int64, err error) {
+	mm_atomic.AddUint64(&mmGetCheckoutOrderID.beforeGetCheckoutOrderIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetCheckoutOrderID.afterGetCheckoutOrderIDCounter, 1)
+
+	mmGetCheckoutOrderID.t.Helper()
+
+	if mmGetCheckoutOrderID.inspectFuncGetCheckoutOrderID != nil {
+		mmGetCheckoutOrderID.inspectFuncGetCheckoutOrderID(ctx, userID)
+	}
+
+	mm_params := RepositoryMockGetCheckoutOrderIDParams{ctx, userID}
+
+	// Record call args
+	mmGetCheckoutOrderID.GetCheckoutOrderIDMock.mutex.Lock()
+	mmGetCheckoutOrderID.GetCheckoutOrderIDMock.callArgs = append(mmGetCheckoutOrderID.GetCheckoutOrderIDMock.callArgs, &mm_params)
+	mmGetCheckoutOrderID.GetCheckoutOrderIDMock.mutex.Unlock()
+
+	for _, e := range mmGetCheckoutOrderID.GetCheckoutOrderIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.err
+		}
+	}
+
+	if mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetCheckoutOrderIDParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetCheckoutOrderID.t.Errorf("RepositoryMock.GetCheckoutOrderID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetCheckoutOrderID.t.Errorf("RepositoryMock.GetCheckoutOrderID got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetCheckoutOrderID.t.Errorf("RepositoryMock.GetCheckoutOrderID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetCheckoutOrderID.GetCheckoutOrderIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetCheckoutOrderID.t.Fatal("No results are set for the RepositoryMock.GetCheckoutOrderID")
+		}
+		return (*mm_results).i1, (*mm_results).err
+	}
+	if mmGetCheckoutOrderID.funcGetCheckoutOrderID != nil {
+		return mmGetCheckoutOrderID.funcGetCheckoutOrderID(ctx, userID)
+	}
+	mmGetCheckoutOrderID.t.Fatalf("Unexpected call to RepositoryMock.GetCheckoutOrderID. %v %v", ctx, userID)
+	return
+}
+
+// GetCheckoutOrderIDAfterCounter returns a count of finished RepositoryMock.GetCheckoutOrderID invocations
+func (mmGetCheckoutOrderID *RepositoryMock) GetCheckoutOrderIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCheckoutOrderID.afterGetCheckoutOrderIDCounter)
+}
+
+// GetCheckoutOrderIDBeforeCounter returns a count of RepositoryMock.GetCheckoutOrderID invocations
+func (mmGetCheckoutOrderID *RepositoryMock) GetCheckoutOrderIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetCheckoutOrderID.beforeGetCheckoutOrderIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetCheckoutOrderID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetCheckoutOrderID *mRepositoryMockGetCheckoutOrderID) Calls() []*RepositoryMockGetCheckoutOrderIDParams {
+	mmGetCheckoutOrderID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetCheckoutOrderIDParams, len(mmGetCheckoutOrderID.callArgs))
+	copy(argCopy, mmGetCheckoutOrderID.callArgs)
+
+	mmGetCheckoutOrderID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetCheckoutOrderIDDone returns true if the count of the GetCheckoutOrderID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetCheckoutOrderIDDone() bool {
+	if m.GetCheckoutOrderIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetCheckoutOrderIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetCheckoutOrderIDMock.invocationsDone()
+}
+
+// MinimockGetCheckoutOrderIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetCheckoutOrderIDInspect() {
+	for _, e := range m.GetCheckoutOrderIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetCheckoutOrderID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetCheckoutOrderIDCounter := mm_atomic.LoadUint64(&m.afterGetCheckoutOrderIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetCheckoutOrderIDMock.defaultExpectation != nil && afterGetCheckoutOrderIDCounter < 1 {
+		if m.GetCheckoutOrderIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetCheckoutOrderID at\n%s", m.GetCheckoutOrderIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetCheckoutOrderID at\n%s with params: %#v", m.GetCheckoutOrderIDMock.defaultExpectation.expectationOrigins.origin, *m.GetCheckoutOrderIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetCheckoutOrderID != nil && afterGetCheckoutOrderIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetCheckoutOrderID at\n%s", m.funcGetCheckoutOrderIDOrigin)
+	}
+
+	if !m.GetCheckoutOrderIDMock.invocationsDone() && afterGetCheckoutOrderIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetCheckoutOrderID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetCheckoutOrderIDMock.expectedInvocations), m.GetCheckoutOrderIDMock.expectedInvocationsOrigin, afterGetCheckoutOrderIDCounter)
+	}
+}
+
+type mRepositoryMockGetItemsByUserID struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetItemsByUserIDExpectation
+	expectations       []*RepositoryMockGetItemsByUserIDExpectation
+
+	callArgs []*RepositoryMockGetItemsByUserIDParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetItemsByUserIDExpectation specifies expectation struct of the Repository.GetItemsByUserID
+type RepositoryMockGetItemsByUserIDExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetItemsByUserIDParams
+	paramPtrs          *RepositoryMockGetItemsByUserIDParamPtrs
+	expectationOrigins RepositoryMockGetItemsByUserIDExpectationOrigins
+	results            *RepositoryMockGetItemsByUserIDResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetItemsByUserIDParams contains parameters of the Repository.GetItemsByUserID
+type RepositoryMockGetItemsByUserIDParams struct {
+	ctx       context.Context
+	cartItems model.RequestData
+}
+
+// RepositoryMockGetItemsByUserIDParamPtrs contains pointers to parameters of the Repository.GetItemsByUserID
+type RepositoryMockGetItemsByUserIDParamPtrs struct {
+	ctx       *context.Context
+	cartItems *model.RequestData
+}
+
+// RepositoryMockGetItemsByUserIDResults contains results of the Repository.GetItemsByUserID
+type RepositoryMockGetItemsByUserIDResults struct {
+	ca1 []model.Cart
+	err error
+}
+
+// RepositoryMockGetItemsByUserIDOrigins contains origins of expectations of the Repository.GetItemsByUserID
+type RepositoryMockGetItemsByUserIDExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originCartItems string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Optional() *mRepositoryMockGetItemsByUserID {
+	mmGetItemsByUserID.optional = true
+	return mmGetItemsByUserID
+}
+
+// Expect sets up expected params for Repository.GetItemsByUserID
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Expect(ctx context.Context, cartItems model.RequestData) *mRepositoryMockGetItemsByUserID {
+	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+	}
+
+	if mmGetItemsByUserID.defaultExpectation == nil {
+		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{}
+	}
+
+	if mmGetItemsByUserID.defaultExpectation.paramPtrs != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by ExpectParams functions")
+	}
+
+	mmGetItemsByUserID.defaultExpectation.params = &RepositoryMockGetItemsByUserIDParams{ctx, cartItems}
+	mmGetItemsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetItemsByUserID.expectations {
+		if minimock.Equal(e.params, mmGetItemsByUserID.defaultExpectation.params) {
+			mmGetItemsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsByUserID.defaultExpectation.params)
+		}
+	}
+
+	return mmGetItemsByUserID
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetItemsByUserID
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetItemsByUserID {
+	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+	}
+
+	if mmGetItemsByUserID.defaultExpectation == nil {
+		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{}
+	}
+
+	if mmGetItemsByUserID.defaultExpectation.params != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Expect")
+	}
+
+	if mmGetItemsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetItemsByUserID.defaultExpectation.paramPtrs = &RepositoryMockGetItemsByUserIDParamPtrs{}
+	}
+	mmGetItemsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetItemsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetItemsByUserID
+}
+
+// ExpectCartItemsParam2 sets up expected param cartItems for Repository.GetItemsByUserID
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) ExpectCartItemsParam2(cartItems model.RequestData) *mRepositoryMockGetItemsByUserID {
+	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+	}
+
+	if mmGetItemsByUserID.defaultExpectation == nil {
+		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{}
+	}
+
+	if mmGetItemsByUserID.defaultExpectation.params != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Expect")
+	}
+
+	if mmGetItemsByUserID.defaultExpectation.paramPtrs == nil {
+		mmGetItemsByUserID.defaultExpectation.paramPtrs = &RepositoryMockGetItemsByUserIDParamPtrs{}
+	}
+	mmGetItemsByUserID.defaultExpectation.paramPtrs.cartItems = &cartItems
+	mmGetItemsByUserID.defaultExpectation.expectationOrigins.originCartItems = minimock.CallerInfo(1)
+
+	return mmGetItemsByUserID
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetItemsByUserID
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Inspect(f func(ctx context.Context, cartItems model.RequestData)) *mRepositoryMockGetItemsByUserID {
+	if mmGetItemsByUserID.mock.inspectFuncGetItemsByUserID != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetItemsByUserID")
+	}
+
+	mmGetItemsByUserID.mock.inspectFuncGetItemsByUserID = f
+
+	return mmGetItemsByUserID
+}
+
+// Return sets up results that will be returned by Repository.GetItemsByUserID
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Return(ca1 []model.Cart, err error) *RepositoryMock {
+	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+	}
+
+	if mmGetItemsByUserID.defaultExpectation == nil {
+		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{mock: mmGetItemsByUserID.mock}
+	}
+	mmGetItemsByUserID.defaultExpectation.results = &RepositoryMockGetItemsByUserIDResults{ca1, err}
+	mmGetItemsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetItemsByUserID.mock
+}
+
+// Set uses given function f to mock the Repository.GetItemsByUserID method
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Set(f func(ctx context.Context, cartItems model.RequestData) (ca1 []model.Cart, err error)) *RepositoryMock {
+	if mmGetItemsByUserID.defaultExpectation != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("Default expectation is already set for the Repository.GetItemsByUserID method")
+	}
+
+	if len(mmGetItemsByUserID.expectations) > 0 {
+		mmGetItemsByUserID.mock.t.Fatalf("Some expectations are already set for the Repository.GetItemsByUserID method")
+	}
+
+	mmGetItemsByUserID.mock.funcGetItemsByUserID = f
+	mmGetItemsByUserID.mock.funcGetItemsByUserIDOrigin = minimock.CallerInfo(1)
+	return mmGetItemsByUserID.mock
+}
+
+// When sets expectation for the Repository.GetItemsByUserID which will trigger the result defined by the following
+// Then helper
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) When(ctx context.Context, cartItems model.RequestData) *RepositoryMockGetItemsByUserIDExpectation {
+	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
+		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetItemsByUserIDExpectation{
+		mock:               mmGetItemsByUserID.mock,
+		params:             &RepositoryMockGetItemsByUserIDParams{ctx, cartItems},
+		expectationOrigins: RepositoryMockGetItemsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetItemsByUserID.expectations = append(mmGetItemsByUserID.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetItemsByUserID return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetItemsByUserIDExpectation) Then(ca1 []model.Cart, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetItemsByUserIDResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetItemsByUserID should be invoked
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Times(n uint64) *mRepositoryMockGetItemsByUserID {
+	if n == 0 {
+		mmGetItemsByUserID.mock.t.Fatalf("Times of RepositoryMock.GetItemsByUserID mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetItemsByUserID.expectedInvocations, n)
+	mmGetItemsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetItemsByUserID
+}
+
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) invocationsDone() bool {
+	if len(mmGetItemsByUserID.expectations) == 0 && mmGetItemsByUserID.defaultExpectation == nil && mmGetItemsByUserID.mock.funcGetItemsByUserID == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsByUserID.mock.afterGetItemsByUserIDCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsByUserID.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetItemsByUserID implements mm_service.Repository
+func (mmGetItemsByUserID *RepositoryMock) GetItemsByUserID(ctx context.Context, cartItems model.RequestData) (ca1 []model.Cart, err error) {
+	mm_atomic.AddUint64(&mmGetItemsByUserID.beforeGetItemsByUserIDCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetItemsByUserID.afterGetItemsByUserIDCounter, 1)
+
+	mmGetItemsByUserID.t.Helper()
+
+	if mmGetItemsByUserID.inspectFuncGetItemsByUserID != nil {
+		mmGetItemsByUserID.inspectFuncGetItemsByUserID(ctx, cartItems)
+	}
+
+	mm_params := RepositoryMockGetItemsByUserIDParams{ctx, cartItems}
+
+	// Record call args
+	mmGetItemsByUserID.GetItemsByUserIDMock.mutex.Lock()
+	mmGetItemsByUserID.GetItemsByUserIDMock.callArgs = append(mmGetItemsByUserID.GetItemsByUserIDMock.callArgs, &mm_params)
+	mmGetItemsByUserID.GetItemsByUserIDMock.mutex.Unlock()
+
+	for _, e := range mmGetItemsByUserID.GetItemsByUserIDMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.params
+		mm_want_ptrs := mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetItemsByUserIDParams{ctx, cartItems}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetItemsByUserID.t.Errorf("RepositoryMock.GetItemsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.cartItems != nil && !minimock.Equal(*mm_want_ptrs.cartItems, mm_got.cartItems) {
+				mmGetItemsByUserID.t.Errorf("RepositoryMock.GetItemsByUserID got unexpected parameter cartItems, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.originCartItems, *mm_want_ptrs.cartItems, mm_got.cartItems, minimock.Diff(*mm_want_ptrs.cartItems, mm_got.cartItems))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetItemsByUserID.t.Errorf("RepositoryMock.GetItemsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetItemsByUserID.t.Fatal("No results are set for the RepositoryMock.GetItemsByUserID")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetItemsByUserID.funcGetItemsByUserID != nil {
+		return mmGetItemsByUserID.funcGetItemsByUserID(ctx, cartItems)
+	}
+	mmGetItemsByUserID.t.Fatalf("Unexpected call to RepositoryMock.GetItemsByUserID. %v %v", ctx, cartItems)
+	return
+}
+
+// GetItemsByUserIDAfterCounter returns a count of finished RepositoryMock.GetItemsByUserID invocations
+func (mmGetItemsByUserID *RepositoryMock) GetItemsByUserIDAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsByUserID.afterGetItemsByUserIDCounter)
+}
+
+// GetItemsByUserIDBeforeCounter returns a count of RepositoryMock.GetItemsByUserID invocations
+func (mmGetItemsByUserID *RepositoryMock) GetItemsByUserIDBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetItemsByUserID.beforeGetItemsByUserIDCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetItemsByUserID.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Calls() []*RepositoryMockGetItemsByUserIDParams {
+	mmGetItemsByUserID.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetItemsByUserIDParams, len(mmGetItemsByUserID.callArgs))
+	copy(argCopy, mmGetItemsByUserID.callArgs)
+
+	mmGetItemsByUserID.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetItemsByUserIDDone returns true if the count of the GetItemsByUserID invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetItemsByUserIDDone() bool {
+	if m.GetItemsByUserIDMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetItemsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetItemsByUserIDMock.invocationsDone()
+}
+
+// MinimockGetItemsByUserIDInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetItemsByUserIDInspect() {
+	for _, e := range m.GetItemsByUserIDMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetItemsByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetItemsByUserIDCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetItemsByUserIDMock.defaultExpectation != nil && afterGetItemsByUserIDCounter < 1 {
+		if m.GetItemsByUserIDMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s", m.GetItemsByUserIDMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s with params: %#v", m.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsByUserIDMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetItemsByUserID != nil && afterGetItemsByUserIDCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s", m.funcGetItemsByUserIDOrigin)
+	}
+
+	if !m.GetItemsByUserIDMock.invocationsDone() && afterGetItemsByUserIDCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetItemsByUserID at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetItemsByUserIDMock.expectedInvocations), m.GetItemsByUserIDMock.expectedInvocationsOrigin, afterGetItemsByUserIDCounter)
+	}
+}
+
+type mRepositoryMockGetPromoCode struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetPromoCodeExpectation
+	expectations       []*RepositoryMockGetPromoCodeExpectation
+
+	callArgs []*RepositoryMockGetPromoCodeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetPromoCodeExpectation specifies expectation struct of the Repository.GetPromoCode
+type RepositoryMockGetPromoCodeExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetPromoCodeParams
+	paramPtrs          *RepositoryMockGetPromoCodeParamPtrs
+	expectationOrigins RepositoryMockGetPromoCodeExpectationOrigins
+	results            *RepositoryMockGetPromoCodeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetPromoCodeParams contains parameters of the Repository.GetPromoCode
+type RepositoryMockGetPromoCodeParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// RepositoryMockGetPromoCodeParamPtrs contains pointers to parameters of the Repository.GetPromoCode
+type RepositoryMockGetPromoCodeParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// RepositoryMockGetPromoCodeResults contains results of the Repository.GetPromoCode
+type RepositoryMockGetPromoCodeResults struct {
+	s1  string
+	err error
+}
+
+// RepositoryMockGetPromoCodeOrigins contains origins of expectations of the Repository.GetPromoCode
+type RepositoryMockGetPromoCodeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) Optional() *mRepositoryMockGetPromoCode {
+	mmGetPromoCode.optional = true
+	return mmGetPromoCode
+}
+
+// Expect sets up expected params for Repository.GetPromoCode
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) Expect(ctx context.Context, userID int64) *mRepositoryMockGetPromoCode {
+	if mmGetPromoCode.mock.funcGetPromoCode != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by Set")
+	}
+
+	if mmGetPromoCode.defaultExpectation == nil {
+		mmGetPromoCode.defaultExpectation = &RepositoryMockGetPromoCodeExpectation{}
+	}
+
+	if mmGetPromoCode.defaultExpectation.paramPtrs != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by ExpectParams functions")
+	}
+
+	mmGetPromoCode.defaultExpectation.params = &RepositoryMockGetPromoCodeParams{ctx, userID}
+	mmGetPromoCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetPromoCode.expectations {
+		if minimock.Equal(e.params, mmGetPromoCode.defaultExpectation.params) {
+			mmGetPromoCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetPromoCode.defaultExpectation.params)
+		}
+	}
+
+	return mmGetPromoCode
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetPromoCode
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetPromoCode {
+	if mmGetPromoCode.mock.funcGetPromoCode != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by Set")
+	}
+
+	if mmGetPromoCode.defaultExpectation == nil {
+		mmGetPromoCode.defaultExpectation = &RepositoryMockGetPromoCodeExpectation{}
+	}
+
+	if mmGetPromoCode.defaultExpectation.params != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by Expect")
+	}
+
+	if mmGetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmGetPromoCode.defaultExpectation.paramPtrs = &RepositoryMockGetPromoCodeParamPtrs{}
+	}
+	mmGetPromoCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetPromoCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetPromoCode
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetPromoCode
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) ExpectUserIDParam2(userID int64) *mRepositoryMockGetPromoCode {
+	if mmGetPromoCode.mock.funcGetPromoCode != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by Set")
+	}
+
+	if mmGetPromoCode.defaultExpectation == nil {
+		mmGetPromoCode.defaultExpectation = &RepositoryMockGetPromoCodeExpectation{}
+	}
+
+	if mmGetPromoCode.defaultExpectation.params != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by Expect")
+	}
+
+	if mmGetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmGetPromoCode.defaultExpectation.paramPtrs = &RepositoryMockGetPromoCodeParamPtrs{}
+	}
+	mmGetPromoCode.defaultExpectation.paramPtrs.userID = &userID
+	mmGetPromoCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetPromoCode
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetPromoCode
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) Inspect(f func(ctx context.Context, userID int64)) *mRepositoryMockGetPromoCode {
+	if mmGetPromoCode.mock.inspectFuncGetPromoCode != nil {
+		mmGetPromoCode.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetPromoCode")
+	}
+
+	mmGetPromoCode.mock.inspectFuncGetPromoCode = f
+
+	return mmGetPromoCode
+}
+
+// Return sets up results that will be returned by Repository.GetPromoCode
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) Return(s1 string, err error) *RepositoryMock {
+	if mmGetPromoCode.mock.funcGetPromoCode != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by Set")
+	}
+
+	if mmGetPromoCode.defaultExpectation == nil {
+		mmGetPromoCode.defaultExpectation = &RepositoryMockGetPromoCodeExpectation{mock: mmGetPromoCode.mock}
+	}
+	mmGetPromoCode.defaultExpectation.results = &RepositoryMockGetPromoCodeResults{s1, err}
+	mmGetPromoCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetPromoCode.mock
+}
+
+// Set uses given function f to mock the Repository.GetPromoCode method
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) Set(f func(ctx context.Context, userID int64) (s1 string, err error)) *RepositoryMock {
+	if mmGetPromoCode.defaultExpectation != nil {
+		mmGetPromoCode.mock.t.Fatalf("Default expectation is already set for the Repository.GetPromoCode method")
+	}
+
+	if len(mmGetPromoCode.expectations) > 0 {
+		mmGetPromoCode.mock.t.Fatalf("Some expectations are already set for the Repository.GetPromoCode method")
+	}
+
+	mmGetPromoCode.mock.funcGetPromoCode = f
+	mmGetPromoCode.mock.funcGetPromoCodeOrigin = minimock.CallerInfo(1)
+	return mmGetPromoCode.mock
+}
+
+// When sets expectation for the Repository.GetPromoCode which will trigger the result defined by the following
+// Then helper
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) When(ctx context.Context, userID int64) *RepositoryMockGetPromoCodeExpectation {
+	if mmGetPromoCode.mock.funcGetPromoCode != nil {
+		mmGetPromoCode.mock.t.Fatalf("RepositoryMock.GetPromoCode mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetPromoCodeExpectation{
+		mock:               mmGetPromoCode.mock,
+		params:             &RepositoryMockGetPromoCodeParams{ctx, userID},
+		expectationOrigins: RepositoryMockGetPromoCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetPromoCode.expectations = append(mmGetPromoCode.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetPromoCode return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetPromoCodeExpectation) Then(s1 string, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetPromoCodeResults{s1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetPromoCode should be invoked
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) Times(n uint64) *mRepositoryMockGetPromoCode {
+	if n == 0 {
+		mmGetPromoCode.mock.t.Fatalf("Times of RepositoryMock.GetPromoCode mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetPromoCode.expectedInvocations, n)
+	mmGetPromoCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetPromoCode
+}
+
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) invocationsDone() bool {
+	if len(mmGetPromoCode.expectations) == 0 && mmGetPromoCode.defaultExpectation == nil && mmGetPromoCode.mock.funcGetPromoCode == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetPromoCode.mock.afterGetPromoCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetPromoCode.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetPromoCode implements mm_service.Repository
+func (mmGetPromoCode *RepositoryMock) GetPromoCode(ctx context.Context, userID int64) (s1 string, err error) {
+	mm_atomic.AddUint64(&mmGetPromoCode.beforeGetPromoCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetPromoCode.afterGetPromoCodeCounter, 1)
+
+	mmGetPromoCode.t.Helper()
+
+	if mmGetPromoCode.inspectFuncGetPromoCode != nil {
+		mmGetPromoCode.inspectFuncGetPromoCode(ctx, userID)
+	}
+
+	mm_params := RepositoryMockGetPromoCodeParams{ctx, userID}
+
+	// Record call args
+	mmGetPromoCode.GetPromoCodeMock.mutex.Lock()
+	mmGetPromoCode.GetPromoCodeMock.callArgs = append(mmGetPromoCode.GetPromoCodeMock.callArgs, &mm_params)
+	mmGetPromoCode.GetPromoCodeMock.mutex.Unlock()
+
+	for _, e := range mmGetPromoCode.GetPromoCodeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.s1, e.results.err
+		}
+	}
+
+	if mmGetPromoCode.GetPromoCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetPromoCode.GetPromoCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetPromoCode.GetPromoCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmGetPromoCode.GetPromoCodeMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetPromoCodeParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetPromoCode.t.Errorf("RepositoryMock.GetPromoCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPromoCode.GetPromoCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetPromoCode.t.Errorf("RepositoryMock.GetPromoCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetPromoCode.GetPromoCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetPromoCode.t.Errorf("RepositoryMock.GetPromoCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetPromoCode.GetPromoCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetPromoCode.GetPromoCodeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetPromoCode.t.Fatal("No results are set for the RepositoryMock.GetPromoCode")
+		}
+		return (*mm_results).s1, (*mm_results).err
+	}
+	if mmGetPromoCode.funcGetPromoCode != nil {
+		return mmGetPromoCode.funcGetPromoCode(ctx, userID)
+	}
+	mmGetPromoCode.t.Fatalf("Unexpected call to RepositoryMock.GetPromoCode. %v %v", ctx, userID)
+	return
+}
+
+// GetPromoCodeAfterCounter returns a count of finished RepositoryMock.GetPromoCode invocations
+func (mmGetPromoCode *RepositoryMock) GetPromoCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPromoCode.afterGetPromoCodeCounter)
+}
+
+// GetPromoCodeBeforeCounter returns a count of RepositoryMock.GetPromoCode invocations
+func (mmGetPromoCode *RepositoryMock) GetPromoCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetPromoCode.beforeGetPromoCodeCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetPromoCode.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetPromoCode *mRepositoryMockGetPromoCode) Calls() []*RepositoryMockGetPromoCodeParams {
+	mmGetPromoCode.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetPromoCodeParams, len(mmGetPromoCode.callArgs))
+	copy(argCopy, mmGetPromoCode.callArgs)
+
+	mmGetPromoCode.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetPromoCodeDone returns true if the count of the GetPromoCode invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetPromoCodeDone() bool {
+	if m.GetPromoCodeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetPromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetPromoCodeMock.invocationsDone()
+}
+
+// MinimockGetPromoCodeInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetPromoCodeInspect() {
+	for _, e := range m.GetPromoCodeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetPromoCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetPromoCodeCounter := mm_atomic.LoadUint64(&m.afterGetPromoCodeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetPromoCodeMock.defaultExpectation != nil && afterGetPromoCodeCounter < 1 {
+		if m.GetPromoCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetPromoCode at\n%s", m.GetPromoCodeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetPromoCode at\n%s with params: %#v", m.GetPromoCodeMock.defaultExpectation.expectationOrigins.origin, *m.GetPromoCodeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetPromoCode != nil && afterGetPromoCodeCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetPromoCode at\n%s", m.funcGetPromoCodeOrigin)
+	}
+
+	if !m.GetPromoCodeMock.invocationsDone() && afterGetPromoCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetPromoCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetPromoCodeMock.expectedInvocations), m.GetPromoCodeMock.expectedInvocationsOrigin, afterGetPromoCodeCounter)
+	}
+}
+
+type mRepositoryMockGetSavedItems struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetSavedItemsExpectation
+	expectations       []*RepositoryMockGetSavedItemsExpectation
+
+	callArgs []*RepositoryMockGetSavedItemsParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetSavedItemsExpectation specifies expectation struct of the Repository.GetSavedItems
+type RepositoryMockGetSavedItemsExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetSavedItemsParams
+	paramPtrs          *RepositoryMockGetSavedItemsParamPtrs
+	expectationOrigins RepositoryMockGetSavedItemsExpectationOrigins
+	results            *RepositoryMockGetSavedItemsResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetSavedItemsParams contains parameters of the Repository.GetSavedItems
+type RepositoryMockGetSavedItemsParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// RepositoryMockGetSavedItemsParamPtrs contains pointers to parameters of the Repository.GetSavedItems
+type RepositoryMockGetSavedItemsParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// RepositoryMockGetSavedItemsResults contains results of the Repository.GetSavedItems
+type RepositoryMockGetSavedItemsResults struct {
+	ca1 []model.Cart
+	err error
+}
+
+// RepositoryMockGetSavedItemsOrigins contains origins of expectations of the Repository.GetSavedItems
+type RepositoryMockGetSavedItemsExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) Optional() *mRepositoryMockGetSavedItems {
+	mmGetSavedItems.optional = true
+	return mmGetSavedItems
+}
+
+// Expect sets up expected params for Repository.GetSavedItems
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) Expect(ctx context.Context, userID int64) *mRepositoryMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &RepositoryMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by ExpectParams functions")
+	}
+
+	mmGetSavedItems.defaultExpectation.params = &RepositoryMockGetSavedItemsParams{ctx, userID}
+	mmGetSavedItems.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetSavedItems.expectations {
+		if minimock.Equal(e.params, mmGetSavedItems.defaultExpectation.params) {
+			mmGetSavedItems.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetSavedItems.defaultExpectation.params)
+		}
+	}
+
+	return mmGetSavedItems
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetSavedItems
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &RepositoryMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.params != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by Expect")
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItems.defaultExpectation.paramPtrs = &RepositoryMockGetSavedItemsParamPtrs{}
+	}
+	mmGetSavedItems.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetSavedItems.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetSavedItems
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetSavedItems
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) ExpectUserIDParam2(userID int64) *mRepositoryMockGetSavedItems {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &RepositoryMockGetSavedItemsExpectation{}
+	}
+
+	if mmGetSavedItems.defaultExpectation.params != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by Expect")
+	}
+
+	if mmGetSavedItems.defaultExpectation.paramPtrs == nil {
+		mmGetSavedItems.defaultExpectation.paramPtrs = &RepositoryMockGetSavedItemsParamPtrs{}
+	}
+	mmGetSavedItems.defaultExpectation.paramPtrs.userID = &userID
+	mmGetSavedItems.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetSavedItems
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetSavedItems
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) Inspect(f func(ctx context.Context, userID int64)) *mRepositoryMockGetSavedItems {
+	if mmGetSavedItems.mock.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetSavedItems")
+	}
+
+	mmGetSavedItems.mock.inspectFuncGetSavedItems = f
+
+	return mmGetSavedItems
+}
+
+// Return sets up results that will be returned by Repository.GetSavedItems
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) Return(ca1 []model.Cart, err error) *RepositoryMock {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	if mmGetSavedItems.defaultExpectation == nil {
+		mmGetSavedItems.defaultExpectation = &RepositoryMockGetSavedItemsExpectation{mock: mmGetSavedItems.mock}
+	}
+	mmGetSavedItems.defaultExpectation.results = &RepositoryMockGetSavedItemsResults{ca1, err}
+	mmGetSavedItems.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems.mock
+}
+
+// Set uses given function f to mock the Repository.GetSavedItems method
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) Set(f func(ctx context.Context, userID int64) (ca1 []model.Cart, err error)) *RepositoryMock {
+	if mmGetSavedItems.defaultExpectation != nil {
+		mmGetSavedItems.mock.t.Fatalf("Default expectation is already set for the Repository.GetSavedItems method")
+	}
+
+	if len(mmGetSavedItems.expectations) > 0 {
+		mmGetSavedItems.mock.t.Fatalf("Some expectations are already set for the Repository.GetSavedItems method")
+	}
+
+	mmGetSavedItems.mock.funcGetSavedItems = f
+	mmGetSavedItems.mock.funcGetSavedItemsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems.mock
+}
+
+// When sets expectation for the Repository.GetSavedItems which will trigger the result defined by the following
+// Then helper
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) When(ctx context.Context, userID int64) *RepositoryMockGetSavedItemsExpectation {
+	if mmGetSavedItems.mock.funcGetSavedItems != nil {
+		mmGetSavedItems.mock.t.Fatalf("RepositoryMock.GetSavedItems mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetSavedItemsExpectation{
+		mock:               mmGetSavedItems.mock,
+		params:             &RepositoryMockGetSavedItemsParams{ctx, userID},
+		expectationOrigins: RepositoryMockGetSavedItemsExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetSavedItems.expectations = append(mmGetSavedItems.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetSavedItems return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetSavedItemsExpectation) Then(ca1 []model.Cart, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetSavedItemsResults{ca1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetSavedItems should be invoked
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) Times(n uint64) *mRepositoryMockGetSavedItems {
+	if n == 0 {
+		mmGetSavedItems.mock.t.Fatalf("Times of RepositoryMock.GetSavedItems mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetSavedItems.expectedInvocations, n)
+	mmGetSavedItems.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetSavedItems
+}
+
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) invocationsDone() bool {
+	if len(mmGetSavedItems.expectations) == 0 && mmGetSavedItems.defaultExpectation == nil && mmGetSavedItems.mock.funcGetSavedItems == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetSavedItems.mock.afterGetSavedItemsCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetSavedItems.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetSavedItems implements mm_service.Repository
+func (mmGetSavedItems *RepositoryMock) GetSavedItems(ctx context.Context, userID int64) (ca1 []model.Cart, err error) {
+	mm_atomic.AddUint64(&mmGetSavedItems.beforeGetSavedItemsCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetSavedItems.afterGetSavedItemsCounter, 1)
+
+	mmGetSavedItems.t.Helper()
+
+	if mmGetSavedItems.inspectFuncGetSavedItems != nil {
+		mmGetSavedItems.inspectFuncGetSavedItems(ctx, userID)
+	}
+
+	mm_params := RepositoryMockGetSavedItemsParams{ctx, userID}
+
+	// Record call args
+	mmGetSavedItems.GetSavedItemsMock.mutex.Lock()
+	mmGetSavedItems.GetSavedItemsMock.callArgs = append(mmGetSavedItems.GetSavedItemsMock.callArgs, &mm_params)
+	mmGetSavedItems.GetSavedItemsMock.mutex.Unlock()
+
+	for _, e := range mmGetSavedItems.GetSavedItemsMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ca1, e.results.err
+		}
+	}
+
+	if mmGetSavedItems.GetSavedItemsMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetSavedItems.GetSavedItemsMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.params
+		mm_want_ptrs := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetSavedItemsParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetSavedItems.t.Errorf("RepositoryMock.GetSavedItems got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetSavedItems.t.Errorf("RepositoryMock.GetSavedItems got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetSavedItems.t.Errorf("RepositoryMock.GetSavedItems got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetSavedItems.GetSavedItemsMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetSavedItems.GetSavedItemsMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetSavedItems.t.Fatal("No results are set for the RepositoryMock.GetSavedItems")
+		}
+		return (*mm_results).ca1, (*mm_results).err
+	}
+	if mmGetSavedItems.funcGetSavedItems != nil {
+		return mmGetSavedItems.funcGetSavedItems(ctx, userID)
+	}
+	mmGetSavedItems.t.Fatalf("Unexpected call to RepositoryMock.GetSavedItems. %v %v", ctx, userID)
+	return
+}
+
+// GetSavedItemsAfterCounter returns a count of finished RepositoryMock.GetSavedItems invocations
+func (mmGetSavedItems *RepositoryMock) GetSavedItemsAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.afterGetSavedItemsCounter)
+}
+
+// GetSavedItemsBeforeCounter returns a count of RepositoryMock.GetSavedItems invocations
+func (mmGetSavedItems *RepositoryMock) GetSavedItemsBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetSavedItems.beforeGetSavedItemsCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetSavedItems.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetSavedItems *mRepositoryMockGetSavedItems) Calls() []*RepositoryMockGetSavedItemsParams {
+	mmGetSavedItems.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetSavedItemsParams, len(mmGetSavedItems.callArgs))
+	copy(argCopy, mmGetSavedItems.callArgs)
+
+	mmGetSavedItems.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetSavedItemsDone returns true if the count of the GetSavedItems invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetSavedItemsDone() bool {
+	if m.GetSavedItemsMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetSavedItemsMock.invocationsDone()
+}
+
+// MinimockGetSavedItemsInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetSavedItemsInspect() {
+	for _, e := range m.GetSavedItemsMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetSavedItems at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetSavedItemsCounter := mm_atomic.LoadUint64(&m.afterGetSavedItemsCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetSavedItemsMock.defaultExpectation != nil && afterGetSavedItemsCounter < 1 {
+		if m.GetSavedItemsMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetSavedItems at\n%s", m.GetSavedItemsMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetSavedItems at\n%s with params: %#v", m.GetSavedItemsMock.defaultExpectation.expectationOrigins.origin, *m.GetSavedItemsMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetSavedItems != nil && afterGetSavedItemsCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetSavedItems at\n%s", m.funcGetSavedItemsOrigin)
+	}
+
+	if !m.GetSavedItemsMock.invocationsDone() && afterGetSavedItemsCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetSavedItems at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetSavedItemsMock.expectedInvocations), m.GetSavedItemsMock.expectedInvocationsOrigin, afterGetSavedItemsCounter)
+	}
+}
+
+type mRepositoryMockGetVersion struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockGetVersionExpectation
+	expectations       []*RepositoryMockGetVersionExpectation
+
+	callArgs []*RepositoryMockGetVersionParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockGetVersionExpectation specifies expectation struct of the Repository.GetVersion
+type RepositoryMockGetVersionExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockGetVersionParams
+	paramPtrs          *RepositoryMockGetVersionParamPtrs
+	expectationOrigins RepositoryMockGetVersionExpectationOrigins
+	results            *RepositoryMockGetVersionResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockGetVersionParams contains parameters of the Repository.GetVersion
+type RepositoryMockGetVersionParams struct {
+	ctx    context.Context
+	userID int64
+}
+
+// RepositoryMockGetVersionParamPtrs contains pointers to parameters of the Repository.GetVersion
+type RepositoryMockGetVersionParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+}
+
+// RepositoryMockGetVersionResults contains results of the Repository.GetVersion
+type RepositoryMockGetVersionResults struct {
+	u1  uint64
+	err error
+}
+
+// RepositoryMockGetVersionOrigins contains origins of expectations of the Repository.GetVersion
+type RepositoryMockGetVersionExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmGetVersion *mRepositoryMockGetVersion) Optional() *mRepositoryMockGetVersion {
+	mmGetVersion.optional = true
+	return mmGetVersion
+}
+
+// Expect sets up expected params for Repository.GetVersion
+func (mmGetVersion *mRepositoryMockGetVersion) Expect(ctx context.Context, userID int64) *mRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &RepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by ExpectParams functions")
+	}
+
+	mmGetVersion.defaultExpectation.params = &RepositoryMockGetVersionParams{ctx, userID}
+	mmGetVersion.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmGetVersion.expectations {
+		if minimock.Equal(e.params, mmGetVersion.defaultExpectation.params) {
+			mmGetVersion.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetVersion.defaultExpectation.params)
+		}
+	}
+
+	return mmGetVersion
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.GetVersion
+func (mmGetVersion *mRepositoryMockGetVersion) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &RepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &RepositoryMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.ctx = &ctx
+	mmGetVersion.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.GetVersion
+func (mmGetVersion *mRepositoryMockGetVersion) ExpectUserIDParam2(userID int64) *mRepositoryMockGetVersion {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &RepositoryMockGetVersionExpectation{}
+	}
+
+	if mmGetVersion.defaultExpectation.params != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by Expect")
+	}
+
+	if mmGetVersion.defaultExpectation.paramPtrs == nil {
+		mmGetVersion.defaultExpectation.paramPtrs = &RepositoryMockGetVersionParamPtrs{}
+	}
+	mmGetVersion.defaultExpectation.paramPtrs.userID = &userID
+	mmGetVersion.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmGetVersion
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.GetVersion
+func (mmGetVersion *mRepositoryMockGetVersion) Inspect(f func(ctx context.Context, userID int64)) *mRepositoryMockGetVersion {
+	if mmGetVersion.mock.inspectFuncGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetVersion")
+	}
+
+	mmGetVersion.mock.inspectFuncGetVersion = f
+
+	return mmGetVersion
+}
+
+// Return sets up results that will be returned by Repository.GetVersion
+func (mmGetVersion *mRepositoryMockGetVersion) Return(u1 uint64, err error) *RepositoryMock {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	if mmGetVersion.defaultExpectation == nil {
+		mmGetVersion.defaultExpectation = &RepositoryMockGetVersionExpectation{mock: mmGetVersion.mock}
+	}
+	mmGetVersion.defaultExpectation.results = &RepositoryMockGetVersionResults{u1, err}
+	mmGetVersion.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// Set uses given function f to mock the Repository.GetVersion method
+func (mmGetVersion *mRepositoryMockGetVersion) Set(f func(ctx context.Context, userID int64) (u1 uint64, err error)) *RepositoryMock {
+	if mmGetVersion.defaultExpectation != nil {
+		mmGetVersion.mock.t.Fatalf("Default expectation is already set for the Repository.GetVersion method")
+	}
+
+	if len(mmGetVersion.expectations) > 0 {
+		mmGetVersion.mock.t.Fatalf("Some expectations are already set for the Repository.GetVersion method")
+	}
+
+	mmGetVersion.mock.funcGetVersion = f
+	mmGetVersion.mock.funcGetVersionOrigin = minimock.CallerInfo(1)
+	return mmGetVersion.mock
+}
+
+// When sets expectation for the Repository.GetVersion which will trigger the result defined by the following
+// Then helper
+func (mmGetVersion *mRepositoryMockGetVersion) When(ctx context.Context, userID int64) *RepositoryMockGetVersionExpectation {
+	if mmGetVersion.mock.funcGetVersion != nil {
+		mmGetVersion.mock.t.Fatalf("RepositoryMock.GetVersion mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockGetVersionExpectation{
+		mock:               mmGetVersion.mock,
+		params:             &RepositoryMockGetVersionParams{ctx, userID},
+		expectationOrigins: RepositoryMockGetVersionExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmGetVersion.expectations = append(mmGetVersion.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.GetVersion return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockGetVersionExpectation) Then(u1 uint64, err error) *RepositoryMock {
+	e.results = &RepositoryMockGetVersionResults{u1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.GetVersion should be invoked
+func (mmGetVersion *mRepositoryMockGetVersion) Times(n uint64) *mRepositoryMockGetVersion {
+	if n == 0 {
+		mmGetVersion.mock.t.Fatalf("Times of RepositoryMock.GetVersion mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmGetVersion.expectedInvocations, n)
+	mmGetVersion.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmGetVersion
+}
+
+func (mmGetVersion *mRepositoryMockGetVersion) invocationsDone() bool {
+	if len(mmGetVersion.expectations) == 0 && mmGetVersion.defaultExpectation == nil && mmGetVersion.mock.funcGetVersion == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmGetVersion.mock.afterGetVersionCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmGetVersion.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// GetVersion implements mm_service.Repository
+func (mmGetVersion *RepositoryMock) GetVersion(ctx context.Context, userID int64) (u1 uint64, err error) {
+	mm_atomic.AddUint64(&mmGetVersion.beforeGetVersionCounter, 1)
+	defer mm_atomic.AddUint64(&mmGetVersion.afterGetVersionCounter, 1)
+
+	mmGetVersion.t.Helper()
+
+	if mmGetVersion.inspectFuncGetVersion != nil {
+		mmGetVersion.inspectFuncGetVersion(ctx, userID)
+	}
+
+	mm_params := RepositoryMockGetVersionParams{ctx, userID}
+
+	// Record call args
+	mmGetVersion.GetVersionMock.mutex.Lock()
+	mmGetVersion.GetVersionMock.callArgs = append(mmGetVersion.GetVersionMock.callArgs, &mm_params)
+	mmGetVersion.GetVersionMock.mutex.Unlock()
+
+	for _, e := range mmGetVersion.GetVersionMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.u1, e.results.err
+		}
+	}
+
+	if mmGetVersion.GetVersionMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmGetVersion.GetVersionMock.defaultExpectation.Counter, 1)
+		mm_want := mmGetVersion.GetVersionMock.defaultExpectation.params
+		mm_want_ptrs := mmGetVersion.GetVersionMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockGetVersionParams{ctx, userID}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmGetVersion.t.Errorf("RepositoryMock.GetVersion got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmGetVersion.t.Errorf("RepositoryMock.GetVersion got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmGetVersion.t.Errorf("RepositoryMock.GetVersion got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmGetVersion.GetVersionMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmGetVersion.GetVersionMock.defaultExpectation.results
+		if mm_results == nil {
+			mmGetVersion.t.Fatal("No results are set for the RepositoryMock.GetVersion")
+		}
+		return (*mm_results).u1, (*mm_results).err
+	}
+	if mmGetVersion.funcGetVersion != nil {
+		return mmGetVersion.funcGetVersion(ctx, userID)
+	}
+	mmGetVersion.t.Fatalf("Unexpected call to RepositoryMock.GetVersion. %v %v", ctx, userID)
+	return
+}
+
+// GetVersionAfterCounter returns a count of finished RepositoryMock.GetVersion invocations
+func (mmGetVersion *RepositoryMock) GetVersionAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.afterGetVersionCounter)
+}
+
+// GetVersionBeforeCounter returns a count of RepositoryMock.GetVersion invocations
+func (mmGetVersion *RepositoryMock) GetVersionBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmGetVersion.beforeGetVersionCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.GetVersion.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmGetVersion *mRepositoryMockGetVersion) Calls() []*RepositoryMockGetVersionParams {
+	mmGetVersion.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockGetVersionParams, len(mmGetVersion.callArgs))
+	copy(argCopy, mmGetVersion.callArgs)
+
+	mmGetVersion.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockGetVersionDone returns true if the count of the GetVersion invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockGetVersionDone() bool {
+	if m.GetVersionMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.GetVersionMock.invocationsDone()
+}
+
+// MinimockGetVersionInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockGetVersionInspect() {
+	for _, e := range m.GetVersionMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.GetVersion at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterGetVersionCounter := mm_atomic.LoadUint64(&m.afterGetVersionCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.GetVersionMock.defaultExpectation != nil && afterGetVersionCounter < 1 {
+		if m.GetVersionMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.GetVersion at\n%s", m.GetVersionMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.GetVersion at\n%s with params: %#v", m.GetVersionMock.defaultExpectation.expectationOrigins.origin, *m.GetVersionMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcGetVersion != nil && afterGetVersionCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.GetVersion at\n%s", m.funcGetVersionOrigin)
+	}
+
+	if !m.GetVersionMock.invocationsDone() && afterGetVersionCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.GetVersion at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.GetVersionMock.expectedInvocations), m.GetVersionMock.expectedInvocationsOrigin, afterGetVersionCounter)
+	}
+}
+
+type mRepositoryMockMarkAbandonedReported struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockMarkAbandonedReportedExpectation
+	expectations       []*RepositoryMockMarkAbandonedReportedExpectation
+
+	callArgs []*RepositoryMockMarkAbandonedReportedParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockMarkAbandonedReportedExpectation specifies expectation struct of the Repository.MarkAbandonedReported
+type RepositoryMockMarkAbandonedReportedExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockMarkAbandonedReportedParams
+	paramPtrs          *RepositoryMockMarkAbandonedReportedParamPtrs
+	expectationOrigins RepositoryMockMarkAbandonedReportedExpectationOrigins
+	results            *RepositoryMockMarkAbandonedReportedResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockMarkAbandonedReportedParams contains parameters of the Repository.MarkAbandonedReported
+type RepositoryMockMarkAbandonedReportedParams struct {
+	ctx       context.Context
+	userID    int64
+	updatedAt time.Time
+}
+
+// RepositoryMockMarkAbandonedReportedParamPtrs contains pointers to parameters of the Repository.MarkAbandonedReported
+type RepositoryMockMarkAbandonedReportedParamPtrs struct {
+	ctx       *context.Context
+	userID    *int64
+	updatedAt *time.Time
+}
+
+// RepositoryMockMarkAbandonedReportedResults contains results of the Repository.MarkAbandonedReported
+type RepositoryMockMarkAbandonedReportedResults struct {
+	err error
+}
+
+// RepositoryMockMarkAbandonedReportedOrigins contains origins of expectations of the Repository.MarkAbandonedReported
+type RepositoryMockMarkAbandonedReportedExpectationOrigins struct {
+	origin          string
+	originCtx       string
+	originUserID    string
+	originUpdatedAt string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) Optional() *mRepositoryMockMarkAbandonedReported {
+	mmMarkAbandonedReported.optional = true
+	return mmMarkAbandonedReported
+}
+
+// Expect sets up expected params for Repository.MarkAbandonedReported
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) Expect(ctx context.Context, userID int64, updatedAt time.Time) *mRepositoryMockMarkAbandonedReported {
+	if mmMarkAbandonedReported.mock.funcMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Set")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation == nil {
+		mmMarkAbandonedReported.defaultExpectation = &RepositoryMockMarkAbandonedReportedExpectation{}
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation.paramPtrs != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by ExpectParams functions")
+	}
+
+	mmMarkAbandonedReported.defaultExpectation.params = &RepositoryMockMarkAbandonedReportedParams{ctx, userID, updatedAt}
+	mmMarkAbandonedReported.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMarkAbandonedReported.expectations {
+		if minimock.Equal(e.params, mmMarkAbandonedReported.defaultExpectation.params) {
+			mmMarkAbandonedReported.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMarkAbandonedReported.defaultExpectation.params)
+		}
+	}
+
+	return mmMarkAbandonedReported
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.MarkAbandonedReported
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) ExpectCtxParam1(ctx context.Context) *mRepositoryMockMarkAbandonedReported {
+	if mmMarkAbandonedReported.mock.funcMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Set")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation == nil {
+		mmMarkAbandonedReported.defaultExpectation = &RepositoryMockMarkAbandonedReportedExpectation{}
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation.params != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Expect")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation.paramPtrs == nil {
+		mmMarkAbandonedReported.defaultExpectation.paramPtrs = &RepositoryMockMarkAbandonedReportedParamPtrs{}
+	}
+	mmMarkAbandonedReported.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMarkAbandonedReported.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMarkAbandonedReported
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.MarkAbandonedReported
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) ExpectUserIDParam2(userID int64) *mRepositoryMockMarkAbandonedReported {
+	if mmMarkAbandonedReported.mock.funcMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Set")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation == nil {
+		mmMarkAbandonedReported.defaultExpectation = &RepositoryMockMarkAbandonedReportedExpectation{}
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation.params != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Expect")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation.paramPtrs == nil {
+		mmMarkAbandonedReported.defaultExpectation.paramPtrs = &RepositoryMockMarkAbandonedReportedParamPtrs{}
+	}
+	mmMarkAbandonedReported.defaultExpectation.paramPtrs.userID = &userID
+	mmMarkAbandonedReported.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmMarkAbandonedReported
+}
+
+// ExpectUpdatedAtParam3 sets up expected param updatedAt for Repository.MarkAbandonedReported
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) ExpectUpdatedAtParam3(updatedAt time.Time) *mRepositoryMockMarkAbandonedReported {
+	if mmMarkAbandonedReported.mock.funcMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Set")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation == nil {
+		mmMarkAbandonedReported.defaultExpectation = &RepositoryMockMarkAbandonedReportedExpectation{}
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation.params != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Expect")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation.paramPtrs == nil {
+		mmMarkAbandonedReported.defaultExpectation.paramPtrs = &RepositoryMockMarkAbandonedReportedParamPtrs{}
+	}
+	mmMarkAbandonedReported.defaultExpectation.paramPtrs.updatedAt = &updatedAt
+	mmMarkAbandonedReported.defaultExpectation.expectationOrigins.originUpdatedAt = minimock.CallerInfo(1)
+
+	return mmMarkAbandonedReported
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.MarkAbandonedReported
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) Inspect(f func(ctx context.Context, userID int64, updatedAt time.Time)) *mRepositoryMockMarkAbandonedReported {
+	if mmMarkAbandonedReported.mock.inspectFuncMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("Inspect function is already set for RepositoryMock.MarkAbandonedReported")
+	}
+
+	mmMarkAbandonedReported.mock.inspectFuncMarkAbandonedReported = f
+
+	return mmMarkAbandonedReported
+}
+
+// Return sets up results that will be returned by Repository.MarkAbandonedReported
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) Return(err error) *RepositoryMock {
+	if mmMarkAbandonedReported.mock.funcMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Set")
+	}
+
+	if mmMarkAbandonedReported.defaultExpectation == nil {
+		mmMarkAbandonedReported.defaultExpectation = &RepositoryMockMarkAbandonedReportedExpectation{mock: mmMarkAbandonedReported.mock}
+	}
+	mmMarkAbandonedReported.defaultExpectation.results = &RepositoryMockMarkAbandonedReportedResults{err}
+	mmMarkAbandonedReported.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMarkAbandonedReported.mock
+}
+
+// Set uses given function f to mock the Repository.MarkAbandonedReported method
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) Set(f func(ctx context.Context, userID int64, updatedAt time.Time) (err error)) *RepositoryMock {
+	if mmMarkAbandonedReported.defaultExpectation != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("Default expectation is already set for the Repository.MarkAbandonedReported method")
+	}
+
+	if len(mmMarkAbandonedReported.expectations) > 0 {
+		mmMarkAbandonedReported.mock.t.Fatalf("Some expectations are already set for the Repository.MarkAbandonedReported method")
+	}
+
+	mmMarkAbandonedReported.mock.funcMarkAbandonedReported = f
+	mmMarkAbandonedReported.mock.funcMarkAbandonedReportedOrigin = minimock.CallerInfo(1)
+	return mmMarkAbandonedReported.mock
+}
+
+// When sets expectation for the Repository.MarkAbandonedReported which will trigger the result defined by the following
+// Then helper
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) When(ctx context.Context, userID int64, updatedAt time.Time) *RepositoryMockMarkAbandonedReportedExpectation {
+	if mmMarkAbandonedReported.mock.funcMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.mock.t.Fatalf("RepositoryMock.MarkAbandonedReported mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockMarkAbandonedReportedExpectation{
+		mock:               mmMarkAbandonedReported.mock,
+		params:             &RepositoryMockMarkAbandonedReportedParams{ctx, userID, updatedAt},
+		expectationOrigins: RepositoryMockMarkAbandonedReportedExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMarkAbandonedReported.expectations = append(mmMarkAbandonedReported.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.MarkAbandonedReported return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockMarkAbandonedReportedExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockMarkAbandonedReportedResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.MarkAbandonedReported should be invoked
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) Times(n uint64) *mRepositoryMockMarkAbandonedReported {
+	if n == 0 {
+		mmMarkAbandonedReported.mock.t.Fatalf("Times of RepositoryMock.MarkAbandonedReported mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMarkAbandonedReported.expectedInvocations, n)
+	mmMarkAbandonedReported.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMarkAbandonedReported
+}
+
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) invocationsDone() bool {
+	if len(mmMarkAbandonedReported.expectations) == 0 && mmMarkAbandonedReported.defaultExpectation == nil && mmMarkAbandonedReported.mock.funcMarkAbandonedReported == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMarkAbandonedReported.mock.afterMarkAbandonedReportedCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMarkAbandonedReported.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MarkAbandonedReported implements mm_service.Repository
+func (mmMarkAbandonedReported *RepositoryMock) MarkAbandonedReported(ctx context.Context, userID int64, updatedAt time.Time) (err error) {
+	mm_atomic.AddUint64(&mmMarkAbandonedReported.beforeMarkAbandonedReportedCounter, 1)
+	defer mm_atomic.AddUint64(&mmMarkAbandonedReported.afterMarkAbandonedReportedCounter, 1)
+
+	mmMarkAbandonedReported.t.Helper()
+
+	if mmMarkAbandonedReported.inspectFuncMarkAbandonedReported != nil {
+		mmMarkAbandonedReported.inspectFuncMarkAbandonedReported(ctx, userID, updatedAt)
+	}
+
+	mm_params := RepositoryMockMarkAbandonedReportedParams{ctx, userID, updatedAt}
+
+	// Record call args
+	mmMarkAbandonedReported.MarkAbandonedReportedMock.mutex.Lock()
+	mmMarkAbandonedReported.MarkAbandonedReportedMock.callArgs = append(mmMarkAbandonedReported.MarkAbandonedReportedMock.callArgs, &mm_params)
+	mmMarkAbandonedReported.MarkAbandonedReportedMock.mutex.Unlock()
+
+	for _, e := range mmMarkAbandonedReported.MarkAbandonedReportedMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.Counter, 1)
+		mm_want := mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.params
+		mm_want_ptrs := mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockMarkAbandonedReportedParams{ctx, userID, updatedAt}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMarkAbandonedReported.t.Errorf("RepositoryMock.MarkAbandonedReported got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmMarkAbandonedReported.t.Errorf("RepositoryMock.MarkAbandonedReported got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.updatedAt != nil && !minimock.Equal(*mm_want_ptrs.updatedAt, mm_got.updatedAt) {
+				mmMarkAbandonedReported.t.Errorf("RepositoryMock.MarkAbandonedReported got unexpected parameter updatedAt, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.expectationOrigins.originUpdatedAt, *mm_want_ptrs.updatedAt, mm_got.updatedAt, minimock.Diff(*mm_want_ptrs.updatedAt, mm_got.updatedAt))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMarkAbandonedReported.t.Errorf("RepositoryMock.MarkAbandonedReported got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMarkAbandonedReported.MarkAbandonedReportedMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMarkAbandonedReported.t.Fatal("No results are set for the RepositoryMock.MarkAbandonedReported")
+		}
+		return (*mm_results).err
+	}
+	if mmMarkAbandonedReported.funcMarkAbandonedReported != nil {
+		return mmMarkAbandonedReported.funcMarkAbandonedReported(ctx, userID, updatedAt)
+	}
+	mmMarkAbandonedReported.t.Fatalf("Unexpected call to RepositoryMock.MarkAbandonedReported. %v %v %v", ctx, userID, updatedAt)
+	return
+}
+
+// MarkAbandonedReportedAfterCounter returns a count of finished RepositoryMock.MarkAbandonedReported invocations
+func (mmMarkAbandonedReported *RepositoryMock) MarkAbandonedReportedAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAbandonedReported.afterMarkAbandonedReportedCounter)
+}
+
+// MarkAbandonedReportedBeforeCounter returns a count of RepositoryMock.MarkAbandonedReported invocations
+func (mmMarkAbandonedReported *RepositoryMock) MarkAbandonedReportedBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMarkAbandonedReported.beforeMarkAbandonedReportedCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.MarkAbandonedReported.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMarkAbandonedReported *mRepositoryMockMarkAbandonedReported) Calls() []*RepositoryMockMarkAbandonedReportedParams {
+	mmMarkAbandonedReported.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockMarkAbandonedReportedParams, len(mmMarkAbandonedReported.callArgs))
+	copy(argCopy, mmMarkAbandonedReported.callArgs)
+
+	mmMarkAbandonedReported.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMarkAbandonedReportedDone returns true if the count of the MarkAbandonedReported invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockMarkAbandonedReportedDone() bool {
+	if m.MarkAbandonedReportedMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MarkAbandonedReportedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MarkAbandonedReportedMock.invocationsDone()
+}
+
+// MinimockMarkAbandonedReportedInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockMarkAbandonedReportedInspect() {
+	for _, e := range m.MarkAbandonedReportedMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.MarkAbandonedReported at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMarkAbandonedReportedCounter := mm_atomic.LoadUint64(&m.afterMarkAbandonedReportedCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MarkAbandonedReportedMock.defaultExpectation != nil && afterMarkAbandonedReportedCounter < 1 {
+		if m.MarkAbandonedReportedMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.MarkAbandonedReported at\n%s", m.MarkAbandonedReportedMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.MarkAbandonedReported at\n%s with params: %#v", m.MarkAbandonedReportedMock.defaultExpectation.expectationOrigins.origin, *m.MarkAbandonedReportedMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMarkAbandonedReported != nil && afterMarkAbandonedReportedCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.MarkAbandonedReported at\n%s", m.funcMarkAbandonedReportedOrigin)
+	}
+
+	if !m.MarkAbandonedReportedMock.invocationsDone() && afterMarkAbandonedReportedCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.MarkAbandonedReported at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MarkAbandonedReportedMock.expectedInvocations), m.MarkAbandonedReportedMock.expectedInvocationsOrigin, afterMarkAbandonedReportedCounter)
+	}
+}
+
+type mRepositoryMockMerge struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockMergeExpectation
+	expectations       []*RepositoryMockMergeExpectation
+
+	callArgs []*RepositoryMockMergeParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockMergeExpectation specifies expectation struct of the Repository.Merge
+type RepositoryMockMergeExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockMergeParams
+	paramPtrs          *RepositoryMockMergeParamPtrs
+	expectationOrigins RepositoryMockMergeExpectationOrigins
+	results            *RepositoryMockMergeResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockMergeParams contains parameters of the Repository.Merge
+type RepositoryMockMergeParams struct {
+	ctx          context.Context
+	targetUserID int64
+	sourceUserID int64
+	source       []model.Cart
+	available    map[int64]uint32
+	limits       model.CartLimits
+}
+
+// RepositoryMockMergeParamPtrs contains pointers to parameters of the Repository.Merge
+type RepositoryMockMergeParamPtrs struct {
+	ctx          *context.Context
+	targetUserID *int64
+	sourceUserID *int64
+	source       *[]model.Cart
+	available    *map[int64]uint32
+	limits       *model.CartLimits
+}
+
+// RepositoryMockMergeResults contains results of the Repository.Merge
+type RepositoryMockMergeResults struct {
+	ma1 []model.MergedLine
+	err error
+}
+
+// RepositoryMockMergeOrigins contains origins of expectations of the Repository.Merge
+type RepositoryMockMergeExpectationOrigins struct {
+	origin             string
+	originCtx          string
+	originTargetUserID string
+	originSourceUserID string
+	originSource       string
+	originAvailable    string
+	originLimits       string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMerge *mRepositoryMockMerge) Optional() *mRepositoryMockMerge {
+	mmMerge.optional = true
+	return mmMerge
+}
+
+// Expect sets up expected params for Repository.Merge
+func (mmMerge *mRepositoryMockMerge) Expect(ctx context.Context, targetUserID int64, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) *mRepositoryMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{}
+	}
+
+	if mmMerge.defaultExpectation.paramPtrs != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by ExpectParams functions")
+	}
+
+	mmMerge.defaultExpectation.params = &RepositoryMockMergeParams{ctx, targetUserID, sourceUserID, source, available, limits}
+	mmMerge.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMerge.expectations {
+		if minimock.Equal(e.params, mmMerge.defaultExpectation.params) {
+			mmMerge.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMerge.defaultExpectation.params)
+		}
+	}
+
+	return mmMerge
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.Merge
+func (mmMerge *mRepositoryMockMerge) ExpectCtxParam1(ctx context.Context) *mRepositoryMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{}
+	}
+
+	if mmMerge.defaultExpectation.params != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Expect")
+	}
+
+	if mmMerge.defaultExpectation.paramPtrs == nil {
+		mmMerge.defaultExpectation.paramPtrs = &RepositoryMockMergeParamPtrs{}
+	}
+	mmMerge.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMerge.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMerge
+}
+
+// ExpectTargetUserIDParam2 sets up expected param targetUserID for Repository.Merge
+func (mmMerge *mRepositoryMockMerge) ExpectTargetUserIDParam2(targetUserID int64) *mRepositoryMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{}
+	}
+
+	if mmMerge.defaultExpectation.params != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Expect")
+	}
+
+	if mmMerge.defaultExpectation.paramPtrs == nil {
+		mmMerge.defaultExpectation.paramPtrs = &RepositoryMockMergeParamPtrs{}
+	}
+	mmMerge.defaultExpectation.paramPtrs.targetUserID = &targetUserID
+	mmMerge.defaultExpectation.expectationOrigins.originTargetUserID = minimock.CallerInfo(1)
+
+	return mmMerge
+}
+
+// ExpectSourceUserIDParam3 sets up expected param sourceUserID for Repository.Merge
+func (mmMerge *mRepositoryMockMerge) ExpectSourceUserIDParam3(sourceUserID int64) *mRepositoryMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{}
+	}
+
+	if mmMerge.defaultExpectation.params != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Expect")
+	}
+
+	if mmMerge.defaultExpectation.paramPtrs == nil {
+		mmMerge.defaultExpectation.paramPtrs = &RepositoryMockMergeParamPtrs{}
+	}
+	mmMerge.defaultExpectation.paramPtrs.sourceUserID = &sourceUserID
+	mmMerge.defaultExpectation.expectationOrigins.originSourceUserID = minimock.CallerInfo(1)
+
+	return mmMerge
+}
+
+// ExpectSourceParam4 sets up expected param source for Repository.Merge
+func (mmMerge *mRepositoryMockMerge) ExpectSourceParam4(source []model.Cart) *mRepositoryMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{}
+	}
+
+	if mmMerge.defaultExpectation.params != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Expect")
+	}
+
+	if mmMerge.defaultExpectation.paramPtrs == nil {
+		mmMerge.defaultExpectation.paramPtrs = &RepositoryMockMergeParamPtrs{}
+	}
+	mmMerge.defaultExpectation.paramPtrs.source = &source
+	mmMerge.defaultExpectation.expectationOrigins.originSource = minimock.CallerInfo(1)
+
+	return mmMerge
+}
+
+// ExpectAvailableParam5 sets up expected param available for Repository.Merge
+func (mmMerge *mRepositoryMockMerge) ExpectAvailableParam5(available map[int64]uint32) *mRepositoryMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{}
+	}
+
+	if mmMerge.defaultExpectation.params != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Expect")
+	}
+
+	if mmMerge.defaultExpectation.paramPtrs == nil {
+		mmMerge.defaultExpectation.paramPtrs = &RepositoryMockMergeParamPtrs{}
+	}
+	mmMerge.defaultExpectation.paramPtrs.available = &available
+	mmMerge.defaultExpectation.expectationOrigins.originAvailable = minimock.CallerInfo(1)
+
+	return mmMerge
+}
+
+// ExpectLimitsParam6 sets up expected param limits for Repository.Merge
+func (mmMerge *mRepositoryMockMerge) ExpectLimitsParam6(limits model.CartLimits) *mRepositoryMockMerge {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{}
+	}
+
+	if mmMerge.defaultExpectation.params != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Expect")
+	}
+
+	if mmMerge.defaultExpectation.paramPtrs == nil {
+		mmMerge.defaultExpectation.paramPtrs = &RepositoryMockMergeParamPtrs{}
+	}
+	mmMerge.defaultExpectation.paramPtrs.limits = &limits
+	mmMerge.defaultExpectation.expectationOrigins.originLimits = minimock.CallerInfo(1)
+
+	return mmMerge
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.Merge
+func (mmMerge *mRepositoryMockMerge) Inspect(f func(ctx context.Context, targetUserID int64, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits)) *mRepositoryMockMerge {
+	if mmMerge.mock.inspectFuncMerge != nil {
+		mmMerge.mock.t.Fatalf("Inspect function is already set for RepositoryMock.Merge")
+	}
+
+	mmMerge.mock.inspectFuncMerge = f
+
+	return mmMerge
+}
+
+// Return sets up results that will be returned by Repository.Merge
+func (mmMerge *mRepositoryMockMerge) Return(ma1 []model.MergedLine, err error) *RepositoryMock {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	if mmMerge.defaultExpectation == nil {
+		mmMerge.defaultExpectation = &RepositoryMockMergeExpectation{mock: mmMerge.mock}
+	}
+	mmMerge.defaultExpectation.results = &RepositoryMockMergeResults{ma1, err}
+	mmMerge.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMerge.mock
+}
+
+// Set uses given function f to mock the Repository.Merge method
+func (mmMerge *mRepositoryMockMerge) Set(f func(ctx context.Context, targetUserID int64, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) (ma1 []model.MergedLine, err error)) *RepositoryMock {
+	if mmMerge.defaultExpectation != nil {
+		mmMerge.mock.t.Fatalf("Default expectation is already set for the Repository.Merge method")
+	}
+
+	if len(mmMerge.expectations) > 0 {
+		mmMerge.mock.t.Fatalf("Some expectations are already set for the Repository.Merge method")
+	}
+
+	mmMerge.mock.funcMerge = f
+	mmMerge.mock.funcMergeOrigin = minimock.CallerInfo(1)
+	return mmMerge.mock
+}
+
+// When sets expectation for the Repository.Merge which will trigger the result defined by the following
+// Then helper
+func (mmMerge *mRepositoryMockMerge) When(ctx context.Context, targetUserID int64, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) *RepositoryMockMergeExpectation {
+	if mmMerge.mock.funcMerge != nil {
+		mmMerge.mock.t.Fatalf("RepositoryMock.Merge mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockMergeExpectation{
+		mock:               mmMerge.mock,
+		params:             &RepositoryMockMergeParams{ctx, targetUserID, sourceUserID, source, available, limits},
+		expectationOrigins: RepositoryMockMergeExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMerge.expectations = append(mmMerge.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.Merge return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockMergeExpectation) Then(ma1 []model.MergedLine, err error) *RepositoryMock {
+	e.results = &RepositoryMockMergeResults{ma1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.Merge should be invoked
+func (mmMerge *mRepositoryMockMerge) Times(n uint64) *mRepositoryMockMerge {
+	if n == 0 {
+		mmMerge.mock.t.Fatalf("Times of RepositoryMock.Merge mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMerge.expectedInvocations, n)
+	mmMerge.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMerge
+}
+
+func (mmMerge *mRepositoryMockMerge) invocationsDone() bool {
+	if len(mmMerge.expectations) == 0 && mmMerge.defaultExpectation == nil && mmMerge.mock.funcMerge == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMerge.mock.afterMergeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMerge.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// Merge implements mm_service.Repository
+func (mmMerge *RepositoryMock) Merge(ctx context.Context, targetUserID int64, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) (ma1 []model.MergedLine, err error) {
+	mm_atomic.AddUint64(&mmMerge.beforeMergeCounter, 1)
+	defer mm_atomic.AddUint64(&mmMerge.afterMergeCounter, 1)
+
+	mmMerge.t.Helper()
+
+	if mmMerge.inspectFuncMerge != nil {
+		mmMerge.inspectFuncMerge(ctx, targetUserID, sourceUserID, source, available, limits)
+	}
+
+	mm_params := RepositoryMockMergeParams{ctx, targetUserID, sourceUserID, source, available, limits}
+
+	// Record call args
+	mmMerge.MergeMock.mutex.Lock()
+	mmMerge.MergeMock.callArgs = append(mmMerge.MergeMock.callArgs, &mm_params)
+	mmMerge.MergeMock.mutex.Unlock()
+
+	for _, e := range mmMerge.MergeMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.ma1, e.results.err
+		}
+	}
+
+	if mmMerge.MergeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMerge.MergeMock.defaultExpectation.Counter, 1)
+		mm_want := mmMerge.MergeMock.defaultExpectation.params
+		mm_want_ptrs := mmMerge.MergeMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockMergeParams{ctx, targetUserID, sourceUserID, source, available, limits}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMerge.t.Errorf("RepositoryMock.Merge got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMerge.MergeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.targetUserID != nil && !minimock.Equal(*mm_want_ptrs.targetUserID, mm_got.targetUserID) {
+				mmMerge.t.Errorf("RepositoryMock.Merge got unexpected parameter targetUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMerge.MergeMock.defaultExpectation.expectationOrigins.originTargetUserID, *mm_want_ptrs.targetUserID, mm_got.targetUserID, minimock.Diff(*mm_want_ptrs.targetUserID, mm_got.targetUserID))
+			}
+
+			if mm_want_ptrs.sourceUserID != nil && !minimock.Equal(*mm_want_ptrs.sourceUserID, mm_got.sourceUserID) {
+				mmMerge.t.Errorf("RepositoryMock.Merge got unexpected parameter sourceUserID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMerge.MergeMock.defaultExpectation.expectationOrigins.originSourceUserID, *mm_want_ptrs.sourceUserID, mm_got.sourceUserID, minimock.Diff(*mm_want_ptrs.sourceUserID, mm_got.sourceUserID))
+			}
+
+			if mm_want_ptrs.source != nil && !minimock.Equal(*mm_want_ptrs.source, mm_got.source) {
+				mmMerge.t.Errorf("RepositoryMock.Merge got unexpected parameter source, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMerge.MergeMock.defaultExpectation.expectationOrigins.originSource, *mm_want_ptrs.source, mm_got.source, minimock.Diff(*mm_want_ptrs.source, mm_got.source))
+			}
+
+			if mm_want_ptrs.available != nil && !minimock.Equal(*mm_want_ptrs.available, mm_got.available) {
+				mmMerge.t.Errorf("RepositoryMock.Merge got unexpected parameter available, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMerge.MergeMock.defaultExpectation.expectationOrigins.originAvailable, *mm_want_ptrs.available, mm_got.available, minimock.Diff(*mm_want_ptrs.available, mm_got.available))
+			}
+
+			if mm_want_ptrs.limits != nil && !minimock.Equal(*mm_want_ptrs.limits, mm_got.limits) {
+				mmMerge.t.Errorf("RepositoryMock.Merge got unexpected parameter limits, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMerge.MergeMock.defaultExpectation.expectationOrigins.originLimits, *mm_want_ptrs.limits, mm_got.limits, minimock.Diff(*mm_want_ptrs.limits, mm_got.limits))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMerge.t.Errorf("RepositoryMock.Merge got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMerge.MergeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMerge.MergeMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMerge.t.Fatal("No results are set for the RepositoryMock.Merge")
+		}
+		return (*mm_results).ma1, (*mm_results).err
+	}
+	if mmMerge.funcMerge != nil {
+		return mmMerge.funcMerge(ctx, targetUserID, sourceUserID, source, available, limits)
+	}
+	mmMerge.t.Fatalf("Unexpected call to RepositoryMock.Merge. %v %v %v %v %v %v", ctx, targetUserID, sourceUserID, source, available, limits)
+	return
+}
+
+// MergeAfterCounter returns a count of finished RepositoryMock.Merge invocations
+func (mmMerge *RepositoryMock) MergeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMerge.afterMergeCounter)
+}
+
+// MergeBeforeCounter returns a count of RepositoryMock.Merge invocations
+func (mmMerge *RepositoryMock) MergeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMerge.beforeMergeCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.Merge.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMerge *mRepositoryMockMerge) Calls() []*RepositoryMockMergeParams {
+	mmMerge.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockMergeParams, len(mmMerge.callArgs))
+	copy(argCopy, mmMerge.callArgs)
+
+	mmMerge.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMergeDone returns true if the count of the Merge invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockMergeDone() bool {
+	if m.MergeMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MergeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MergeMock.invocationsDone()
+}
+
+// MinimockMergeInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockMergeInspect() {
+	for _, e := range m.MergeMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.Merge at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMergeCounter := mm_atomic.LoadUint64(&m.afterMergeCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MergeMock.defaultExpectation != nil && afterMergeCounter < 1 {
+		if m.MergeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.Merge at\n%s", m.MergeMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.Merge at\n%s with params: %#v", m.MergeMock.defaultExpectation.expectationOrigins.origin, *m.MergeMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMerge != nil && afterMergeCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.Merge at\n%s", m.funcMergeOrigin)
+	}
+
+	if !m.MergeMock.invocationsDone() && afterMergeCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.Merge at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MergeMock.expectedInvocations), m.MergeMock.expectedInvocationsOrigin, afterMergeCounter)
+	}
+}
+
+type mRepositoryMockMoveToCart struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockMoveToCartExpectation
+	expectations       []*RepositoryMockMoveToCartExpectation
+
+	callArgs []*RepositoryMockMoveToCartParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockMoveToCartExpectation specifies expectation struct of the Repository.MoveToCart
+type RepositoryMockMoveToCartExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockMoveToCartParams
+	paramPtrs          *RepositoryMockMoveToCartParamPtrs
+	expectationOrigins RepositoryMockMoveToCartExpectationOrigins
+	results            *RepositoryMockMoveToCartResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockMoveToCartParams contains parameters of the Repository.MoveToCart
+type RepositoryMockMoveToCartParams struct {
+	ctx  context.Context
+	item model.RequestData
+}
+
+// RepositoryMockMoveToCartParamPtrs contains pointers to parameters of the Repository.MoveToCart
+type RepositoryMockMoveToCartParamPtrs struct {
+	ctx  *context.Context
+	item *model.RequestData
+}
+
+// RepositoryMockMoveToCartResults contains results of the Repository.MoveToCart
+type RepositoryMockMoveToCartResults struct {
+	err error
+}
+
+// RepositoryMockMoveToCartOrigins contains origins of expectations of the Repository.MoveToCart
+type RepositoryMockMoveToCartExpectationOrigins struct {
+	origin     string
+	originCtx  string
+	originItem string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmMoveToCart *mRepositoryMockMoveToCart) Optional() *mRepositoryMockMoveToCart {
+	mmMoveToCart.optional = true
+	return mmMoveToCart
+}
+
+// Expect sets up expected params for Repository.MoveToCart
+func (mmMoveToCart *mRepositoryMockMoveToCart) Expect(ctx context.Context, item model.RequestData) *mRepositoryMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by ExpectParams functions")
+	}
+
+	mmMoveToCart.defaultExpectation.params = &RepositoryMockMoveToCartParams{ctx, item}
+	mmMoveToCart.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmMoveToCart.expectations {
+		if minimock.Equal(e.params, mmMoveToCart.defaultExpectation.params) {
+			mmMoveToCart.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmMoveToCart.defaultExpectation.params)
+		}
+	}
+
+	return mmMoveToCart
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.MoveToCart
+func (mmMoveToCart *mRepositoryMockMoveToCart) ExpectCtxParam1(ctx context.Context) *mRepositoryMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.ctx = &ctx
+	mmMoveToCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// ExpectItemParam2 sets up expected param item for Repository.MoveToCart
+func (mmMoveToCart *mRepositoryMockMoveToCart) ExpectItemParam2(item model.RequestData) *mRepositoryMockMoveToCart {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryMockMoveToCartExpectation{}
+	}
+
+	if mmMoveToCart.defaultExpectation.params != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by Expect")
+	}
+
+	if mmMoveToCart.defaultExpectation.paramPtrs == nil {
+		mmMoveToCart.defaultExpectation.paramPtrs = &RepositoryMockMoveToCartParamPtrs{}
+	}
+	mmMoveToCart.defaultExpectation.paramPtrs.item = &item
+	mmMoveToCart.defaultExpectation.expectationOrigins.originItem = minimock.CallerInfo(1)
+
+	return mmMoveToCart
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.MoveToCart
+func (mmMoveToCart *mRepositoryMockMoveToCart) Inspect(f func(ctx context.Context, item model.RequestData)) *mRepositoryMockMoveToCart {
+	if mmMoveToCart.mock.inspectFuncMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("Inspect function is already set for RepositoryMock.MoveToCart")
+	}
+
+	mmMoveToCart.mock.inspectFuncMoveToCart = f
+
+	return mmMoveToCart
+}
+
+// Return sets up results that will be returned by Repository.MoveToCart
+func (mmMoveToCart *mRepositoryMockMoveToCart) Return(err error) *RepositoryMock {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by Set")
+	}
+
+	if mmMoveToCart.defaultExpectation == nil {
+		mmMoveToCart.defaultExpectation = &RepositoryMockMoveToCartExpectation{mock: mmMoveToCart.mock}
+	}
+	mmMoveToCart.defaultExpectation.results = &RepositoryMockMoveToCartResults{err}
+	mmMoveToCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart.mock
+}
+
+// Set uses given function f to mock the Repository.MoveToCart method
+func (mmMoveToCart *mRepositoryMockMoveToCart) Set(f func(ctx context.Context, item model.RequestData) (err error)) *RepositoryMock {
+	if mmMoveToCart.defaultExpectation != nil {
+		mmMoveToCart.mock.t.Fatalf("Default expectation is already set for the Repository.MoveToCart method")
+	}
+
+	if len(mmMoveToCart.expectations) > 0 {
+		mmMoveToCart.mock.t.Fatalf("Some expectations are already set for the Repository.MoveToCart method")
+	}
+
+	mmMoveToCart.mock.funcMoveToCart = f
+	mmMoveToCart.mock.funcMoveToCartOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart.mock
+}
+
+// When sets expectation for the Repository.MoveToCart which will trigger the result defined by the following
+// Then helper
+func (mmMoveToCart *mRepositoryMockMoveToCart) When(ctx context.Context, item model.RequestData) *RepositoryMockMoveToCartExpectation {
+	if mmMoveToCart.mock.funcMoveToCart != nil {
+		mmMoveToCart.mock.t.Fatalf("RepositoryMock.MoveToCart mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockMoveToCartExpectation{
+		mock:               mmMoveToCart.mock,
+		params:             &RepositoryMockMoveToCartParams{ctx, item},
+		expectationOrigins: RepositoryMockMoveToCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmMoveToCart.expectations = append(mmMoveToCart.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.MoveToCart return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockMoveToCartExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockMoveToCartResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.MoveToCart should be invoked
+func (mmMoveToCart *mRepositoryMockMoveToCart) Times(n uint64) *mRepositoryMockMoveToCart {
+	if n == 0 {
+		mmMoveToCart.mock.t.Fatalf("Times of RepositoryMock.MoveToCart mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmMoveToCart.expectedInvocations, n)
+	mmMoveToCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmMoveToCart
+}
+
+func (mmMoveToCart *mRepositoryMockMoveToCart) invocationsDone() bool {
+	if len(mmMoveToCart.expectations) == 0 && mmMoveToCart.defaultExpectation == nil && mmMoveToCart.mock.funcMoveToCart == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmMoveToCart.mock.afterMoveToCartCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmMoveToCart.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// MoveToCart implements mm_service.Repository
+func (mmMoveToCart *RepositoryMock) MoveToCart(ctx context.Context, item model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmMoveToCart.beforeMoveToCartCounter, 1)
+	defer mm_atomic.AddUint64(&mmMoveToCart.afterMoveToCartCounter, 1)
+
+	mmMoveToCart.t.Helper()
+
+	if mmMoveToCart.inspectFuncMoveToCart != nil {
+		mmMoveToCart.inspectFuncMoveToCart(ctx, item)
+	}
+
+	mm_params := RepositoryMockMoveToCartParams{ctx, item}
+
+	// Record call args
+	mmMoveToCart.MoveToCartMock.mutex.Lock()
+	mmMoveToCart.MoveToCartMock.callArgs = append(mmMoveToCart.MoveToCartMock.callArgs, &mm_params)
+	mmMoveToCart.MoveToCartMock.mutex.Unlock()
+
+	for _, e := range mmMoveToCart.MoveToCartMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmMoveToCart.MoveToCartMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmMoveToCart.MoveToCartMock.defaultExpectation.Counter, 1)
+		mm_want := mmMoveToCart.MoveToCartMock.defaultExpectation.params
+		mm_want_ptrs := mmMoveToCart.MoveToCartMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockMoveToCartParams{ctx, item}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmMoveToCart.t.Errorf("RepositoryMock.MoveToCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.item != nil && !minimock.Equal(*mm_want_ptrs.item, mm_got.item) {
+				mmMoveToCart.t.Errorf("RepositoryMock.MoveToCart got unexpected parameter item, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.originItem, *mm_want_ptrs.item, mm_got.item, minimock.Diff(*mm_want_ptrs.item, mm_got.item))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmMoveToCart.t.Errorf("RepositoryMock.MoveToCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmMoveToCart.MoveToCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmMoveToCart.MoveToCartMock.defaultExpectation.results
+		if mm_results == nil {
+			mmMoveToCart.t.Fatal("No results are set for the RepositoryMock.MoveToCart")
+		}
+		return (*mm_results).err
+	}
+	if mmMoveToCart.funcMoveToCart != nil {
+		return mmMoveToCart.funcMoveToCart(ctx, item)
+	}
+	mmMoveToCart.t.Fatalf("Unexpected call to RepositoryMock.MoveToCart. %v %v", ctx, item)
+	return
+}
+
+// MoveToCartAfterCounter returns a count of finished RepositoryMock.MoveToCart invocations
+func (mmMoveToCart *RepositoryMock) MoveToCartAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToCart.afterMoveToCartCounter)
+}
+
+// MoveToCartBeforeCounter returns a count of RepositoryMock.MoveToCart invocations
+func (mmMoveToCart *RepositoryMock) MoveToCartBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmMoveToCart.beforeMoveToCartCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.MoveToCart.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmMoveToCart *mRepositoryMockMoveToCart) Calls() []*RepositoryMockMoveToCartParams {
+	mmMoveToCart.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockMoveToCartParams, len(mmMoveToCart.callArgs))
+	copy(argCopy, mmMoveToCart.callArgs)
+
+	mmMoveToCart.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockMoveToCartDone returns true if the count of the MoveToCart invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockMoveToCartDone() bool {
+	if m.MoveToCartMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.MoveToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.MoveToCartMock.invocationsDone()
+}
+
+// MinimockMoveToCartInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockMoveToCartInspect() {
+	for _, e := range m.MoveToCartMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.MoveToCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterMoveToCartCounter := mm_atomic.LoadUint64(&m.afterMoveToCartCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.MoveToCartMock.defaultExpectation != nil && afterMoveToCartCounter < 1 {
+		if m.MoveToCartMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.MoveToCart at\n%s", m.MoveToCartMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.MoveToCart at\n%s with params: %#v", m.MoveToCartMock.defaultExpectation.expectationOrigins.origin, *m.MoveToCartMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcMoveToCart != nil && afterMoveToCartCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.MoveToCart at\n%s", m.funcMoveToCartOrigin)
+	}
+
+	if !m.MoveToCartMock.invocationsDone() && afterMoveToCartCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.MoveToCart at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.MoveToCartMock.expectedInvocations), m.MoveToCartMock.expectedInvocationsOrigin, afterMoveToCartCounter)
+	}
+}
+
+type mRepositoryMockReleaseIdempotencyKey struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockReleaseIdempotencyKeyExpectation
+	expectations       []*RepositoryMockReleaseIdempotencyKeyExpectation
+
+	callArgs []*RepositoryMockReleaseIdempotencyKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockReleaseIdempotencyKeyExpectation specifies expectation struct of the Repository.ReleaseIdempotencyKey
+type RepositoryMockReleaseIdempotencyKeyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockReleaseIdempotencyKeyParams
+	paramPtrs          *RepositoryMockReleaseIdempotencyKeyParamPtrs
+	expectationOrigins RepositoryMockReleaseIdempotencyKeyExpectationOrigins
+	results            *RepositoryMockReleaseIdempotencyKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockReleaseIdempotencyKeyParams contains parameters of the Repository.ReleaseIdempotencyKey
+type RepositoryMockReleaseIdempotencyKeyParams struct {
+	ctx    context.Context
+	userID int64
+	key    string
+}
+
+// RepositoryMockReleaseIdempotencyKeyParamPtrs contains pointers to parameters of the Repository.ReleaseIdempotencyKey
+type RepositoryMockReleaseIdempotencyKeyParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	key    *string
+}
+
+// RepositoryMockReleaseIdempotencyKeyResults contains results of the Repository.ReleaseIdempotencyKey
+type RepositoryMockReleaseIdempotencyKeyResults struct {
+	err error
+}
+
+// RepositoryMockReleaseIdempotencyKeyOrigins contains origins of expectations of the Repository.ReleaseIdempotencyKey
+type RepositoryMockReleaseIdempotencyKeyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originKey    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) Optional() *mRepositoryMockReleaseIdempotencyKey {
+	mmReleaseIdempotencyKey.optional = true
+	return mmReleaseIdempotencyKey
+}
+
+// Expect sets up expected params for Repository.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) Expect(ctx context.Context, userID int64, key string) *mRepositoryMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by ExpectParams functions")
+	}
+
+	mmReleaseIdempotencyKey.defaultExpectation.params = &RepositoryMockReleaseIdempotencyKeyParams{ctx, userID, key}
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReleaseIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmReleaseIdempotencyKey.defaultExpectation.params) {
+			mmReleaseIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReleaseIdempotencyKey.defaultExpectation.params)
+		}
+	}
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mRepositoryMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) ExpectUserIDParam2(userID int64) *mRepositoryMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.userID = &userID
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// ExpectKeyParam3 sets up expected param key for Repository.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) ExpectKeyParam3(key string) *mRepositoryMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryMockReleaseIdempotencyKeyExpectation{}
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.params != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReleaseIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockReleaseIdempotencyKeyParamPtrs{}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmReleaseIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReleaseIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) Inspect(f func(ctx context.Context, userID int64, key string)) *mRepositoryMockReleaseIdempotencyKey {
+	if mmReleaseIdempotencyKey.mock.inspectFuncReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ReleaseIdempotencyKey")
+	}
+
+	mmReleaseIdempotencyKey.mock.inspectFuncReleaseIdempotencyKey = f
+
+	return mmReleaseIdempotencyKey
+}
+
+// Return sets up results that will be returned by Repository.ReleaseIdempotencyKey
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) Return(err error) *RepositoryMock {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReleaseIdempotencyKey.defaultExpectation == nil {
+		mmReleaseIdempotencyKey.defaultExpectation = &RepositoryMockReleaseIdempotencyKeyExpectation{mock: mmReleaseIdempotencyKey.mock}
+	}
+	mmReleaseIdempotencyKey.defaultExpectation.results = &RepositoryMockReleaseIdempotencyKeyResults{err}
+	mmReleaseIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the Repository.ReleaseIdempotencyKey method
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) Set(f func(ctx context.Context, userID int64, key string) (err error)) *RepositoryMock {
+	if mmReleaseIdempotencyKey.defaultExpectation != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the Repository.ReleaseIdempotencyKey method")
+	}
+
+	if len(mmReleaseIdempotencyKey.expectations) > 0 {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the Repository.ReleaseIdempotencyKey method")
+	}
+
+	mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey = f
+	mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey.mock
+}
+
+// When sets expectation for the Repository.ReleaseIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) When(ctx context.Context, userID int64, key string) *RepositoryMockReleaseIdempotencyKeyExpectation {
+	if mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReleaseIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockReleaseIdempotencyKeyExpectation{
+		mock:               mmReleaseIdempotencyKey.mock,
+		params:             &RepositoryMockReleaseIdempotencyKeyParams{ctx, userID, key},
+		expectationOrigins: RepositoryMockReleaseIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReleaseIdempotencyKey.expectations = append(mmReleaseIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ReleaseIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockReleaseIdempotencyKeyExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockReleaseIdempotencyKeyResults{err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ReleaseIdempotencyKey should be invoked
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) Times(n uint64) *mRepositoryMockReleaseIdempotencyKey {
+	if n == 0 {
+		mmReleaseIdempotencyKey.mock.t.Fatalf("Times of RepositoryMock.ReleaseIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReleaseIdempotencyKey.expectedInvocations, n)
+	mmReleaseIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReleaseIdempotencyKey
+}
+
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) invocationsDone() bool {
+	if len(mmReleaseIdempotencyKey.expectations) == 0 && mmReleaseIdempotencyKey.defaultExpectation == nil && mmReleaseIdempotencyKey.mock.funcReleaseIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.mock.afterReleaseIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReleaseIdempotencyKey implements mm_service.Repository
+func (mmReleaseIdempotencyKey *RepositoryMock) ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) (err error) {
+	mm_atomic.AddUint64(&mmReleaseIdempotencyKey.beforeReleaseIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmReleaseIdempotencyKey.afterReleaseIdempotencyKeyCounter, 1)
+
+	mmReleaseIdempotencyKey.t.Helper()
+
+	if mmReleaseIdempotencyKey.inspectFuncReleaseIdempotencyKey != nil {
+		mmReleaseIdempotencyKey.inspectFuncReleaseIdempotencyKey(ctx, userID, key)
+	}
+
+	mm_params := RepositoryMockReleaseIdempotencyKeyParams{ctx, userID, key}
+
+	// Record call args
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.mutex.Lock()
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.callArgs = append(mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.callArgs, &mm_params)
+	mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.err
+		}
+	}
+
+	if mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockReleaseIdempotencyKeyParams{ctx, userID, key}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReleaseIdempotencyKey.t.Errorf("RepositoryMock.ReleaseIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReleaseIdempotencyKey.t.Errorf("RepositoryMock.ReleaseIdempotencyKey got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReleaseIdempotencyKey.t.Errorf("RepositoryMock.ReleaseIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReleaseIdempotencyKey.t.Errorf("RepositoryMock.ReleaseIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReleaseIdempotencyKey.ReleaseIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReleaseIdempotencyKey.t.Fatal("No results are set for the RepositoryMock.ReleaseIdempotencyKey")
+		}
+		return (*mm_results).err
+	}
+	if mmReleaseIdempotencyKey.funcReleaseIdempotencyKey != nil {
+		return mmReleaseIdempotencyKey.funcReleaseIdempotencyKey(ctx, userID, key)
+	}
+	mmReleaseIdempotencyKey.t.Fatalf("Unexpected call to RepositoryMock.ReleaseIdempotencyKey. %v %v %v", ctx, userID, key)
+	return
+}
+
+// ReleaseIdempotencyKeyAfterCounter returns a count of finished RepositoryMock.ReleaseIdempotencyKey invocations
+func (mmReleaseIdempotencyKey *RepositoryMock) ReleaseIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.afterReleaseIdempotencyKeyCounter)
+}
+
+// ReleaseIdempotencyKeyBeforeCounter returns a count of RepositoryMock.ReleaseIdempotencyKey invocations
+func (mmReleaseIdempotencyKey *RepositoryMock) ReleaseIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReleaseIdempotencyKey.beforeReleaseIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ReleaseIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReleaseIdempotencyKey *mRepositoryMockReleaseIdempotencyKey) Calls() []*RepositoryMockReleaseIdempotencyKeyParams {
+	mmReleaseIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockReleaseIdempotencyKeyParams, len(mmReleaseIdempotencyKey.callArgs))
+	copy(argCopy, mmReleaseIdempotencyKey.callArgs)
+
+	mmReleaseIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReleaseIdempotencyKeyDone returns true if the count of the ReleaseIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockReleaseIdempotencyKeyDone() bool {
+	if m.ReleaseIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReleaseIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReleaseIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockReleaseIdempotencyKeyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockReleaseIdempotencyKeyInspect() {
+	for _, e := range m.ReleaseIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ReleaseIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReleaseIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterReleaseIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReleaseIdempotencyKeyMock.defaultExpectation != nil && afterReleaseIdempotencyKeyCounter < 1 {
+		if m.ReleaseIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ReleaseIdempotencyKey at\n%s", m.ReleaseIdempotencyKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ReleaseIdempotencyKey at\n%s with params: %#v", m.ReleaseIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.ReleaseIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReleaseIdempotencyKey != nil && afterReleaseIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ReleaseIdempotencyKey at\n%s", m.funcReleaseIdempotencyKeyOrigin)
+	}
+
+	if !m.ReleaseIdempotencyKeyMock.invocationsDone() && afterReleaseIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ReleaseIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReleaseIdempotencyKeyMock.expectedInvocations), m.ReleaseIdempotencyKeyMock.expectedInvocationsOrigin, afterReleaseIdempotencyKeyCounter)
+	}
+}
+
+type mRepositoryMockReserveIdempotencyKey struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockReserveIdempotencyKeyExpectation
+	expectations       []*RepositoryMockReserveIdempotencyKeyExpectation
+
+	callArgs []*RepositoryMockReserveIdempotencyKeyParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockReserveIdempotencyKeyExpectation specifies expectation struct of the Repository.ReserveIdempotencyKey
+type RepositoryMockReserveIdempotencyKeyExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockReserveIdempotencyKeyParams
+	paramPtrs          *RepositoryMockReserveIdempotencyKeyParamPtrs
+	expectationOrigins RepositoryMockReserveIdempotencyKeyExpectationOrigins
+	results            *RepositoryMockReserveIdempotencyKeyResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockReserveIdempotencyKeyParams contains parameters of the Repository.ReserveIdempotencyKey
+type RepositoryMockReserveIdempotencyKeyParams struct {
+	ctx    context.Context
+	userID int64
+	key    string
+	ttl    time.Duration
+}
+
+// RepositoryMockReserveIdempotencyKeyParamPtrs contains pointers to parameters of the Repository.ReserveIdempotencyKey
+type RepositoryMockReserveIdempotencyKeyParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	key    *string
+	ttl    *time.Duration
+}
+
+// RepositoryMockReserveIdempotencyKeyResults contains results of the Repository.ReserveIdempotencyKey
+type RepositoryMockReserveIdempotencyKeyResults struct {
+	i1  model.IdempotencyRecord
+	b1  bool
+	err error
+}
+
+// RepositoryMockReserveIdempotencyKeyOrigins contains origins of expectations of the Repository.ReserveIdempotencyKey
+type RepositoryMockReserveIdempotencyKeyExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originKey    string
+	originTtl    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) Optional() *mRepositoryMockReserveIdempotencyKey {
+	mmReserveIdempotencyKey.optional = true
+	return mmReserveIdempotencyKey
+}
+
+// Expect sets up expected params for Repository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) Expect(ctx context.Context, userID int64, key string, ttl time.Duration) *mRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &RepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by ExpectParams functions")
+	}
+
+	mmReserveIdempotencyKey.defaultExpectation.params = &RepositoryMockReserveIdempotencyKeyParams{ctx, userID, key, ttl}
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmReserveIdempotencyKey.expectations {
+		if minimock.Equal(e.params, mmReserveIdempotencyKey.defaultExpectation.params) {
+			mmReserveIdempotencyKey.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmReserveIdempotencyKey.defaultExpectation.params)
+		}
+	}
+
+	return mmReserveIdempotencyKey
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) ExpectCtxParam1(ctx context.Context) *mRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &RepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.params != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReserveIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockReserveIdempotencyKeyParamPtrs{}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.paramPtrs.ctx = &ctx
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+
+	return mmReserveIdempotencyKey
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) ExpectUserIDParam2(userID int64) *mRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &RepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.params != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReserveIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockReserveIdempotencyKeyParamPtrs{}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.paramPtrs.userID = &userID
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmReserveIdempotencyKey
+}
+
+// ExpectKeyParam3 sets up expected param key for Repository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) ExpectKeyParam3(key string) *mRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &RepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.params != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReserveIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockReserveIdempotencyKeyParamPtrs{}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.paramPtrs.key = &key
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.originKey = minimock.CallerInfo(1)
+
+	return mmReserveIdempotencyKey
+}
+
+// ExpectTtlParam4 sets up expected param ttl for Repository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) ExpectTtlParam4(ttl time.Duration) *mRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &RepositoryMockReserveIdempotencyKeyExpectation{}
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.params != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Expect")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation.paramPtrs == nil {
+		mmReserveIdempotencyKey.defaultExpectation.paramPtrs = &RepositoryMockReserveIdempotencyKeyParamPtrs{}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.paramPtrs.ttl = &ttl
+	mmReserveIdempotencyKey.defaultExpectation.expectationOrigins.originTtl = minimock.CallerInfo(1)
+
+	return mmReserveIdempotencyKey
+}
+
+// Inspect accepts an inspector function that has same arguments as the Repository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) Inspect(f func(ctx context.Context, userID int64, key string, ttl time.Duration)) *mRepositoryMockReserveIdempotencyKey {
+	if mmReserveIdempotencyKey.mock.inspectFuncReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Inspect function is already set for RepositoryMock.ReserveIdempotencyKey")
+	}
+
+	mmReserveIdempotencyKey.mock.inspectFuncReserveIdempotencyKey = f
+
+	return mmReserveIdempotencyKey
+}
+
+// Return sets up results that will be returned by Repository.ReserveIdempotencyKey
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) Return(i1 model.IdempotencyRecord, b1 bool, err error) *RepositoryMock {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	if mmReserveIdempotencyKey.defaultExpectation == nil {
+		mmReserveIdempotencyKey.defaultExpectation = &RepositoryMockReserveIdempotencyKeyExpectation{mock: mmReserveIdempotencyKey.mock}
+	}
+	mmReserveIdempotencyKey.defaultExpectation.results = &RepositoryMockReserveIdempotencyKeyResults{i1, b1, err}
+	mmReserveIdempotencyKey.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmReserveIdempotencyKey.mock
+}
+
+// Set uses given function f to mock the Repository.ReserveIdempotencyKey method
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) Set(f func(ctx context.Context, userID int64, key string, ttl time.Duration) (i1 model.IdempotencyRecord, b1 bool, err error)) *RepositoryMock {
+	if mmReserveIdempotencyKey.defaultExpectation != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Default expectation is already set for the Repository.ReserveIdempotencyKey method")
+	}
+
+	if len(mmReserveIdempotencyKey.expectations) > 0 {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Some expectations are already set for the Repository.ReserveIdempotencyKey method")
+	}
+
+	mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey = f
+	mmReserveIdempotencyKey.mock.funcReserveIdempotencyKeyOrigin = minimock.CallerInfo(1)
+	return mmReserveIdempotencyKey.mock
+}
+
+// When sets expectation for the Repository.ReserveIdempotencyKey which will trigger the result defined by the following
+// Then helper
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) When(ctx context.Context, userID int64, key string, ttl time.Duration) *RepositoryMockReserveIdempotencyKeyExpectation {
+	if mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.mock.t.Fatalf("RepositoryMock.ReserveIdempotencyKey mock is already set by Set")
+	}
+
+	expectation := &RepositoryMockReserveIdempotencyKeyExpectation{
+		mock:               mmReserveIdempotencyKey.mock,
+		params:             &RepositoryMockReserveIdempotencyKeyParams{ctx, userID, key, ttl},
+		expectationOrigins: RepositoryMockReserveIdempotencyKeyExpectationOrigins{origin: minimock.CallerInfo(1)},
+	}
+	mmReserveIdempotencyKey.expectations = append(mmReserveIdempotencyKey.expectations, expectation)
+	return expectation
+}
+
+// Then sets up Repository.ReserveIdempotencyKey return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockReserveIdempotencyKeyExpectation) Then(i1 model.IdempotencyRecord, b1 bool, err error) *RepositoryMock {
+	e.results = &RepositoryMockReserveIdempotencyKeyResults{i1, b1, err}
+	return e.mock
+}
+
+// Times sets number of times Repository.ReserveIdempotencyKey should be invoked
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) Times(n uint64) *mRepositoryMockReserveIdempotencyKey {
+	if n == 0 {
+		mmReserveIdempotencyKey.mock.t.Fatalf("Times of RepositoryMock.ReserveIdempotencyKey mock can not be zero")
+	}
+	mm_atomic.StoreUint64(&mmReserveIdempotencyKey.expectedInvocations, n)
+	mmReserveIdempotencyKey.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmReserveIdempotencyKey
+}
+
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) invocationsDone() bool {
+	if len(mmReserveIdempotencyKey.expectations) == 0 && mmReserveIdempotencyKey.defaultExpectation == nil && mmReserveIdempotencyKey.mock.funcReserveIdempotencyKey == nil {
+		return true
+	}
+
+	totalInvocations := mm_atomic.LoadUint64(&mmReserveIdempotencyKey.mock.afterReserveIdempotencyKeyCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmReserveIdempotencyKey.expectedInvocations)
+
+	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
+}
+
+// ReserveIdempotencyKey implements mm_service.Repository
+func (mmReserveIdempotencyKey *RepositoryMock) ReserveIdempotencyKey(ctx context.Context, userID int64, key string, ttl time.Duration) (i1 model.IdempotencyRecord, b1 bool, err error) {
+	mm_atomic.AddUint64(&mmReserveIdempotencyKey.beforeReserveIdempotencyKeyCounter, 1)
+	defer mm_atomic.AddUint64(&mmReserveIdempotencyKey.afterReserveIdempotencyKeyCounter, 1)
+
+	mmReserveIdempotencyKey.t.Helper()
+
+	if mmReserveIdempotencyKey.inspectFuncReserveIdempotencyKey != nil {
+		mmReserveIdempotencyKey.inspectFuncReserveIdempotencyKey(ctx, userID, key, ttl)
+	}
+
+	mm_params := RepositoryMockReserveIdempotencyKeyParams{ctx, userID, key, ttl}
+
+	// Record call args
+	mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.mutex.Lock()
+	mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.callArgs = append(mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.callArgs, &mm_params)
+	mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.mutex.Unlock()
+
+	for _, e := range mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.expectations {
+		if minimock.Equal(*e.params, mm_params) {
+			mm_atomic.AddUint64(&e.Counter, 1)
+			return e.results.i1, e.results.b1, e.results.err
+		}
+	}
+
+	if mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.Counter, 1)
+		mm_want := mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.params
+		mm_want_ptrs := mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.paramPtrs
+
+		mm_got := RepositoryMockReserveIdempotencyKeyParams{ctx, userID, key, ttl}
+
+		if mm_want_ptrs != nil {
+
+			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
+				mmReserveIdempotencyKey.t.Errorf("RepositoryMock.ReserveIdempotencyKey got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+			}
+
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmReserveIdempotencyKey.t.Errorf("RepositoryMock.ReserveIdempotencyKey got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.key != nil && !minimock.Equal(*mm_want_ptrs.key, mm_got.key) {
+				mmReserveIdempotencyKey.t.Errorf("RepositoryMock.ReserveIdempotencyKey got unexpected parameter key, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.originKey, *mm_want_ptrs.key, mm_got.key, minimock.Diff(*mm_want_ptrs.key, mm_got.key))
+			}
+
+			if mm_want_ptrs.ttl != nil && !minimock.Equal(*mm_want_ptrs.ttl, mm_got.ttl) {
+				mmReserveIdempotencyKey.t.Errorf("RepositoryMock.ReserveIdempotencyKey got unexpected parameter ttl, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.originTtl, *mm_want_ptrs.ttl, mm_got.ttl, minimock.Diff(*mm_want_ptrs.ttl, mm_got.ttl))
+			}
+
+		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
+			mmReserveIdempotencyKey.t.Errorf("RepositoryMock.ReserveIdempotencyKey got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+		}
+
+		mm_results := mmReserveIdempotencyKey.ReserveIdempotencyKeyMock.defaultExpectation.results
+		if mm_results == nil {
+			mmReserveIdempotencyKey.t.Fatal("No results are set for the RepositoryMock.ReserveIdempotencyKey")
+		}
+		return (*mm_results).i1, (*mm_results).b1, (*mm_results).err
+	}
+	if mmReserveIdempotencyKey.funcReserveIdempotencyKey != nil {
+		return mmReserveIdempotencyKey.funcReserveIdempotencyKey(ctx, userID, key, ttl)
+	}
+	mmReserveIdempotencyKey.t.Fatalf("Unexpected call to RepositoryMock.ReserveIdempotencyKey. %v %v %v %v", ctx, userID, key, ttl)
+	return
+}
+
+// ReserveIdempotencyKeyAfterCounter returns a count of finished RepositoryMock.ReserveIdempotencyKey invocations
+func (mmReserveIdempotencyKey *RepositoryMock) ReserveIdempotencyKeyAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveIdempotencyKey.afterReserveIdempotencyKeyCounter)
+}
+
+// ReserveIdempotencyKeyBeforeCounter returns a count of RepositoryMock.ReserveIdempotencyKey invocations
+func (mmReserveIdempotencyKey *RepositoryMock) ReserveIdempotencyKeyBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmReserveIdempotencyKey.beforeReserveIdempotencyKeyCounter)
+}
+
+// Calls returns a list of arguments used in each call to RepositoryMock.ReserveIdempotencyKey.
+// The list is in the same order as the calls were made (i.e. recent calls have a higher index)
+func (mmReserveIdempotencyKey *mRepositoryMockReserveIdempotencyKey) Calls() []*RepositoryMockReserveIdempotencyKeyParams {
+	mmReserveIdempotencyKey.mutex.RLock()
+
+	argCopy := make([]*RepositoryMockReserveIdempotencyKeyParams, len(mmReserveIdempotencyKey.callArgs))
+	copy(argCopy, mmReserveIdempotencyKey.callArgs)
+
+	mmReserveIdempotencyKey.mutex.RUnlock()
+
+	return argCopy
+}
+
+// MinimockReserveIdempotencyKeyDone returns true if the count of the ReserveIdempotencyKey invocations corresponds
+// the number of defined expectations
+func (m *RepositoryMock) MinimockReserveIdempotencyKeyDone() bool {
+	if m.ReserveIdempotencyKeyMock.optional {
+		// Optional methods provide '0 or more' call count restriction.
+		return true
+	}
+
+	for _, e := range m.ReserveIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			return false
+		}
+	}
+
+	return m.ReserveIdempotencyKeyMock.invocationsDone()
+}
+
+// MinimockReserveIdempotencyKeyInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockReserveIdempotencyKeyInspect() {
+	for _, e := range m.ReserveIdempotencyKeyMock.expectations {
+		if mm_atomic.LoadUint64(&e.Counter) < 1 {
+			m.t.Errorf("Expected call to RepositoryMock.ReserveIdempotencyKey at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+		}
+	}
+
+	afterReserveIdempotencyKeyCounter := mm_atomic.LoadUint64(&m.afterReserveIdempotencyKeyCounter)
+	// if default expectation was set then invocations count should be greater than zero
+	if m.ReserveIdempotencyKeyMock.defaultExpectation != nil && afterReserveIdempotencyKeyCounter < 1 {
+		if m.ReserveIdempotencyKeyMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.ReserveIdempotencyKey at\n%s", m.ReserveIdempotencyKeyMock.defaultExpectation.returnOrigin)
+		} else {
+			m.t.Errorf("Expected call to RepositoryMock.ReserveIdempotencyKey at\n%s with params: %#v", m.ReserveIdempotencyKeyMock.defaultExpectation.expectationOrigins.origin, *m.ReserveIdempotencyKeyMock.defaultExpectation.params)
+		}
+	}
+	// if func was set then invocations count should be greater than zero
+	if m.funcReserveIdempotencyKey != nil && afterReserveIdempotencyKeyCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.ReserveIdempotencyKey at\n%s", m.funcReserveIdempotencyKeyOrigin)
+	}
+
+	if !m.ReserveIdempotencyKeyMock.invocationsDone() && afterReserveIdempotencyKeyCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.ReserveIdempotencyKey at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.ReserveIdempotencyKeyMock.expectedInvocations), m.ReserveIdempotencyKeyMock.expectedInvocationsOrigin, afterReserveIdempotencyKeyCounter)
+	}
+}
+
+type mRepositoryMockSaveForLater struct {
+	optional           bool
+	mock               *RepositoryMock
+	defaultExpectation *RepositoryMockSaveForLaterExpectation
+	expectations       []*RepositoryMockSaveForLaterExpectation
+
+	callArgs []*RepositoryMockSaveForLaterParams
+	mutex    sync.RWMutex
+
+	expectedInvocations       uint64
+	expectedInvocationsOrigin string
+}
+
+// RepositoryMockSaveForLaterExpectation specifies expectation struct of the Repository.SaveForLater
+type RepositoryMockSaveForLaterExpectation struct {
+	mock               *RepositoryMock
+	params             *RepositoryMockSaveForLaterParams
+	paramPtrs          *RepositoryMockSaveForLaterParamPtrs
+	expectationOrigins RepositoryMockSaveForLaterExpectationOrigins
+	results            *RepositoryMockSaveForLaterResults
+	returnOrigin       string
+	Counter            uint64
+}
+
+// RepositoryMockSaveForLaterParams contains parameters of the Repository.SaveForLater
+type RepositoryMockSaveForLaterParams struct {
+	ctx    context.Context
+	userID int64
+	sku    int64
+}
+
+// RepositoryMockSaveForLaterParamPtrs contains pointers to parameters of the Repository.SaveForLater
+type RepositoryMockSaveForLaterParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	sku    *int64
+}
+
+// RepositoryMockSaveForLaterResults contains results of the Repository.SaveForLater
+type RepositoryMockSaveForLaterResults struct {
+	err error
+}
+
+// RepositoryMockSaveForLaterOrigins contains origins of expectations of the Repository.SaveForLater
+type RepositoryMockSaveForLaterExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originSku    string
+}
+
+// Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
+// the test will fail minimock's automatic final call check if the mocked method was not called at least once.
+// Optional() makes method check to work in '0 or more' mode.
+// It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
+// catch the problems when the expected method call is totally skipped during test run.
+func (mmSaveForLater *mRepositoryMockSaveForLater) Optional() *mRepositoryMockSaveForLater {
+	mmSaveForLater.optional = true
+	return mmSaveForLater
+}
+
+// Expect sets up expected params for Repository.SaveForLater
+func (mmSaveForLater *mRepositoryMockSaveForLater) Expect(ctx context.Context, userID int64, sku int64) *mRepositoryMockSaveForLater {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Set")
+	}
+
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &RepositoryMockSaveForLaterExpectation{}
+	}
+
+	if mmSaveForLater.defaultExpectation.paramPtrs != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by ExpectParams functions")
+	}
+
+	mmSaveForLater.defaultExpectation.params = &RepositoryMockSaveForLaterParams{ctx, userID, sku}
+	mmSaveForLater.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSaveForLater.expectations {
+		if minimock.Equal(e.params, mmSaveForLater.defaultExpectation.params) {
+			mmSaveForLater.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSaveForLater.defaultExpectation.params)
+		}
+	}
+
+	return mmSaveForLater
+}
+
+// ExpectCtxParam1 sets up expected param ctx for Repository.SaveForLater
+func (mmSaveForLater *mRepositoryMockSaveForLater) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSaveForLater {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Set")
+	}
+
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &RepositoryMockSaveForLaterExpectation{}
+	}
+
+	if mmSaveForLater.defaultExpectation.params != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Expect")
+	}
+
+	if mmSaveForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveForLater.defaultExpectation.paramPtrs = &RepositoryMockSaveForLaterParamPtrs{}
 	}
+	mmSaveForLater.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSaveForLater.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	if mmDeleteAllItemsFromCart.defaultExpectation.params != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Expect")
+	return mmSaveForLater
+}
+
+// ExpectUserIDParam2 sets up expected param userID for Repository.SaveForLater
+func (mmSaveForLater *mRepositoryMockSaveForLater) ExpectUserIDParam2(userID int64) *mRepositoryMockSaveForLater {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Set")
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs == nil {
-		mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs = &RepositoryMockDeleteAllItemsFromCartParamPtrs{}
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &RepositoryMockSaveForLaterExpectation{}
 	}
-	mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteAllItemsFromCart.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteAllItemsFromCart
+	if mmSaveForLater.defaultExpectation.params != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Expect")
+	}
+
+	if mmSaveForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveForLater.defaultExpectation.paramPtrs = &RepositoryMockSaveForLaterParamPtrs{}
+	}
+	mmSaveForLater.defaultExpectation.paramPtrs.userID = &userID
+	mmSaveForLater.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
+
+	return mmSaveForLater
 }
 
-// ExpectCartItemsParam2 sets up expected param cartItems for Repository.DeleteAllItemsFromCart
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) ExpectCartItemsParam2(cartItems model.RequestData) *mRepositoryMockDeleteAllItemsFromCart {
-	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+// ExpectSkuParam3 sets up expected param sku for Repository.SaveForLater
+func (mmSaveForLater *mRepositoryMockSaveForLater) ExpectSkuParam3(sku int64) *mRepositoryMockSaveForLater {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Set")
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
-		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{}
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &RepositoryMockSaveForLaterExpectation{}
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation.params != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Expect")
+	if mmSaveForLater.defaultExpectation.params != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Expect")
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs == nil {
-		mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs = &RepositoryMockDeleteAllItemsFromCartParamPtrs{}
+	if mmSaveForLater.defaultExpectation.paramPtrs == nil {
+		mmSaveForLater.defaultExpectation.paramPtrs = &RepositoryMockSaveForLaterParamPtrs{}
 	}
-	mmDeleteAllItemsFromCart.defaultExpectation.paramPtrs.cartItems = &cartItems
-	mmDeleteAllItemsFromCart.defaultExpectation.expectationOrigins.originCartItems = minimock.CallerInfo(1)
+	mmSaveForLater.defaultExpectation.paramPtrs.sku = &sku
+	mmSaveForLater.defaultExpectation.expectationOrigins.originSku = minimock.CallerInfo(1)
 
-	return mmDeleteAllItemsFromCart
+	return mmSaveForLater
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.DeleteAllItemsFromCart
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Inspect(f func(ctx context.Context, cartItems model.RequestData)) *mRepositoryMockDeleteAllItemsFromCart {
-	if mmDeleteAllItemsFromCart.mock.inspectFuncDeleteAllItemsFromCart != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteAllItemsFromCart")
+// Inspect accepts an inspector function that has same arguments as the Repository.SaveForLater
+func (mmSaveForLater *mRepositoryMockSaveForLater) Inspect(f func(ctx context.Context, userID int64, sku int64)) *mRepositoryMockSaveForLater {
+	if mmSaveForLater.mock.inspectFuncSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SaveForLater")
 	}
 
-	mmDeleteAllItemsFromCart.mock.inspectFuncDeleteAllItemsFromCart = f
+	mmSaveForLater.mock.inspectFuncSaveForLater = f
 
-	return mmDeleteAllItemsFromCart
+	return mmSaveForLater
 }
 
-// Return sets up results that will be returned by Repository.DeleteAllItemsFromCart
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Return(err error) *RepositoryMock {
-	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+// Return sets up results that will be returned by Repository.SaveForLater
+func (mmSaveForLater *mRepositoryMockSaveForLater) Return(err error) *RepositoryMock {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Set")
 	}
 
-	if mmDeleteAllItemsFromCart.defaultExpectation == nil {
-		mmDeleteAllItemsFromCart.defaultExpectation = &RepositoryMockDeleteAllItemsFromCartExpectation{mock: mmDeleteAllItemsFromCart.mock}
+	if mmSaveForLater.defaultExpectation == nil {
+		mmSaveForLater.defaultExpectation = &RepositoryMockSaveForLaterExpectation{mock: mmSaveForLater.mock}
 	}
-	mmDeleteAllItemsFromCart.defaultExpectation.results = &RepositoryMockDeleteAllItemsFromCartResults{err}
-	mmDeleteAllItemsFromCart.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteAllItemsFromCart.mock
+	mmSaveForLater.defaultExpectation.results = &RepositoryMockSaveForLaterResults{err}
+	mmSaveForLater.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSaveForLater.mock
 }
 
-// Set uses given function f to mock the Repository.DeleteAllItemsFromCart method
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Set(f func(ctx context.Context, cartItems model.RequestData) (err error)) *RepositoryMock {
-	if mmDeleteAllItemsFromCart.defaultExpectation != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteAllItemsFromCart method")
+// Set uses given function f to mock the Repository.SaveForLater method
+func (mmSaveForLater *mRepositoryMockSaveForLater) Set(f func(ctx context.Context, userID int64, sku int64) (err error)) *RepositoryMock {
+	if mmSaveForLater.defaultExpectation != nil {
+		mmSaveForLater.mock.t.Fatalf("Default expectation is already set for the Repository.SaveForLater method")
 	}
 
-	if len(mmDeleteAllItemsFromCart.expectations) > 0 {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteAllItemsFromCart method")
+	if len(mmSaveForLater.expectations) > 0 {
+		mmSaveForLater.mock.t.Fatalf("Some expectations are already set for the Repository.SaveForLater method")
 	}
 
-	mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart = f
-	mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCartOrigin = minimock.CallerInfo(1)
-	return mmDeleteAllItemsFromCart.mock
+	mmSaveForLater.mock.funcSaveForLater = f
+	mmSaveForLater.mock.funcSaveForLaterOrigin = minimock.CallerInfo(1)
+	return mmSaveForLater.mock
 }
 
-// When sets expectation for the Repository.DeleteAllItemsFromCart which will trigger the result defined by the following
+// When sets expectation for the Repository.SaveForLater which will trigger the result defined by the following
 // Then helper
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) When(ctx context.Context, cartItems model.RequestData) *RepositoryMockDeleteAllItemsFromCartExpectation {
-	if mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart != nil {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("RepositoryMock.DeleteAllItemsFromCart mock is already set by Set")
+func (mmSaveForLater *mRepositoryMockSaveForLater) When(ctx context.Context, userID int64, sku int64) *RepositoryMockSaveForLaterExpectation {
+	if mmSaveForLater.mock.funcSaveForLater != nil {
+		mmSaveForLater.mock.t.Fatalf("RepositoryMock.SaveForLater mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockDeleteAllItemsFromCartExpectation{
-		mock:               mmDeleteAllItemsFromCart.mock,
-		params:             &RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems},
-		expectationOrigins: RepositoryMockDeleteAllItemsFromCartExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockSaveForLaterExpectation{
+		mock:               mmSaveForLater.mock,
+		params:             &RepositoryMockSaveForLaterParams{ctx, userID, sku},
+		expectationOrigins: RepositoryMockSaveForLaterExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteAllItemsFromCart.expectations = append(mmDeleteAllItemsFromCart.expectations, expectation)
+	mmSaveForLater.expectations = append(mmSaveForLater.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.DeleteAllItemsFromCart return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockDeleteAllItemsFromCartExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockDeleteAllItemsFromCartResults{err}
+// Then sets up Repository.SaveForLater return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSaveForLaterExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSaveForLaterResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.DeleteAllItemsFromCart should be invoked
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Times(n uint64) *mRepositoryMockDeleteAllItemsFromCart {
+// Times sets number of times Repository.SaveForLater should be invoked
+func (mmSaveForLater *mRepositoryMockSaveForLater) Times(n uint64) *mRepositoryMockSaveForLater {
 	if n == 0 {
-		mmDeleteAllItemsFromCart.mock.t.Fatalf("Times of RepositoryMock.DeleteAllItemsFromCart mock can not be zero")
+		mmSaveForLater.mock.t.Fatalf("Times of RepositoryMock.SaveForLater mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteAllItemsFromCart.expectedInvocations, n)
-	mmDeleteAllItemsFromCart.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteAllItemsFromCart
+	mm_atomic.StoreUint64(&mmSaveForLater.expectedInvocations, n)
+	mmSaveForLater.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSaveForLater
 }
 
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) invocationsDone() bool {
-	if len(mmDeleteAllItemsFromCart.expectations) == 0 && mmDeleteAllItemsFromCart.defaultExpectation == nil && mmDeleteAllItemsFromCart.mock.funcDeleteAllItemsFromCart == nil {
+func (mmSaveForLater *mRepositoryMockSaveForLater) invocationsDone() bool {
+	if len(mmSaveForLater.expectations) == 0 && mmSaveForLater.defaultExpectation == nil && mmSaveForLater.mock.funcSaveForLater == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.mock.afterDeleteAllItemsFromCartCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSaveForLater.mock.afterSaveForLaterCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSaveForLater.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteAllItemsFromCart implements mm_service.Repository
-func (mmDeleteAllItemsFromCart *RepositoryMock) DeleteAllItemsFromCart(ctx context.Context, cartItems model.RequestData) (err error) {
-	mm_atomic.AddUint64(&mmDeleteAllItemsFromCart.beforeDeleteAllItemsFromCartCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteAllItemsFromCart.afterDeleteAllItemsFromCartCounter, 1)
+// SaveForLater implements mm_service.Repository
+func (mmSaveForLater *RepositoryMock) SaveForLater(ctx context.Context, userID int64, sku int64) (err error) {
+	mm_atomic.AddUint64(&mmSaveForLater.beforeSaveForLaterCounter, 1)
+	defer mm_atomic.AddUint64(&mmSaveForLater.afterSaveForLaterCounter, 1)
 
-	mmDeleteAllItemsFromCart.t.Helper()
+	mmSaveForLater.t.Helper()
 
-	if mmDeleteAllItemsFromCart.inspectFuncDeleteAllItemsFromCart != nil {
-		mmDeleteAllItemsFromCart.inspectFuncDeleteAllItemsFromCart(ctx, cartItems)
+	if mmSaveForLater.inspectFuncSaveForLater != nil {
+		mmSaveForLater.inspectFuncSaveForLater(ctx, userID, sku)
 	}
 
-	mm_params := RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems}
+	mm_params := RepositoryMockSaveForLaterParams{ctx, userID, sku}
 
 	// Record call args
-	mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.mutex.Lock()
-	mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.callArgs = append(mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.callArgs, &mm_params)
-	mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.mutex.Unlock()
+	mmSaveForLater.SaveForLaterMock.mutex.Lock()
+	mmSaveForLater.SaveForLaterMock.callArgs = append(mmSaveForLater.SaveForLaterMock.callArgs, &mm_params)
+	mmSaveForLater.SaveForLaterMock.mutex.Unlock()
 
-	for _, e := range mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.expectations {
+	for _, e := range mmSaveForLater.SaveForLaterMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.paramPtrs
+	if mmSaveForLater.SaveForLaterMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSaveForLater.SaveForLaterMock.defaultExpectation.Counter, 1)
+		mm_want := mmSaveForLater.SaveForLaterMock.defaultExpectation.params
+		mm_want_ptrs := mmSaveForLater.SaveForLaterMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockDeleteAllItemsFromCartParams{ctx, cartItems}
+		mm_got := RepositoryMockSaveForLaterParams{ctx, userID, sku}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteAllItemsFromCart.t.Errorf("RepositoryMock.DeleteAllItemsFromCart got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSaveForLater.t.Errorf("RepositoryMock.SaveForLater got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveForLater.SaveForLaterMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.cartItems != nil && !minimock.Equal(*mm_want_ptrs.cartItems, mm_got.cartItems) {
-				mmDeleteAllItemsFromCart.t.Errorf("RepositoryMock.DeleteAllItemsFromCart got unexpected parameter cartItems, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.originCartItems, *mm_want_ptrs.cartItems, mm_got.cartItems, minimock.Diff(*mm_want_ptrs.cartItems, mm_got.cartItems))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSaveForLater.t.Errorf("RepositoryMock.SaveForLater got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveForLater.SaveForLaterMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.sku != nil && !minimock.Equal(*mm_want_ptrs.sku, mm_got.sku) {
+				mmSaveForLater.t.Errorf("RepositoryMock.SaveForLater got unexpected parameter sku, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSaveForLater.SaveForLaterMock.defaultExpectation.expectationOrigins.originSku, *mm_want_ptrs.sku, mm_got.sku, minimock.Diff(*mm_want_ptrs.sku, mm_got.sku))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteAllItemsFromCart.t.Errorf("RepositoryMock.DeleteAllItemsFromCart got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSaveForLater.t.Errorf("RepositoryMock.SaveForLater got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSaveForLater.SaveForLaterMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteAllItemsFromCart.DeleteAllItemsFromCartMock.defaultExpectation.results
+		mm_results := mmSaveForLater.SaveForLaterMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteAllItemsFromCart.t.Fatal("No results are set for the RepositoryMock.DeleteAllItemsFromCart")
+			mmSaveForLater.t.Fatal("No results are set for the RepositoryMock.SaveForLater")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteAllItemsFromCart.funcDeleteAllItemsFromCart != nil {
-		return mmDeleteAllItemsFromCart.funcDeleteAllItemsFromCart(ctx, cartItems)
+	if mmSaveForLater.funcSaveForLater != nil {
+		return mmSaveForLater.funcSaveForLater(ctx, userID, sku)
 	}
-	mmDeleteAllItemsFromCart.t.Fatalf("Unexpected call to RepositoryMock.DeleteAllItemsFromCart. %v %v", ctx, cartItems)
+	mmSaveForLater.t.Fatalf("Unexpected call to RepositoryMock.SaveForLater. %v %v %v", ctx, userID, sku)
 	return
 }
 
-// DeleteAllItemsFromCartAfterCounter returns a count of finished RepositoryMock.DeleteAllItemsFromCart invocations
-func (mmDeleteAllItemsFromCart *RepositoryMock) DeleteAllItemsFromCartAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.afterDeleteAllItemsFromCartCounter)
+// SaveForLaterAfterCounter returns a count of finished RepositoryMock.SaveForLater invocations
+func (mmSaveForLater *RepositoryMock) SaveForLaterAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveForLater.afterSaveForLaterCounter)
 }
 
-// DeleteAllItemsFromCartBeforeCounter returns a count of RepositoryMock.DeleteAllItemsFromCart invocations
-func (mmDeleteAllItemsFromCart *RepositoryMock) DeleteAllItemsFromCartBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteAllItemsFromCart.beforeDeleteAllItemsFromCartCounter)
+// SaveForLaterBeforeCounter returns a count of RepositoryMock.SaveForLater invocations
+func (mmSaveForLater *RepositoryMock) SaveForLaterBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSaveForLater.beforeSaveForLaterCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.DeleteAllItemsFromCart.
+// Calls returns a list of arguments used in each call to RepositoryMock.SaveForLater.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteAllItemsFromCart *mRepositoryMockDeleteAllItemsFromCart) Calls() []*RepositoryMockDeleteAllItemsFromCartParams {
-	mmDeleteAllItemsFromCart.mutex.RLock()
+func (mmSaveForLater *mRepositoryMockSaveForLater) Calls() []*RepositoryMockSaveForLaterParams {
+	mmSaveForLater.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockDeleteAllItemsFromCartParams, len(mmDeleteAllItemsFromCart.callArgs))
-	copy(argCopy, mmDeleteAllItemsFromCart.callArgs)
+	argCopy := make([]*RepositoryMockSaveForLaterParams, len(mmSaveForLater.callArgs))
+	copy(argCopy, mmSaveForLater.callArgs)
 
-	mmDeleteAllItemsFromCart.mutex.RUnlock()
+	mmSaveForLater.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteAllItemsFromCartDone returns true if the count of the DeleteAllItemsFromCart invocations corresponds
+// MinimockSaveForLaterDone returns true if the count of the SaveForLater invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockDeleteAllItemsFromCartDone() bool {
-	if m.DeleteAllItemsFromCartMock.optional {
+func (m *RepositoryMock) MinimockSaveForLaterDone() bool {
+	if m.SaveForLaterMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteAllItemsFromCartMock.expectations {
+	for _, e := range m.SaveForLaterMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteAllItemsFromCartMock.invocationsDone()
+	return m.SaveForLaterMock.invocationsDone()
 }
 
-// MinimockDeleteAllItemsFromCartInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockDeleteAllItemsFromCartInspect() {
-	for _, e := range m.DeleteAllItemsFromCartMock.expectations {
+// MinimockSaveForLaterInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSaveForLaterInspect() {
+	for _, e := range m.SaveForLaterMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.SaveForLater at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteAllItemsFromCartCounter := mm_atomic.LoadUint64(&m.afterDeleteAllItemsFromCartCounter)
+	afterSaveForLaterCounter := mm_atomic.LoadUint64(&m.afterSaveForLaterCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteAllItemsFromCartMock.defaultExpectation != nil && afterDeleteAllItemsFromCartCounter < 1 {
-		if m.DeleteAllItemsFromCartMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s", m.DeleteAllItemsFromCartMock.defaultExpectation.returnOrigin)
+	if m.SaveForLaterMock.defaultExpectation != nil && afterSaveForLaterCounter < 1 {
+		if m.SaveForLaterMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SaveForLater at\n%s", m.SaveForLaterMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s with params: %#v", m.DeleteAllItemsFromCartMock.defaultExpectation.expectationOrigins.origin, *m.DeleteAllItemsFromCartMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.SaveForLater at\n%s with params: %#v", m.SaveForLaterMock.defaultExpectation.expectationOrigins.origin, *m.SaveForLaterMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteAllItemsFromCart != nil && afterDeleteAllItemsFromCartCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.DeleteAllItemsFromCart at\n%s", m.funcDeleteAllItemsFromCartOrigin)
+	if m.funcSaveForLater != nil && afterSaveForLaterCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SaveForLater at\n%s", m.funcSaveForLaterOrigin)
 	}
 
-	if !m.DeleteAllItemsFromCartMock.invocationsDone() && afterDeleteAllItemsFromCartCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteAllItemsFromCart at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteAllItemsFromCartMock.expectedInvocations), m.DeleteAllItemsFromCartMock.expectedInvocationsOrigin, afterDeleteAllItemsFromCartCounter)
+	if !m.SaveForLaterMock.invocationsDone() && afterSaveForLaterCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SaveForLater at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SaveForLaterMock.expectedInvocations), m.SaveForLaterMock.expectedInvocationsOrigin, afterSaveForLaterCounter)
 	}
 }
 
-type mRepositoryMockDeleteItemsBySku struct {
+type mRepositoryMockSetCount struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockDeleteItemsBySkuExpectation
-	expectations       []*RepositoryMockDeleteItemsBySkuExpectation
+	defaultExpectation *RepositoryMockSetCountExpectation
+	expectations       []*RepositoryMockSetCountExpectation
 
-	callArgs []*RepositoryMockDeleteItemsBySkuParams
+	callArgs []*RepositoryMockSetCountParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockDeleteItemsBySkuExpectation specifies expectation struct of the Repository.DeleteItemsBySku
-type RepositoryMockDeleteItemsBySkuExpectation struct {
+// RepositoryMockSetCountExpectation specifies expectation struct of the Repository.SetCount
+type RepositoryMockSetCountExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockDeleteItemsBySkuParams
-	paramPtrs          *RepositoryMockDeleteItemsBySkuParamPtrs
-	expectationOrigins RepositoryMockDeleteItemsBySkuExpectationOrigins
-	results            *RepositoryMockDeleteItemsBySkuResults
+	params             *RepositoryMockSetCountParams
+	paramPtrs          *RepositoryMockSetCountParamPtrs
+	expectationOrigins RepositoryMockSetCountExpectationOrigins
+	results            *RepositoryMockSetCountResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockDeleteItemsBySkuParams contains parameters of the Repository.DeleteItemsBySku
-type RepositoryMockDeleteItemsBySkuParams struct {
+// RepositoryMockSetCountParams contains parameters of the Repository.SetCount
+type RepositoryMockSetCountParams struct {
 	ctx       context.Context
 	cartItems model.RequestData
 }
 
-// RepositoryMockDeleteItemsBySkuParamPtrs contains pointers to parameters of the Repository.DeleteItemsBySku
-type RepositoryMockDeleteItemsBySkuParamPtrs struct {
+// RepositoryMockSetCountParamPtrs contains pointers to parameters of the Repository.SetCount
+type RepositoryMockSetCountParamPtrs struct {
 	ctx       *context.Context
 	cartItems *model.RequestData
 }
 
-// RepositoryMockDeleteItemsBySkuResults contains results of the Repository.DeleteItemsBySku
-type RepositoryMockDeleteItemsBySkuResults struct {
+// RepositoryMockSetCountResults contains results of the Repository.SetCount
+type RepositoryMockSetCountResults struct {
 	err error
 }
 
-// RepositoryMockDeleteItemsBySkuOrigins contains origins of expectations of the Repository.DeleteItemsBySku
-type RepositoryMockDeleteItemsBySkuExpectationOrigins struct {
+// RepositoryMockSetCountOrigins contains origins of expectations of the Repository.SetCount
+type RepositoryMockSetCountExpectationOrigins struct {
 	origin          string
 	originCtx       string
 	originCartItems string
@@ -997,342 +7166,344 @@ type RepositoryMockDeleteItemsBySkuExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Optional() *mRepositoryMockDeleteItemsBySku {
-	mmDeleteItemsBySku.optional = true
-	return mmDeleteItemsBySku
+func (mmSetCount *mRepositoryMockSetCount) Optional() *mRepositoryMockSetCount {
+	mmSetCount.optional = true
+	return mmSetCount
 }
 
-// Expect sets up expected params for Repository.DeleteItemsBySku
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Expect(ctx context.Context, cartItems model.RequestData) *mRepositoryMockDeleteItemsBySku {
-	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+// Expect sets up expected params for Repository.SetCount
+func (mmSetCount *mRepositoryMockSetCount) Expect(ctx context.Context, cartItems model.RequestData) *mRepositoryMockSetCount {
+	if mmSetCount.mock.funcSetCount != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by Set")
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation == nil {
-		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{}
+	if mmSetCount.defaultExpectation == nil {
+		mmSetCount.defaultExpectation = &RepositoryMockSetCountExpectation{}
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation.paramPtrs != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by ExpectParams functions")
+	if mmSetCount.defaultExpectation.paramPtrs != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by ExpectParams functions")
 	}
 
-	mmDeleteItemsBySku.defaultExpectation.params = &RepositoryMockDeleteItemsBySkuParams{ctx, cartItems}
-	mmDeleteItemsBySku.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmDeleteItemsBySku.expectations {
-		if minimock.Equal(e.params, mmDeleteItemsBySku.defaultExpectation.params) {
-			mmDeleteItemsBySku.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmDeleteItemsBySku.defaultExpectation.params)
+	mmSetCount.defaultExpectation.params = &RepositoryMockSetCountParams{ctx, cartItems}
+	mmSetCount.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetCount.expectations {
+		if minimock.Equal(e.params, mmSetCount.defaultExpectation.params) {
+			mmSetCount.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetCount.defaultExpectation.params)
 		}
 	}
 
-	return mmDeleteItemsBySku
+	return mmSetCount
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.DeleteItemsBySku
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) ExpectCtxParam1(ctx context.Context) *mRepositoryMockDeleteItemsBySku {
-	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.SetCount
+func (mmSetCount *mRepositoryMockSetCount) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSetCount {
+	if mmSetCount.mock.funcSetCount != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by Set")
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation == nil {
-		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{}
+	if mmSetCount.defaultExpectation == nil {
+		mmSetCount.defaultExpectation = &RepositoryMockSetCountExpectation{}
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation.params != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Expect")
+	if mmSetCount.defaultExpectation.params != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by Expect")
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation.paramPtrs == nil {
-		mmDeleteItemsBySku.defaultExpectation.paramPtrs = &RepositoryMockDeleteItemsBySkuParamPtrs{}
+	if mmSetCount.defaultExpectation.paramPtrs == nil {
+		mmSetCount.defaultExpectation.paramPtrs = &RepositoryMockSetCountParamPtrs{}
 	}
-	mmDeleteItemsBySku.defaultExpectation.paramPtrs.ctx = &ctx
-	mmDeleteItemsBySku.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetCount.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetCount.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmDeleteItemsBySku
+	return mmSetCount
 }
 
-// ExpectCartItemsParam2 sets up expected param cartItems for Repository.DeleteItemsBySku
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) ExpectCartItemsParam2(cartItems model.RequestData) *mRepositoryMockDeleteItemsBySku {
-	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+// ExpectCartItemsParam2 sets up expected param cartItems for Repository.SetCount
+func (mmSetCount *mRepositoryMockSetCount) ExpectCartItemsParam2(cartItems model.RequestData) *mRepositoryMockSetCount {
+	if mmSetCount.mock.funcSetCount != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by Set")
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation == nil {
-		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{}
+	if mmSetCount.defaultExpectation == nil {
+		mmSetCount.defaultExpectation = &RepositoryMockSetCountExpectation{}
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation.params != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Expect")
+	if mmSetCount.defaultExpectation.params != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by Expect")
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation.paramPtrs == nil {
-		mmDeleteItemsBySku.defaultExpectation.paramPtrs = &RepositoryMockDeleteItemsBySkuParamPtrs{}
+	if mmSetCount.defaultExpectation.paramPtrs == nil {
+		mmSetCount.defaultExpectation.paramPtrs = &RepositoryMockSetCountParamPtrs{}
 	}
-	mmDeleteItemsBySku.defaultExpectation.paramPtrs.cartItems = &cartItems
-	mmDeleteItemsBySku.defaultExpectation.expectationOrigins.originCartItems = minimock.CallerInfo(1)
+	mmSetCount.defaultExpectation.paramPtrs.cartItems = &cartItems
+	mmSetCount.defaultExpectation.expectationOrigins.originCartItems = minimock.CallerInfo(1)
 
-	return mmDeleteItemsBySku
+	return mmSetCount
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.DeleteItemsBySku
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Inspect(f func(ctx context.Context, cartItems model.RequestData)) *mRepositoryMockDeleteItemsBySku {
-	if mmDeleteItemsBySku.mock.inspectFuncDeleteItemsBySku != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("Inspect function is already set for RepositoryMock.DeleteItemsBySku")
+// Inspect accepts an inspector function that has same arguments as the Repository.SetCount
+func (mmSetCount *mRepositoryMockSetCount) Inspect(f func(ctx context.Context, cartItems model.RequestData)) *mRepositoryMockSetCount {
+	if mmSetCount.mock.inspectFuncSetCount != nil {
+		mmSetCount.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SetCount")
 	}
 
-	mmDeleteItemsBySku.mock.inspectFuncDeleteItemsBySku = f
+	mmSetCount.mock.inspectFuncSetCount = f
 
-	return mmDeleteItemsBySku
+	return mmSetCount
 }
 
-// Return sets up results that will be returned by Repository.DeleteItemsBySku
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Return(err error) *RepositoryMock {
-	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+// Return sets up results that will be returned by Repository.SetCount
+func (mmSetCount *mRepositoryMockSetCount) Return(err error) *RepositoryMock {
+	if mmSetCount.mock.funcSetCount != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by Set")
 	}
 
-	if mmDeleteItemsBySku.defaultExpectation == nil {
-		mmDeleteItemsBySku.defaultExpectation = &RepositoryMockDeleteItemsBySkuExpectation{mock: mmDeleteItemsBySku.mock}
+	if mmSetCount.defaultExpectation == nil {
+		mmSetCount.defaultExpectation = &RepositoryMockSetCountExpectation{mock: mmSetCount.mock}
 	}
-	mmDeleteItemsBySku.defaultExpectation.results = &RepositoryMockDeleteItemsBySkuResults{err}
-	mmDeleteItemsBySku.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmDeleteItemsBySku.mock
+	mmSetCount.defaultExpectation.results = &RepositoryMockSetCountResults{err}
+	mmSetCount.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetCount.mock
 }
 
-// Set uses given function f to mock the Repository.DeleteItemsBySku method
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Set(f func(ctx context.Context, cartItems model.RequestData) (err error)) *RepositoryMock {
-	if mmDeleteItemsBySku.defaultExpectation != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("Default expectation is already set for the Repository.DeleteItemsBySku method")
+// Set uses given function f to mock the Repository.SetCount method
+func (mmSetCount *mRepositoryMockSetCount) Set(f func(ctx context.Context, cartItems model.RequestData) (err error)) *RepositoryMock {
+	if mmSetCount.defaultExpectation != nil {
+		mmSetCount.mock.t.Fatalf("Default expectation is already set for the Repository.SetCount method")
 	}
 
-	if len(mmDeleteItemsBySku.expectations) > 0 {
-		mmDeleteItemsBySku.mock.t.Fatalf("Some expectations are already set for the Repository.DeleteItemsBySku method")
+	if len(mmSetCount.expectations) > 0 {
+		mmSetCount.mock.t.Fatalf("Some expectations are already set for the Repository.SetCount method")
 	}
 
-	mmDeleteItemsBySku.mock.funcDeleteItemsBySku = f
-	mmDeleteItemsBySku.mock.funcDeleteItemsBySkuOrigin = minimock.CallerInfo(1)
-	return mmDeleteItemsBySku.mock
+	mmSetCount.mock.funcSetCount = f
+	mmSetCount.mock.funcSetCountOrigin = minimock.CallerInfo(1)
+	return mmSetCount.mock
 }
 
-// When sets expectation for the Repository.DeleteItemsBySku which will trigger the result defined by the following
+// When sets expectation for the Repository.SetCount which will trigger the result defined by the following
 // Then helper
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) When(ctx context.Context, cartItems model.RequestData) *RepositoryMockDeleteItemsBySkuExpectation {
-	if mmDeleteItemsBySku.mock.funcDeleteItemsBySku != nil {
-		mmDeleteItemsBySku.mock.t.Fatalf("RepositoryMock.DeleteItemsBySku mock is already set by Set")
+func (mmSetCount *mRepositoryMockSetCount) When(ctx context.Context, cartItems model.RequestData) *RepositoryMockSetCountExpectation {
+	if mmSetCount.mock.funcSetCount != nil {
+		mmSetCount.mock.t.Fatalf("RepositoryMock.SetCount mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockDeleteItemsBySkuExpectation{
-		mock:               mmDeleteItemsBySku.mock,
-		params:             &RepositoryMockDeleteItemsBySkuParams{ctx, cartItems},
-		expectationOrigins: RepositoryMockDeleteItemsBySkuExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockSetCountExpectation{
+		mock:               mmSetCount.mock,
+		params:             &RepositoryMockSetCountParams{ctx, cartItems},
+		expectationOrigins: RepositoryMockSetCountExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmDeleteItemsBySku.expectations = append(mmDeleteItemsBySku.expectations, expectation)
+	mmSetCount.expectations = append(mmSetCount.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.DeleteItemsBySku return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockDeleteItemsBySkuExpectation) Then(err error) *RepositoryMock {
-	e.results = &RepositoryMockDeleteItemsBySkuResults{err}
+// Then sets up Repository.SetCount return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSetCountExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSetCountResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.DeleteItemsBySku should be invoked
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Times(n uint64) *mRepositoryMockDeleteItemsBySku {
+// Times sets number of times Repository.SetCount should be invoked
+func (mmSetCount *mRepositoryMockSetCount) Times(n uint64) *mRepositoryMockSetCount {
 	if n == 0 {
-		mmDeleteItemsBySku.mock.t.Fatalf("Times of RepositoryMock.DeleteItemsBySku mock can not be zero")
+		mmSetCount.mock.t.Fatalf("Times of RepositoryMock.SetCount mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmDeleteItemsBySku.expectedInvocations, n)
-	mmDeleteItemsBySku.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmDeleteItemsBySku
+	mm_atomic.StoreUint64(&mmSetCount.expectedInvocations, n)
+	mmSetCount.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetCount
 }
 
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) invocationsDone() bool {
-	if len(mmDeleteItemsBySku.expectations) == 0 && mmDeleteItemsBySku.defaultExpectation == nil && mmDeleteItemsBySku.mock.funcDeleteItemsBySku == nil {
+func (mmSetCount *mRepositoryMockSetCount) invocationsDone() bool {
+	if len(mmSetCount.expectations) == 0 && mmSetCount.defaultExpectation == nil && mmSetCount.mock.funcSetCount == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmDeleteItemsBySku.mock.afterDeleteItemsBySkuCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmDeleteItemsBySku.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetCount.mock.afterSetCountCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetCount.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// DeleteItemsBySku implements mm_service.Repository
-func (mmDeleteItemsBySku *RepositoryMock) DeleteItemsBySku(ctx context.Context, cartItems model.RequestData) (err error) {
-	mm_atomic.AddUint64(&mmDeleteItemsBySku.beforeDeleteItemsBySkuCounter, 1)
-	defer mm_atomic.AddUint64(&mmDeleteItemsBySku.afterDeleteItemsBySkuCounter, 1)
+// SetCount implements mm_service.Repository
+func (mmSetCount *RepositoryMock) SetCount(ctx context.Context, cartItems model.RequestData) (err error) {
+	mm_atomic.AddUint64(&mmSetCount.beforeSetCountCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetCount.afterSetCountCounter, 1)
 
-	mmDeleteItemsBySku.t.Helper()
+	mmSetCount.t.Helper()
 
-	if mmDeleteItemsBySku.inspectFuncDeleteItemsBySku != nil {
-		mmDeleteItemsBySku.inspectFuncDeleteItemsBySku(ctx, cartItems)
+	if mmSetCount.inspectFuncSetCount != nil {
+		mmSetCount.inspectFuncSetCount(ctx, cartItems)
 	}
 
-	mm_params := RepositoryMockDeleteItemsBySkuParams{ctx, cartItems}
+	mm_params := RepositoryMockSetCountParams{ctx, cartItems}
 
-	// Record call args
-	mmDeleteItemsBySku.DeleteItemsBySkuMock.mutex.Lock()
-	mmDeleteItemsBySku.DeleteItemsBySkuMock.callArgs = append(mmDeleteItemsBySku.DeleteItemsBySkuMock.callArgs, &mm_params)
-	mmDeleteItemsBySku.DeleteItemsBySkuMock.mutex.Unlock()
+	// Record call args
+	mmSetCount.SetCountMock.mutex.Lock()
+	mmSetCount.SetCountMock.callArgs = append(mmSetCount.SetCountMock.callArgs, &mm_params)
+	mmSetCount.SetCountMock.mutex.Unlock()
 
-	for _, e := range mmDeleteItemsBySku.DeleteItemsBySkuMock.expectations {
+	for _, e := range mmSetCount.SetCountMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
 			return e.results.err
 		}
 	}
 
-	if mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.Counter, 1)
-		mm_want := mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.params
-		mm_want_ptrs := mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.paramPtrs
+	if mmSetCount.SetCountMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetCount.SetCountMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetCount.SetCountMock.defaultExpectation.params
+		mm_want_ptrs := mmSetCount.SetCountMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockDeleteItemsBySkuParams{ctx, cartItems}
+		mm_got := RepositoryMockSetCountParams{ctx, cartItems}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmDeleteItemsBySku.t.Errorf("RepositoryMock.DeleteItemsBySku got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetCount.t.Errorf("RepositoryMock.SetCount got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetCount.SetCountMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
 			if mm_want_ptrs.cartItems != nil && !minimock.Equal(*mm_want_ptrs.cartItems, mm_got.cartItems) {
-				mmDeleteItemsBySku.t.Errorf("RepositoryMock.DeleteItemsBySku got unexpected parameter cartItems, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.originCartItems, *mm_want_ptrs.cartItems, mm_got.cartItems, minimock.Diff(*mm_want_ptrs.cartItems, mm_got.cartItems))
+				mmSetCount.t.Errorf("RepositoryMock.SetCount got unexpected parameter cartItems, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetCount.SetCountMock.defaultExpectation.expectationOrigins.originCartItems, *mm_want_ptrs.cartItems, mm_got.cartItems, minimock.Diff(*mm_want_ptrs.cartItems, mm_got.cartItems))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmDeleteItemsBySku.t.Errorf("RepositoryMock.DeleteItemsBySku got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetCount.t.Errorf("RepositoryMock.SetCount got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetCount.SetCountMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmDeleteItemsBySku.DeleteItemsBySkuMock.defaultExpectation.results
+		mm_results := mmSetCount.SetCountMock.defaultExpectation.results
 		if mm_results == nil {
-			mmDeleteItemsBySku.t.Fatal("No results are set for the RepositoryMock.DeleteItemsBySku")
+			mmSetCount.t.Fatal("No results are set for the RepositoryMock.SetCount")
 		}
 		return (*mm_results).err
 	}
-	if mmDeleteItemsBySku.funcDeleteItemsBySku != nil {
-		return mmDeleteItemsBySku.funcDeleteItemsBySku(ctx, cartItems)
+	if mmSetCount.funcSetCount != nil {
+		return mmSetCount.funcSetCount(ctx, cartItems)
 	}
-	mmDeleteItemsBySku.t.Fatalf("Unexpected call to RepositoryMock.DeleteItemsBySku. %v %v", ctx, cartItems)
+	mmSetCount.t.Fatalf("Unexpected call to RepositoryMock.SetCount. %v %v", ctx, cartItems)
 	return
 }
 
-// DeleteItemsBySkuAfterCounter returns a count of finished RepositoryMock.DeleteItemsBySku invocations
-func (mmDeleteItemsBySku *RepositoryMock) DeleteItemsBySkuAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItemsBySku.afterDeleteItemsBySkuCounter)
+// SetCountAfterCounter returns a count of finished RepositoryMock.SetCount invocations
+func (mmSetCount *RepositoryMock) SetCountAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCount.afterSetCountCounter)
 }
 
-// DeleteItemsBySkuBeforeCounter returns a count of RepositoryMock.DeleteItemsBySku invocations
-func (mmDeleteItemsBySku *RepositoryMock) DeleteItemsBySkuBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmDeleteItemsBySku.beforeDeleteItemsBySkuCounter)
+// SetCountBeforeCounter returns a count of RepositoryMock.SetCount invocations
+func (mmSetCount *RepositoryMock) SetCountBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetCount.beforeSetCountCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.DeleteItemsBySku.
+// Calls returns a list of arguments used in each call to RepositoryMock.SetCount.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmDeleteItemsBySku *mRepositoryMockDeleteItemsBySku) Calls() []*RepositoryMockDeleteItemsBySkuParams {
-	mmDeleteItemsBySku.mutex.RLock()
+func (mmSetCount *mRepositoryMockSetCount) Calls() []*RepositoryMockSetCountParams {
+	mmSetCount.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockDeleteItemsBySkuParams, len(mmDeleteItemsBySku.callArgs))
-	copy(argCopy, mmDeleteItemsBySku.callArgs)
+	argCopy := make([]*RepositoryMockSetCountParams, len(mmSetCount.callArgs))
+	copy(argCopy, mmSetCount.callArgs)
 
-	mmDeleteItemsBySku.mutex.RUnlock()
+	mmSetCount.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockDeleteItemsBySkuDone returns true if the count of the DeleteItemsBySku invocations corresponds
+// MinimockSetCountDone returns true if the count of the SetCount invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockDeleteItemsBySkuDone() bool {
-	if m.DeleteItemsBySkuMock.optional {
+func (m *RepositoryMock) MinimockSetCountDone() bool {
+	if m.SetCountMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.DeleteItemsBySkuMock.expectations {
+	for _, e := range m.SetCountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.DeleteItemsBySkuMock.invocationsDone()
+	return m.SetCountMock.invocationsDone()
 }
 
-// MinimockDeleteItemsBySkuInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockDeleteItemsBySkuInspect() {
-	for _, e := range m.DeleteItemsBySkuMock.expectations {
+// MinimockSetCountInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSetCountInspect() {
+	for _, e := range m.SetCountMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.SetCount at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterDeleteItemsBySkuCounter := mm_atomic.LoadUint64(&m.afterDeleteItemsBySkuCounter)
+	afterSetCountCounter := mm_atomic.LoadUint64(&m.afterSetCountCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.DeleteItemsBySkuMock.defaultExpectation != nil && afterDeleteItemsBySkuCounter < 1 {
-		if m.DeleteItemsBySkuMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s", m.DeleteItemsBySkuMock.defaultExpectation.returnOrigin)
+	if m.SetCountMock.defaultExpectation != nil && afterSetCountCounter < 1 {
+		if m.SetCountMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SetCount at\n%s", m.SetCountMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s with params: %#v", m.DeleteItemsBySkuMock.defaultExpectation.expectationOrigins.origin, *m.DeleteItemsBySkuMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.SetCount at\n%s with params: %#v", m.SetCountMock.defaultExpectation.expectationOrigins.origin, *m.SetCountMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcDeleteItemsBySku != nil && afterDeleteItemsBySkuCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.DeleteItemsBySku at\n%s", m.funcDeleteItemsBySkuOrigin)
+	if m.funcSetCount != nil && afterSetCountCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SetCount at\n%s", m.funcSetCountOrigin)
 	}
 
-	if !m.DeleteItemsBySkuMock.invocationsDone() && afterDeleteItemsBySkuCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.DeleteItemsBySku at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.DeleteItemsBySkuMock.expectedInvocations), m.DeleteItemsBySkuMock.expectedInvocationsOrigin, afterDeleteItemsBySkuCounter)
+	if !m.SetCountMock.invocationsDone() && afterSetCountCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SetCount at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetCountMock.expectedInvocations), m.SetCountMock.expectedInvocationsOrigin, afterSetCountCounter)
 	}
 }
 
-type mRepositoryMockGetItemsByUserID struct {
+type mRepositoryMockSetPromoCode struct {
 	optional           bool
 	mock               *RepositoryMock
-	defaultExpectation *RepositoryMockGetItemsByUserIDExpectation
-	expectations       []*RepositoryMockGetItemsByUserIDExpectation
+	defaultExpectation *RepositoryMockSetPromoCodeExpectation
+	expectations       []*RepositoryMockSetPromoCodeExpectation
 
-	callArgs []*RepositoryMockGetItemsByUserIDParams
+	callArgs []*RepositoryMockSetPromoCodeParams
 	mutex    sync.RWMutex
 
 	expectedInvocations       uint64
 	expectedInvocationsOrigin string
 }
 
-// RepositoryMockGetItemsByUserIDExpectation specifies expectation struct of the Repository.GetItemsByUserID
-type RepositoryMockGetItemsByUserIDExpectation struct {
+// RepositoryMockSetPromoCodeExpectation specifies expectation struct of the Repository.SetPromoCode
+type RepositoryMockSetPromoCodeExpectation struct {
 	mock               *RepositoryMock
-	params             *RepositoryMockGetItemsByUserIDParams
-	paramPtrs          *RepositoryMockGetItemsByUserIDParamPtrs
-	expectationOrigins RepositoryMockGetItemsByUserIDExpectationOrigins
-	results            *RepositoryMockGetItemsByUserIDResults
+	params             *RepositoryMockSetPromoCodeParams
+	paramPtrs          *RepositoryMockSetPromoCodeParamPtrs
+	expectationOrigins RepositoryMockSetPromoCodeExpectationOrigins
+	results            *RepositoryMockSetPromoCodeResults
 	returnOrigin       string
 	Counter            uint64
 }
 
-// RepositoryMockGetItemsByUserIDParams contains parameters of the Repository.GetItemsByUserID
-type RepositoryMockGetItemsByUserIDParams struct {
-	ctx       context.Context
-	cartItems model.RequestData
+// RepositoryMockSetPromoCodeParams contains parameters of the Repository.SetPromoCode
+type RepositoryMockSetPromoCodeParams struct {
+	ctx    context.Context
+	userID int64
+	code   string
 }
 
-// RepositoryMockGetItemsByUserIDParamPtrs contains pointers to parameters of the Repository.GetItemsByUserID
-type RepositoryMockGetItemsByUserIDParamPtrs struct {
-	ctx       *context.Context
-	cartItems *model.RequestData
+// RepositoryMockSetPromoCodeParamPtrs contains pointers to parameters of the Repository.SetPromoCode
+type RepositoryMockSetPromoCodeParamPtrs struct {
+	ctx    *context.Context
+	userID *int64
+	code   *string
 }
 
-// RepositoryMockGetItemsByUserIDResults contains results of the Repository.GetItemsByUserID
-type RepositoryMockGetItemsByUserIDResults struct {
-	ca1 []model.Cart
+// RepositoryMockSetPromoCodeResults contains results of the Repository.SetPromoCode
+type RepositoryMockSetPromoCodeResults struct {
 	err error
 }
 
-// RepositoryMockGetItemsByUserIDOrigins contains origins of expectations of the Repository.GetItemsByUserID
-type RepositoryMockGetItemsByUserIDExpectationOrigins struct {
-	origin          string
-	originCtx       string
-	originCartItems string
+// RepositoryMockSetPromoCodeOrigins contains origins of expectations of the Repository.SetPromoCode
+type RepositoryMockSetPromoCodeExpectationOrigins struct {
+	origin       string
+	originCtx    string
+	originUserID string
+	originCode   string
 }
 
 // Marks this method to be optional. The default behavior of any method with Return() is '1 or more', meaning
@@ -1340,292 +7511,320 @@ type RepositoryMockGetItemsByUserIDExpectationOrigins struct {
 // Optional() makes method check to work in '0 or more' mode.
 // It is NOT RECOMMENDED to use this option unless you really need it, as default behaviour helps to
 // catch the problems when the expected method call is totally skipped during test run.
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Optional() *mRepositoryMockGetItemsByUserID {
-	mmGetItemsByUserID.optional = true
-	return mmGetItemsByUserID
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) Optional() *mRepositoryMockSetPromoCode {
+	mmSetPromoCode.optional = true
+	return mmSetPromoCode
 }
 
-// Expect sets up expected params for Repository.GetItemsByUserID
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Expect(ctx context.Context, cartItems model.RequestData) *mRepositoryMockGetItemsByUserID {
-	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+// Expect sets up expected params for Repository.SetPromoCode
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) Expect(ctx context.Context, userID int64, code string) *mRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Set")
 	}
 
-	if mmGetItemsByUserID.defaultExpectation == nil {
-		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{}
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &RepositoryMockSetPromoCodeExpectation{}
 	}
 
-	if mmGetItemsByUserID.defaultExpectation.paramPtrs != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by ExpectParams functions")
+	if mmSetPromoCode.defaultExpectation.paramPtrs != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by ExpectParams functions")
 	}
 
-	mmGetItemsByUserID.defaultExpectation.params = &RepositoryMockGetItemsByUserIDParams{ctx, cartItems}
-	mmGetItemsByUserID.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
-	for _, e := range mmGetItemsByUserID.expectations {
-		if minimock.Equal(e.params, mmGetItemsByUserID.defaultExpectation.params) {
-			mmGetItemsByUserID.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmGetItemsByUserID.defaultExpectation.params)
+	mmSetPromoCode.defaultExpectation.params = &RepositoryMockSetPromoCodeParams{ctx, userID, code}
+	mmSetPromoCode.defaultExpectation.expectationOrigins.origin = minimock.CallerInfo(1)
+	for _, e := range mmSetPromoCode.expectations {
+		if minimock.Equal(e.params, mmSetPromoCode.defaultExpectation.params) {
+			mmSetPromoCode.mock.t.Fatalf("Expectation set by When has same params: %#v", *mmSetPromoCode.defaultExpectation.params)
 		}
 	}
 
-	return mmGetItemsByUserID
+	return mmSetPromoCode
 }
 
-// ExpectCtxParam1 sets up expected param ctx for Repository.GetItemsByUserID
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) ExpectCtxParam1(ctx context.Context) *mRepositoryMockGetItemsByUserID {
-	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+// ExpectCtxParam1 sets up expected param ctx for Repository.SetPromoCode
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) ExpectCtxParam1(ctx context.Context) *mRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Set")
 	}
 
-	if mmGetItemsByUserID.defaultExpectation == nil {
-		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{}
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &RepositoryMockSetPromoCodeExpectation{}
 	}
 
-	if mmGetItemsByUserID.defaultExpectation.params != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Expect")
+	if mmSetPromoCode.defaultExpectation.params != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Expect")
 	}
 
-	if mmGetItemsByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetItemsByUserID.defaultExpectation.paramPtrs = &RepositoryMockGetItemsByUserIDParamPtrs{}
+	if mmSetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmSetPromoCode.defaultExpectation.paramPtrs = &RepositoryMockSetPromoCodeParamPtrs{}
 	}
-	mmGetItemsByUserID.defaultExpectation.paramPtrs.ctx = &ctx
-	mmGetItemsByUserID.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
+	mmSetPromoCode.defaultExpectation.paramPtrs.ctx = &ctx
+	mmSetPromoCode.defaultExpectation.expectationOrigins.originCtx = minimock.CallerInfo(1)
 
-	return mmGetItemsByUserID
+	return mmSetPromoCode
 }
 
-// ExpectCartItemsParam2 sets up expected param cartItems for Repository.GetItemsByUserID
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) ExpectCartItemsParam2(cartItems model.RequestData) *mRepositoryMockGetItemsByUserID {
-	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+// ExpectUserIDParam2 sets up expected param userID for Repository.SetPromoCode
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) ExpectUserIDParam2(userID int64) *mRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Set")
 	}
 
-	if mmGetItemsByUserID.defaultExpectation == nil {
-		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{}
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &RepositoryMockSetPromoCodeExpectation{}
 	}
 
-	if mmGetItemsByUserID.defaultExpectation.params != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Expect")
+	if mmSetPromoCode.defaultExpectation.params != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Expect")
 	}
 
-	if mmGetItemsByUserID.defaultExpectation.paramPtrs == nil {
-		mmGetItemsByUserID.defaultExpectation.paramPtrs = &RepositoryMockGetItemsByUserIDParamPtrs{}
+	if mmSetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmSetPromoCode.defaultExpectation.paramPtrs = &RepositoryMockSetPromoCodeParamPtrs{}
 	}
-	mmGetItemsByUserID.defaultExpectation.paramPtrs.cartItems = &cartItems
-	mmGetItemsByUserID.defaultExpectation.expectationOrigins.originCartItems = minimock.CallerInfo(1)
+	mmSetPromoCode.defaultExpectation.paramPtrs.userID = &userID
+	mmSetPromoCode.defaultExpectation.expectationOrigins.originUserID = minimock.CallerInfo(1)
 
-	return mmGetItemsByUserID
+	return mmSetPromoCode
 }
 
-// Inspect accepts an inspector function that has same arguments as the Repository.GetItemsByUserID
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Inspect(f func(ctx context.Context, cartItems model.RequestData)) *mRepositoryMockGetItemsByUserID {
-	if mmGetItemsByUserID.mock.inspectFuncGetItemsByUserID != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("Inspect function is already set for RepositoryMock.GetItemsByUserID")
+// ExpectCodeParam3 sets up expected param code for Repository.SetPromoCode
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) ExpectCodeParam3(code string) *mRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Set")
 	}
 
-	mmGetItemsByUserID.mock.inspectFuncGetItemsByUserID = f
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &RepositoryMockSetPromoCodeExpectation{}
+	}
 
-	return mmGetItemsByUserID
+	if mmSetPromoCode.defaultExpectation.params != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Expect")
+	}
+
+	if mmSetPromoCode.defaultExpectation.paramPtrs == nil {
+		mmSetPromoCode.defaultExpectation.paramPtrs = &RepositoryMockSetPromoCodeParamPtrs{}
+	}
+	mmSetPromoCode.defaultExpectation.paramPtrs.code = &code
+	mmSetPromoCode.defaultExpectation.expectationOrigins.originCode = minimock.CallerInfo(1)
+
+	return mmSetPromoCode
 }
 
-// Return sets up results that will be returned by Repository.GetItemsByUserID
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Return(ca1 []model.Cart, err error) *RepositoryMock {
-	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+// Inspect accepts an inspector function that has same arguments as the Repository.SetPromoCode
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) Inspect(f func(ctx context.Context, userID int64, code string)) *mRepositoryMockSetPromoCode {
+	if mmSetPromoCode.mock.inspectFuncSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("Inspect function is already set for RepositoryMock.SetPromoCode")
 	}
 
-	if mmGetItemsByUserID.defaultExpectation == nil {
-		mmGetItemsByUserID.defaultExpectation = &RepositoryMockGetItemsByUserIDExpectation{mock: mmGetItemsByUserID.mock}
+	mmSetPromoCode.mock.inspectFuncSetPromoCode = f
+
+	return mmSetPromoCode
+}
+
+// Return sets up results that will be returned by Repository.SetPromoCode
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) Return(err error) *RepositoryMock {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Set")
 	}
-	mmGetItemsByUserID.defaultExpectation.results = &RepositoryMockGetItemsByUserIDResults{ca1, err}
-	mmGetItemsByUserID.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
-	return mmGetItemsByUserID.mock
+
+	if mmSetPromoCode.defaultExpectation == nil {
+		mmSetPromoCode.defaultExpectation = &RepositoryMockSetPromoCodeExpectation{mock: mmSetPromoCode.mock}
+	}
+	mmSetPromoCode.defaultExpectation.results = &RepositoryMockSetPromoCodeResults{err}
+	mmSetPromoCode.defaultExpectation.returnOrigin = minimock.CallerInfo(1)
+	return mmSetPromoCode.mock
 }
 
-// Set uses given function f to mock the Repository.GetItemsByUserID method
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Set(f func(ctx context.Context, cartItems model.RequestData) (ca1 []model.Cart, err error)) *RepositoryMock {
-	if mmGetItemsByUserID.defaultExpectation != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("Default expectation is already set for the Repository.GetItemsByUserID method")
+// Set uses given function f to mock the Repository.SetPromoCode method
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) Set(f func(ctx context.Context, userID int64, code string) (err error)) *RepositoryMock {
+	if mmSetPromoCode.defaultExpectation != nil {
+		mmSetPromoCode.mock.t.Fatalf("Default expectation is already set for the Repository.SetPromoCode method")
 	}
 
-	if len(mmGetItemsByUserID.expectations) > 0 {
-		mmGetItemsByUserID.mock.t.Fatalf("Some expectations are already set for the Repository.GetItemsByUserID method")
+	if len(mmSetPromoCode.expectations) > 0 {
+		mmSetPromoCode.mock.t.Fatalf("Some expectations are already set for the Repository.SetPromoCode method")
 	}
 
-	mmGetItemsByUserID.mock.funcGetItemsByUserID = f
-	mmGetItemsByUserID.mock.funcGetItemsByUserIDOrigin = minimock.CallerInfo(1)
-	return mmGetItemsByUserID.mock
+	mmSetPromoCode.mock.funcSetPromoCode = f
+	mmSetPromoCode.mock.funcSetPromoCodeOrigin = minimock.CallerInfo(1)
+	return mmSetPromoCode.mock
 }
 
-// When sets expectation for the Repository.GetItemsByUserID which will trigger the result defined by the following
+// When sets expectation for the Repository.SetPromoCode which will trigger the result defined by the following
 // Then helper
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) When(ctx context.Context, cartItems model.RequestData) *RepositoryMockGetItemsByUserIDExpectation {
-	if mmGetItemsByUserID.mock.funcGetItemsByUserID != nil {
-		mmGetItemsByUserID.mock.t.Fatalf("RepositoryMock.GetItemsByUserID mock is already set by Set")
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) When(ctx context.Context, userID int64, code string) *RepositoryMockSetPromoCodeExpectation {
+	if mmSetPromoCode.mock.funcSetPromoCode != nil {
+		mmSetPromoCode.mock.t.Fatalf("RepositoryMock.SetPromoCode mock is already set by Set")
 	}
 
-	expectation := &RepositoryMockGetItemsByUserIDExpectation{
-		mock:               mmGetItemsByUserID.mock,
-		params:             &RepositoryMockGetItemsByUserIDParams{ctx, cartItems},
-		expectationOrigins: RepositoryMockGetItemsByUserIDExpectationOrigins{origin: minimock.CallerInfo(1)},
+	expectation := &RepositoryMockSetPromoCodeExpectation{
+		mock:               mmSetPromoCode.mock,
+		params:             &RepositoryMockSetPromoCodeParams{ctx, userID, code},
+		expectationOrigins: RepositoryMockSetPromoCodeExpectationOrigins{origin: minimock.CallerInfo(1)},
 	}
-	mmGetItemsByUserID.expectations = append(mmGetItemsByUserID.expectations, expectation)
+	mmSetPromoCode.expectations = append(mmSetPromoCode.expectations, expectation)
 	return expectation
 }
 
-// Then sets up Repository.GetItemsByUserID return parameters for the expectation previously defined by the When method
-func (e *RepositoryMockGetItemsByUserIDExpectation) Then(ca1 []model.Cart, err error) *RepositoryMock {
-	e.results = &RepositoryMockGetItemsByUserIDResults{ca1, err}
+// Then sets up Repository.SetPromoCode return parameters for the expectation previously defined by the When method
+func (e *RepositoryMockSetPromoCodeExpectation) Then(err error) *RepositoryMock {
+	e.results = &RepositoryMockSetPromoCodeResults{err}
 	return e.mock
 }
 
-// Times sets number of times Repository.GetItemsByUserID should be invoked
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Times(n uint64) *mRepositoryMockGetItemsByUserID {
+// Times sets number of times Repository.SetPromoCode should be invoked
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) Times(n uint64) *mRepositoryMockSetPromoCode {
 	if n == 0 {
-		mmGetItemsByUserID.mock.t.Fatalf("Times of RepositoryMock.GetItemsByUserID mock can not be zero")
+		mmSetPromoCode.mock.t.Fatalf("Times of RepositoryMock.SetPromoCode mock can not be zero")
 	}
-	mm_atomic.StoreUint64(&mmGetItemsByUserID.expectedInvocations, n)
-	mmGetItemsByUserID.expectedInvocationsOrigin = minimock.CallerInfo(1)
-	return mmGetItemsByUserID
+	mm_atomic.StoreUint64(&mmSetPromoCode.expectedInvocations, n)
+	mmSetPromoCode.expectedInvocationsOrigin = minimock.CallerInfo(1)
+	return mmSetPromoCode
 }
 
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) invocationsDone() bool {
-	if len(mmGetItemsByUserID.expectations) == 0 && mmGetItemsByUserID.defaultExpectation == nil && mmGetItemsByUserID.mock.funcGetItemsByUserID == nil {
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) invocationsDone() bool {
+	if len(mmSetPromoCode.expectations) == 0 && mmSetPromoCode.defaultExpectation == nil && mmSetPromoCode.mock.funcSetPromoCode == nil {
 		return true
 	}
 
-	totalInvocations := mm_atomic.LoadUint64(&mmGetItemsByUserID.mock.afterGetItemsByUserIDCounter)
-	expectedInvocations := mm_atomic.LoadUint64(&mmGetItemsByUserID.expectedInvocations)
+	totalInvocations := mm_atomic.LoadUint64(&mmSetPromoCode.mock.afterSetPromoCodeCounter)
+	expectedInvocations := mm_atomic.LoadUint64(&mmSetPromoCode.expectedInvocations)
 
 	return totalInvocations > 0 && (expectedInvocations == 0 || expectedInvocations == totalInvocations)
 }
 
-// GetItemsByUserID implements mm_service.Repository
-func (mmGetItemsByUserID *RepositoryMock) GetItemsByUserID(ctx context.Context, cartItems model.RequestData) (ca1 []model.Cart, err error) {
-	mm_atomic.AddUint64(&mmGetItemsByUserID.beforeGetItemsByUserIDCounter, 1)
-	defer mm_atomic.AddUint64(&mmGetItemsByUserID.afterGetItemsByUserIDCounter, 1)
+// SetPromoCode implements mm_service.Repository
+func (mmSetPromoCode *RepositoryMock) SetPromoCode(ctx context.Context, userID int64, code string) (err error) {
+	mm_atomic.AddUint64(&mmSetPromoCode.beforeSetPromoCodeCounter, 1)
+	defer mm_atomic.AddUint64(&mmSetPromoCode.afterSetPromoCodeCounter, 1)
 
-	mmGetItemsByUserID.t.Helper()
+	mmSetPromoCode.t.Helper()
 
-	if mmGetItemsByUserID.inspectFuncGetItemsByUserID != nil {
-		mmGetItemsByUserID.inspectFuncGetItemsByUserID(ctx, cartItems)
+	if mmSetPromoCode.inspectFuncSetPromoCode != nil {
+		mmSetPromoCode.inspectFuncSetPromoCode(ctx, userID, code)
 	}
 
-	mm_params := RepositoryMockGetItemsByUserIDParams{ctx, cartItems}
+	mm_params := RepositoryMockSetPromoCodeParams{ctx, userID, code}
 
 	// Record call args
-	mmGetItemsByUserID.GetItemsByUserIDMock.mutex.Lock()
-	mmGetItemsByUserID.GetItemsByUserIDMock.callArgs = append(mmGetItemsByUserID.GetItemsByUserIDMock.callArgs, &mm_params)
-	mmGetItemsByUserID.GetItemsByUserIDMock.mutex.Unlock()
+	mmSetPromoCode.SetPromoCodeMock.mutex.Lock()
+	mmSetPromoCode.SetPromoCodeMock.callArgs = append(mmSetPromoCode.SetPromoCodeMock.callArgs, &mm_params)
+	mmSetPromoCode.SetPromoCodeMock.mutex.Unlock()
 
-	for _, e := range mmGetItemsByUserID.GetItemsByUserIDMock.expectations {
+	for _, e := range mmSetPromoCode.SetPromoCodeMock.expectations {
 		if minimock.Equal(*e.params, mm_params) {
 			mm_atomic.AddUint64(&e.Counter, 1)
-			return e.results.ca1, e.results.err
+			return e.results.err
 		}
 	}
 
-	if mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation != nil {
-		mm_atomic.AddUint64(&mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.Counter, 1)
-		mm_want := mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.params
-		mm_want_ptrs := mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.paramPtrs
+	if mmSetPromoCode.SetPromoCodeMock.defaultExpectation != nil {
+		mm_atomic.AddUint64(&mmSetPromoCode.SetPromoCodeMock.defaultExpectation.Counter, 1)
+		mm_want := mmSetPromoCode.SetPromoCodeMock.defaultExpectation.params
+		mm_want_ptrs := mmSetPromoCode.SetPromoCodeMock.defaultExpectation.paramPtrs
 
-		mm_got := RepositoryMockGetItemsByUserIDParams{ctx, cartItems}
+		mm_got := RepositoryMockSetPromoCodeParams{ctx, userID, code}
 
 		if mm_want_ptrs != nil {
 
 			if mm_want_ptrs.ctx != nil && !minimock.Equal(*mm_want_ptrs.ctx, mm_got.ctx) {
-				mmGetItemsByUserID.t.Errorf("RepositoryMock.GetItemsByUserID got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
+				mmSetPromoCode.t.Errorf("RepositoryMock.SetPromoCode got unexpected parameter ctx, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.originCtx, *mm_want_ptrs.ctx, mm_got.ctx, minimock.Diff(*mm_want_ptrs.ctx, mm_got.ctx))
 			}
 
-			if mm_want_ptrs.cartItems != nil && !minimock.Equal(*mm_want_ptrs.cartItems, mm_got.cartItems) {
-				mmGetItemsByUserID.t.Errorf("RepositoryMock.GetItemsByUserID got unexpected parameter cartItems, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-					mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.originCartItems, *mm_want_ptrs.cartItems, mm_got.cartItems, minimock.Diff(*mm_want_ptrs.cartItems, mm_got.cartItems))
+			if mm_want_ptrs.userID != nil && !minimock.Equal(*mm_want_ptrs.userID, mm_got.userID) {
+				mmSetPromoCode.t.Errorf("RepositoryMock.SetPromoCode got unexpected parameter userID, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.originUserID, *mm_want_ptrs.userID, mm_got.userID, minimock.Diff(*mm_want_ptrs.userID, mm_got.userID))
+			}
+
+			if mm_want_ptrs.code != nil && !minimock.Equal(*mm_want_ptrs.code, mm_got.code) {
+				mmSetPromoCode.t.Errorf("RepositoryMock.SetPromoCode got unexpected parameter code, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+					mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.originCode, *mm_want_ptrs.code, mm_got.code, minimock.Diff(*mm_want_ptrs.code, mm_got.code))
 			}
 
 		} else if mm_want != nil && !minimock.Equal(*mm_want, mm_got) {
-			mmGetItemsByUserID.t.Errorf("RepositoryMock.GetItemsByUserID got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
-				mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
+			mmSetPromoCode.t.Errorf("RepositoryMock.SetPromoCode got unexpected parameters, expected at\n%s:\nwant: %#v\n got: %#v%s\n",
+				mmSetPromoCode.SetPromoCodeMock.defaultExpectation.expectationOrigins.origin, *mm_want, mm_got, minimock.Diff(*mm_want, mm_got))
 		}
 
-		mm_results := mmGetItemsByUserID.GetItemsByUserIDMock.defaultExpectation.results
+		mm_results := mmSetPromoCode.SetPromoCodeMock.defaultExpectation.results
 		if mm_results == nil {
-			mmGetItemsByUserID.t.Fatal("No results are set for the RepositoryMock.GetItemsByUserID")
+			mmSetPromoCode.t.Fatal("No results are set for the RepositoryMock.SetPromoCode")
 		}
-		return (*mm_results).ca1, (*mm_results).err
+		return (*mm_results).err
 	}
-	if mmGetItemsByUserID.funcGetItemsByUserID != nil {
-		return mmGetItemsByUserID.funcGetItemsByUserID(ctx, cartItems)
+	if mmSetPromoCode.funcSetPromoCode != nil {
+		return mmSetPromoCode.funcSetPromoCode(ctx, userID, code)
 	}
-	mmGetItemsByUserID.t.Fatalf("Unexpected call to RepositoryMock.GetItemsByUserID. %v %v", ctx, cartItems)
+	mmSetPromoCode.t.Fatalf("Unexpected call to RepositoryMock.SetPromoCode. %v %v %v", ctx, userID, code)
 	return
 }
 
-// GetItemsByUserIDAfterCounter returns a count of finished RepositoryMock.GetItemsByUserID invocations
-func (mmGetItemsByUserID *RepositoryMock) GetItemsByUserIDAfterCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemsByUserID.afterGetItemsByUserIDCounter)
+// SetPromoCodeAfterCounter returns a count of finished RepositoryMock.SetPromoCode invocations
+func (mmSetPromoCode *RepositoryMock) SetPromoCodeAfterCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPromoCode.afterSetPromoCodeCounter)
 }
 
-// GetItemsByUserIDBeforeCounter returns a count of RepositoryMock.GetItemsByUserID invocations
-func (mmGetItemsByUserID *RepositoryMock) GetItemsByUserIDBeforeCounter() uint64 {
-	return mm_atomic.LoadUint64(&mmGetItemsByUserID.beforeGetItemsByUserIDCounter)
+// SetPromoCodeBeforeCounter returns a count of RepositoryMock.SetPromoCode invocations
+func (mmSetPromoCode *RepositoryMock) SetPromoCodeBeforeCounter() uint64 {
+	return mm_atomic.LoadUint64(&mmSetPromoCode.beforeSetPromoCodeCounter)
 }
 
-// Calls returns a list of arguments used in each call to RepositoryMock.GetItemsByUserID.
+// Calls returns a list of arguments used in each call to RepositoryMock.SetPromoCode.
 // The list is in the same order as the calls were made (i.e. recent calls have a higher index)
-func (mmGetItemsByUserID *mRepositoryMockGetItemsByUserID) Calls() []*RepositoryMockGetItemsByUserIDParams {
-	mmGetItemsByUserID.mutex.RLock()
+func (mmSetPromoCode *mRepositoryMockSetPromoCode) Calls() []*RepositoryMockSetPromoCodeParams {
+	mmSetPromoCode.mutex.RLock()
 
-	argCopy := make([]*RepositoryMockGetItemsByUserIDParams, len(mmGetItemsByUserID.callArgs))
-	copy(argCopy, mmGetItemsByUserID.callArgs)
+	argCopy := make([]*RepositoryMockSetPromoCodeParams, len(mmSetPromoCode.callArgs))
+	copy(argCopy, mmSetPromoCode.callArgs)
 
-	mmGetItemsByUserID.mutex.RUnlock()
+	mmSetPromoCode.mutex.RUnlock()
 
 	return argCopy
 }
 
-// MinimockGetItemsByUserIDDone returns true if the count of the GetItemsByUserID invocations corresponds
+// MinimockSetPromoCodeDone returns true if the count of the SetPromoCode invocations corresponds
 // the number of defined expectations
-func (m *RepositoryMock) MinimockGetItemsByUserIDDone() bool {
-	if m.GetItemsByUserIDMock.optional {
+func (m *RepositoryMock) MinimockSetPromoCodeDone() bool {
+	if m.SetPromoCodeMock.optional {
 		// Optional methods provide '0 or more' call count restriction.
 		return true
 	}
 
-	for _, e := range m.GetItemsByUserIDMock.expectations {
+	for _, e := range m.SetPromoCodeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
 			return false
 		}
 	}
 
-	return m.GetItemsByUserIDMock.invocationsDone()
+	return m.SetPromoCodeMock.invocationsDone()
 }
 
-// MinimockGetItemsByUserIDInspect logs each unmet expectation
-func (m *RepositoryMock) MinimockGetItemsByUserIDInspect() {
-	for _, e := range m.GetItemsByUserIDMock.expectations {
+// MinimockSetPromoCodeInspect logs each unmet expectation
+func (m *RepositoryMock) MinimockSetPromoCodeInspect() {
+	for _, e := range m.SetPromoCodeMock.expectations {
 		if mm_atomic.LoadUint64(&e.Counter) < 1 {
-			m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
+			m.t.Errorf("Expected call to RepositoryMock.SetPromoCode at\n%s with params: %#v", e.expectationOrigins.origin, *e.params)
 		}
 	}
 
-	afterGetItemsByUserIDCounter := mm_atomic.LoadUint64(&m.afterGetItemsByUserIDCounter)
+	afterSetPromoCodeCounter := mm_atomic.LoadUint64(&m.afterSetPromoCodeCounter)
 	// if default expectation was set then invocations count should be greater than zero
-	if m.GetItemsByUserIDMock.defaultExpectation != nil && afterGetItemsByUserIDCounter < 1 {
-		if m.GetItemsByUserIDMock.defaultExpectation.params == nil {
-			m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s", m.GetItemsByUserIDMock.defaultExpectation.returnOrigin)
+	if m.SetPromoCodeMock.defaultExpectation != nil && afterSetPromoCodeCounter < 1 {
+		if m.SetPromoCodeMock.defaultExpectation.params == nil {
+			m.t.Errorf("Expected call to RepositoryMock.SetPromoCode at\n%s", m.SetPromoCodeMock.defaultExpectation.returnOrigin)
 		} else {
-			m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s with params: %#v", m.GetItemsByUserIDMock.defaultExpectation.expectationOrigins.origin, *m.GetItemsByUserIDMock.defaultExpectation.params)
+			m.t.Errorf("Expected call to RepositoryMock.SetPromoCode at\n%s with params: %#v", m.SetPromoCodeMock.defaultExpectation.expectationOrigins.origin, *m.SetPromoCodeMock.defaultExpectation.params)
 		}
 	}
 	// if func was set then invocations count should be greater than zero
-	if m.funcGetItemsByUserID != nil && afterGetItemsByUserIDCounter < 1 {
-		m.t.Errorf("Expected call to RepositoryMock.GetItemsByUserID at\n%s", m.funcGetItemsByUserIDOrigin)
+	if m.funcSetPromoCode != nil && afterSetPromoCodeCounter < 1 {
+		m.t.Errorf("Expected call to RepositoryMock.SetPromoCode at\n%s", m.funcSetPromoCodeOrigin)
 	}
 
-	if !m.GetItemsByUserIDMock.invocationsDone() && afterGetItemsByUserIDCounter > 0 {
-		m.t.Errorf("Expected %d calls to RepositoryMock.GetItemsByUserID at\n%s but found %d calls",
-			mm_atomic.LoadUint64(&m.GetItemsByUserIDMock.expectedInvocations), m.GetItemsByUserIDMock.expectedInvocationsOrigin, afterGetItemsByUserIDCounter)
+	if !m.SetPromoCodeMock.invocationsDone() && afterSetPromoCodeCounter > 0 {
+		m.t.Errorf("Expected %d calls to RepositoryMock.SetPromoCode at\n%s but found %d calls",
+			mm_atomic.LoadUint64(&m.SetPromoCodeMock.expectedInvocations), m.SetPromoCodeMock.expectedInvocationsOrigin, afterSetPromoCodeCounter)
 	}
 }
 
@@ -1635,13 +7834,45 @@ func (m *RepositoryMock) MinimockFinish() {
 		if !m.minimockDone() {
 			m.MinimockAddInspect()
 
+			m.MinimockCheckoutInspect()
+
 			m.MinimockCloseInspect()
 
+			m.MinimockCompleteIdempotencyKeyInspect()
+
 			m.MinimockDeleteAllItemsFromCartInspect()
 
 			m.MinimockDeleteItemsBySkuInspect()
 
+			m.MinimockDeleteSavedItemInspect()
+
+			m.MinimockGetAbandonedCartsInspect()
+
+			m.MinimockGetCheckoutOrderIDInspect()
+
 			m.MinimockGetItemsByUserIDInspect()
+
+			m.MinimockGetPromoCodeInspect()
+
+			m.MinimockGetSavedItemsInspect()
+
+			m.MinimockGetVersionInspect()
+
+			m.MinimockMarkAbandonedReportedInspect()
+
+			m.MinimockMergeInspect()
+
+			m.MinimockMoveToCartInspect()
+
+			m.MinimockReleaseIdempotencyKeyInspect()
+
+			m.MinimockReserveIdempotencyKeyInspect()
+
+			m.MinimockSaveForLaterInspect()
+
+			m.MinimockSetCountInspect()
+
+			m.MinimockSetPromoCodeInspect()
 		}
 	})
 }
@@ -1666,8 +7897,24 @@ func (m *RepositoryMock) minimockDone() bool {
 	done := true
 	return done &&
 		m.MinimockAddDone() &&
+		m.MinimockCheckoutDone() &&
 		m.MinimockCloseDone() &&
+		m.MinimockCompleteIdempotencyKeyDone() &&
 		m.MinimockDeleteAllItemsFromCartDone() &&
 		m.MinimockDeleteItemsBySkuDone() &&
-		m.MinimockGetItemsByUserIDDone()
+		m.MinimockDeleteSavedItemDone() &&
+		m.MinimockGetAbandonedCartsDone() &&
+		m.MinimockGetCheckoutOrderIDDone() &&
+		m.MinimockGetItemsByUserIDDone() &&
+		m.MinimockGetPromoCodeDone() &&
+		m.MinimockGetSavedItemsDone() &&
+		m.MinimockGetVersionDone() &&
+		m.MinimockMarkAbandonedReportedDone() &&
+		m.MinimockMergeDone() &&
+		m.MinimockMoveToCartDone() &&
+		m.MinimockReleaseIdempotencyKeyDone() &&
+		m.MinimockReserveIdempotencyKeyDone() &&
+		m.MinimockSaveForLaterDone() &&
+		m.MinimockSetCountDone() &&
+		m.MinimockSetPromoCodeDone()
 }
@@ -3,28 +3,48 @@ package service
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"math"
+	"slices"
 	"sort"
+	"time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
-	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/errgroup"
 	pbLoms "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/loms/api/v1"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
 	"go.opentelemetry.io/otel/trace"
-	"golang.org/x/time/rate"
 )
 
 // ProductService ...
 type ProductService interface {
 	GetProductBySku(ctx context.Context, sku int64) (*model.GetProductResponse, error)
+	GetProductsBySkus(ctx context.Context, skus []int64) (map[int64]*model.GetProductResponse, error)
+	GetLastKnownProducts(skus []int64) map[int64]*model.GetProductResponse
 }
 
 // Repository ...
 type Repository interface {
 	Add(ctx context.Context, cartItems model.RequestData) error
+	SetCount(ctx context.Context, cartItems model.RequestData) error
 	DeleteItemsBySku(ctx context.Context, cartItems model.RequestData) error
 	DeleteAllItemsFromCart(ctx context.Context, cartItems model.RequestData) error
 	GetItemsByUserID(ctx context.Context, cartItems model.RequestData) ([]model.Cart, error)
+	Checkout(ctx context.Context, userID int64, items []model.Cart, orderID int64) error
+	GetCheckoutOrderID(ctx context.Context, userID int64) (int64, error)
+	SetPromoCode(ctx context.Context, userID int64, code string) error
+	GetPromoCode(ctx context.Context, userID int64) (string, error)
+	SaveForLater(ctx context.Context, userID, sku int64) error
+	MoveToCart(ctx context.Context, item model.RequestData) error
+	GetSavedItems(ctx context.Context, userID int64) ([]model.Cart, error)
+	DeleteSavedItem(ctx context.Context, userID, sku int64) error
+	Merge(ctx context.Context, targetUserID, sourceUserID int64, source []model.Cart, available map[int64]uint32, limits model.CartLimits) ([]model.MergedLine, error)
+	GetAbandonedCarts(ctx context.Context, before time.Time, limit int) ([]model.AbandonedCart, error)
+	MarkAbandonedReported(ctx context.Context, userID int64, updatedAt time.Time) error
+	GetVersion(ctx context.Context, userID int64) (uint64, error)
+	ReserveIdempotencyKey(ctx context.Context, userID int64, key string, ttl time.Duration) (model.IdempotencyRecord, bool, error)
+	CompleteIdempotencyKey(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error
 	Close()
 }
 
@@ -32,6 +52,18 @@ type Repository interface {
 type Loms interface {
 	CreateOrder(ctx context.Context, req *pbLoms.OrderCreateRequest) (*pbLoms.OrderCreateResponse, error)
 	GetStocksInfo(ctx context.Context, req *pbLoms.StocksInfoRequest) (*pbLoms.StocksInfoResponse, error)
+	CancelOrder(ctx context.Context, req *pbLoms.OrderCancelRequest) error
+}
+
+// PromoEngine ...
+type PromoEngine interface {
+	Apply(code string, items []model.Item, subtotal uint64) ([]model.DiscountLine, error)
+}
+
+// EventProducer ...
+type EventProducer interface {
+	Send(event model.CartEvent)
+	SendAbandonedCart(cart model.AbandonedCart) error
 }
 
 // Tracer ...
@@ -39,28 +71,35 @@ type Tracer interface {
 	Start(ctx context.Context, spanName string, opts ...trace.SpanStartOption) (context.Context, trace.Span)
 }
 
-const (
-	// countGoroutines ...
-	countGoroutines = 3
-)
-
 // Service ...
 type Service struct {
 	productService ProductService
 	Repository     Repository
 	loms           Loms
-	limiterPS      *rate.Limiter
 	tracer         Tracer
+	limits         model.CartLimits
+	promo          PromoEngine
+	events         EventProducer
 }
 
-// NewService ...
-func NewService(productService ProductService, repo Repository, loms Loms, limiterPS *rate.Limiter, Tracer Tracer) *Service {
+// NewService promo nil - промокоды отключены, events nil - события корзины не отправляются
+func NewService(
+	productService ProductService,
+	repo Repository,
+	loms Loms,
+	Tracer Tracer,
+	limits model.CartLimits,
+	promo PromoEngine,
+	events EventProducer,
+) *Service {
 	return &Service{
 		productService: productService,
 		Repository:     repo,
 		loms:           loms,
-		limiterPS:      limiterPS,
 		tracer:         Tracer,
+		limits:         limits,
+		promo:          promo,
+		events:         events,
 	}
 }
 
@@ -68,9 +107,6 @@ func NewService(productService ProductService, repo Repository, loms Loms, limit
 func (s *Service) AddItem(ctx context.Context, dataCart model.RequestData) error {
 	ctx, span := s.tracer.Start(ctx, "CartService:AddItem")
 	defer span.End()
-	if err := s.limiterPS.Wait(ctx); err != nil {
-		return fmt.Errorf("limiter.Wait: %w", err)
-	}
 
 	product, err := s.productService.GetProductBySku(ctx, dataCart.Sku)
 	if err != nil {
@@ -90,10 +126,77 @@ func (s *Service) AddItem(ctx context.Context, dataCart model.RequestData) error
 		return model.ErrAddedMoreItemThanInStock
 	}
 
+	dataCart.Price, err = SafeInt64ToUint32(product.Price)
+	if err != nil {
+		return fmt.Errorf("safeInt64ToUint32: %w", err)
+	}
+
+	if s.limits.Enabled() {
+		items, err := s.cartItems(ctx, dataCart.UserID)
+		if err != nil {
+			return err
+		}
+
+		inCart, _ := countBySku(items, dataCart.Sku)
+		if err := s.checkLimits(items, dataCart.Sku, uint64(inCart)+uint64(dataCart.Count), dataCart.Price); err != nil {
+			return err
+		}
+	}
+
 	if err := s.Repository.Add(ctx, dataCart); err != nil {
 		return fmt.Errorf("repository.AddItemsToCart: %w", err)
 	}
 
+	s.publish(dataCart.UserID, dataCart.Sku, int64(dataCart.Count), model.CartEventAdd)
+
+	return nil
+}
+
+// SetItemCount устанавливает количество товара в корзине, 0 удаляет товар
+func (s *Service) SetItemCount(ctx context.Context, dataCart model.RequestData) error {
+	ctx, span := s.tracer.Start(ctx, "CartService:SetItemCount")
+	defer span.End()
+
+	if dataCart.Count > 0 {
+		freeStock, err := s.StocksInfo(ctx, dataCart.Sku)
+		if err != nil {
+			return err
+		}
+
+		if dataCart.Count > freeStock {
+			return model.ErrAddedMoreItemThanInStock
+		}
+	}
+
+	var items []model.Cart
+	if (dataCart.Count > 0 && s.limits.Enabled()) || s.events != nil {
+		var err error
+		items, err = s.cartItems(ctx, dataCart.UserID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if dataCart.Count > 0 && s.limits.Enabled() {
+		// уменьшать количество можно всегда, даже если корзина собрана до изменения лимитов
+		if i := slices.IndexFunc(items, func(c model.Cart) bool { return c.SkuID == dataCart.Sku }); i >= 0 && dataCart.Count > items[i].Count {
+			if err := s.checkLimits(items, dataCart.Sku, uint64(dataCart.Count), items[i].Price); err != nil {
+				return err
+			}
+		}
+	}
+
+	if err := s.Repository.SetCount(ctx, dataCart); err != nil {
+		return fmt.Errorf("repository.SetCount: %w", err)
+	}
+
+	reason := model.CartEventSetCount
+	if dataCart.Count == 0 {
+		reason = model.CartEventRemove
+	}
+	inCart, _ := countBySku(items, dataCart.Sku)
+	s.publish(dataCart.UserID, dataCart.Sku, int64(dataCart.Count)-int64(inCart), reason)
+
 	return nil
 }
 
@@ -102,11 +205,21 @@ func (s *Service) DeleteItem(ctx context.Context, data model.RequestData) error
 	ctx, span := s.tracer.Start(ctx, "CartService:DeleteItem")
 	defer span.End()
 
-	if err := s.Repository.DeleteItemsBySku(ctx, data); err != nil {
+	items, err := s.itemsForEvents(ctx, data.UserID)
+	if err != nil {
+		return err
+	}
+
+	// репозитории сообщают об успешном удалении через ErrNoContent
+	err = s.Repository.DeleteItemsBySku(ctx, data)
+	if err != nil && !errors.Is(err, model.ErrNoContent) {
 		return fmt.Errorf("repository.DeleteItemsBySku: %w", err)
 	}
 
-	return nil
+	inCart, _ := countBySku(items, data.Sku)
+	s.publish(data.UserID, data.Sku, -int64(inCart), model.CartEventRemove)
+
+	return err
 }
 
 // DeleteItemsByUserID ...
@@ -114,112 +227,603 @@ func (s *Service) DeleteItemsByUserID(ctx context.Context, data model.RequestDat
 	ctx, span := s.tracer.Start(ctx, "CartService:DeleteItemsByUserID")
 	defer span.End()
 
-	if err := s.Repository.DeleteAllItemsFromCart(ctx, data); err != nil {
+	items, err := s.itemsForEvents(ctx, data.UserID)
+	if err != nil {
+		return err
+	}
+
+	err = s.Repository.DeleteAllItemsFromCart(ctx, data)
+	if err != nil && !errors.Is(err, model.ErrNoContent) {
 		return fmt.Errorf("repository.DeleteAllItemsFromCart: %w", err)
 	}
 
-	return nil
+	for _, item := range items {
+		s.publish(data.UserID, item.SkuID, -int64(item.Count), model.CartEventClear)
+	}
+
+	return err
 }
 
-// GetItemsFromCart ...
+// GetItemsFromCart пустая корзина отдается без ошибки с пустым списком позиций.
+// Если product-service недоступен, корзина собирается из последних известных данных
+// о товарах и помечается Degraded, позиции без данных помечаются Unavailable
 func (s *Service) GetItemsFromCart(ctx context.Context, data model.RequestData) (*model.GetItemsFromCartResponce, error) {
 	ctx, span := s.tracer.Start(ctx, "CartService:GetItemsFromCart")
 	defer span.End()
 
 	itemsCart, err := s.Repository.GetItemsByUserID(ctx, data)
 	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return s.checkedOutCart(ctx, data.UserID)
+		}
 		return nil, fmt.Errorf("repository.GetItemsByUserID: %w", err)
 	}
 
 	if len(itemsCart) < 1 {
-		return nil, model.ErrNotFound
+		return s.checkedOutCart(ctx, data.UserID)
+	}
+
+	response, err := s.resolveItems(ctx, itemsCart)
+	if err != nil {
+		return nil, err
+	}
+
+	if s.promo != nil {
+		code, err := s.Repository.GetPromoCode(ctx, data.UserID)
+		if err != nil {
+			return nil, fmt.Errorf("repository.GetPromoCode: %w", err)
+		}
+		if code != "" {
+			s.applyPromo(response, code)
+		}
+	}
+
+	return response, nil
+}
+
+// ApplyPromoCode применяет промокод к корзине и возвращает корзину с пересчитанным итогом.
+// Промокод, который не дает скидку на текущее содержимое корзины, не применяется
+func (s *Service) ApplyPromoCode(ctx context.Context, userID int64, code string) (*model.GetItemsFromCartResponce, error) {
+	ctx, span := s.tracer.Start(ctx, "CartService:ApplyPromoCode")
+	defer span.End()
+
+	if s.promo == nil {
+		return nil, model.ErrPromoCodeNotFound
+	}
+
+	itemsCart, err := s.Repository.GetItemsByUserID(ctx, model.RequestData{UserID: userID})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, model.ErrNotFound
+		}
+		return nil, fmt.Errorf("repository.GetItemsByUserID: %w", err)
+	}
+
+	response, err := s.resolveItems(ctx, itemsCart)
+	if err != nil {
+		return nil, err
+	}
+
+	code = model.NormalizePromoCode(code)
+	if _, err := s.promo.Apply(code, response.Items, response.Subtotal); err != nil {
+		return nil, err
+	}
+
+	if err := s.Repository.SetPromoCode(ctx, userID, code); err != nil {
+		return nil, fmt.Errorf("repository.SetPromoCode: %w", err)
 	}
 
+	s.applyPromo(response, code)
+
+	return response, nil
+}
+
+// RemovePromoCode ...
+func (s *Service) RemovePromoCode(ctx context.Context, userID int64) error {
+	ctx, span := s.tracer.Start(ctx, "CartService:RemovePromoCode")
+	defer span.End()
+
+	if err := s.Repository.SetPromoCode(ctx, userID, ""); err != nil {
+		return fmt.Errorf("repository.SetPromoCode: %w", err)
+	}
+
+	return nil
+}
+
+// applyPromo пересчитывает итог корзины по промокоду. Если промокод перестал подходить к корзине
+// (например, после удаления товаров), он остается примененным, а причина отдается в PromoNotApplied
+func (s *Service) applyPromo(response *model.GetItemsFromCartResponce, code string) {
+	response.PromoCode = code
+
+	lines, err := s.promo.Apply(code, response.Items, response.Subtotal)
+	if err != nil {
+		response.PromoNotApplied = err.Error()
+		return
+	}
+
+	var discount uint64
+	for _, line := range lines {
+		discount += line.Amount
+	}
+
+	response.Discounts = lines
+	response.TotalPrice = response.Subtotal - discount
+}
+
+// resolveItems дополняет позиции названиями и текущими ценами из product-service. Если product-service
+// недоступен, берутся последние известные данные, ответ помечается Degraded, позиции без данных - Unavailable
+func (s *Service) resolveItems(ctx context.Context, itemsCart []model.Cart) (*model.GetItemsFromCartResponce, error) {
 	response := &model.GetItemsFromCartResponce{
-		Items:      []model.Item{},
-		TotalPrice: 0,
-	}
-
-	ch := make(chan model.Cart, len(itemsCart))
-	chRespItem := make(chan model.Item, len(itemsCart))
-	g, gCtx := errgroup.WithContext(ctx)
-
-	for i := 0; i < countGoroutines; i++ {
-		g.Go(func() error {
-			for item := range ch {
-				//nolint:govet
-				if err := s.limiterPS.Wait(gCtx); err != nil {
-					return fmt.Errorf("limiter.Wait: %w", err)
-				}
-				//nolint:govet
-				itemsPS, err := s.productService.GetProductBySku(gCtx, item.SkuID)
-				if err != nil {
-					return fmt.Errorf("productService.GetProductBySku: %w", err)
-				}
-
-				safePrice, err := SafeInt64ToUint32(itemsPS.Price)
-				if err != nil {
-					return fmt.Errorf("safeInt64ToUint32: %w", err)
-				}
-
-				respItem := model.Item{
-					Sku:   item.SkuID,
-					Name:  itemsPS.Name,
-					Count: item.Count,
-					Price: safePrice,
-				}
-
-				select {
-				case chRespItem <- respItem:
-				case <-gCtx.Done():
-					return gCtx.Err()
-				}
-			}
-			return nil
-		})
+		Items: []model.Item{},
 	}
 
+	skus := make([]int64, 0, len(itemsCart))
 	for _, item := range itemsCart {
-		ch <- item
+		skus = append(skus, item.SkuID)
 	}
 
-	close(ch)
-
-	if err = g.Wait(); err != nil {
-		return nil, fmt.Errorf("g.Wait : %v", err)
+	products, err := s.productService.GetProductsBySkus(ctx, skus)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("productService.GetProductsBySkus: %w", err)
+		}
+		logger.Errorw(fmt.Sprintf("GetProductsBySkus : %v, using last known products", err), "span", trace.SpanFromContext(ctx))
+		products = s.productService.GetLastKnownProducts(skus)
+		response.Degraded = true
 	}
 
-	close(chRespItem)
+	items := make([]model.Item, 0, len(itemsCart))
+	var totalPrice uint64
+	for _, item := range itemsCart {
+		product, ok := products[item.SkuID]
+		if !ok && !response.Degraded {
+			return nil, fmt.Errorf("productService.GetProductsBySkus: sku %d: %w", item.SkuID, model.ErrProductNotFound)
+		}
+		if !ok {
+			respItem := unavailableItem(item)
+			items = append(items, respItem)
+			totalPrice += uint64(respItem.Price) * uint64(respItem.Count)
+			continue
+		}
+
+		safePrice, err := SafeInt64ToUint32(product.Price)
+		if err != nil {
+			return nil, fmt.Errorf("safeInt64ToUint32: %w", err)
+		}
 
-	var items []model.Item
-	var totalPrice uint32
-	for item := range chRespItem {
-		items = append(items, item)
-		totalPrice += item.Price * item.Count
+		respItem := model.Item{
+			Sku:   item.SkuID,
+			Name:  product.Name,
+			Count: item.Count,
+			Price: safePrice,
+		}
+		setSavedPrice(&respItem, item.Price)
+
+		items = append(items, respItem)
+		totalPrice += uint64(respItem.Price) * uint64(respItem.Count)
+		response.PriceChanged = response.PriceChanged || respItem.PriceChanged
 	}
 
 	sortItem := sortItems(items)
 	response.Items = sortItem
+	response.Subtotal = totalPrice
 	response.TotalPrice = totalPrice
 
 	return response, nil
 }
 
-// OrderCreate ...
+// SaveForLater переносит позицию из корзины в отложенные
+func (s *Service) SaveForLater(ctx context.Context, data model.RequestData) error {
+	ctx, span := s.tracer.Start(ctx, "CartService:SaveForLater")
+	defer span.End()
+
+	items, err := s.itemsForEvents(ctx, data.UserID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.Repository.SaveForLater(ctx, data.UserID, data.Sku); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ErrNotFound
+		}
+		return fmt.Errorf("repository.SaveForLater: %w", err)
+	}
+
+	inCart, _ := countBySku(items, data.Sku)
+	s.publish(data.UserID, data.Sku, -int64(inCart), model.CartEventRemove)
+
+	return nil
+}
+
+// MoveToCart возвращает отложенную позицию в корзину. Как и при добавлении, проверяется остаток
+// с учетом того, что уже лежит в корзине, и запоминается текущая цена товара
+func (s *Service) MoveToCart(ctx context.Context, data model.RequestData) error {
+	ctx, span := s.tracer.Start(ctx, "CartService:MoveToCart")
+	defer span.End()
+
+	saved, err := s.Repository.GetSavedItems(ctx, data.UserID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ErrNotFound
+		}
+		return fmt.Errorf("repository.GetSavedItems: %w", err)
+	}
+
+	savedCount, ok := countBySku(saved, data.Sku)
+	if !ok {
+		return model.ErrNotFound
+	}
+
+	inCart, err := s.cartItems(ctx, data.UserID)
+	if err != nil {
+		return err
+	}
+	cartCount, _ := countBySku(inCart, data.Sku)
+
+	product, err := s.productService.GetProductBySku(ctx, data.Sku)
+	if err != nil {
+		return err
+	}
+
+	freeStock, err := s.StocksInfo(ctx, data.Sku)
+	if err != nil {
+		return err
+	}
+
+	if uint64(savedCount)+uint64(cartCount) > uint64(freeStock) {
+		return model.ErrAddedMoreItemThanInStock
+	}
+
+	data.Price, err = SafeInt64ToUint32(product.Price)
+	if err != nil {
+		return fmt.Errorf("safeInt64ToUint32: %w", err)
+	}
+
+	if err := s.checkLimits(inCart, data.Sku, uint64(savedCount)+uint64(cartCount), data.Price); err != nil {
+		return err
+	}
+
+	if err := s.Repository.MoveToCart(ctx, data); err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return model.ErrNotFound
+		}
+		return fmt.Errorf("repository.MoveToCart: %w", err)
+	}
+
+	s.publish(data.UserID, data.Sku, int64(savedCount), model.CartEventAdd)
+
+	return nil
+}
+
+// GetSavedItems отложенные товары с названиями и ценами из product-service
+func (s *Service) GetSavedItems(ctx context.Context, data model.RequestData) (*model.GetSavedItemsResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "CartService:GetSavedItems")
+	defer span.End()
+
+	saved, err := s.Repository.GetSavedItems(ctx, data.UserID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, model.ErrNotFound
+		}
+		return nil, fmt.Errorf("repository.GetSavedItems: %w", err)
+	}
+
+	resolved, err := s.resolveItems(ctx, saved)
+	if err != nil {
+		return nil, err
+	}
+
+	return &model.GetSavedItemsResponse{
+		Items:    resolved.Items,
+		Degraded: resolved.Degraded,
+	}, nil
+}
+
+// DeleteSavedItem ...
+func (s *Service) DeleteSavedItem(ctx context.Context, data model.RequestData) error {
+	ctx, span := s.tracer.Start(ctx, "CartService:DeleteSavedItem")
+	defer span.End()
+
+	if err := s.Repository.DeleteSavedItem(ctx, data.UserID, data.Sku); err != nil {
+		return fmt.Errorf("repository.DeleteSavedItem: %w", err)
+	}
+
+	return nil
+}
+
+// cartItems позиции корзины, пустая корзина не считается ошибкой
+func (s *Service) cartItems(ctx context.Context, userID int64) ([]model.Cart, error) {
+	items, err := s.Repository.GetItemsByUserID(ctx, model.RequestData{UserID: userID})
+	if err != nil && !errors.Is(err, model.ErrNotFound) {
+		return nil, fmt.Errorf("repository.GetItemsByUserID: %w", err)
+	}
+
+	return items, nil
+}
+
+// itemsForEvents позиции корзины до изменения, нужны только для расчета delta в событиях корзины
+func (s *Service) itemsForEvents(ctx context.Context, userID int64) ([]model.Cart, error) {
+	if s.events == nil {
+		return nil, nil
+	}
+
+	return s.cartItems(ctx, userID)
+}
+
+// publish отправляет событие изменения корзины, если продюсер событий настроен
+func (s *Service) publish(userID, sku, delta int64, reason string) {
+	if s.events == nil || delta == 0 {
+		return
+	}
+
+	s.events.Send(model.CartEvent{
+		UserID:    userID,
+		Sku:       sku,
+		Delta:     delta,
+		Timestamp: time.Now(),
+		Reason:    reason,
+	})
+}
+
+// checkLimits проверяет лимиты корзины items, если у позиции sku станет count штук по цене price
+func (s *Service) checkLimits(items []model.Cart, sku int64, count uint64, price uint32) error {
+	if s.limits.MaxSkuCount > 0 && count > uint64(s.limits.MaxSkuCount) {
+		return &model.SkuQuantityLimitError{Sku: sku, Max: s.limits.MaxSkuCount}
+	}
+
+	isNew := true
+	total := count * uint64(price)
+	for _, item := range items {
+		if item.SkuID == sku {
+			isNew = false
+			continue
+		}
+		total += uint64(item.Count) * uint64(item.Price)
+	}
+
+	if isNew && s.limits.MaxLines > 0 && len(items) >= s.limits.MaxLines {
+		return &model.CartLinesLimitError{Max: s.limits.MaxLines}
+	}
+
+	if s.limits.MaxTotal > 0 && total > s.limits.MaxTotal {
+		return &model.CartTotalLimitError{Max: s.limits.MaxTotal}
+	}
+
+	return nil
+}
+
+// countBySku ...
+func countBySku(items []model.Cart, sku int64) (uint32, bool) {
+	for _, item := range items {
+		if item.SkuID == sku {
+			return item.Count, true
+		}
+	}
+
+	return 0, false
+}
+
+// unavailableItem позиция, о товаре которой нет данных, отдается по цене на момент добавления в корзину
+func unavailableItem(item model.Cart) model.Item {
+	return model.Item{
+		Sku:         item.SkuID,
+		Count:       item.Count,
+		Price:       item.Price,
+		SavedPrice:  item.Price,
+		Unavailable: true,
+	}
+}
+
+// setSavedPrice проставляет цену на момент добавления в корзину и признак ее изменения.
+// Для позиций без сохраненной цены изменение не отмечается
+func setSavedPrice(item *model.Item, savedPrice uint32) {
+	if savedPrice == 0 {
+		item.SavedPrice = item.Price
+		return
+	}
+
+	item.SavedPrice = savedPrice
+	item.PriceChanged = savedPrice != item.Price
+}
+
+// GetCartVersion версия корзины меняется при каждом изменении позиций, промокода и оформлении заказа
+func (s *Service) GetCartVersion(ctx context.Context, userID int64) (uint64, error) {
+	ctx, span := s.tracer.Start(ctx, "CartService:GetCartVersion")
+	defer span.End()
+
+	version, err := s.Repository.GetVersion(ctx, userID)
+	if err != nil {
+		return 0, fmt.Errorf("repository.GetVersion: %w", err)
+	}
+
+	return version, nil
+}
+
+// checkedOutCart пустая корзина, которая была оформлена заказом, отдается вместе с номером заказа
+func (s *Service) checkedOutCart(ctx context.Context, userID int64) (*model.GetItemsFromCartResponce, error) {
+	orderID, err := s.Repository.GetCheckoutOrderID(ctx, userID)
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return &model.GetItemsFromCartResponce{Items: []model.Item{}}, nil
+		}
+		return nil, fmt.Errorf("repository.GetCheckoutOrderID: %w", err)
+	}
+
+	return &model.GetItemsFromCartResponce{
+		Items:             []model.Item{},
+		CheckedOutOrderID: orderID,
+	}, nil
+}
+
+// OrderCreate корзина, собранная без product-service, не оформляется, потому что цены в ней не подтверждены
 func (s *Service) OrderCreate(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce) (int64, error) {
 	ctx, span := s.tracer.Start(ctx, "CartService:OrderCreate")
 	defer span.End()
+
+	if items.Degraded {
+		return 0, model.ErrPricesNotConfirmed
+	}
+
+	if err := s.checkStocks(ctx, items); err != nil {
+		return 0, err
+	}
+
 	req := convertToOrderCreateRequest(items)
 	req.UserID = UserID
 
 	resp, err := s.loms.CreateOrder(ctx, req)
 	if err != nil {
-		return resp.OrderID, err
+		return 0, err
 	}
 
 	return resp.OrderID, nil
 }
 
+// ClaimCart помечает корзину оформленной заказом orderID. Если корзина изменилась с момента
+// создания заказа или ее не удалось пометить, заказ в loms отменяется, чтобы не оформить товары дважды
+func (s *Service) ClaimCart(ctx context.Context, UserID int64, items *model.GetItemsFromCartResponce, orderID int64) error {
+	ctx, span := s.tracer.Start(ctx, "CartService:ClaimCart")
+	defer span.End()
+
+	err := s.Repository.Checkout(ctx, UserID, convertToCart(items), orderID)
+	if err == nil {
+		for _, item := range items.Items {
+			s.publish(UserID, item.Sku, -int64(item.Count), model.CartEventCheckout)
+		}
+		return nil
+	}
+
+	// отмена не должна прерываться вместе с запросом клиента, иначе заказ останется в loms
+	cancelCtx := context.WithoutCancel(ctx)
+	if cancelErr := s.loms.CancelOrder(cancelCtx, &pbLoms.OrderCancelRequest{OrderID: orderID}); cancelErr != nil {
+		return fmt.Errorf("loms.CancelOrder %d: %w (repository.Checkout: %v)", orderID, cancelErr, err)
+	}
+
+	if errors.Is(err, model.ErrCartChanged) {
+		return err
+	}
+
+	return fmt.Errorf("repository.Checkout: %w", err)
+}
+
+// MergeCart переносит позиции корзины sourceUserID в корзину targetUserID, суммируя количества.
+// Количество ограничивается свободным остатком, в ответе возвращаются урезанные позиции
+func (s *Service) MergeCart(ctx context.Context, targetUserID, sourceUserID int64) (*model.MergeCartResponse, error) {
+	ctx, span := s.tracer.Start(ctx, "CartService:MergeCart")
+	defer span.End()
+
+	source, err := s.Repository.GetItemsByUserID(ctx, model.RequestData{UserID: sourceUserID})
+	if err != nil {
+		if errors.Is(err, model.ErrNotFound) {
+			return nil, model.ErrNotFound
+		}
+		return nil, fmt.Errorf("repository.GetItemsByUserID: %w", err)
+	}
+
+	available := make(map[int64]uint32, len(source))
+	for _, item := range source {
+		freeStock, err := s.StocksInfo(ctx, item.SkuID)
+		if err != nil {
+			return nil, fmt.Errorf("StocksInfo: %w", err)
+		}
+		available[item.SkuID] = freeStock
+	}
+
+	// лимиты проверяются в репозитории вместе с содержимым целевой корзины на момент переноса
+	lines, err := s.Repository.Merge(ctx, targetUserID, sourceUserID, source, available, s.limits)
+	if err != nil {
+		if errors.Is(err, model.ErrCartChanged) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("repository.Merge: %w", err)
+	}
+
+	for _, item := range source {
+		s.publish(sourceUserID, item.SkuID, -int64(item.Count), model.CartEventClear)
+	}
+
+	response := &model.MergeCartResponse{Adjusted: []model.MergedLine{}}
+	for _, line := range lines {
+		// Requested - сумма позиции в обеих корзинах, в целевой было Requested минус перенесенное из source
+		sourceCount, _ := countBySku(source, line.Sku)
+		s.publish(targetUserID, line.Sku, int64(line.Merged)-(int64(line.Requested)-int64(sourceCount)), model.CartEventAdd)
+
+		if line.Merged < line.Requested {
+			response.Adjusted = append(response.Adjusted, line)
+		}
+	}
+
+	sort.Slice(response.Adjusted, func(i, j int) bool {
+		return response.Adjusted[i].Sku < response.Adjusted[j].Sku
+	})
+
+	return response, nil
+}
+
+// ReportAbandonedCarts отправляет события о корзинах, которые не менялись дольше threshold, и помечает их отправленными.
+// Корзина, измененная после отправки, может быть отправлена снова
+func (s *Service) ReportAbandonedCarts(ctx context.Context, threshold time.Duration, limit int) {
+	if s.events == nil {
+		return
+	}
+
+	ctx, span := s.tracer.Start(ctx, "CartService:ReportAbandonedCarts")
+	defer span.End()
+
+	carts, err := s.Repository.GetAbandonedCarts(ctx, time.Now().Add(-threshold), limit)
+	if err != nil {
+		logger.Errorw(fmt.Sprintf("GetAbandonedCarts: %v", err))
+		return
+	}
+
+	for _, cart := range carts {
+		cart.Items, err = s.cartItems(ctx, cart.UserID)
+		if err != nil {
+			logger.Errorw(fmt.Sprintf("cartItems user_id=%d: %v", cart.UserID, err))
+			continue
+		}
+
+		// пустая корзина помечается без отправки, чтобы не попадать в выборку снова
+		if len(cart.Items) > 0 {
+			if err = s.events.SendAbandonedCart(cart); err != nil {
+				logger.Errorw(fmt.Sprintf("SendAbandonedCart user_id=%d: %v", cart.UserID, err))
+				continue
+			}
+		}
+
+		if err = s.Repository.MarkAbandonedReported(ctx, cart.UserID, cart.UpdatedAt); err != nil {
+			logger.Errorw(fmt.Sprintf("MarkAbandonedReported user_id=%d: %v", cart.UserID, err))
+		}
+	}
+}
+
+// checkStocks проверяет наличие всех позиций корзины до создания заказа в loms,
+// чтобы не создавать заказ, который упадет в failed
+func (s *Service) checkStocks(ctx context.Context, items *model.GetItemsFromCartResponce) error {
+	var shortages []model.StockShortage
+
+	for _, item := range items.Items {
+		freeStock, err := s.StocksInfo(ctx, item.Sku)
+		if err != nil {
+			return fmt.Errorf("StocksInfo: %w", err)
+		}
+
+		if item.Count > freeStock {
+			shortages = append(shortages, model.StockShortage{
+				Sku:       item.Sku,
+				Requested: item.Count,
+				Available: freeStock,
+			})
+		}
+	}
+
+	if len(shortages) > 0 {
+		return &model.InsufficientStockError{Items: shortages}
+	}
+
+	return nil
+}
+
 // StocksInfo ...
 func (s *Service) StocksInfo(ctx context.Context, sku int64) (uint32, error) {
 	ctx, span := s.tracer.Start(ctx, "CartService:StocksInfo")
@@ -249,9 +853,28 @@ func convertToOrderCreateRequest(items *model.GetItemsFromCartResponce) *pbLoms.
 		req.Items = append(req.Items, pbItem)
 	}
 
+	// промокод, который сейчас не дает скидку, в заказ не передаем
+	if items.PromoNotApplied == "" {
+		req.PromoCode = items.PromoCode
+	}
+	req.TotalPrice = items.TotalPrice
+
 	return &req
 }
 
+func convertToCart(items *model.GetItemsFromCartResponce) []model.Cart {
+	carts := make([]model.Cart, 0, len(items.Items))
+
+	for _, item := range items.Items {
+		carts = append(carts, model.Cart{
+			SkuID: item.Sku,
+			Count: item.Count,
+		})
+	}
+
+	return carts
+}
+
 // SafeInt64ToUint32 функция преобразования int64 в uint32, чтобы линтер не ругался
 func SafeInt64ToUint32(val int64) (uint32, error) {
 	if val < 0 || val > int64(math.MaxUint32) {
@@ -2,11 +2,14 @@ package service
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
-	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/errgroup"
+	pbLoms "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/loms/api/v1"
 	"github.com/gojuno/minimock/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -60,34 +63,15 @@ func TestService_GetItemsFromCart(t *testing.T) {
 					Expect(minimock.AnyContext, testData).
 					Return([]model.Cart{testRepoResp[0]}, nil)
 
-				ch := make(chan model.Cart, len(testRepoResp))
-				g, gCtx := errgroup.WithContext(context.Background())
-				for i := 0; i < countGoroutines; i++ {
-					g.Go(func() error {
-						for item := range ch {
-							if err := tc.service.limiterPS.Wait(gCtx); err != nil {
-								t.Logf("err : %v", err)
-							}
-							tc.mockPS.GetProductBySkuMock.
-								Expect(minimock.AnyContext, item.SkuID).
-								Return(&model.GetProductResponse{
-									Name:  "test",
-									Price: 100,
-									Sku:   item.SkuID,
-								}, nil)
-						}
-						return nil
-					})
-				}
-
-				ch <- testRepoResp[0]
-
-				close(ch)
-
-				if err := g.Wait(); err != nil {
-					t.Logf("g.Wait() : %v", err)
-				}
-
+				tc.mockPS.GetProductsBySkusMock.
+					Expect(minimock.AnyContext, []int64{testRepoResp[0].SkuID}).
+					Return(map[int64]*model.GetProductResponse{
+						testRepoResp[0].SkuID: {
+							Name:  "test",
+							Price: 100,
+							Sku:   testRepoResp[0].SkuID,
+						},
+					}, nil)
 			},
 			expectedResp: &model.GetItemsFromCartResponce{
 				Items: []model.Item{
@@ -98,7 +82,7 @@ func TestService_GetItemsFromCart(t *testing.T) {
 						Price: safePriceSku,
 					},
 				},
-				TotalPrice: safePriceSku * testRepoResp[0].Count,
+				TotalPrice: uint64(safePriceSku) * uint64(testRepoResp[0].Count),
 			},
 		},
 		{
@@ -116,45 +100,18 @@ func TestService_GetItemsFromCart(t *testing.T) {
 					Expect(minimock.AnyContext, testData).
 					Return(testRepoResp, nil)
 
+				products := make(map[int64]*model.GetProductResponse, len(testRepoResp))
 				for _, item := range testRepoResp {
-
-					tc.mockPS.GetProductBySkuMock.When(minimock.AnyContext, item.SkuID).
-						Then(&model.GetProductResponse{
-							Name:  "test",
-							Price: 100,
-							Sku:   item.SkuID,
-						}, nil)
-				}
-
-				ch := make(chan model.Cart, len(testRepoResp))
-				g, gCtx := errgroup.WithContext(context.Background())
-				for i := 0; i < countGoroutines; i++ {
-					g.Go(func() error {
-						for item := range ch {
-							if err := tc.service.limiterPS.Wait(gCtx); err != nil {
-								t.Logf("err : %v", err)
-								return err
-							}
-							_, err := tc.mockPS.GetProductBySku(minimock.AnyContext, item.SkuID)
-							if err != nil {
-								t.Logf("err : %v", err)
-								return err
-							}
-						}
-						return nil
-					})
-				}
-
-				for _, item := range testRepoResp {
-					ch <- item
-				}
-
-				close(ch)
-
-				if err := g.Wait(); err != nil {
-					t.Logf("g.Wait() : %v", err)
+					products[item.SkuID] = &model.GetProductResponse{
+						Name:  "test",
+						Price: 100,
+						Sku:   item.SkuID,
+					}
 				}
 
+				tc.mockPS.GetProductsBySkusMock.
+					Expect(minimock.AnyContext, []int64{testRepoResp[0].SkuID, testRepoResp[1].SkuID}).
+					Return(products, nil)
 			},
 			expectedResp: &model.GetItemsFromCartResponce{
 				Items: []model.Item{
@@ -171,8 +128,145 @@ func TestService_GetItemsFromCart(t *testing.T) {
 						Price: safePriceSku,
 					},
 				},
-				TotalPrice: safePriceSku*testRepoResp[0].Count +
-					safePriceSku*testRepoResp[1].Count,
+				TotalPrice: uint64(safePriceSku)*uint64(testRepoResp[0].Count) +
+					uint64(safePriceSku)*uint64(testRepoResp[1].Count),
+			},
+		},
+		{
+			name:     "success for changed price",
+			testData: testData,
+			setupMock: func(tc testServiceComponent) {
+				tc.mockTrace.StartMock.
+					Expect(
+						context.Background(),
+						"CartService:GetItemsFromCart",
+					).
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, testData).
+					Return([]model.Cart{{SkuID: 1, Count: 1, Price: 90}}, nil)
+
+				tc.mockPS.GetProductsBySkusMock.
+					Expect(minimock.AnyContext, []int64{1}).
+					Return(map[int64]*model.GetProductResponse{
+						1: {
+							Name:  "test",
+							Price: 100,
+							Sku:   1,
+						},
+					}, nil)
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items: []model.Item{
+					{
+						Sku:          1,
+						Name:         "test",
+						Count:        1,
+						Price:        100,
+						SavedPrice:   90,
+						PriceChanged: true,
+					},
+				},
+				TotalPrice:   100,
+				PriceChanged: true,
+			},
+		},
+		{
+			name:     "err product not found",
+			testData: testData,
+			setupMock: func(tc testServiceComponent) {
+				tc.mockTrace.StartMock.
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, testData).
+					Return(testRepoResp, nil)
+
+				tc.mockPS.GetProductsBySkusMock.
+					Expect(minimock.AnyContext, []int64{testRepoResp[0].SkuID, testRepoResp[1].SkuID}).
+					Return(map[int64]*model.GetProductResponse{
+						testRepoResp[0].SkuID: {Name: "test", Price: 100, Sku: testRepoResp[0].SkuID},
+					}, nil)
+			},
+			expectedErr: model.ErrProductNotFound,
+		},
+		{
+			name:     "success degraded with last known products",
+			testData: testData,
+			setupMock: func(tc testServiceComponent) {
+				tc.mockTrace.StartMock.
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, testData).
+					Return([]model.Cart{{SkuID: 1, Count: 2, Price: 90}, {SkuID: 2, Count: 1, Price: 50}}, nil)
+
+				tc.mockPS.GetProductsBySkusMock.
+					Expect(minimock.AnyContext, []int64{1, 2}).
+					Return(nil, model.ErrServiceUnavailable)
+
+				tc.mockPS.GetLastKnownProductsMock.
+					Expect([]int64{1, 2}).
+					Return(map[int64]*model.GetProductResponse{
+						1: {Name: "test", Price: 100, Sku: 1},
+					})
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items: []model.Item{
+					{Sku: 1, Name: "test", Count: 2, Price: 100, SavedPrice: 90, PriceChanged: true},
+					{Sku: 2, Count: 1, Price: 50, SavedPrice: 50, Unavailable: true},
+				},
+				TotalPrice:   250,
+				PriceChanged: true,
+				Degraded:     true,
+			},
+		},
+		{
+			name:     "success for checked out cart",
+			testData: testData,
+			setupMock: func(tc testServiceComponent) {
+				tc.mockTrace.StartMock.
+					Expect(
+						context.Background(),
+						"CartService:GetItemsFromCart",
+					).
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, testData).
+					Return(nil, model.ErrNotFound)
+
+				tc.mockRepo.GetCheckoutOrderIDMock.
+					Expect(minimock.AnyContext, testData.UserID).
+					Return(42, nil)
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items:             []model.Item{},
+				CheckedOutOrderID: 42,
+			},
+		},
+		{
+			name:     "success for empty cart",
+			testData: testData,
+			setupMock: func(tc testServiceComponent) {
+				tc.mockTrace.StartMock.
+					Expect(
+						context.Background(),
+						"CartService:GetItemsFromCart",
+					).
+					Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, testData).
+					Return(nil, model.ErrNotFound)
+
+				tc.mockRepo.GetCheckoutOrderIDMock.
+					Expect(minimock.AnyContext, testData.UserID).
+					Return(0, model.ErrNotFound)
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items: []model.Item{},
 			},
 		},
 	}
@@ -185,10 +279,974 @@ func TestService_GetItemsFromCart(t *testing.T) {
 			tt.setupMock(tc)
 
 			result, err := tc.service.GetItemsFromCart(context.Background(), testData)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
 			require.NoError(t, err)
 			assert.Len(t, result.Items, len(tt.expectedResp.Items))
 			assert.Equal(t, result.TotalPrice, tt.expectedResp.TotalPrice)
+			assert.Equal(t, tt.expectedResp.CheckedOutOrderID, result.CheckedOutOrderID)
+			assert.Equal(t, tt.expectedResp.PriceChanged, result.PriceChanged)
+			assert.Equal(t, tt.expectedResp.Degraded, result.Degraded)
+			if tt.expectedResp.Degraded {
+				assert.Equal(t, tt.expectedResp.Items, result.Items)
+			}
+		})
+	}
+}
+
+func TestService_SetItemCount(t *testing.T) {
+	testData := model.RequestData{
+		// nolint:gosec
+		UserID: rand.Int63(),
+		Sku:    1076963,
+		Count:  5,
+	}
+
+	tests := []struct {
+		name        string
+		testData    model.RequestData
+		setupMock   func(tc testServiceComponent, data model.RequestData)
+		expectedErr error
+	}{
+		{
+			name:     "success",
+			testData: testData,
+			setupMock: func(tc testServiceComponent, data model.RequestData) {
+				tc.mockLoms.GetStocksInfoMock.
+					Expect(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: data.Sku}).
+					Return(&pbLoms.StocksInfoResponse{Count: data.Count}, nil)
+				tc.mockRepo.SetCountMock.
+					Expect(minimock.AnyContext, data).
+					Return(nil)
+			},
+		},
+		{
+			name:     "zero count skips stock check",
+			testData: model.RequestData{UserID: testData.UserID, Sku: testData.Sku},
+			setupMock: func(tc testServiceComponent, data model.RequestData) {
+				tc.mockRepo.SetCountMock.
+					Expect(minimock.AnyContext, data).
+					Return(nil)
+			},
+		},
+		{
+			name:     "err more than in stock",
+			testData: testData,
+			setupMock: func(tc testServiceComponent, data model.RequestData) {
+				tc.mockLoms.GetStocksInfoMock.
+					Expect(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: data.Sku}).
+					Return(&pbLoms.StocksInfoResponse{Count: data.Count - 1}, nil)
+			},
+			expectedErr: model.ErrAddedMoreItemThanInStock,
+		},
+		{
+			name:     "err item not found",
+			testData: testData,
+			setupMock: func(tc testServiceComponent, data model.RequestData) {
+				tc.mockLoms.GetStocksInfoMock.
+					Expect(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: data.Sku}).
+					Return(&pbLoms.StocksInfoResponse{Count: data.Count}, nil)
+				tc.mockRepo.SetCountMock.
+					Expect(minimock.AnyContext, data).
+					Return(model.ErrNotFound)
+			},
+			expectedErr: model.ErrNotFound,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc, tt.testData)
+
+			err := tc.service.SetItemCount(context.Background(), tt.testData)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_OrderCreate(t *testing.T) {
+	// nolint:gosec
+	userID := rand.Int63()
+
+	items := &model.GetItemsFromCartResponce{
+		Items: []model.Item{
+			{Sku: 1, Count: 2},
+			{Sku: 2, Count: 5},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		setupMock     func(tc testServiceComponent)
+		expectedID    int64
+		expectedShort []model.StockShortage
+	}{
+		{
+			name: "success",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockLoms.GetStocksInfoMock.
+					When(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: 1}).
+					Then(&pbLoms.StocksInfoResponse{Count: 2}, nil)
+				tc.mockLoms.GetStocksInfoMock.
+					When(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: 2}).
+					Then(&pbLoms.StocksInfoResponse{Count: 10}, nil)
+				tc.mockLoms.CreateOrderMock.
+					Expect(minimock.AnyContext, &pbLoms.OrderCreateRequest{
+						UserID: userID,
+						Items: []*pbLoms.Item{
+							{Sku: 1, Count: 2},
+							{Sku: 2, Count: 5},
+						},
+					}).
+					Return(&pbLoms.OrderCreateResponse{OrderID: 42}, nil)
+			},
+			expectedID: 42,
+		},
+		{
+			name: "err insufficient stock does not create order",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockLoms.GetStocksInfoMock.
+					When(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: 1}).
+					Then(&pbLoms.StocksInfoResponse{Count: 2}, nil)
+				tc.mockLoms.GetStocksInfoMock.
+					When(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: 2}).
+					Then(&pbLoms.StocksInfoResponse{Count: 3}, nil)
+			},
+			expectedShort: []model.StockShortage{
+				{Sku: 2, Requested: 5, Available: 3},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc)
+
+			orderID, err := tc.service.OrderCreate(context.Background(), userID, items)
+			if tt.expectedShort != nil {
+				var stockErr *model.InsufficientStockError
+				require.ErrorAs(t, err, &stockErr)
+				assert.Equal(t, tt.expectedShort, stockErr.Items)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedID, orderID)
+		})
+	}
+
+	t.Run("err degraded cart is not ordered", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.mockTrace.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+
+		degraded := *items
+		degraded.Degraded = true
+
+		_, err := tc.service.OrderCreate(context.Background(), userID, &degraded)
+		require.ErrorIs(t, err, model.ErrPricesNotConfirmed)
+		require.ErrorIs(t, err, model.ErrServiceUnavailable)
+	})
+
+	t.Run("promo code and discounted total are forwarded", func(t *testing.T) {
+		tc := setupTest(t)
+		tc.mockTrace.StartMock.
+			Return(context.Background(), trace.SpanFromContext(context.Background()))
+		tc.mockLoms.GetStocksInfoMock.
+			Return(&pbLoms.StocksInfoResponse{Count: 10}, nil)
+		tc.mockLoms.CreateOrderMock.
+			Expect(minimock.AnyContext, &pbLoms.OrderCreateRequest{
+				UserID: userID,
+				Items: []*pbLoms.Item{
+					{Sku: 1, Count: 2},
+					{Sku: 2, Count: 5},
+				},
+				PromoCode:  "MINUS50",
+				TotalPrice: 650,
+			}).
+			Return(&pbLoms.OrderCreateResponse{OrderID: 42}, nil)
+
+		discounted := *items
+		discounted.Subtotal = 700
+		discounted.TotalPrice = 650
+		discounted.PromoCode = "MINUS50"
+
+		orderID, err := tc.service.OrderCreate(context.Background(), userID, &discounted)
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), orderID)
+	})
+}
+
+func TestService_ClaimCart(t *testing.T) {
+	// nolint:gosec
+	userID := rand.Int63()
+	// nolint:gosec
+	orderID := rand.Int63()
+
+	items := &model.GetItemsFromCartResponce{
+		Items: []model.Item{
+			{Sku: 1, Count: 2},
+			{Sku: 2, Count: 5},
+		},
+	}
+	carts := []model.Cart{
+		{SkuID: 1, Count: 2},
+		{SkuID: 2, Count: 5},
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func(tc testServiceComponent)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.CheckoutMock.
+					Expect(minimock.AnyContext, userID, carts, orderID).
+					Return(nil)
+			},
+		},
+		{
+			name: "err cart changed cancels order",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.CheckoutMock.
+					Expect(minimock.AnyContext, userID, carts, orderID).
+					Return(model.ErrCartChanged)
+				tc.mockLoms.CancelOrderMock.
+					Expect(minimock.AnyContext, &pbLoms.OrderCancelRequest{OrderID: orderID}).
+					Return(nil)
+			},
+			expectedErr: model.ErrCartChanged,
+		},
+		{
+			name: "err repository cancels order",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.CheckoutMock.
+					Expect(minimock.AnyContext, userID, carts, orderID).
+					Return(errors.New("test"))
+				tc.mockLoms.CancelOrderMock.
+					Expect(minimock.AnyContext, &pbLoms.OrderCancelRequest{OrderID: orderID}).
+					Return(nil)
+			},
+			expectedErr: errors.New("repository.Checkout: test"),
+		},
+		{
+			name: "err cancel order",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.CheckoutMock.
+					Expect(minimock.AnyContext, userID, carts, orderID).
+					Return(model.ErrCartChanged)
+				tc.mockLoms.CancelOrderMock.
+					Expect(minimock.AnyContext, &pbLoms.OrderCancelRequest{OrderID: orderID}).
+					Return(errors.New("test"))
+			},
+			expectedErr: fmt.Errorf("loms.CancelOrder %d: test (repository.Checkout: %v)", orderID, model.ErrCartChanged),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc)
+
+			err := tc.service.ClaimCart(context.Background(), userID, items, orderID)
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_MergeCart(t *testing.T) {
+	const (
+		targetUserID = int64(1)
+		sourceUserID = int64(2)
+	)
+
+	source := []model.Cart{
+		{SkuID: 1, Count: 2, Price: 100},
+		{SkuID: 2, Count: 5, Price: 30},
+	}
+	available := map[int64]uint32{1: 10, 2: 3}
+
+	stocks := func(_ context.Context, req *pbLoms.StocksInfoRequest) (*pbLoms.StocksInfoResponse, error) {
+		return &pbLoms.StocksInfoResponse{Count: available[req.Sku]}, nil
+	}
+
+	tests := []struct {
+		name         string
+		setupMock    func(tc testServiceComponent)
+		expectedResp *model.MergeCartResponse
+		expectedErr  error
+	}{
+		{
+			name: "success returns adjusted lines",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: sourceUserID}).
+					Return(source, nil)
+				tc.mockLoms.GetStocksInfoMock.Set(stocks)
+				tc.mockRepo.MergeMock.
+					Expect(minimock.AnyContext, targetUserID, sourceUserID, source, available, model.CartLimits{}).
+					Return([]model.MergedLine{
+						{Sku: 1, Requested: 2, Merged: 2},
+						{Sku: 2, Requested: 6, Merged: 3},
+					}, nil)
+			},
+			expectedResp: &model.MergeCartResponse{
+				Adjusted: []model.MergedLine{{Sku: 2, Requested: 6, Merged: 3}},
+			},
+		},
+		{
+			name: "success limits are checked by repository",
+			setupMock: func(tc testServiceComponent) {
+				tc.service.limits = model.CartLimits{MaxSkuCount: 3, MaxLines: 1}
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: sourceUserID}).
+					Return(source, nil)
+				tc.mockLoms.GetStocksInfoMock.Set(stocks)
+				tc.mockRepo.MergeMock.
+					Expect(minimock.AnyContext, targetUserID, sourceUserID, source, available, model.CartLimits{MaxSkuCount: 3, MaxLines: 1}).
+					Return([]model.MergedLine{
+						{Sku: 1, Requested: 2, Merged: 2},
+						{Sku: 2, Requested: 6, Merged: 0},
+					}, nil)
+			},
+			expectedResp: &model.MergeCartResponse{
+				Adjusted: []model.MergedLine{{Sku: 2, Requested: 6, Merged: 0}},
+			},
+		},
+		{
+			name: "err source cart not found",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: sourceUserID}).
+					Return(nil, model.ErrNotFound)
+			},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name: "err stocks info",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: sourceUserID}).
+					Return(source, nil)
+				tc.mockLoms.GetStocksInfoMock.
+					Return(nil, errors.New("test"))
+			},
+			expectedErr: errors.New("StocksInfo: test"),
+		},
+		{
+			name: "err source cart changed",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: sourceUserID}).
+					Return(source, nil)
+				tc.mockLoms.GetStocksInfoMock.Set(stocks)
+				tc.mockRepo.MergeMock.
+					Return(nil, model.ErrCartChanged)
+			},
+			expectedErr: model.ErrCartChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc)
+
+			resp, err := tc.service.MergeCart(context.Background(), targetUserID, sourceUserID)
+			if tt.expectedErr != nil {
+				require.EqualError(t, err, tt.expectedErr.Error())
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResp, resp)
+		})
+	}
+}
+
+func TestService_MoveToCart(t *testing.T) {
+	data := model.RequestData{UserID: 1, Sku: 5}
+	saved := []model.Cart{{SkuID: data.Sku, Count: 2, Price: 90}}
+
+	tests := []struct {
+		name        string
+		setupMock   func(tc testServiceComponent)
+		expectedErr error
+	}{
+		{
+			name: "success",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetSavedItemsMock.
+					Expect(minimock.AnyContext, data.UserID).
+					Return(saved, nil)
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: data.UserID}).
+					Return([]model.Cart{{SkuID: data.Sku, Count: 1}}, nil)
+				tc.mockPS.GetProductBySkuMock.
+					Expect(minimock.AnyContext, data.Sku).
+					Return(&model.GetProductResponse{Sku: data.Sku, Price: 100}, nil)
+				tc.mockLoms.GetStocksInfoMock.
+					Expect(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: data.Sku}).
+					Return(&pbLoms.StocksInfoResponse{Count: 3}, nil)
+				tc.mockRepo.MoveToCartMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: data.UserID, Sku: data.Sku, Price: 100}).
+					Return(nil)
+			},
+		},
+		{
+			name: "err item is not saved",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetSavedItemsMock.
+					Expect(minimock.AnyContext, data.UserID).
+					Return([]model.Cart{{SkuID: 6, Count: 1}}, nil)
+			},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name: "err more than in stock",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetSavedItemsMock.
+					Expect(minimock.AnyContext, data.UserID).
+					Return(saved, nil)
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: data.UserID}).
+					Return([]model.Cart{{SkuID: data.Sku, Count: 2}}, nil)
+				tc.mockPS.GetProductBySkuMock.
+					Expect(minimock.AnyContext, data.Sku).
+					Return(&model.GetProductResponse{Sku: data.Sku, Price: 100}, nil)
+				tc.mockLoms.GetStocksInfoMock.
+					Expect(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: data.Sku}).
+					Return(&pbLoms.StocksInfoResponse{Count: 3}, nil)
+			},
+			expectedErr: model.ErrAddedMoreItemThanInStock,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc)
+
+			err := tc.service.MoveToCart(context.Background(), data)
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_GetSavedItems(t *testing.T) {
+	data := model.RequestData{UserID: 1}
+
+	tc := setupTest(t)
+	tc.mockTrace.StartMock.
+		Return(context.Background(), trace.SpanFromContext(context.Background()))
+	tc.mockRepo.GetSavedItemsMock.
+		Expect(minimock.AnyContext, data.UserID).
+		Return([]model.Cart{{SkuID: 2, Count: 1, Price: 50}, {SkuID: 1, Count: 2, Price: 100}}, nil)
+	tc.mockPS.GetProductsBySkusMock.
+		Expect(minimock.AnyContext, []int64{2, 1}).
+		Return(map[int64]*model.GetProductResponse{
+			1: {Name: "first", Price: 100, Sku: 1},
+			2: {Name: "second", Price: 60, Sku: 2},
+		}, nil)
+
+	resp, err := tc.service.GetSavedItems(context.Background(), data)
+	require.NoError(t, err)
+	assert.Equal(t, &model.GetSavedItemsResponse{
+		Items: []model.Item{
+			{Sku: 1, Name: "first", Count: 2, Price: 100, SavedPrice: 100},
+			{Sku: 2, Name: "second", Count: 1, Price: 60, SavedPrice: 50, PriceChanged: true},
+		},
+	}, resp)
+}
+
+func TestService_AddItemLimits(t *testing.T) {
+	data := model.RequestData{UserID: 1, Sku: 5, Count: 2}
+	limits := model.CartLimits{MaxSkuCount: 10, MaxLines: 2, MaxTotal: 1000}
+
+	tests := []struct {
+		name        string
+		cart        []model.Cart
+		expectedErr error
+	}{
+		{
+			name: "success",
+			cart: []model.Cart{{SkuID: data.Sku, Count: 8, Price: 100}},
+		},
+		{
+			name:        "err sku quantity limit",
+			cart:        []model.Cart{{SkuID: data.Sku, Count: 9, Price: 10}},
+			expectedErr: &model.SkuQuantityLimitError{Sku: data.Sku, Max: limits.MaxSkuCount},
+		},
+		{
+			name:        "err cart lines limit",
+			cart:        []model.Cart{{SkuID: 1, Count: 1, Price: 1}, {SkuID: 2, Count: 1, Price: 1}},
+			expectedErr: &model.CartLinesLimitError{Max: limits.MaxLines},
+		},
+		{
+			name:        "err cart total limit",
+			cart:        []model.Cart{{SkuID: 1, Count: 9, Price: 100}},
+			expectedErr: &model.CartTotalLimitError{Max: limits.MaxTotal},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.service.limits = limits
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tc.mockPS.GetProductBySkuMock.
+				Expect(minimock.AnyContext, data.Sku).
+				Return(&model.GetProductResponse{Sku: data.Sku, Price: 100}, nil)
+			tc.mockLoms.GetStocksInfoMock.
+				Expect(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: data.Sku}).
+				Return(&pbLoms.StocksInfoResponse{Count: 100}, nil)
+			tc.mockRepo.GetItemsByUserIDMock.
+				Expect(minimock.AnyContext, model.RequestData{UserID: data.UserID}).
+				Return(tt.cart, nil)
+			if tt.expectedErr == nil {
+				tc.mockRepo.AddMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: data.UserID, Sku: data.Sku, Count: data.Count, Price: 100}).
+					Return(nil)
+			}
+
+			err := tc.service.AddItem(context.Background(), data)
+			if tt.expectedErr != nil {
+				require.Equal(t, tt.expectedErr, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_ApplyPromoCode(t *testing.T) {
+	const userID = int64(1)
+
+	cart := []model.Cart{{SkuID: 1, Count: 2, Price: 100}}
+	items := []model.Item{{Sku: 1, Name: "test", Count: 2, Price: 100, SavedPrice: 100}}
+	discounts := []model.DiscountLine{{Code: "WELCOME10", Description: "скидка 10% на корзину", Amount: 20}}
+
+	tests := []struct {
+		name         string
+		code         string
+		setupMock    func(tc testServiceComponent)
+		expectedErr  error
+		expectedResp *model.GetItemsFromCartResponce
+	}{
+		{
+			name: "success",
+			code: " welcome10",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockPS.GetProductsBySkusMock.
+					Expect(minimock.AnyContext, []int64{1}).
+					Return(map[int64]*model.GetProductResponse{1: {Sku: 1, Name: "test", Price: 100}}, nil)
+				tc.mockPromo.ApplyMock.
+					Expect("WELCOME10", items, 200).
+					Return(discounts, nil)
+				tc.mockRepo.SetPromoCodeMock.
+					Expect(minimock.AnyContext, userID, "WELCOME10").
+					Return(nil)
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items:      items,
+				Subtotal:   200,
+				TotalPrice: 180,
+				PromoCode:  "WELCOME10",
+				Discounts:  discounts,
+			},
+		},
+		{
+			name: "err empty cart",
+			code: "WELCOME10",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(nil, model.ErrNotFound)
+			},
+			expectedErr: model.ErrNotFound,
+		},
+		{
+			name: "err not applicable is not stored",
+			code: "MINUS500",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockPS.GetProductsBySkusMock.
+					Expect(minimock.AnyContext, []int64{1}).
+					Return(map[int64]*model.GetProductResponse{1: {Sku: 1, Name: "test", Price: 100}}, nil)
+				tc.mockPromo.ApplyMock.
+					Expect("MINUS500", items, 200).
+					Return(nil, fmt.Errorf("%w: минимальная сумма корзины 3000", model.ErrPromoNotApplicable))
+			},
+			expectedErr: model.ErrPromoNotApplicable,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.service.promo = tc.mockPromo
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc)
+
+			// Execute
+			result, err := tc.service.ApplyPromoCode(context.Background(), userID, tt.code)
+
+			// Verify
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResp, result)
+		})
+	}
+}
+
+func TestService_GetItemsFromCartPromo(t *testing.T) {
+	data := model.RequestData{UserID: 1}
+	items := []model.Item{{Sku: 1, Name: "test", Count: 2, Price: 100, SavedPrice: 100}}
+
+	tests := []struct {
+		name         string
+		setupMock    func(tc testServiceComponent)
+		expectedResp *model.GetItemsFromCartResponce
+	}{
+		{
+			name: "discount applied",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetPromoCodeMock.
+					Expect(minimock.AnyContext, data.UserID).
+					Return("MINUS50", nil)
+				tc.mockPromo.ApplyMock.
+					Expect("MINUS50", items, 200).
+					Return([]model.DiscountLine{{Code: "MINUS50", Amount: 50}}, nil)
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items:      items,
+				Subtotal:   200,
+				TotalPrice: 150,
+				PromoCode:  "MINUS50",
+				Discounts:  []model.DiscountLine{{Code: "MINUS50", Amount: 50}},
+			},
+		},
+		{
+			name: "promo code no longer applicable",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetPromoCodeMock.
+					Expect(minimock.AnyContext, data.UserID).
+					Return("MINUS500", nil)
+				tc.mockPromo.ApplyMock.
+					Expect("MINUS500", items, 200).
+					Return(nil, fmt.Errorf("%w: минимальная сумма корзины 3000", model.ErrPromoNotApplicable))
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items:           items,
+				Subtotal:        200,
+				TotalPrice:      200,
+				PromoCode:       "MINUS500",
+				PromoNotApplied: fmt.Sprintf("%s: минимальная сумма корзины 3000", model.ErrPromoNotApplicable),
+			},
+		},
+		{
+			name: "no promo code",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetPromoCodeMock.
+					Expect(minimock.AnyContext, data.UserID).
+					Return("", nil)
+			},
+			expectedResp: &model.GetItemsFromCartResponce{
+				Items:      items,
+				Subtotal:   200,
+				TotalPrice: 200,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.service.promo = tc.mockPromo
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tc.mockRepo.GetItemsByUserIDMock.
+				Expect(minimock.AnyContext, data).
+				Return([]model.Cart{{SkuID: 1, Count: 2, Price: 100}}, nil)
+			tc.mockPS.GetProductsBySkusMock.
+				Expect(minimock.AnyContext, []int64{1}).
+				Return(map[int64]*model.GetProductResponse{1: {Sku: 1, Name: "test", Price: 100}}, nil)
+			tt.setupMock(tc)
+
+			// Execute
+			result, err := tc.service.GetItemsFromCart(context.Background(), data)
+
+			// Verify
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedResp, result)
+		})
+	}
+}
+
+func TestService_CartEvents(t *testing.T) {
+	const userID = int64(1)
+	cart := []model.Cart{
+		{SkuID: 1, Count: 3, Price: 100},
+		{SkuID: 2, Count: 1, Price: 200},
+	}
+
+	tests := []struct {
+		name        string
+		setupMock   func(tc testServiceComponent)
+		execute     func(s *Service) error
+		expectedErr error
+		expected    []model.CartEvent
+	}{
+		{
+			name: "set count",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockLoms.GetStocksInfoMock.
+					Expect(minimock.AnyContext, &pbLoms.StocksInfoRequest{Sku: 1}).
+					Return(&pbLoms.StocksInfoResponse{Count: 10}, nil)
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockRepo.SetCountMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID, Sku: 1, Count: 5}).
+					Return(nil)
+			},
+			execute: func(s *Service) error {
+				return s.SetItemCount(context.Background(), model.RequestData{UserID: userID, Sku: 1, Count: 5})
+			},
+			expected: []model.CartEvent{
+				{UserID: userID, Sku: 1, Delta: 2, Reason: model.CartEventSetCount},
+			},
+		},
+		{
+			name: "set zero count",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockRepo.SetCountMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID, Sku: 2}).
+					Return(nil)
+			},
+			execute: func(s *Service) error {
+				return s.SetItemCount(context.Background(), model.RequestData{UserID: userID, Sku: 2})
+			},
+			expected: []model.CartEvent{
+				{UserID: userID, Sku: 2, Delta: -1, Reason: model.CartEventRemove},
+			},
+		},
+		{
+			name: "delete item",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockRepo.DeleteItemsBySkuMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID, Sku: 1}).
+					Return(model.ErrNoContent)
+			},
+			execute: func(s *Service) error {
+				return s.DeleteItem(context.Background(), model.RequestData{UserID: userID, Sku: 1})
+			},
+			expectedErr: model.ErrNoContent,
+			expected: []model.CartEvent{
+				{UserID: userID, Sku: 1, Delta: -3, Reason: model.CartEventRemove},
+			},
+		},
+		{
+			name: "delete missing item sends nothing",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockRepo.DeleteItemsBySkuMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID, Sku: 3}).
+					Return(model.ErrNoContent)
+			},
+			execute: func(s *Service) error {
+				return s.DeleteItem(context.Background(), model.RequestData{UserID: userID, Sku: 3})
+			},
+			expectedErr: model.ErrNoContent,
+		},
+		{
+			name: "clear cart",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockRepo.DeleteAllItemsFromCartMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(model.ErrNoContent)
+			},
+			execute: func(s *Service) error {
+				return s.DeleteItemsByUserID(context.Background(), model.RequestData{UserID: userID})
+			},
+			expectedErr: model.ErrNoContent,
+			expected: []model.CartEvent{
+				{UserID: userID, Sku: 1, Delta: -3, Reason: model.CartEventClear},
+				{UserID: userID, Sku: 2, Delta: -1, Reason: model.CartEventClear},
+			},
+		},
+		{
+			name: "failed change sends nothing",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(cart, nil)
+				tc.mockRepo.DeleteAllItemsFromCartMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: userID}).
+					Return(errors.New("test"))
+			},
+			execute: func(s *Service) error {
+				//nolint:errcheck
+				s.DeleteItemsByUserID(context.Background(), model.RequestData{UserID: userID})
+				return nil
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.service.events = tc.mockEvents
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc)
+
+			var events []model.CartEvent
+			tc.mockEvents.SendMock.Optional().Set(func(event model.CartEvent) {
+				assert.False(t, event.Timestamp.IsZero())
+				event.Timestamp = time.Time{}
+				events = append(events, event)
+			})
+
+			// Execute
+			err := tt.execute(tc.service)
+
+			// Verify
+			if tt.expectedErr != nil {
+				require.ErrorIs(t, err, tt.expectedErr)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, events)
+		})
+	}
+}
+
+func TestService_ReportAbandonedCarts(t *testing.T) {
+	updatedAt := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	items := []model.Cart{{SkuID: 1, Count: 2, Price: 100, UpdatedAt: updatedAt}}
+
+	tests := []struct {
+		name      string
+		setupMock func(tc testServiceComponent)
+	}{
+		{
+			name: "success",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetAbandonedCartsMock.
+					Return([]model.AbandonedCart{{UserID: 1, UpdatedAt: updatedAt}}, nil)
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: 1}).
+					Return(items, nil)
+				tc.mockEvents.SendAbandonedCartMock.
+					Expect(model.AbandonedCart{UserID: 1, UpdatedAt: updatedAt, Items: items}).
+					Return(nil)
+				tc.mockRepo.MarkAbandonedReportedMock.
+					Expect(minimock.AnyContext, 1, updatedAt).
+					Return(nil)
+			},
+		},
+		{
+			name: "empty cart is marked without event",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetAbandonedCartsMock.
+					Return([]model.AbandonedCart{{UserID: 1, UpdatedAt: updatedAt}}, nil)
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: 1}).
+					Return(nil, model.ErrNotFound)
+				tc.mockRepo.MarkAbandonedReportedMock.
+					Expect(minimock.AnyContext, 1, updatedAt).
+					Return(nil)
+			},
+		},
+		{
+			name: "failed event is not marked",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetAbandonedCartsMock.
+					Return([]model.AbandonedCart{{UserID: 1, UpdatedAt: updatedAt}}, nil)
+				tc.mockRepo.GetItemsByUserIDMock.
+					Expect(minimock.AnyContext, model.RequestData{UserID: 1}).
+					Return(items, nil)
+				tc.mockEvents.SendAbandonedCartMock.
+					Return(errors.New("test"))
+			},
+		},
+		{
+			name: "err repository",
+			setupMock: func(tc testServiceComponent) {
+				tc.mockRepo.GetAbandonedCartsMock.
+					Return(nil, errors.New("test"))
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Setup
+			tc := setupTest(t)
+			tc.service.events = tc.mockEvents
+			tc.mockTrace.StartMock.
+				Return(context.Background(), trace.SpanFromContext(context.Background()))
+			tt.setupMock(tc)
 
+			// Execute
+			tc.service.ReportAbandonedCarts(context.Background(), time.Hour, 10)
 		})
 	}
 }
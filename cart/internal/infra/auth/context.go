@@ -0,0 +1,37 @@
+package auth
+
+import "context"
+
+// claimsKey ...
+type claimsKey struct{}
+
+// WithClaims ...
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	return context.WithValue(ctx, claimsKey{}, claims)
+}
+
+// FromContext ...
+func FromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey{}).(*Claims)
+	return claims, ok
+}
+
+// CanAccess можно ли работать с корзиной userID. Без claims в контексте (аутентификация явно отключена) доступ разрешен
+func CanAccess(ctx context.Context, userID int64) bool {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return true
+	}
+
+	return claims.CanAccess(userID)
+}
+
+// IsAdmin токен дает права администратора. Без claims в контексте (аутентификация отключена) доступ разрешен
+func IsAdmin(ctx context.Context) bool {
+	claims, ok := FromContext(ctx)
+	if !ok {
+		return true
+	}
+
+	return claims.Admin
+}
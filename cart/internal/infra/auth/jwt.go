@@ -0,0 +1,253 @@
+// Package auth ...
+package auth
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// AlgHS256 ...
+	AlgHS256 = "HS256"
+	// AlgRS256 ...
+	AlgRS256 = "RS256"
+	// leeway допустимое расхождение часов при проверке exp и nbf
+	leeway = 30 * time.Second
+)
+
+var (
+	// ErrInvalidToken ...
+	ErrInvalidToken = errors.New("invalid token")
+	// ErrNoKeys ...
+	ErrNoKeys = errors.New("no keys configured")
+)
+
+// Config пустой ключ отключает соответствующий алгоритм
+type Config struct {
+	// HS256Secret общий секрет для HS256
+	HS256Secret string
+	// RS256PublicKey открытый ключ RSA в PEM для RS256
+	RS256PublicKey []byte
+	// Issuer если задан, iss токена должен совпадать
+	Issuer string
+	// Audience если задан, aud токена должен его содержать
+	Audience string
+	// AdminScope scope, с которым доступны корзины всех пользователей
+	AdminScope string
+}
+
+// Claims ...
+type Claims struct {
+	// UserID subject токена
+	UserID    int64
+	Scopes    []string
+	Admin     bool
+	ExpiresAt time.Time
+}
+
+// CanAccess токен выдан userID или дает права администратора
+func (c *Claims) CanAccess(userID int64) bool {
+	return c.Admin || c.UserID == userID
+}
+
+// Verifier проверяет подпись, срок действия и subject JWT
+type Verifier struct {
+	cfg    Config
+	secret []byte
+	rsaKey *rsa.PublicKey
+	now    func() time.Time
+}
+
+// NewVerifier ...
+func NewVerifier(cfg Config) (*Verifier, error) {
+	v := &Verifier{
+		cfg: cfg,
+		now: time.Now,
+	}
+
+	if cfg.HS256Secret != "" {
+		v.secret = []byte(cfg.HS256Secret)
+	}
+
+	if len(cfg.RS256PublicKey) > 0 {
+		key, err := ParseRSAPublicKey(cfg.RS256PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		v.rsaKey = key
+	}
+
+	if v.secret == nil && v.rsaKey == nil {
+		return nil, ErrNoKeys
+	}
+
+	return v, nil
+}
+
+// Verify алгоритм берется из заголовка токена, но принимается только тот, для которого настроен ключ
+func (v *Verifier) Verify(token string) (*Claims, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("%w: malformed token", ErrInvalidToken)
+	}
+
+	var header struct {
+		Alg string `json:"alg"`
+	}
+	if err := decodeSegment(parts[0], &header); err != nil {
+		return nil, fmt.Errorf("%w: header: %v", ErrInvalidToken, err)
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, fmt.Errorf("%w: signature: %v", ErrInvalidToken, err)
+	}
+
+	if err := v.verifySignature(header.Alg, parts[0]+"."+parts[1], signature); err != nil {
+		return nil, err
+	}
+
+	var payload struct {
+		Subject   string   `json:"sub"`
+		ExpiresAt *float64 `json:"exp"`
+		NotBefore *float64 `json:"nbf"`
+		Issuer    string   `json:"iss"`
+		Audience  audience `json:"aud"`
+		Scope     string   `json:"scope"`
+	}
+	if err := decodeSegment(parts[1], &payload); err != nil {
+		return nil, fmt.Errorf("%w: payload: %v", ErrInvalidToken, err)
+	}
+
+	now := v.now()
+
+	if payload.ExpiresAt == nil {
+		return nil, fmt.Errorf("%w: exp is required", ErrInvalidToken)
+	}
+	expiresAt := unixTime(*payload.ExpiresAt)
+	if now.After(expiresAt.Add(leeway)) {
+		return nil, fmt.Errorf("%w: token expired", ErrInvalidToken)
+	}
+
+	if payload.NotBefore != nil && now.Add(leeway).Before(unixTime(*payload.NotBefore)) {
+		return nil, fmt.Errorf("%w: token not valid yet", ErrInvalidToken)
+	}
+
+	if v.cfg.Issuer != "" && payload.Issuer != v.cfg.Issuer {
+		return nil, fmt.Errorf("%w: unexpected issuer %q", ErrInvalidToken, payload.Issuer)
+	}
+
+	if v.cfg.Audience != "" && !slices.Contains(payload.Audience, v.cfg.Audience) {
+		return nil, fmt.Errorf("%w: unexpected audience", ErrInvalidToken)
+	}
+
+	userID, err := strconv.ParseInt(payload.Subject, 10, 64)
+	if err != nil || userID < 1 {
+		return nil, fmt.Errorf("%w: subject must be a user id", ErrInvalidToken)
+	}
+
+	scopes := strings.Fields(payload.Scope)
+
+	return &Claims{
+		UserID:    userID,
+		Scopes:    scopes,
+		Admin:     v.cfg.AdminScope != "" && slices.Contains(scopes, v.cfg.AdminScope),
+		ExpiresAt: expiresAt,
+	}, nil
+}
+
+// verifySignature ...
+func (v *Verifier) verifySignature(alg, signingInput string, signature []byte) error {
+	switch {
+	case alg == AlgHS256 && v.secret != nil:
+		mac := hmac.New(sha256.New, v.secret)
+		mac.Write([]byte(signingInput))
+		if !hmac.Equal(mac.Sum(nil), signature) {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+	case alg == AlgRS256 && v.rsaKey != nil:
+		hash := sha256.Sum256([]byte(signingInput))
+		if err := rsa.VerifyPKCS1v15(v.rsaKey, crypto.SHA256, hash[:], signature); err != nil {
+			return fmt.Errorf("%w: bad signature", ErrInvalidToken)
+		}
+	default:
+		return fmt.Errorf("%w: unsupported alg %q", ErrInvalidToken, alg)
+	}
+
+	return nil
+}
+
+// ParseRSAPublicKey принимает PEM "PUBLIC KEY" (PKIX) или "RSA PUBLIC KEY" (PKCS#1)
+func ParseRSAPublicKey(data []byte) (*rsa.PublicKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("rsa public key: no PEM block")
+	}
+
+	if block.Type == "RSA PUBLIC KEY" {
+		key, err := x509.ParsePKCS1PublicKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("rsa public key: %w", err)
+		}
+		return key, nil
+	}
+
+	parsed, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("rsa public key: %w", err)
+	}
+
+	key, ok := parsed.(*rsa.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("rsa public key: unexpected key type %T", parsed)
+	}
+
+	return key, nil
+}
+
+// audience aud может быть строкой или массивом строк
+type audience []string
+
+// UnmarshalJSON ...
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var list []string
+	if err := json.Unmarshal(data, &list); err != nil {
+		return err
+	}
+	*a = list
+
+	return nil
+}
+
+// decodeSegment ...
+func decodeSegment(segment string, v interface{}) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+// unixTime ...
+func unixTime(seconds float64) time.Time {
+	return time.Unix(0, int64(seconds*float64(time.Second)))
+}
@@ -0,0 +1,191 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "test-secret"
+
+func signHS256(t *testing.T, secret string, claims map[string]interface{}) string {
+	input := signingInput(t, AlgHS256, claims)
+
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(input))
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func signRS256(t *testing.T, key *rsa.PrivateKey, claims map[string]interface{}) string {
+	input := signingInput(t, AlgRS256, claims)
+
+	hash := sha256.Sum256([]byte(input))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, hash[:])
+	require.NoError(t, err)
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
+
+func signingInput(t *testing.T, alg string, claims map[string]interface{}) string {
+	header, err := json.Marshal(map[string]string{"alg": alg, "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+
+	return base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	t.Parallel()
+
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	der, err := x509.MarshalPKIXPublicKey(&rsaKey.PublicKey)
+	require.NoError(t, err)
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})
+
+	verifier, err := NewVerifier(Config{
+		HS256Secret:    testSecret,
+		RS256PublicKey: publicPEM,
+		Issuer:         "auth",
+		Audience:       "cart",
+		AdminScope:     "cart:admin",
+	})
+	require.NoError(t, err)
+
+	now := time.Now()
+	valid := func() map[string]interface{} {
+		return map[string]interface{}{
+			"sub":   "42",
+			"iss":   "auth",
+			"aud":   []string{"cart", "loms"},
+			"exp":   now.Add(time.Hour).Unix(),
+			"scope": "cart:read",
+		}
+	}
+	with := func(key string, value interface{}) map[string]interface{} {
+		claims := valid()
+		if value == nil {
+			delete(claims, key)
+			return claims
+		}
+		claims[key] = value
+		return claims
+	}
+
+	tests := []struct {
+		name     string
+		token    string
+		expected *Claims
+	}{
+		{
+			name:     "hs256",
+			token:    signHS256(t, testSecret, valid()),
+			expected: &Claims{UserID: 42, Scopes: []string{"cart:read"}, ExpiresAt: time.Unix(now.Add(time.Hour).Unix(), 0)},
+		},
+		{
+			name:     "rs256",
+			token:    signRS256(t, rsaKey, with("aud", "cart")),
+			expected: &Claims{UserID: 42, Scopes: []string{"cart:read"}, ExpiresAt: time.Unix(now.Add(time.Hour).Unix(), 0)},
+		},
+		{
+			name:  "admin scope",
+			token: signHS256(t, testSecret, with("scope", "cart:read cart:admin")),
+			expected: &Claims{
+				UserID:    42,
+				Scopes:    []string{"cart:read", "cart:admin"},
+				Admin:     true,
+				ExpiresAt: time.Unix(now.Add(time.Hour).Unix(), 0),
+			},
+		},
+		{name: "err malformed", token: "abc.def"},
+		{name: "err wrong secret", token: signHS256(t, "other", valid())},
+		{name: "err wrong rsa key", token: signRS256(t, otherKey, valid())},
+		{name: "err alg none", token: signingInput(t, "none", valid()) + "."},
+		{name: "err expired", token: signHS256(t, testSecret, with("exp", now.Add(-time.Hour).Unix()))},
+		{name: "err without exp", token: signHS256(t, testSecret, with("exp", nil))},
+		{name: "err not valid yet", token: signHS256(t, testSecret, with("nbf", now.Add(time.Hour).Unix()))},
+		{name: "err issuer", token: signHS256(t, testSecret, with("iss", "other"))},
+		{name: "err audience", token: signHS256(t, testSecret, with("aud", "loms"))},
+		{name: "err subject", token: signHS256(t, testSecret, with("sub", "admin"))},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Execute
+			claims, err := verifier.Verify(tt.token)
+
+			// Verify
+			if tt.expected == nil {
+				require.ErrorIs(t, err, ErrInvalidToken)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, claims)
+		})
+	}
+}
+
+func TestVerifier_KeyAlgorithm(t *testing.T) {
+	t.Parallel()
+
+	// токен HS256, подписанный открытым ключом RSA, не принимается верификатором только с RS256
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	publicPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PUBLIC KEY", Bytes: x509.MarshalPKCS1PublicKey(&rsaKey.PublicKey)})
+
+	verifier, err := NewVerifier(Config{RS256PublicKey: publicPEM})
+	require.NoError(t, err)
+
+	claims := map[string]interface{}{"sub": "1", "exp": time.Now().Add(time.Hour).Unix()}
+
+	_, err = verifier.Verify(signHS256(t, string(publicPEM), claims))
+	require.ErrorIs(t, err, ErrInvalidToken)
+
+	_, err = verifier.Verify(signRS256(t, rsaKey, claims))
+	require.NoError(t, err)
+
+	_, err = NewVerifier(Config{})
+	require.ErrorIs(t, err, ErrNoKeys)
+
+	_, err = NewVerifier(Config{RS256PublicKey: []byte("not a key")})
+	require.Error(t, err)
+}
+
+func TestCanAccess(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	assert.True(t, CanAccess(ctx, 1))
+
+	user := WithClaims(ctx, &Claims{UserID: 1})
+	assert.True(t, CanAccess(user, 1))
+	assert.False(t, CanAccess(user, 2))
+
+	admin := WithClaims(ctx, &Claims{UserID: 1, Admin: true})
+	assert.True(t, CanAccess(admin, 2))
+
+	assert.True(t, IsAdmin(ctx))
+	assert.False(t, IsAdmin(user))
+	assert.True(t, IsAdmin(admin))
+}
@@ -0,0 +1,188 @@
+// Package breaker ...
+package breaker
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
+)
+
+// State ...
+type State int
+
+const (
+	// StateClosed запросы проходят, ошибки считаются
+	StateClosed State = iota
+	// StateHalfOpen пропускается ограниченное число пробных запросов
+	StateHalfOpen
+	// StateOpen запросы сразу завершаются ошибкой
+	StateOpen
+)
+
+const (
+	// DefaultFailureThreshold ...
+	DefaultFailureThreshold = 5
+	// DefaultOpenTimeout ...
+	DefaultOpenTimeout = 10 * time.Second
+	// DefaultHalfOpenRequests ...
+	DefaultHalfOpenRequests = 1
+)
+
+// Config нулевые значения заменяются значениями по умолчанию
+type Config struct {
+	// FailureThreshold сколько ошибок подряд открывает breaker
+	FailureThreshold int
+	// OpenTimeout через сколько открытый breaker пропускает пробные запросы
+	OpenTimeout time.Duration
+	// HalfOpenRequests сколько пробных запросов пропускается и сколько успешных нужно, чтобы закрыть breaker
+	HalfOpenRequests int
+}
+
+// Breaker ...
+type Breaker struct {
+	name      string
+	cfg       Config
+	isFailure func(err error) bool
+	mx        sync.Mutex
+	state     State
+	// generation меняется при каждой смене состояния, результаты запросов из прошлого состояния игнорируются
+	generation uint64
+	failures   int
+	probes     int
+	successes  int
+	openedAt   time.Time
+	now        func() time.Time
+}
+
+// New isFailure решает, какие ошибки говорят о недоступности сервиса. Остальные ошибки считаются успешным ответом
+func New(name string, cfg Config, isFailure func(err error) bool) *Breaker {
+	if cfg.FailureThreshold < 1 {
+		cfg.FailureThreshold = DefaultFailureThreshold
+	}
+	if cfg.OpenTimeout <= 0 {
+		cfg.OpenTimeout = DefaultOpenTimeout
+	}
+	if cfg.HalfOpenRequests < 1 {
+		cfg.HalfOpenRequests = DefaultHalfOpenRequests
+	}
+
+	b := &Breaker{
+		name:      name,
+		cfg:       cfg,
+		isFailure: isFailure,
+		now:       time.Now,
+	}
+	metrics.SetBreakerState(name, int(StateClosed))
+
+	return b
+}
+
+// Call выполняет fn, если breaker пропускает запрос, и учитывает результат.
+// Открытый breaker возвращает ошибку, оборачивающую model.ErrServiceUnavailable
+func Call[T any](b *Breaker, fn func() (T, error)) (T, error) {
+	var zero T
+
+	generation, err := b.allow()
+	if err != nil {
+		return zero, err
+	}
+
+	resp, err := fn()
+	b.done(generation, err)
+
+	return resp, err
+}
+
+// Execute ...
+func (b *Breaker) Execute(fn func() error) error {
+	_, err := Call(b, func() (struct{}, error) {
+		return struct{}{}, fn()
+	})
+
+	return err
+}
+
+// State ...
+func (b *Breaker) State() State {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	return b.state
+}
+
+// allow ...
+func (b *Breaker) allow() (uint64, error) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if b.state == StateOpen {
+		if b.now().Sub(b.openedAt) < b.cfg.OpenTimeout {
+			return 0, b.errOpen()
+		}
+		b.setState(StateHalfOpen)
+	}
+
+	if b.state == StateHalfOpen {
+		if b.probes >= b.cfg.HalfOpenRequests {
+			return 0, b.errOpen()
+		}
+		b.probes++
+	}
+
+	return b.generation, nil
+}
+
+// done ...
+func (b *Breaker) done(generation uint64, err error) {
+	b.mx.Lock()
+	defer b.mx.Unlock()
+
+	if generation != b.generation {
+		return
+	}
+
+	failed := err != nil && b.isFailure(err)
+
+	switch b.state {
+	case StateClosed:
+		if !failed {
+			b.failures = 0
+			return
+		}
+		b.failures++
+		if b.failures >= b.cfg.FailureThreshold {
+			b.setState(StateOpen)
+		}
+	case StateHalfOpen:
+		if failed {
+			b.setState(StateOpen)
+			return
+		}
+		b.successes++
+		if b.successes >= b.cfg.HalfOpenRequests {
+			b.setState(StateClosed)
+		}
+	}
+}
+
+// setState вызывается под мьютексом
+func (b *Breaker) setState(state State) {
+	b.state = state
+	b.generation++
+	b.failures = 0
+	b.probes = 0
+	b.successes = 0
+	if state == StateOpen {
+		b.openedAt = b.now()
+	}
+
+	metrics.SetBreakerState(b.name, int(state))
+}
+
+// errOpen ...
+func (b *Breaker) errOpen() error {
+	return fmt.Errorf("circuit breaker %s is open: %w", b.name, model.ErrServiceUnavailable)
+}
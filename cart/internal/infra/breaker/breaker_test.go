@@ -0,0 +1,121 @@
+package breaker
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	errFailure  = errors.New("unavailable")
+	errBusiness = errors.New("not found")
+)
+
+func newTestBreaker(cfg Config) (*Breaker, *time.Time) {
+	now := time.Now()
+	b := New("test", cfg, func(err error) bool {
+		return !errors.Is(err, errBusiness)
+	})
+	b.now = func() time.Time { return now }
+
+	return b, &now
+}
+
+func fail() error { return errFailure }
+
+func ok() error { return nil }
+
+func TestBreaker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("opens after threshold", func(t *testing.T) {
+		t.Parallel()
+		b, _ := newTestBreaker(Config{FailureThreshold: 3, OpenTimeout: time.Second})
+
+		for i := 0; i < 3; i++ {
+			require.ErrorIs(t, b.Execute(fail), errFailure)
+		}
+		assert.Equal(t, StateOpen, b.State())
+
+		called := false
+		err := b.Execute(func() error {
+			called = true
+			return nil
+		})
+		require.ErrorIs(t, err, model.ErrServiceUnavailable)
+		assert.False(t, called)
+	})
+
+	t.Run("success resets failures", func(t *testing.T) {
+		t.Parallel()
+		b, _ := newTestBreaker(Config{FailureThreshold: 2})
+
+		require.Error(t, b.Execute(fail))
+		require.NoError(t, b.Execute(ok))
+		require.Error(t, b.Execute(fail))
+		assert.Equal(t, StateClosed, b.State())
+	})
+
+	t.Run("business errors are not failures", func(t *testing.T) {
+		t.Parallel()
+		b, _ := newTestBreaker(Config{FailureThreshold: 1})
+
+		for i := 0; i < 3; i++ {
+			require.ErrorIs(t, b.Execute(func() error { return errBusiness }), errBusiness)
+		}
+		assert.Equal(t, StateClosed, b.State())
+	})
+
+	t.Run("half open probe closes", func(t *testing.T) {
+		t.Parallel()
+		b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Second})
+
+		require.Error(t, b.Execute(fail))
+		assert.Equal(t, StateOpen, b.State())
+
+		*now = now.Add(time.Second)
+		resp, err := Call(b, func() (int, error) { return 1, nil })
+		require.NoError(t, err)
+		assert.Equal(t, 1, resp)
+		assert.Equal(t, StateClosed, b.State())
+	})
+
+	t.Run("half open probe failure reopens", func(t *testing.T) {
+		t.Parallel()
+		b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Second})
+
+		require.Error(t, b.Execute(fail))
+		*now = now.Add(time.Second)
+		require.ErrorIs(t, b.Execute(fail), errFailure)
+		assert.Equal(t, StateOpen, b.State())
+		require.ErrorIs(t, b.Execute(ok), model.ErrServiceUnavailable)
+	})
+
+	t.Run("half open limits probes", func(t *testing.T) {
+		t.Parallel()
+		b, now := newTestBreaker(Config{FailureThreshold: 1, OpenTimeout: time.Second, HalfOpenRequests: 1})
+
+		require.Error(t, b.Execute(fail))
+		*now = now.Add(time.Second)
+
+		release := make(chan struct{})
+		done := make(chan error)
+		go func() {
+			done <- b.Execute(func() error {
+				<-release
+				return nil
+			})
+		}()
+
+		require.Eventually(t, func() bool { return b.State() == StateHalfOpen }, time.Second, time.Millisecond)
+		require.ErrorIs(t, b.Execute(ok), model.ErrServiceUnavailable)
+
+		close(release)
+		require.NoError(t, <-done)
+		assert.Equal(t, StateClosed, b.State())
+	})
+}
@@ -3,15 +3,52 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"gopkg.in/yaml.v3"
 )
 
+const (
+	// StorageMemory ...
+	StorageMemory = "memory"
+	// StoragePostgres ...
+	StoragePostgres = "postgres"
+	// StorageRedis ...
+	StorageRedis = "redis"
+)
+
+// CircuitBreaker ...
+type CircuitBreaker struct {
+	// FailureThreshold сколько ошибок подряд открывает breaker
+	FailureThreshold int `yaml:"failure_threshold"`
+	// OpenTimeout через сколько открытый breaker пропускает пробные запросы
+	OpenTimeout time.Duration `yaml:"open_timeout"`
+	// HalfOpenRequests сколько пробных запросов пропускается в полуоткрытом состоянии
+	HalfOpenRequests int `yaml:"half_open_requests"`
+}
+
+// RateLimit ...
+type RateLimit struct {
+	// RPS запросов в секунду, 0 - без ограничения
+	RPS   float64 `yaml:"rps"`
+	Burst int     `yaml:"burst"`
+}
+
 // Config ...
 type Config struct {
 	Server struct {
-		Host string `yaml:"host"`
-		Port string `yaml:"port"`
+		Host     string `yaml:"host"`
+		Port     string `yaml:"port"`
+		GRPCPort string `yaml:"grpc_port"`
+		// Таймауты http сервера, 0 - значение по умолчанию
+		ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+		ReadTimeout       time.Duration `yaml:"read_timeout"`
+		WriteTimeout      time.Duration `yaml:"write_timeout"`
+		IdleTimeout       time.Duration `yaml:"idle_timeout"`
+		// ShutdownTimeout сколько при остановке ждать завершения текущих запросов
+		ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+		// MaxBodyBytes максимальный размер тела http запроса
+		MaxBodyBytes int64 `yaml:"max_body_bytes"`
 	} `yaml:"service"`
 	ProductService struct {
 		Host  string `yaml:"host"`
@@ -19,15 +56,111 @@ type Config struct {
 		Token string `yaml:"token"`
 		Limit int    `yaml:"limit"`
 		Burst int    `yaml:"burst"`
+		// CacheTTL сколько хранится ответ product-service
+		CacheTTL time.Duration `yaml:"cache_ttl"`
+		// CacheNegativeTTL сколько хранится ответ "товар не найден"
+		CacheNegativeTTL time.Duration `yaml:"cache_negative_ttl"`
+		// CacheStaleTTL сколько хранится последний известный ответ на случай недоступности product-service
+		CacheStaleTTL time.Duration `yaml:"cache_stale_ttl"`
+		Retry         struct {
+			MaxAttempts int           `yaml:"max_attempts"`
+			BaseDelay   time.Duration `yaml:"base_delay"`
+			MaxDelay    time.Duration `yaml:"max_delay"`
+			Jitter      float64       `yaml:"jitter"`
+		} `yaml:"retry"`
+		CircuitBreaker CircuitBreaker `yaml:"circuit_breaker"`
 	} `yaml:"product_service"`
 	LomsService struct {
-		Host string `yaml:"host"`
-		Port string `yaml:"port"`
+		Host           string         `yaml:"host"`
+		Port           string         `yaml:"port"`
+		CircuitBreaker CircuitBreaker `yaml:"circuit_breaker"`
 	} `yaml:"loms_service"`
+	// Limits ограничения корзины, 0 - без ограничения
+	Limits struct {
+		// MaxSkuCount максимальное количество одного товара в корзине
+		MaxSkuCount uint32 `yaml:"max_sku_count"`
+		// MaxLines максимальное количество разных товаров в корзине
+		MaxLines int `yaml:"max_lines"`
+		// MaxTotal максимальная сумма корзины
+		MaxTotal uint64 `yaml:"max_total"`
+	} `yaml:"limits"`
+	Promo struct {
+		// RulesFile yaml-файл с правилами промокодов, пустой - промокодов нет
+		RulesFile string `yaml:"rules_file"`
+	} `yaml:"promo"`
+	Checkout struct {
+		// IdempotencyTTL сколько хранится результат оформления заказа по Idempotency-Key
+		IdempotencyTTL time.Duration `yaml:"idempotency_ttl"`
+	} `yaml:"checkout"`
+	Kafka struct {
+		// Brokers адрес брокера, пустой - события корзины не отправляются
+		Brokers   string `yaml:"brokers"`
+		TopicName string `yaml:"cart_topic"`
+		// AbandonedTopic топик событий о брошенных корзинах
+		AbandonedTopic string `yaml:"abandoned_topic"`
+		// BufferSize сколько событий может ждать отправки в брокер
+		BufferSize int `yaml:"buffer_size"`
+	} `yaml:"kafka"`
+	// AbandonedCarts поиск брошенных корзин, работает только вместе с kafka
+	AbandonedCarts struct {
+		// Threshold через сколько после последнего изменения корзина считается брошенной, 0 - поиск отключен
+		Threshold time.Duration `yaml:"threshold"`
+		// Interval как часто искать брошенные корзины
+		Interval  time.Duration `yaml:"interval"`
+		BatchSize int           `yaml:"batch_size"`
+	} `yaml:"abandoned_carts"`
+	// Auth без hs256_secret и rs256_public_key_file сервис не запускается, если явно не задано enabled: false
+	Auth struct {
+		// Enabled по умолчанию аутентификация включена, false отключает проверку токенов и доступа к корзинам
+		Enabled     *bool  `yaml:"enabled"`
+		HS256Secret string `yaml:"hs256_secret"`
+		// RS256PublicKeyFile PEM с открытым ключом RSA
+		RS256PublicKeyFile string `yaml:"rs256_public_key_file"`
+		// Issuer и Audience проверяются, если заданы
+		Issuer   string `yaml:"issuer"`
+		Audience string `yaml:"audience"`
+		// AdminScope scope, с которым доступны корзины всех пользователей
+		AdminScope string `yaml:"admin_scope"`
+	} `yaml:"auth"`
+	// RateLimits ограничение входящих http и gRPC запросов на пользователя, без аутентификации - на IP.
+	// Методы gRPC ограничиваются лимитами HTTP маршрутов тех же операций
+	RateLimits struct {
+		Default RateLimit `yaml:"default"`
+		// Routes лимиты отдельных маршрутов, ключ - шаблон маршрута, например "POST /checkout/{user_id}"
+		Routes map[string]RateLimit `yaml:"routes"`
+		// IdleTTL через сколько удаляется bucket клиента без запросов
+		IdleTTL time.Duration `yaml:"idle_ttl"`
+	} `yaml:"rate_limits"`
+	// AccessLog строка лога на каждый http запрос
+	AccessLog struct {
+		// SampleRate доля записываемых запросов от 0 до 1, ответы 5xx записываются всегда
+		SampleRate float64 `yaml:"sample_rate"`
+		// RedactHeaders заголовки, значения которых не пишутся в лог, Authorization, Cookie и X-API-KEY скрываются всегда
+		RedactHeaders []string `yaml:"redact_headers"`
+	} `yaml:"access_log"`
 	Jaeger struct {
 		Host string `yaml:"host"`
 		Port string `yaml:"port"`
 	} `yaml:"jaeger"`
+	Storage struct {
+		// Type memory | postgres | redis, по умолчанию memory
+		Type     string `yaml:"type"`
+		Postgres struct {
+			Host     string `yaml:"host"`
+			Port     string `yaml:"port"`
+			User     string `yaml:"user"`
+			Password string `yaml:"password"`
+			DBName   string `yaml:"db_name"`
+		} `yaml:"postgres"`
+		Redis struct {
+			Host     string `yaml:"host"`
+			Port     string `yaml:"port"`
+			Password string `yaml:"password"`
+			DB       int    `yaml:"db"`
+			// TTL время жизни корзины без изменений, 0 - без ограничения
+			TTL time.Duration `yaml:"ttl"`
+		} `yaml:"redis"`
+	} `yaml:"storage"`
 }
 
 // LoadConfig ...
@@ -0,0 +1,140 @@
+package middlewares
+
+import (
+	"context"
+	"math/rand"
+	"net/http"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// redacted ...
+const redacted = "[REDACTED]"
+
+// defaultRedactHeaders не попадают в лог независимо от конфига
+var defaultRedactHeaders = []string{"Authorization", "Cookie", "X-API-KEY"}
+
+// AccessLogConfig ...
+type AccessLogConfig struct {
+	// SampleRate доля записываемых запросов от 0 до 1, ответы 5xx записываются всегда
+	SampleRate float64
+	// RedactHeaders заголовки, значения которых заменяются на [REDACTED], в дополнение к defaultRedactHeaders
+	RedactHeaders []string
+}
+
+// accessEntry маршрут и user_id становятся известны только после роутинга, их записывают
+// RequireUser и RateLimiter через контекст запроса
+type accessEntry struct {
+	route  string
+	userID int64
+}
+
+// accessEntryKey ...
+type accessEntryKey struct{}
+
+// AccessLogMiddleware пишет одну строку лога на запрос. Запрос выполняется в отдельном span,
+// спаны ручек становятся его дочерними, а trace id попадает в строку лога
+type AccessLogMiddleware struct {
+	h          http.Handler
+	tracer     trace.Tracer
+	sampleRate float64
+	redact     map[string]struct{}
+	sample     func() float64
+	log        func(msg string, keysAndValues ...interface{})
+}
+
+// NewAccessLogMiddleware ...
+func NewAccessLogMiddleware(h http.Handler, tracer trace.Tracer, cfg AccessLogConfig) http.Handler {
+	redact := make(map[string]struct{}, len(defaultRedactHeaders)+len(cfg.RedactHeaders))
+	for _, header := range slices.Concat(defaultRedactHeaders, cfg.RedactHeaders) {
+		redact[http.CanonicalHeaderKey(header)] = struct{}{}
+	}
+
+	return &AccessLogMiddleware{
+		h:          h,
+		tracer:     tracer,
+		sampleRate: cfg.SampleRate,
+		redact:     redact,
+		//nolint:gosec
+		sample: rand.Float64,
+		log:    logger.Infow,
+	}
+}
+
+// ServeHTTP ...
+func (m *AccessLogMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	start := time.Now()
+
+	ctx, span := m.tracer.Start(
+		r.Context(),
+		"HTTP "+r.Method,
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(attribute.String("http.path", r.URL.Path)),
+	)
+	defer span.End()
+
+	entry := &accessEntry{}
+	rw := newResponseWriterWrapper(w)
+
+	m.h.ServeHTTP(rw, r.WithContext(context.WithValue(ctx, accessEntryKey{}, entry)))
+
+	latency := time.Since(start)
+
+	if rw.statusCode < http.StatusInternalServerError && m.sample() >= m.sampleRate {
+		return
+	}
+
+	route := entry.route
+	if route == "" {
+		route = r.Method + " " + r.URL.Path
+	}
+
+	keysAndValues := []interface{}{
+		"span", span,
+		"method", r.Method,
+		"route", route,
+		"status", rw.statusCode,
+		"latency", latency,
+		"bytes", rw.bytes,
+		"remote_addr", r.RemoteAddr,
+		"headers", m.headers(r.Header),
+	}
+	if entry.userID > 0 {
+		keysAndValues = append(keysAndValues, "user_id", entry.userID)
+	}
+
+	m.log("access", keysAndValues...)
+}
+
+// headers значения чувствительных заголовков заменяются на [REDACTED]
+func (m *AccessLogMiddleware) headers(header http.Header) map[string]string {
+	headers := make(map[string]string, len(header))
+	for name, values := range header {
+		if _, ok := m.redact[http.CanonicalHeaderKey(name)]; ok {
+			headers[name] = redacted
+			continue
+		}
+		headers[name] = strings.Join(values, ", ")
+	}
+
+	return headers
+}
+
+// setAccessRoute ...
+func setAccessRoute(ctx context.Context, route string) {
+	if entry, ok := ctx.Value(accessEntryKey{}).(*accessEntry); ok && route != "" {
+		entry.route = route
+	}
+}
+
+// setAccessUserID ...
+func setAccessUserID(ctx context.Context, userID int64) {
+	if entry, ok := ctx.Value(accessEntryKey{}).(*accessEntry); ok {
+		entry.userID = userID
+	}
+}
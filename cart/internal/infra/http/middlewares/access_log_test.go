@@ -0,0 +1,125 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+func TestAccessLogMiddleware(t *testing.T) {
+	t.Parallel()
+
+	mx := http.NewServeMux()
+	mx.HandleFunc(model.GetItemsByUserIDURL, RequireUser(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("cart"))
+	}))
+	mx.HandleFunc(model.OrderFullCartURL, RequireUser(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+
+	tests := []struct {
+		name       string
+		method     string
+		url        string
+		sampleRate float64
+		expected   map[string]interface{}
+	}{
+		{
+			name:       "success",
+			method:     http.MethodGet,
+			url:        "/user/7/cart",
+			sampleRate: 1,
+			expected: map[string]interface{}{
+				"method":  http.MethodGet,
+				"route":   model.GetItemsByUserIDURL,
+				"status":  http.StatusOK,
+				"bytes":   4,
+				"user_id": int64(7),
+				"headers": map[string]string{
+					"Authorization": redacted,
+					"X-Api-Key":     redacted,
+					"X-Secret":      redacted,
+					"Accept":        "application/json",
+				},
+			},
+		},
+		{
+			name:       "sampled out",
+			method:     http.MethodGet,
+			url:        "/user/7/cart",
+			sampleRate: 0,
+		},
+		{
+			name:       "server error always logged",
+			method:     http.MethodPost,
+			url:        "/checkout/7",
+			sampleRate: 0,
+			expected: map[string]interface{}{
+				"route":   model.OrderFullCartURL,
+				"status":  http.StatusInternalServerError,
+				"user_id": int64(7),
+			},
+		},
+		{
+			name:       "unknown route",
+			method:     http.MethodGet,
+			url:        "/unknown",
+			sampleRate: 1,
+			expected: map[string]interface{}{
+				"route":  "GET /unknown",
+				"status": http.StatusNotFound,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			h, ok := NewAccessLogMiddleware(mx, noop.NewTracerProvider().Tracer(""), AccessLogConfig{
+				SampleRate:    tt.sampleRate,
+				RedactHeaders: []string{"x-secret"},
+			}).(*AccessLogMiddleware)
+			require.True(t, ok)
+
+			var logged map[string]interface{}
+			h.sample = func() float64 { return 0.5 }
+			h.log = func(_ string, keysAndValues ...interface{}) {
+				logged = make(map[string]interface{}, len(keysAndValues)/2)
+				for i := 0; i < len(keysAndValues)-1; i += 2 {
+					logged[keysAndValues[i].(string)] = keysAndValues[i+1]
+				}
+			}
+
+			req := httptest.NewRequest(tt.method, tt.url, nil)
+			req.Header.Set("Authorization", "Bearer token")
+			req.Header.Set("x-api-key", "key")
+			req.Header.Set("X-Secret", "secret")
+			req.Header.Set("Accept", "application/json")
+
+			// Execute
+			h.ServeHTTP(httptest.NewRecorder(), req)
+
+			// Verify
+			if tt.expected == nil {
+				assert.Nil(t, logged)
+				return
+			}
+			require.NotNil(t, logged)
+			assert.Contains(t, logged, "span")
+			assert.Contains(t, logged, "latency")
+			for key, value := range tt.expected {
+				assert.Equal(t, value, logged[key], key)
+			}
+			if _, ok := tt.expected["user_id"]; !ok {
+				assert.NotContains(t, logged, "user_id")
+			}
+		})
+	}
+}
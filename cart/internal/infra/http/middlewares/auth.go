@@ -0,0 +1,131 @@
+package middlewares
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/auth"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// publicPaths доступны без токена
+var publicPaths = []string{"/metrics", "/healthz", "/readyz"}
+
+// AuthMiddleware проверяет JWT из заголовка Authorization и кладет claims в контекст запроса
+type AuthMiddleware struct {
+	h        http.Handler
+	verifier *auth.Verifier
+}
+
+// NewAuthMiddleware ...
+func NewAuthMiddleware(h http.Handler, verifier *auth.Verifier) http.Handler {
+	return &AuthMiddleware{h: h, verifier: verifier}
+}
+
+// ServeHTTP ...
+func (m *AuthMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if isPublicPath(r.URL.Path) {
+		m.h.ServeHTTP(w, r)
+		return
+	}
+
+	claims, err := authenticate(m.verifier, r.Header.Get("Authorization"))
+	if err != nil {
+		logger.Infow(fmt.Sprintf("%s %s unauthorized: %v", r.Method, r.URL.Path, err))
+		w.Header().Set("WWW-Authenticate", "Bearer")
+		writeError(w, model.ErrUnauthorized, http.StatusUnauthorized)
+		return
+	}
+
+	m.h.ServeHTTP(w, r.WithContext(auth.WithClaims(r.Context(), claims)))
+}
+
+// RequireUser пропускает запрос, только если user_id из пути совпадает с subject токена или токен дает права администратора.
+// Некорректный user_id пропускается дальше, его отклонит валидация ручки
+func RequireUser(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		userID, err := strconv.ParseInt(r.PathValue("user_id"), 10, 64)
+		setAccessRoute(r.Context(), r.Pattern)
+		setAccessUserID(r.Context(), userID)
+		if err == nil && !auth.CanAccess(r.Context(), userID) {
+			writeError(w, model.ErrForbidden, http.StatusForbidden)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// RequireAdmin пропускает запрос только с токеном администратора, например к /debug/pprof/
+func RequireAdmin(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if !auth.IsAdmin(r.Context()) {
+			writeError(w, model.ErrAdminRequired, http.StatusForbidden)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// Auth gRPC аналог AuthMiddleware и RequireUser: токен берется из метаданных authorization,
+// user_id - из запроса, если в нем есть такое поле
+func Auth(verifier *auth.Verifier) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, _ *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		var header string
+		if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+			header = values[0]
+		}
+
+		claims, err := authenticate(verifier, header)
+		if err != nil {
+			return nil, status.Error(codes.Unauthenticated, model.ErrUnauthorized.Error())
+		}
+
+		if r, ok := req.(interface{ GetUserId() int64 }); ok && !claims.CanAccess(r.GetUserId()) {
+			return nil, status.Error(codes.PermissionDenied, model.ErrForbidden.Error())
+		}
+
+		return handler(auth.WithClaims(ctx, claims), req)
+	}
+}
+
+// authenticate ...
+func authenticate(verifier *auth.Verifier, header string) (*auth.Claims, error) {
+	scheme, token, ok := strings.Cut(header, " ")
+	if !ok || !strings.EqualFold(scheme, "Bearer") {
+		return nil, fmt.Errorf("%w: bearer token required", auth.ErrInvalidToken)
+	}
+
+	return verifier.Verify(strings.TrimSpace(token))
+}
+
+// isPublicPath ...
+func isPublicPath(path string) bool {
+	for _, public := range publicPaths {
+		if path == public || strings.HasSuffix(public, "/") && strings.HasPrefix(path, public) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeError отвечает в том же формате, что и ручки
+func writeError(w http.ResponseWriter, err error, statusCode int) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if errE := json.NewEncoder(w).Encode(struct{ Message string }{Message: err.Error()}); errE != nil {
+		logger.Infow(fmt.Sprintf("Encode : %v", errE))
+	}
+}
@@ -0,0 +1,260 @@
+package middlewares
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/auth"
+	pb "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/v1"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testSecret     = "test-secret"
+	testAdminScope = "cart:admin"
+)
+
+func newTestVerifier(t *testing.T) *auth.Verifier {
+	verifier, err := auth.NewVerifier(auth.Config{HS256Secret: testSecret, AdminScope: testAdminScope})
+	require.NoError(t, err)
+
+	return verifier
+}
+
+func testToken(t *testing.T, userID int64, scope string) string {
+	header, err := json.Marshal(map[string]string{"alg": auth.AlgHS256, "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(map[string]interface{}{
+		"sub":   fmt.Sprintf("%d", userID),
+		"exp":   time.Now().Add(time.Hour).Unix(),
+		"scope": scope,
+	})
+	require.NoError(t, err)
+
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte(input))
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func TestAuthMiddleware(t *testing.T) {
+	t.Parallel()
+
+	mx := http.NewServeMux()
+	mx.HandleFunc(model.GetItemsByUserIDURL, RequireUser(func(w http.ResponseWriter, r *http.Request) {
+		claims, ok := auth.FromContext(r.Context())
+		if !ok {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		_, _ = fmt.Fprintf(w, "%d", claims.UserID)
+	}))
+	mx.HandleFunc(model.GetMetricsURL, func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mx.HandleFunc("GET /readyz", func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	mx.HandleFunc(model.DebugPprof, RequireAdmin(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	h := NewAuthMiddleware(mx, newTestVerifier(t))
+
+	tests := []struct {
+		name           string
+		url            string
+		authorization  string
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "success own cart",
+			url:            "/user/1/cart",
+			authorization:  "Bearer " + testToken(t, 1, ""),
+			expectedStatus: http.StatusOK,
+			expectedBody:   "1",
+		},
+		{
+			name:           "success admin",
+			url:            "/user/2/cart",
+			authorization:  "bearer " + testToken(t, 1, testAdminScope),
+			expectedStatus: http.StatusOK,
+			expectedBody:   "1",
+		},
+		{
+			name:           "success public path",
+			url:            "/metrics",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "success readiness probe",
+			url:            "/readyz",
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "success pprof admin",
+			url:            "/debug/pprof/heap",
+			authorization:  "Bearer " + testToken(t, 1, testAdminScope),
+			expectedStatus: http.StatusOK,
+		},
+		{
+			name:           "err pprof without token",
+			url:            "/debug/pprof/heap",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrUnauthorized),
+		},
+		{
+			name:           "err pprof not admin",
+			url:            "/debug/pprof/heap",
+			authorization:  "Bearer " + testToken(t, 1, "cart:read"),
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrAdminRequired),
+		},
+		{
+			name:           "err another user",
+			url:            "/user/2/cart",
+			authorization:  "Bearer " + testToken(t, 1, "cart:read"),
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrForbidden),
+		},
+		{
+			name:           "err without token",
+			url:            "/user/1/cart",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrUnauthorized),
+		},
+		{
+			name:           "err invalid token",
+			url:            "/user/1/cart",
+			authorization:  "Bearer " + testToken(t, 1, "")[1:],
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrUnauthorized),
+		},
+		{
+			name:           "err basic auth",
+			url:            "/user/1/cart",
+			authorization:  "Basic dXNlcjpwYXNz",
+			expectedStatus: http.StatusUnauthorized,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrUnauthorized),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			req := httptest.NewRequest(http.MethodGet, tt.url, nil)
+			if tt.authorization != "" {
+				req.Header.Set("Authorization", tt.authorization)
+			}
+			w := httptest.NewRecorder()
+
+			// Execute
+			h.ServeHTTP(w, req)
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+			if tt.expectedStatus == http.StatusUnauthorized {
+				assert.Equal(t, "Bearer", w.Header().Get("WWW-Authenticate"))
+			}
+		})
+	}
+}
+
+func TestRequireUser_WithoutAuth(t *testing.T) {
+	t.Parallel()
+
+	// без AuthMiddleware (аутентификация отключена) запросы проходят
+	mx := http.NewServeMux()
+	mx.HandleFunc(model.GetItemsByUserIDURL, RequireUser(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	w := httptest.NewRecorder()
+	mx.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/user/2/cart", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestAuth(t *testing.T) {
+	t.Parallel()
+
+	interceptor := Auth(newTestVerifier(t))
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		claims, ok := auth.FromContext(ctx)
+		require.True(t, ok)
+		return claims.UserID, nil
+	}
+
+	tests := []struct {
+		name         string
+		token        string
+		req          interface{}
+		expectedCode codes.Code
+	}{
+		{
+			name:         "success",
+			token:        testToken(t, 1, ""),
+			req:          &pb.ListCartRequest{UserId: 1},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "success admin",
+			token:        testToken(t, 1, testAdminScope),
+			req:          &pb.ListCartRequest{UserId: 2},
+			expectedCode: codes.OK,
+		},
+		{
+			name:         "err another user",
+			token:        testToken(t, 1, ""),
+			req:          &pb.ClearCartRequest{UserId: 2},
+			expectedCode: codes.PermissionDenied,
+		},
+		{
+			name:         "err without token",
+			req:          &pb.ListCartRequest{UserId: 1},
+			expectedCode: codes.Unauthenticated,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			ctx := context.Background()
+			if tt.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tt.token))
+			}
+
+			// Execute
+			resp, err := interceptor(ctx, tt.req, &grpc.UnaryServerInfo{}, handler)
+
+			// Verify
+			assert.Equal(t, tt.expectedCode, status.Code(err))
+			if tt.expectedCode == codes.OK {
+				assert.Equal(t, int64(1), resp)
+			}
+		})
+	}
+}
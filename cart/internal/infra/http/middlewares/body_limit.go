@@ -0,0 +1,38 @@
+package middlewares
+
+import (
+	"net/http"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+)
+
+// DefaultMaxBodyBytes ...
+const DefaultMaxBodyBytes int64 = 1 << 20
+
+// BodyLimitMiddleware ограничивает размер тела запроса. Запрос с заведомо большим Content-Length
+// отклоняется сразу, тело без Content-Length обрывается при чтении с *http.MaxBytesError
+type BodyLimitMiddleware struct {
+	h        http.Handler
+	maxBytes int64
+}
+
+// NewBodyLimitMiddleware ...
+func NewBodyLimitMiddleware(h http.Handler, maxBytes int64) http.Handler {
+	if maxBytes <= 0 {
+		maxBytes = DefaultMaxBodyBytes
+	}
+
+	return &BodyLimitMiddleware{h: h, maxBytes: maxBytes}
+}
+
+// ServeHTTP ...
+func (m *BodyLimitMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.ContentLength > m.maxBytes {
+		writeError(w, model.ErrRequestTooLarge, http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, m.maxBytes)
+
+	m.h.ServeHTTP(w, r)
+}
@@ -0,0 +1,76 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBodyLimitMiddleware(t *testing.T) {
+	t.Parallel()
+
+	// обработчик читает тело целиком и отвечает 413, если чтение оборвано лимитом
+	h := NewBodyLimitMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		var maxBytesErr *http.MaxBytesError
+		if errors.As(err, &maxBytesErr) {
+			w.WriteHeader(http.StatusRequestEntityTooLarge)
+			return
+		}
+		_, _ = w.Write(body)
+	}), 8)
+
+	tests := []struct {
+		name           string
+		body           string
+		unknownLength  bool
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "success",
+			body:           `{"a":1}`,
+			expectedStatus: http.StatusOK,
+			expectedBody:   `{"a":1}`,
+		},
+		{
+			name:           "err content length",
+			body:           `{"count":10}`,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrRequestTooLarge),
+		},
+		{
+			name:           "err unknown length",
+			body:           `{"count":10}`,
+			unknownLength:  true,
+			expectedStatus: http.StatusRequestEntityTooLarge,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			req := httptest.NewRequest(http.MethodPost, "/user/1/cart/1", strings.NewReader(tt.body))
+			if tt.unknownLength {
+				req.ContentLength = -1
+			}
+			w := httptest.NewRecorder()
+
+			// Execute
+			h.ServeHTTP(w, req)
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
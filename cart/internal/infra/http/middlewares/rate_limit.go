@@ -0,0 +1,215 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"net"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/auth"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// DefaultIdleTTL ...
+	DefaultIdleTTL = 10 * time.Minute
+	// rateLimitCleanupInterval ...
+	rateLimitCleanupInterval = time.Minute
+)
+
+// RateLimit token bucket: RPS запросов в секунду, Burst - размер bucket. RPS <= 0 - без ограничения
+type RateLimit struct {
+	RPS   float64
+	Burst int
+}
+
+// RateLimiterConfig ...
+type RateLimiterConfig struct {
+	// Default лимит маршрутов, для которых нет отдельного
+	Default RateLimit
+	// Routes лимиты отдельных маршрутов, ключ - шаблон маршрута, например "POST /checkout/{user_id}"
+	Routes map[string]RateLimit
+	// IdleTTL через сколько удаляется bucket клиента без запросов
+	IdleTTL time.Duration
+}
+
+// bucket ...
+type bucket struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// RateLimiter ограничивает входящие запросы отдельным token bucket на пару маршрут + клиент.
+// Клиент определяется по subject токена, без аутентификации - по IP:
+// user_id из пути задает сам клиент, поэтому ключом он не используется
+type RateLimiter struct {
+	cfg     RateLimiterConfig
+	buckets map[string]*bucket
+	mx      sync.Mutex
+	now     func() time.Time
+	done    chan struct{}
+}
+
+// NewRateLimiter ...
+func NewRateLimiter(cfg RateLimiterConfig) *RateLimiter {
+	if cfg.IdleTTL <= 0 {
+		cfg.IdleTTL = DefaultIdleTTL
+	}
+
+	l := RateLimiter{
+		cfg:     cfg,
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		t := time.NewTicker(rateLimitCleanupInterval)
+		for {
+			select {
+			case <-t.C:
+				l.cleanup(time.Now())
+			case <-l.done:
+				t.Stop()
+				return
+			}
+		}
+	}()
+
+	return &l
+}
+
+// Limit оборачивает ручку маршрута pattern. Маршруты без ограничения возвращаются как есть
+func (l *RateLimiter) Limit(pattern string, next http.HandlerFunc) http.HandlerFunc {
+	limit := l.limitFor(pattern)
+	if limit.RPS <= 0 {
+		return next
+	}
+
+	return func(w http.ResponseWriter, r *http.Request) {
+		setAccessRoute(r.Context(), pattern)
+		retryAfter, ok := l.allow(pattern+" "+clientKey(r), limit)
+		if !ok {
+			metrics.IncRateLimited(pattern)
+			w.Header().Set("Retry-After", strconv.Itoa(retryAfter))
+			writeError(w, model.ErrRateLimited, http.StatusTooManyRequests)
+			return
+		}
+
+		next(w, r)
+	}
+}
+
+// Unary gRPC аналог Limit. routes сопоставляет методу gRPC шаблон HTTP маршрута той же операции:
+// лимит и bucket клиента у них общие, поэтому смена транспорта не обходит ограничение.
+// Методы без маршрута ограничиваются лимитом по умолчанию под своим именем
+func (l *RateLimiter) Unary(routes map[string]string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		pattern, ok := routes[info.FullMethod]
+		if !ok {
+			pattern = info.FullMethod
+		}
+
+		limit := l.limitFor(pattern)
+		if limit.RPS <= 0 {
+			return handler(ctx, req)
+		}
+
+		var remoteAddr string
+		if p, ok := peer.FromContext(ctx); ok && p.Addr != nil {
+			remoteAddr = p.Addr.String()
+		}
+
+		retryAfter, ok := l.allow(pattern+" "+clientKeyFrom(ctx, remoteAddr), limit)
+		if !ok {
+			metrics.IncRateLimited(pattern)
+			// вне настоящего gRPC сервера (в тестах) заголовок не отправить, ответ от этого не меняется
+			//nolint:errcheck
+			grpc.SetHeader(ctx, metadata.Pairs("retry-after", strconv.Itoa(retryAfter)))
+			return nil, status.Error(codes.ResourceExhausted, model.ErrRateLimited.Error())
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// Close ...
+func (l *RateLimiter) Close() {
+	l.done <- struct{}{}
+	close(l.done)
+}
+
+// limitFor лимит маршрута pattern, без отдельного лимита - лимит по умолчанию
+func (l *RateLimiter) limitFor(pattern string) RateLimit {
+	if limit, ok := l.cfg.Routes[pattern]; ok {
+		return limit
+	}
+
+	return l.cfg.Default
+}
+
+// allow берет токен из bucket клиента. Если токенов нет, возвращает через сколько секунд он появится
+func (l *RateLimiter) allow(key string, limit RateLimit) (int, bool) {
+	now := l.now()
+
+	l.mx.Lock()
+	b, ok := l.buckets[key]
+	if !ok {
+		b = &bucket{limiter: rate.NewLimiter(rate.Limit(limit.RPS), max(limit.Burst, 1))}
+		l.buckets[key] = b
+	}
+	b.lastSeen = now
+	l.mx.Unlock()
+
+	reservation := b.limiter.ReserveN(now, 1)
+	delay := reservation.DelayFrom(now)
+	if delay == 0 {
+		return 0, true
+	}
+	// запрос отклоняется, токен возвращается в bucket
+	reservation.CancelAt(now)
+
+	return max(int(math.Ceil(delay.Seconds())), 1), false
+}
+
+// cleanup удаляет bucket клиентов, от которых давно не было запросов
+func (l *RateLimiter) cleanup(now time.Time) {
+	l.mx.Lock()
+	defer l.mx.Unlock()
+
+	for key, b := range l.buckets {
+		if now.Sub(b.lastSeen) > l.cfg.IdleTTL {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// clientKey ...
+func clientKey(r *http.Request) string {
+	return clientKeyFrom(r.Context(), r.RemoteAddr)
+}
+
+// clientKeyFrom ключ клиента одинаков для HTTP и gRPC
+func clientKeyFrom(ctx context.Context, remoteAddr string) string {
+	if claims, ok := auth.FromContext(ctx); ok {
+		return fmt.Sprintf("user:%d", claims.UserID)
+	}
+
+	host, _, err := net.SplitHostPort(remoteAddr)
+	if err != nil {
+		host = remoteAddr
+	}
+
+	return "ip:" + host
+}
@@ -0,0 +1,229 @@
+package middlewares
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/auth"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestRateLimiter_Limit(t *testing.T) {
+	t.Parallel()
+
+	type request struct {
+		userID     int64
+		remoteAddr string
+	}
+
+	tests := []struct {
+		name       string
+		pattern    string
+		requests   []request
+		expected   []int
+		retryAfter string
+	}{
+		{
+			name:     "success within burst",
+			pattern:  model.GetItemsByUserIDURL,
+			requests: []request{{userID: 1}, {userID: 1}},
+			expected: []int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name:       "err route limit exceeded",
+			pattern:    model.OrderFullCartURL,
+			requests:   []request{{userID: 1}, {userID: 1}},
+			expected:   []int{http.StatusOK, http.StatusTooManyRequests},
+			retryAfter: "10",
+		},
+		{
+			name:     "success separate users",
+			pattern:  model.OrderFullCartURL,
+			requests: []request{{userID: 1}, {userID: 2}},
+			expected: []int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name:       "err same ip without auth",
+			pattern:    model.OrderFullCartURL,
+			requests:   []request{{remoteAddr: "10.0.0.1:1000"}, {remoteAddr: "10.0.0.1:2000"}},
+			expected:   []int{http.StatusOK, http.StatusTooManyRequests},
+			retryAfter: "10",
+		},
+		{
+			name:     "success separate ip",
+			pattern:  model.OrderFullCartURL,
+			requests: []request{{remoteAddr: "10.0.0.1:1000"}, {remoteAddr: "10.0.0.2:1000"}},
+			expected: []int{http.StatusOK, http.StatusOK},
+		},
+		{
+			name:     "success unlimited route",
+			pattern:  model.GetMetricsURL,
+			requests: []request{{userID: 1}, {userID: 1}, {userID: 1}, {userID: 1}},
+			expected: []int{http.StatusOK, http.StatusOK, http.StatusOK, http.StatusOK},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			limiter := NewRateLimiter(RateLimiterConfig{
+				Default: RateLimit{RPS: 1, Burst: 2},
+				Routes: map[string]RateLimit{
+					model.OrderFullCartURL: {RPS: 0.1, Burst: 1},
+					model.GetMetricsURL:    {},
+				},
+			})
+			defer limiter.Close()
+			now := time.Now()
+			limiter.now = func() time.Time { return now }
+
+			h := limiter.Limit(tt.pattern, func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusOK)
+			})
+
+			for i, req := range tt.requests {
+				r := httptest.NewRequest(http.MethodPost, "/checkout/1", nil)
+				if req.remoteAddr != "" {
+					r.RemoteAddr = req.remoteAddr
+				}
+				if req.userID != 0 {
+					r = r.WithContext(auth.WithClaims(r.Context(), &auth.Claims{UserID: req.userID}))
+				}
+				w := httptest.NewRecorder()
+
+				// Execute
+				h(w, r)
+
+				// Verify
+				assert.Equal(t, tt.expected[i], w.Code)
+				if w.Code == http.StatusTooManyRequests {
+					assert.Equal(t, tt.retryAfter, w.Header().Get("Retry-After"))
+					assert.Equal(t, fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrRateLimited), w.Body.String())
+				}
+			}
+		})
+	}
+}
+
+func TestRateLimiter_Unary(t *testing.T) {
+	t.Parallel()
+
+	routes := map[string]string{model.CheckoutGRPC: model.OrderFullCartURL}
+
+	tests := []struct {
+		name      string
+		httpFirst bool
+		method    string
+		ctx       context.Context
+		expected  []codes.Code
+	}{
+		{
+			name:     "err route limit exceeded",
+			method:   model.CheckoutGRPC,
+			ctx:      auth.WithClaims(context.Background(), &auth.Claims{UserID: 1}),
+			expected: []codes.Code{codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name:      "err bucket shared with http",
+			httpFirst: true,
+			method:    model.CheckoutGRPC,
+			ctx:       auth.WithClaims(context.Background(), &auth.Claims{UserID: 1}),
+			expected:  []codes.Code{codes.ResourceExhausted},
+		},
+		{
+			name:   "err same ip without auth",
+			method: model.CheckoutGRPC,
+			ctx: peer.NewContext(context.Background(), &peer.Peer{
+				Addr: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1000},
+			}),
+			expected: []codes.Code{codes.OK, codes.ResourceExhausted},
+		},
+		{
+			name:     "success default limit for unmapped method",
+			method:   model.AddItemGRPC,
+			ctx:      auth.WithClaims(context.Background(), &auth.Claims{UserID: 1}),
+			expected: []codes.Code{codes.OK, codes.OK, codes.ResourceExhausted},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			limiter := NewRateLimiter(RateLimiterConfig{
+				Default: RateLimit{RPS: 1, Burst: 2},
+				Routes: map[string]RateLimit{
+					model.OrderFullCartURL: {RPS: 0.1, Burst: 1},
+				},
+			})
+			defer limiter.Close()
+			now := time.Now()
+			limiter.now = func() time.Time { return now }
+
+			if tt.httpFirst {
+				h := limiter.Limit(model.OrderFullCartURL, func(w http.ResponseWriter, _ *http.Request) {
+					w.WriteHeader(http.StatusOK)
+				})
+				r := httptest.NewRequest(http.MethodPost, "/checkout/1", nil)
+				w := httptest.NewRecorder()
+				h(w, r.WithContext(auth.WithClaims(r.Context(), &auth.Claims{UserID: 1})))
+				assert.Equal(t, http.StatusOK, w.Code)
+			}
+
+			interceptor := limiter.Unary(routes)
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return struct{}{}, nil
+			}
+
+			for _, expected := range tt.expected {
+				// Execute
+				_, err := interceptor(tt.ctx, nil, &grpc.UnaryServerInfo{FullMethod: tt.method}, handler)
+
+				// Verify
+				assert.Equal(t, expected, status.Code(err))
+			}
+		})
+	}
+}
+
+func TestRateLimiter_Refill(t *testing.T) {
+	t.Parallel()
+
+	limiter := NewRateLimiter(RateLimiterConfig{Default: RateLimit{RPS: 1, Burst: 1}, IdleTTL: time.Minute})
+	defer limiter.Close()
+	now := time.Now()
+	limiter.now = func() time.Time { return now }
+
+	limit := RateLimit{RPS: 1, Burst: 1}
+
+	_, ok := limiter.allow("key", limit)
+	assert.True(t, ok)
+
+	// отклоненный запрос не расходует токен: через секунду bucket снова полон
+	retryAfter, ok := limiter.allow("key", limit)
+	assert.False(t, ok)
+	assert.Equal(t, 1, retryAfter)
+
+	now = now.Add(time.Second)
+	_, ok = limiter.allow("key", limit)
+	assert.True(t, ok)
+
+	limiter.cleanup(now.Add(time.Minute))
+	assert.Len(t, limiter.buckets, 1)
+
+	limiter.cleanup(now.Add(time.Minute + time.Second))
+	assert.Empty(t, limiter.buckets)
+}
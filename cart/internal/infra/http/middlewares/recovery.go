@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// RecoveryMiddleware перехватывает панику обработчика и отвечает 500.
+// Паника пишется в лог с trace id span запроса, который открывает AccessLogMiddleware
+type RecoveryMiddleware struct {
+	h http.Handler
+}
+
+// NewRecoveryMiddleware ...
+func NewRecoveryMiddleware(h http.Handler) http.Handler {
+	return &RecoveryMiddleware{h: h}
+}
+
+// ServeHTTP ...
+func (m *RecoveryMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	span := trace.SpanFromContext(r.Context())
+	rw := newResponseWriterWrapper(w)
+
+	defer func() {
+		rec := recover()
+		if rec == nil {
+			return
+		}
+		// ErrAbortHandler - штатный способ прервать ответ, его обрабатывает сам http.Server
+		if err, ok := rec.(error); ok && errors.Is(err, http.ErrAbortHandler) {
+			panic(rec)
+		}
+
+		span.SetStatus(codes.Error, fmt.Sprintf("panic: %v", rec))
+		logger.Errorw(fmt.Sprintf("%s %s panic: %v\n%s", r.Method, r.URL.Path, rec, debug.Stack()), "span", span)
+
+		// ответ уже начат, статус поменять нельзя - соединение закрывается
+		if rw.wroteHeader {
+			panic(http.ErrAbortHandler)
+		}
+		writeError(rw, model.ErrInternal, http.StatusInternalServerError)
+	}()
+
+	m.h.ServeHTTP(rw, r)
+}
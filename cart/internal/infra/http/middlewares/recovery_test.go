@@ -0,0 +1,80 @@
+package middlewares
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRecoveryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name           string
+		handler        http.HandlerFunc
+		expectedStatus int
+		expectedBody   string
+		expectedPanic  interface{}
+	}{
+		{
+			name: "success",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				w.WriteHeader(http.StatusNoContent)
+			},
+			expectedStatus: http.StatusNoContent,
+		},
+		{
+			name: "panic",
+			handler: func(_ http.ResponseWriter, _ *http.Request) {
+				panic("boom")
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   fmt.Sprintf("{\"Message\":\"%s\"}\n", model.ErrInternal),
+		},
+		{
+			name: "panic after response started",
+			handler: func(w http.ResponseWriter, _ *http.Request) {
+				_, _ = w.Write([]byte("partial"))
+				panic("boom")
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "partial",
+			expectedPanic:  http.ErrAbortHandler,
+		},
+		{
+			name: "abort handler",
+			handler: func(_ http.ResponseWriter, _ *http.Request) {
+				panic(http.ErrAbortHandler)
+			},
+			expectedStatus: http.StatusOK,
+			expectedPanic:  http.ErrAbortHandler,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			h := NewRecoveryMiddleware(tt.handler)
+			w := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, "/user/1/cart", nil)
+
+			// Execute
+			serve := func() { h.ServeHTTP(w, req) }
+
+			// Verify
+			if tt.expectedPanic != nil {
+				assert.PanicsWithValue(t, tt.expectedPanic, serve)
+			} else {
+				assert.NotPanics(t, serve)
+			}
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
@@ -4,7 +4,9 @@ import "net/http"
 
 type responseWriterWrapper struct {
 	http.ResponseWriter
-	statusCode int
+	statusCode  int
+	wroteHeader bool
+	bytes       int
 }
 
 func newResponseWriterWrapper(w http.ResponseWriter) *responseWriterWrapper {
@@ -17,5 +19,14 @@ func newResponseWriterWrapper(w http.ResponseWriter) *responseWriterWrapper {
 // Перехватываем вызов WriteHeader
 func (w *responseWriterWrapper) WriteHeader(code int) {
 	w.statusCode = code
+	w.wroteHeader = true
 	w.ResponseWriter.WriteHeader(code)
 }
+
+// Write без WriteHeader отправляет заголовки со статусом 200
+func (w *responseWriterWrapper) Write(b []byte) (int, error) {
+	w.wroteHeader = true
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += n
+	return n, err
+}
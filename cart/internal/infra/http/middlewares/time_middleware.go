@@ -40,16 +40,15 @@ func (m *TimerMiddleware) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	duration := time.Since(start)
 
-	logger.Infow(fmt.Sprintf("%s %s spent %s", r.Method, r.URL.String(), duration))
-
 	metrics.RequestDuration(clearURL, rr.statusCode, model.TypeInternal, duration)
 }
 
 func getURL(r *http.Request) string {
-	tmp := make(map[string][]string, 3)
+	tmp := make(map[string][]string, 4)
 
 	tmp["POST"] = append(tmp["POST"], model.AddItemURL, model.OrderFullCartURL)
 	tmp["GET"] = append(tmp["GET"], model.GetItemsByUserIDURL, model.GetMetricsURL)
+	tmp["PUT"] = append(tmp["PUT"], model.SetItemCountURL)
 	tmp["DELETE"] = append(tmp["DELETE"], model.DeleteItemURL, model.DeleteItemsByUserIDURL)
 
 	for method, urls := range tmp {
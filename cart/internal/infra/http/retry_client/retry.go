@@ -2,56 +2,257 @@
 package retryclient
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"io"
+	"math"
+	"math/rand"
+	"net"
 	"net/http"
+	"strconv"
+	"syscall"
 	"time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
 )
 
+const (
+	// StatusEnhanceYourCalm нестандартный статус, которым product-service отвечает при превышении лимита
+	StatusEnhanceYourCalm = 420
+)
+
+const (
+	// DefaultMaxAttempts ...
+	DefaultMaxAttempts = 3
+	// DefaultBaseDelay ...
+	DefaultBaseDelay = 100 * time.Millisecond
+	// DefaultMaxDelay ...
+	DefaultMaxDelay = 5 * time.Second
+	// DefaultJitter ...
+	DefaultJitter = 0.2
+)
+
+// DefaultRetryableStatuses ...
+var DefaultRetryableStatuses = []int{
+	StatusEnhanceYourCalm,
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// Policy политика ретраев. Нулевые значения, кроме Jitter, заменяются значениями по умолчанию
+type Policy struct {
+	// MaxAttempts общее количество попыток, включая первую
+	MaxAttempts int
+	// BaseDelay задержка перед второй попыткой, дальше удваивается
+	BaseDelay time.Duration
+	// MaxDelay ограничение задержки, Retry-After больше MaxDelay не ждем
+	MaxDelay time.Duration
+	// Jitter доля случайного разброса задержки, от 0 до 1
+	Jitter float64
+	// RetryableStatuses статусы ответа, при которых запрос повторяется
+	RetryableStatuses []int
+}
+
 // HTTPClientConfig ...
 type HTTPClientConfig struct {
-	Client     *http.Client
-	MaxRetries int
-	Delay      time.Duration
+	Client    *http.Client
+	Policy    Policy
+	retryable map[int]struct{}
+	random    func() float64
 }
 
 // NewRetryClient ...
-func NewRetryClient(client *http.Client, maxRetries int, delay time.Duration) *HTTPClientConfig {
+func NewRetryClient(client *http.Client, policy Policy) *HTTPClientConfig {
+	if policy.MaxAttempts < 1 {
+		policy.MaxAttempts = DefaultMaxAttempts
+	}
+	if policy.BaseDelay <= 0 {
+		policy.BaseDelay = DefaultBaseDelay
+	}
+	if policy.MaxDelay <= 0 {
+		policy.MaxDelay = DefaultMaxDelay
+	}
+	if policy.Jitter < 0 || policy.Jitter > 1 {
+		policy.Jitter = DefaultJitter
+	}
+	if policy.RetryableStatuses == nil {
+		policy.RetryableStatuses = DefaultRetryableStatuses
+	}
+
+	retryable := make(map[int]struct{}, len(policy.RetryableStatuses))
+	for _, status := range policy.RetryableStatuses {
+		retryable[status] = struct{}{}
+	}
+
 	return &HTTPClientConfig{
-		Client:     client,
-		MaxRetries: maxRetries,
-		Delay:      delay,
+		Client:    client,
+		Policy:    policy,
+		retryable: retryable,
+		//nolint:gosec
+		random: rand.Float64,
 	}
 }
 
-// RetryMiddleware ...
+// RetryMiddleware повторяет запрос при сетевых ошибках и статусах из политики.
+// Между попытками ждет экспоненциальную задержку с разбросом или Retry-After, если сервер его прислал.
+// Тело запроса перечитывается через req.GetBody, запрос без GetBody с телом не повторяется.
+// Если лимит запросов (420/429) так и не отпустил, возвращается model.ErrManyRequest
 func (c *HTTPClientConfig) RetryMiddleware() func(req *http.Request) (*http.Response, error) {
 	return func(req *http.Request) (*http.Response, error) {
-		var resp *http.Response
-		var err error
+		ctx := req.Context()
+
+		for attempt := 1; ; attempt++ {
+			if attempt > 1 {
+				if err := rewindBody(req); err != nil {
+					return nil, err
+				}
+			}
+
+			resp, err := c.Client.Do(req)
+			metrics.IncRetryAttempt(req.URL.Host, attempt, attemptResult(resp, err))
+
+			canRetry := attempt < c.Policy.MaxAttempts && canRewindBody(req)
 
-		for attempt := 1; attempt <= c.MaxRetries; attempt++ {
-			resp, err = c.Client.Do(req)
 			if err != nil {
-				return nil, err
+				if ctx.Err() != nil || !canRetry || !isRetryableError(err) {
+					return nil, err
+				}
+
+				delay := c.backoff(attempt)
+				logger.Errorw(fmt.Sprintf("Attempt %d failed with error %v. Retrying in %s...", attempt, err, delay))
+				if err := wait(ctx, delay); err != nil {
+					return nil, err
+				}
+				continue
+			}
+
+			if _, ok := c.retryable[resp.StatusCode]; !ok {
+				return resp, nil
 			}
 
-			// если не 420 или 429, то возвращаем резлуьтат запроса
-			if resp.StatusCode != 420 && resp.StatusCode != 429 {
+			delay := c.backoff(attempt)
+			if retryAfter, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+				if retryAfter > c.Policy.MaxDelay {
+					canRetry = false
+				}
+				delay = retryAfter
+			}
+
+			if !canRetry {
+				if isTooManyRequests(resp.StatusCode) {
+					drainBody(resp)
+					return nil, model.ErrManyRequest
+				}
 				return resp, nil
 			}
 
-			// если статус 420 или 429 делаем ретраи
-			// проверяем кол-во ретраев, если меньше, то засыпаем , в противном случае позвращаем ошибку
-			if attempt < c.MaxRetries {
-				logger.Errorw(fmt.Sprintf("Attempt %d failed with status %d. Retrying...\n", attempt, resp.StatusCode))
-				time.Sleep(c.Delay)
-			} else {
-				return resp, model.ErrManyRequest
+			drainBody(resp)
+
+			logger.Errorw(fmt.Sprintf("Attempt %d failed with status %d. Retrying in %s...", attempt, resp.StatusCode, delay))
+			if err := wait(ctx, delay); err != nil {
+				return nil, err
 			}
 		}
-		return resp, nil
 	}
 }
+
+// backoff задержка после попытки attempt: BaseDelay * 2^(attempt-1) с разбросом ±Jitter, не больше MaxDelay
+func (c *HTTPClientConfig) backoff(attempt int) time.Duration {
+	delay := float64(c.Policy.BaseDelay) * math.Pow(2, float64(attempt-1))
+	delay = math.Min(delay, float64(c.Policy.MaxDelay))
+	delay *= 1 + c.Policy.Jitter*(2*c.random()-1)
+
+	return time.Duration(math.Min(delay, float64(c.Policy.MaxDelay)))
+}
+
+// wait ждет delay или отмены контекста
+func wait(ctx context.Context, delay time.Duration) error {
+	t := time.NewTimer(delay)
+	defer t.Stop()
+
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// parseRetryAfter разбирает Retry-After в секундах или в формате HTTP-date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	date, err := http.ParseTime(value)
+	if err != nil {
+		return 0, false
+	}
+
+	return max(date.Sub(now), 0), true
+}
+
+// isRetryableError ошибки соединения, после которых запрос можно повторить
+func isRetryableError(err error) bool {
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) ||
+		errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+// isTooManyRequests ...
+func isTooManyRequests(status int) bool {
+	return status == StatusEnhanceYourCalm || status == http.StatusTooManyRequests
+}
+
+// canRewindBody ...
+func canRewindBody(req *http.Request) bool {
+	return req.Body == nil || req.Body == http.NoBody || req.GetBody != nil
+}
+
+// rewindBody пересоздает тело запроса перед повторной попыткой
+func rewindBody(req *http.Request) error {
+	if req.GetBody == nil {
+		return nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return fmt.Errorf("GetBody: %w", err)
+	}
+	req.Body = body
+
+	return nil
+}
+
+// drainBody дочитывает и закрывает тело ответа, чтобы соединение вернулось в пул
+func drainBody(resp *http.Response) {
+	//nolint:errcheck
+	io.Copy(io.Discard, io.LimitReader(resp.Body, 4096))
+	//nolint:errcheck, gosec
+	resp.Body.Close()
+}
+
+// attemptResult ...
+func attemptResult(resp *http.Response, err error) string {
+	if err != nil {
+		return "error"
+	}
+
+	return strconv.Itoa(resp.StatusCode)
+}
@@ -0,0 +1,285 @@
+package retryclient
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// statusServer отвечает статусами из statuses по очереди, последний статус повторяется
+func statusServer(t *testing.T, calls *int64, header http.Header, statuses ...int) *httptest.Server {
+	t.Helper()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		n := atomic.AddInt64(calls, 1)
+		status := statuses[min(int(n), len(statuses))-1]
+		if status != http.StatusOK {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+		}
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(srv.Close)
+
+	return srv
+}
+
+func testPolicy() Policy {
+	return Policy{
+		MaxAttempts: 3,
+		BaseDelay:   time.Millisecond,
+		MaxDelay:    50 * time.Millisecond,
+	}
+}
+
+func TestRetryMiddleware(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retries 5xx until success", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, nil, http.StatusServiceUnavailable, http.StatusBadGateway, http.StatusOK)
+
+		c := NewRetryClient(srv.Client(), testPolicy())
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+
+		resp, err := c.RetryMiddleware()(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("non retryable status is returned at once", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, nil, http.StatusNotFound)
+
+		c := NewRetryClient(srv.Client(), testPolicy())
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+
+		resp, err := c.RetryMiddleware()(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusNotFound, resp.StatusCode)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("exhausted 5xx returns last response", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, nil, http.StatusServiceUnavailable)
+
+		c := NewRetryClient(srv.Client(), testPolicy())
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+
+		resp, err := c.RetryMiddleware()(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("exhausted 429 returns ErrManyRequest", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, nil, StatusEnhanceYourCalm, http.StatusTooManyRequests)
+
+		c := NewRetryClient(srv.Client(), testPolicy())
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+
+		//nolint:bodyclose
+		_, err = c.RetryMiddleware()(req)
+		require.ErrorIs(t, err, model.ErrManyRequest)
+		assert.Equal(t, int64(3), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("retry after is honoured", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, http.Header{"Retry-After": []string{"1"}}, http.StatusTooManyRequests, http.StatusOK)
+
+		policy := testPolicy()
+		policy.MaxDelay = 2 * time.Second
+		c := NewRetryClient(srv.Client(), policy)
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+
+		start := time.Now()
+		resp, err := c.RetryMiddleware()(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusOK, resp.StatusCode)
+		assert.GreaterOrEqual(t, time.Since(start), time.Second)
+	})
+
+	t.Run("retry after longer than max delay is not awaited", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, http.Header{"Retry-After": []string{"60"}}, http.StatusServiceUnavailable)
+
+		c := NewRetryClient(srv.Client(), testPolicy())
+		req, err := http.NewRequest(http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+
+		resp, err := c.RetryMiddleware()(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("wait respects context", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, nil, http.StatusServiceUnavailable)
+
+		policy := testPolicy()
+		policy.BaseDelay = time.Second
+		policy.MaxDelay = time.Second
+		c := NewRetryClient(srv.Client(), policy)
+
+		ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, srv.URL, http.NoBody)
+		require.NoError(t, err)
+
+		//nolint:bodyclose
+		_, err = c.RetryMiddleware()(req)
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("body is rewound between attempts", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		var bodies []string
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			bodies = append(bodies, string(body))
+			if atomic.AddInt64(&calls, 1) == 1 {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			w.WriteHeader(http.StatusOK)
+		}))
+		t.Cleanup(srv.Close)
+
+		c := NewRetryClient(srv.Client(), testPolicy())
+		req, err := http.NewRequest(http.MethodPost, srv.URL, bytes.NewReader([]byte(`{"count":1}`)))
+		require.NoError(t, err)
+
+		resp, err := c.RetryMiddleware()(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, []string{`{"count":1}`, `{"count":1}`}, bodies)
+	})
+
+	t.Run("body without GetBody is not retried", func(t *testing.T) {
+		t.Parallel()
+		var calls int64
+		srv := statusServer(t, &calls, nil, http.StatusBadGateway, http.StatusOK)
+
+		c := NewRetryClient(srv.Client(), testPolicy())
+		req, err := http.NewRequest(http.MethodPost, srv.URL, io.NopCloser(bytes.NewReader([]byte("body"))))
+		require.NoError(t, err)
+
+		resp, err := c.RetryMiddleware()(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+
+		assert.Equal(t, http.StatusBadGateway, resp.StatusCode)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("connection error is retried", func(t *testing.T) {
+		t.Parallel()
+		srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}))
+		url := srv.URL
+		srv.Close()
+
+		c := NewRetryClient(http.DefaultClient, testPolicy())
+		req, err := http.NewRequest(http.MethodGet, url, http.NoBody)
+		require.NoError(t, err)
+
+		start := time.Now()
+		//nolint:bodyclose
+		_, err = c.RetryMiddleware()(req)
+		require.Error(t, err)
+		// две задержки между тремя попытками
+		assert.GreaterOrEqual(t, time.Since(start), 2*time.Millisecond)
+	})
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+
+	c := NewRetryClient(http.DefaultClient, Policy{
+		BaseDelay: 100 * time.Millisecond,
+		MaxDelay:  time.Second,
+		Jitter:    0.5,
+	})
+
+	c.random = func() float64 { return 0.5 }
+	assert.Equal(t, 100*time.Millisecond, c.backoff(1))
+	assert.Equal(t, 200*time.Millisecond, c.backoff(2))
+	assert.Equal(t, 400*time.Millisecond, c.backoff(3))
+	assert.Equal(t, time.Second, c.backoff(10))
+
+	c.random = func() float64 { return 0 }
+	assert.Equal(t, 50*time.Millisecond, c.backoff(1))
+
+	c.random = func() float64 { return 1 }
+	assert.Equal(t, 150*time.Millisecond, c.backoff(1))
+	assert.Equal(t, time.Second, c.backoff(10))
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		value    string
+		expected time.Duration
+		ok       bool
+	}{
+		{name: "empty", value: ""},
+		{name: "seconds", value: "3", expected: 3 * time.Second, ok: true},
+		{name: "negative", value: "-1"},
+		{name: "http date", value: now.Add(5 * time.Second).Format(http.TimeFormat), expected: 5 * time.Second, ok: true},
+		{name: "date in past", value: now.Add(-time.Minute).Format(http.TimeFormat), expected: 0, ok: true},
+		{name: "garbage", value: "soon"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			delay, ok := parseRetryAfter(tt.value, now)
+			assert.Equal(t, tt.ok, ok)
+			assert.Equal(t, tt.expected, delay)
+		})
+	}
+}
@@ -0,0 +1,276 @@
+// Package idempotency ...
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+)
+
+const (
+	// DefaultTTL ...
+	DefaultTTL = 24 * time.Hour
+	// cleanupInterval ...
+	cleanupInterval = time.Minute
+	// pendingTTL сколько ключ остается занятым незавершенным запросом, если реплика упала, не освободив его
+	pendingTTL = time.Minute
+	// pollInterval как часто проверяется ключ, занятый запросом другой реплики
+	pollInterval = 100 * time.Millisecond
+)
+
+// ErrInterrupted первый запрос с этим ключом завершился паникой
+var ErrInterrupted = errors.New("idempotent request interrupted")
+
+// Backend хранилище корзины, в котором ключи переживают перезапуск и видны всем репликам
+type Backend interface {
+	ReserveIdempotencyKey(ctx context.Context, userID int64, key string, ttl time.Duration) (model.IdempotencyRecord, bool, error)
+	CompleteIdempotencyKey(ctx context.Context, userID int64, key string, orderID int64, fingerprint string, ttl time.Duration) error
+	ReleaseIdempotencyKey(ctx context.Context, userID int64, key string) error
+}
+
+// entry результат выполнения запроса по ключу, done закрывается после завершения первого запроса
+type entry struct {
+	done        chan struct{}
+	orderID     int64
+	fingerprint string
+	err         error
+	expiresAt   time.Time
+}
+
+// Store хранит результаты оформления заказа по паре user_id + Idempotency-Key в backend.
+// Локальные записи объединяют одновременные запросы внутри процесса и кэшируют результат
+type Store struct {
+	backend Backend
+	entries map[string]*entry
+	ttl     time.Duration
+	mx      sync.Mutex
+	done    chan struct{}
+}
+
+// NewStore backend nil - ключи хранятся только в памяти процесса
+func NewStore(ttl time.Duration, backend Backend) *Store {
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+
+	s := Store{
+		backend: backend,
+		entries: make(map[string]*entry),
+		ttl:     ttl,
+		done:    make(chan struct{}),
+	}
+
+	go func() {
+		t := time.NewTicker(cleanupInterval)
+		for {
+			select {
+			case <-t.C:
+				s.cleanup(time.Now())
+			case <-s.done:
+				t.Stop()
+				return
+			}
+		}
+	}()
+
+	return &s
+}
+
+// Fingerprint отпечаток состояния корзины. Заказ сохраняется вместе с отпечатком корзины после оформления,
+// повтор допустим, только пока отпечаток не изменился
+type Fingerprint func(ctx context.Context) (string, error)
+
+// Do выполняет fn один раз для ключа. Повторный запрос с тем же ключом получает сохраненный
+// orderID, а если первый запрос еще выполняется - дожидается его результата.
+// Если корзина изменилась после оформления, повторный запрос получает model.ErrIdempotencyKeyReused.
+// Неуспешный результат не сохраняется, чтобы клиент мог повторить запрос.
+func (s *Store) Do(ctx context.Context, userID int64, key string, fingerprint Fingerprint, fn func() (int64, error)) (int64, error) {
+	k := storeKey(userID, key)
+
+	s.mx.Lock()
+	e, ok := s.entries[k]
+	if ok && !isExpired(e, time.Now()) {
+		s.mx.Unlock()
+		if isDone(e) {
+			if err := verify(ctx, e.fingerprint, fingerprint); err != nil {
+				return 0, err
+			}
+		}
+
+		orderID, err := wait(ctx, e)
+		// первый запрос отменил его клиент, ключ освобожден - выполняем запрос заново
+		if isContextErr(err) && ctx.Err() == nil {
+			return s.Do(ctx, userID, key, fingerprint, fn)
+		}
+		return orderID, err
+	}
+
+	e = &entry{done: make(chan struct{}), err: ErrInterrupted}
+	s.entries[k] = e
+	s.mx.Unlock()
+
+	// запись завершается и при панике в fn, иначе повторные запросы ждали бы ее бесконечно
+	defer s.finish(k, e)
+
+	e.orderID, e.fingerprint, e.err = s.run(ctx, userID, key, fingerprint, fn)
+
+	return e.orderID, e.err
+}
+
+// run выполняет fn, заняв ключ в backend. Если ключ уже завершен другой репликой или до перезапуска,
+// возвращает сохраненный заказ, если еще выполняется - дожидается результата
+func (s *Store) run(ctx context.Context, userID int64, key string, fingerprint Fingerprint, fn func() (int64, error)) (int64, string, error) {
+	if s.backend == nil {
+		orderID, err := fn()
+		if err != nil {
+			return 0, "", err
+		}
+		return orderID, fingerprintOf(ctx, fingerprint), nil
+	}
+
+	for {
+		record, reserved, err := s.backend.ReserveIdempotencyKey(ctx, userID, key, pendingTTL)
+		if err != nil {
+			return 0, "", fmt.Errorf("ReserveIdempotencyKey: %w", err)
+		}
+		if reserved {
+			break
+		}
+		if record.OrderID != 0 {
+			if err := verify(ctx, record.Fingerprint, fingerprint); err != nil {
+				return 0, "", err
+			}
+			return record.OrderID, record.Fingerprint, nil
+		}
+
+		select {
+		case <-time.After(pollInterval):
+		case <-ctx.Done():
+			return 0, "", ctx.Err()
+		}
+	}
+
+	completed := false
+	defer func() {
+		if completed {
+			return
+		}
+		// неуспешный результат не сохраняется, ключ освобождается для повтора
+		if err := s.backend.ReleaseIdempotencyKey(context.WithoutCancel(ctx), userID, key); err != nil {
+			logger.Errorw(fmt.Sprintf("ReleaseIdempotencyKey: %v", err))
+		}
+	}()
+
+	orderID, err := fn()
+	if err != nil {
+		return 0, "", err
+	}
+
+	// заказ уже создан: если сохранить его не удалось, ключ остается занятым до истечения pendingTTL
+	completed = true
+	fp := fingerprintOf(ctx, fingerprint)
+	if err := s.backend.CompleteIdempotencyKey(context.WithoutCancel(ctx), userID, key, orderID, fp, s.ttl); err != nil {
+		logger.Errorw(fmt.Sprintf("CompleteIdempotencyKey: %v", err))
+	}
+
+	return orderID, fp, nil
+}
+
+// finish сохраняет успешный результат и будит ожидающие запросы
+func (s *Store) finish(k string, e *entry) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	if e.err != nil {
+		delete(s.entries, k)
+	} else {
+		e.expiresAt = time.Now().Add(s.ttl)
+	}
+	close(e.done)
+}
+
+// Close ...
+func (s *Store) Close() {
+	s.done <- struct{}{}
+	close(s.done)
+}
+
+// cleanup удаляет завершенные записи с истекшим сроком хранения
+func (s *Store) cleanup(now time.Time) {
+	s.mx.Lock()
+	defer s.mx.Unlock()
+
+	for k, e := range s.entries {
+		if isExpired(e, now) {
+			delete(s.entries, k)
+		}
+	}
+}
+
+// wait ...
+func wait(ctx context.Context, e *entry) (int64, error) {
+	select {
+	case <-e.done:
+		return e.orderID, e.err
+	case <-ctx.Done():
+		return 0, ctx.Err()
+	}
+}
+
+// verify пустой сохраненный отпечаток - его не удалось получить после оформления, повтор не проверяется
+func verify(ctx context.Context, stored string, fingerprint Fingerprint) error {
+	if stored == "" {
+		return nil
+	}
+
+	current, err := fingerprint(ctx)
+	if err != nil {
+		return fmt.Errorf("fingerprint: %w", err)
+	}
+	if current != stored {
+		return model.ErrIdempotencyKeyReused
+	}
+
+	return nil
+}
+
+// fingerprintOf заказ уже создан, поэтому ошибка получения отпечатка не должна сделать запрос неуспешным
+func fingerprintOf(ctx context.Context, fingerprint Fingerprint) string {
+	fp, err := fingerprint(ctx)
+	if err != nil {
+		logger.Errorw(fmt.Sprintf("fingerprint: %v", err))
+		return ""
+	}
+
+	return fp
+}
+
+// isDone ...
+func isDone(e *entry) bool {
+	select {
+	case <-e.done:
+		return true
+	default:
+		return false
+	}
+}
+
+// isContextErr ...
+func isContextErr(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// isExpired запись в процессе выполнения не истекает
+func isExpired(e *entry, now time.Time) bool {
+	return isDone(e) && now.After(e.expiresAt)
+}
+
+// storeKey ...
+func storeKey(userID int64, key string) string {
+	return fmt.Sprintf("%d:%s", userID, key)
+}
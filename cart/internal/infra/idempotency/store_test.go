@@ -0,0 +1,310 @@
+package idempotency
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/repository"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/service/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// sameCart корзина не меняется между запросами
+func sameCart(context.Context) (string, error) {
+	return "1", nil
+}
+
+func TestStore_Do(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	t.Run("replay returns stored result", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		var calls int64
+		fn := func() (int64, error) {
+			atomic.AddInt64(&calls, 1)
+			return 42, nil
+		}
+
+		for i := 0; i < 3; i++ {
+			orderID, err := s.Do(ctx, 1, "key", sameCart, fn)
+			require.NoError(t, err)
+			assert.Equal(t, int64(42), orderID)
+		}
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("keys are scoped by user", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		orderID, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 1, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), orderID)
+
+		orderID, err = s.Do(ctx, 2, "key", sameCart, func() (int64, error) { return 2, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), orderID)
+	})
+
+	t.Run("in flight request is awaited", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		var calls int64
+
+		fn := func() (int64, error) {
+			atomic.AddInt64(&calls, 1)
+			close(started)
+			<-release
+			return 7, nil
+		}
+
+		var wg sync.WaitGroup
+		results := make([]int64, 2)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[0], _ = s.Do(ctx, 1, "key", sameCart, fn)
+		}()
+
+		<-started
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[1], _ = s.Do(ctx, 1, "key", sameCart, fn)
+		}()
+
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, []int64{7, 7}, results)
+		assert.Equal(t, int64(1), atomic.LoadInt64(&calls))
+	})
+
+	t.Run("waiting respects context", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+		defer close(release)
+
+		go func() {
+			//nolint:errcheck
+			s.Do(ctx, 1, "key", sameCart, func() (int64, error) {
+				close(started)
+				<-release
+				return 1, nil
+			})
+		}()
+
+		<-started
+
+		waitCtx, cancel := context.WithTimeout(ctx, 10*time.Millisecond)
+		defer cancel()
+
+		_, err := s.Do(waitCtx, 1, "key", sameCart, func() (int64, error) { return 2, nil })
+		require.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+
+	t.Run("failed result is not stored", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		_, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 0, errors.New("test") })
+		require.Error(t, err)
+
+		orderID, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 3, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), orderID)
+	})
+
+	t.Run("panic releases key", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		assert.Panics(t, func() {
+			//nolint:errcheck
+			s.Do(ctx, 1, "key", sameCart, func() (int64, error) { panic("test") })
+		})
+
+		orderID, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 3, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), orderID)
+	})
+
+	t.Run("expired result is removed", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		_, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 1, nil })
+		require.NoError(t, err)
+
+		s.cleanup(time.Now().Add(2 * time.Minute))
+
+		orderID, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 2, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(2), orderID)
+	})
+
+	t.Run("err key reused after cart changed", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		_, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 1, nil })
+		require.NoError(t, err)
+
+		changedCart := func(context.Context) (string, error) { return "2", nil }
+		_, err = s.Do(ctx, 1, "key", changedCart, func() (int64, error) { return 2, nil })
+		require.ErrorIs(t, err, model.ErrIdempotencyKeyReused)
+	})
+
+	t.Run("cancelled first request is retried by waiter", func(t *testing.T) {
+		t.Parallel()
+		s := NewStore(time.Minute, nil)
+		defer s.Close()
+
+		leaderCtx, cancel := context.WithCancel(ctx)
+		started := make(chan struct{})
+
+		go func() {
+			//nolint:errcheck
+			s.Do(leaderCtx, 1, "key", sameCart, func() (int64, error) {
+				close(started)
+				<-leaderCtx.Done()
+				return 0, leaderCtx.Err()
+			})
+		}()
+
+		<-started
+		time.AfterFunc(10*time.Millisecond, cancel)
+
+		orderID, err := s.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 5, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(5), orderID)
+	})
+}
+
+func TestStore_DoBackend(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	newBackend := func(t *testing.T) Backend {
+		tracer := mocks.NewTracerMock(t)
+		tracer.StartMock.Return(ctx, trace.SpanFromContext(ctx))
+
+		repo := repository.NewInMemoryRepository(tracer)
+		t.Cleanup(repo.Close)
+
+		return repo
+	}
+
+	t.Run("result is shared between stores", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+
+		// две реплики или процесс до и после перезапуска
+		first := NewStore(time.Minute, backend)
+		defer first.Close()
+		second := NewStore(time.Minute, backend)
+		defer second.Close()
+
+		orderID, err := first.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 42, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), orderID)
+
+		orderID, err = second.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 43, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(42), orderID)
+	})
+
+	t.Run("in flight request of other store is awaited", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+
+		first := NewStore(time.Minute, backend)
+		defer first.Close()
+		second := NewStore(time.Minute, backend)
+		defer second.Close()
+
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		go func() {
+			//nolint:errcheck
+			first.Do(ctx, 1, "key", sameCart, func() (int64, error) {
+				close(started)
+				<-release
+				return 7, nil
+			})
+		}()
+
+		<-started
+		time.AfterFunc(10*time.Millisecond, func() { close(release) })
+
+		orderID, err := second.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 8, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(7), orderID)
+	})
+
+	t.Run("failed and panicked requests release key", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+
+		first := NewStore(time.Minute, backend)
+		defer first.Close()
+		second := NewStore(time.Minute, backend)
+		defer second.Close()
+
+		_, err := first.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 0, errors.New("test") })
+		require.Error(t, err)
+
+		assert.Panics(t, func() {
+			//nolint:errcheck
+			first.Do(ctx, 1, "key", sameCart, func() (int64, error) { panic("test") })
+		})
+
+		orderID, err := second.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 3, nil })
+		require.NoError(t, err)
+		assert.Equal(t, int64(3), orderID)
+	})
+
+	t.Run("err key reused after cart changed in other store", func(t *testing.T) {
+		t.Parallel()
+		backend := newBackend(t)
+
+		first := NewStore(time.Minute, backend)
+		defer first.Close()
+		second := NewStore(time.Minute, backend)
+		defer second.Close()
+
+		_, err := first.Do(ctx, 1, "key", sameCart, func() (int64, error) { return 1, nil })
+		require.NoError(t, err)
+
+		changedCart := func(context.Context) (string, error) { return "2", nil }
+		_, err = second.Do(ctx, 1, "key", changedCart, func() (int64, error) { return 2, nil })
+		require.ErrorIs(t, err, model.ErrIdempotencyKeyReused)
+	})
+}
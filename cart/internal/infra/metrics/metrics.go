@@ -3,12 +3,27 @@ package metrics
 
 import (
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 )
 
+const (
+	// ProductCacheHit ...
+	ProductCacheHit = "hit"
+	// ProductCacheMiss ...
+	ProductCacheMiss = "miss"
+
+	// CartEventSent ...
+	CartEventSent = "sent"
+	// CartEventFailed ...
+	CartEventFailed = "failed"
+	// CartEventDropped событие отброшено, потому что буфер продюсера заполнен
+	CartEventDropped = "dropped"
+)
+
 var (
 	// Количество запросов
 	requestCounter = promauto.NewCounterVec(prometheus.CounterOpts{
@@ -25,6 +40,48 @@ var (
 		Buckets:   prometheus.DefBuckets,
 	}, []string{"handler", "status", "type"})
 
+	// Попытки запросов во внешние сервисы через retry client
+	retryAttemptCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cart",
+		Name:      "http_client_attempts_total",
+		Help:      "Total count of outgoing http attempts by host, attempt number and result",
+	}, []string{"host", "attempt", "result"})
+
+	// Состояние circuit breaker: 0 - closed, 1 - half-open, 2 - open
+	breakerStateGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: "cart",
+		Name:      "circuit_breaker_state",
+		Help:      "State of circuit breaker: 0 - closed, 1 - half-open, 2 - open",
+	}, []string{"name"})
+
+	// Обращения к кешу product-service
+	productCacheCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cart",
+		Name:      "product_cache_requests_total",
+		Help:      "Total count of product cache lookups by result",
+	}, []string{"result"})
+
+	// События корзины, отправленные в kafka
+	cartEventCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cart",
+		Name:      "kafka_events_total",
+		Help:      "Total count of cart events by result",
+	}, []string{"result"})
+
+	// Брошенные корзины, отправленные в kafka
+	abandonedCartCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cart",
+		Name:      "abandoned_carts_total",
+		Help:      "Total count of abandoned cart events by result",
+	}, []string{"result"})
+
+	// Запросы, отклоненные rate limiter'ом входящих запросов
+	rateLimitedCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "cart",
+		Name:      "rate_limited_requests_total",
+		Help:      "Total count of incoming requests rejected by rate limiter by route",
+	}, []string{"route"})
+
 	// Количество элементов repository
 	repoSizeGauge = promauto.NewGauge(prometheus.GaugeOpts{
 		Namespace: "cart",
@@ -56,3 +113,33 @@ func RequestDuration(handler string, statusCode any, typeRequest string, duratio
 func StoreRepoSize(size float64) {
 	repoSizeGauge.Set(size)
 }
+
+// IncProductCacheRequest ...
+func IncProductCacheRequest(result string) {
+	productCacheCounter.WithLabelValues(result).Inc()
+}
+
+// IncRetryAttempt ...
+func IncRetryAttempt(host string, attempt int, result string) {
+	retryAttemptCounter.WithLabelValues(host, strconv.Itoa(attempt), result).Inc()
+}
+
+// SetBreakerState ...
+func SetBreakerState(name string, state int) {
+	breakerStateGauge.WithLabelValues(name).Set(float64(state))
+}
+
+// IncCartEvent ...
+func IncCartEvent(result string) {
+	cartEventCounter.WithLabelValues(result).Inc()
+}
+
+// IncAbandonedCart ...
+func IncAbandonedCart(result string) {
+	abandonedCartCounter.WithLabelValues(result).Inc()
+}
+
+// IncRateLimited ...
+func IncRateLimited(route string) {
+	rateLimitedCounter.WithLabelValues(route).Inc()
+}
@@ -0,0 +1,159 @@
+// Package producer ...
+package producer
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/infra/metrics"
+	pbKafka "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/kafka"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// DefaultBufferSize ...
+	DefaultBufferSize = 1024
+)
+
+// Producer отправляет события корзины в kafka из фоновой горутины, чтобы задержка брокера
+// не влияла на время ответа HTTP-запросов
+type Producer struct {
+	producer       sarama.SyncProducer
+	topicName      string
+	abandonedTopic string
+	events         chan model.CartEvent
+	mx             sync.RWMutex
+	closed         bool
+	done           chan struct{}
+}
+
+// NewProducer abandonedTopic топик событий о брошенных корзинах.
+// bufferSize сколько событий может ждать отправки, нулевое значение заменяется значением по умолчанию
+func NewProducer(producer sarama.SyncProducer, topicName, abandonedTopic string, bufferSize int) *Producer {
+	if bufferSize < 1 {
+		bufferSize = DefaultBufferSize
+	}
+
+	p := &Producer{
+		producer:       producer,
+		topicName:      topicName,
+		abandonedTopic: abandonedTopic,
+		events:         make(chan model.CartEvent, bufferSize),
+		done:           make(chan struct{}),
+	}
+
+	go p.run()
+
+	return p
+}
+
+// Send ставит событие в очередь на отправку и не ждет брокера.
+// Если буфер заполнен или продюсер закрыт, событие отбрасывается
+func (p *Producer) Send(event model.CartEvent) {
+	p.mx.RLock()
+	defer p.mx.RUnlock()
+
+	if p.closed {
+		metrics.IncCartEvent(metrics.CartEventDropped)
+		return
+	}
+
+	select {
+	case p.events <- event:
+	default:
+		metrics.IncCartEvent(metrics.CartEventDropped)
+		logger.Errorw(fmt.Sprintf("cart event buffer is full, event for user %d dropped", event.UserID))
+	}
+}
+
+// Close дожидается отправки событий из буфера и закрывает продюсер
+func (p *Producer) Close() error {
+	p.mx.Lock()
+	if p.closed {
+		p.mx.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.events)
+	p.mx.Unlock()
+
+	<-p.done
+
+	return p.producer.Close()
+}
+
+// SendMsg ...
+func (p *Producer) SendMsg(event model.CartEvent) (int32, int64, error) {
+	value, err := proto.Marshal(&pbKafka.CartEvent{
+		UserId:    event.UserID,
+		Sku:       event.Sku,
+		Delta:     event.Delta,
+		Timestamp: event.Timestamp.Format(time.RFC3339Nano),
+		Reason:    event.Reason,
+	})
+	if err != nil {
+		return -1, -1, err
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: p.topicName,
+		Key:   sarama.StringEncoder(fmt.Sprintf("%d", event.UserID)),
+		Value: sarama.ByteEncoder(value),
+	}
+
+	return p.producer.SendMessage(msg)
+}
+
+// SendAbandonedCart отправляет событие о брошенной корзине синхронно, вызывается из фонового обработчика
+func (p *Producer) SendAbandonedCart(cart model.AbandonedCart) error {
+	items := make([]*pbKafka.AbandonedCartItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		items = append(items, &pbKafka.AbandonedCartItem{
+			Sku:   item.SkuID,
+			Count: item.Count,
+			Price: item.Price,
+		})
+	}
+
+	value, err := proto.Marshal(&pbKafka.AbandonedCartEvent{
+		UserId:    cart.UserID,
+		Items:     items,
+		UpdatedAt: cart.UpdatedAt.Format(time.RFC3339Nano),
+		Timestamp: time.Now().Format(time.RFC3339Nano),
+	})
+	if err != nil {
+		return fmt.Errorf("proto.Marshal: %w", err)
+	}
+
+	msg := &sarama.ProducerMessage{
+		Topic: p.abandonedTopic,
+		Key:   sarama.StringEncoder(fmt.Sprintf("%d", cart.UserID)),
+		Value: sarama.ByteEncoder(value),
+	}
+
+	if _, _, err := p.producer.SendMessage(msg); err != nil {
+		metrics.IncAbandonedCart(metrics.CartEventFailed)
+		return fmt.Errorf("SendMessage: %w", err)
+	}
+	metrics.IncAbandonedCart(metrics.CartEventSent)
+
+	return nil
+}
+
+// run ...
+func (p *Producer) run() {
+	defer close(p.done)
+
+	for event := range p.events {
+		if _, _, err := p.SendMsg(event); err != nil {
+			metrics.IncCartEvent(metrics.CartEventFailed)
+			logger.Errorw(fmt.Sprintf("SendMsg : %v", err))
+			continue
+		}
+		metrics.IncCartEvent(metrics.CartEventSent)
+	}
+}
@@ -0,0 +1,115 @@
+package producer
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/IBM/sarama"
+	"github.com/IBM/sarama/mocks"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
+	pbKafka "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/kafka"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	testTopic          = "cart.cart-events"
+	testAbandonedTopic = "cart.abandoned-carts"
+)
+
+func TestProducer_Send(t *testing.T) {
+	t.Parallel()
+
+	events := []model.CartEvent{
+		{UserID: 1, Sku: 10, Delta: 2, Timestamp: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC), Reason: model.CartEventAdd},
+		{UserID: 1, Sku: 10, Delta: -2, Timestamp: time.Date(2025, 1, 2, 3, 4, 6, 0, time.UTC), Reason: model.CartEventRemove},
+	}
+
+	sp := mocks.NewSyncProducer(t, sarama.NewConfig())
+	for _, event := range events {
+		sp.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+			assert.Equal(t, testTopic, msg.Topic)
+
+			key, err := msg.Key.Encode()
+			require.NoError(t, err)
+			assert.Equal(t, "1", string(key))
+
+			value, err := msg.Value.Encode()
+			require.NoError(t, err)
+
+			got := &pbKafka.CartEvent{}
+			require.NoError(t, proto.Unmarshal(value, got))
+			assert.Equal(t, event.Sku, got.GetSku())
+			assert.Equal(t, event.Delta, got.GetDelta())
+			assert.Equal(t, event.Timestamp.Format(time.RFC3339Nano), got.GetTimestamp())
+			assert.Equal(t, event.Reason, got.GetReason())
+
+			return nil
+		})
+	}
+
+	p := NewProducer(sp, testTopic, testAbandonedTopic, 0)
+	for _, event := range events {
+		p.Send(event)
+	}
+
+	// Close дожидается отправки всех событий из буфера
+	require.NoError(t, p.Close())
+
+	// после закрытия события отбрасываются
+	p.Send(events[0])
+	require.NoError(t, p.Close())
+}
+
+func TestProducer_SendFailed(t *testing.T) {
+	t.Parallel()
+
+	sp := mocks.NewSyncProducer(t, sarama.NewConfig())
+	sp.ExpectSendMessageAndFail(errors.New("test"))
+	sp.ExpectSendMessageAndSucceed()
+
+	p := NewProducer(sp, testTopic, testAbandonedTopic, 0)
+	p.Send(model.CartEvent{UserID: 1, Sku: 1, Delta: 1, Reason: model.CartEventAdd})
+	p.Send(model.CartEvent{UserID: 1, Sku: 1, Delta: -1, Reason: model.CartEventRemove})
+
+	require.NoError(t, p.Close())
+}
+
+func TestProducer_SendAbandonedCart(t *testing.T) {
+	t.Parallel()
+
+	cart := model.AbandonedCart{
+		UserID:    1,
+		UpdatedAt: time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC),
+		Items:     []model.Cart{{SkuID: 10, Count: 2, Price: 100}},
+	}
+
+	sp := mocks.NewSyncProducer(t, sarama.NewConfig())
+	sp.ExpectSendMessageWithMessageCheckerFunctionAndSucceed(func(msg *sarama.ProducerMessage) error {
+		assert.Equal(t, testAbandonedTopic, msg.Topic)
+
+		value, err := msg.Value.Encode()
+		require.NoError(t, err)
+
+		got := &pbKafka.AbandonedCartEvent{}
+		require.NoError(t, proto.Unmarshal(value, got))
+		assert.Equal(t, cart.UserID, got.GetUserId())
+		assert.Equal(t, "2025-01-02T03:04:05Z", got.GetUpdatedAt())
+		require.Len(t, got.GetItems(), 1)
+		assert.Equal(t, int64(10), got.GetItems()[0].GetSku())
+		assert.Equal(t, uint32(2), got.GetItems()[0].GetCount())
+		assert.Equal(t, uint32(100), got.GetItems()[0].GetPrice())
+
+		return nil
+	})
+	sp.ExpectSendMessageAndFail(errors.New("test"))
+
+	p := NewProducer(sp, testTopic, testAbandonedTopic, 0)
+
+	require.NoError(t, p.SendAbandonedCart(cart))
+	require.Error(t, p.SendAbandonedCart(cart))
+
+	require.NoError(t, p.Close())
+}
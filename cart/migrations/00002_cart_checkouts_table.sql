@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_checkouts (
+    user_id        int8        NOT NULL PRIMARY KEY,
+    order_id       int8        NOT NULL,
+    checked_out_at timestamptz NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE cart_checkouts;
+-- +goose StatementEnd
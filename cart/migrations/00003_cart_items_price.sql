@@ -0,0 +1,9 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart_items ADD COLUMN price bigint NOT NULL DEFAULT 0 CHECK (price >= 0 AND price <= 4294967295);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cart_items DROP COLUMN price;
+-- +goose StatementEnd
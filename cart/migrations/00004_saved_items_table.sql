@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE saved_items (
+    user_id    int8        NOT NULL,
+    sku        int8        NOT NULL,
+    count      bigint      NOT NULL CHECK (count >= 0 AND count <= 4294967295),
+    price      bigint      NOT NULL DEFAULT 0 CHECK (price >= 0 AND price <= 4294967295),
+    created_at timestamptz NOT NULL DEFAULT now(),
+    updated_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (user_id, sku)
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE saved_items;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_promo_codes (
+    user_id    int8        NOT NULL PRIMARY KEY,
+    code       text        NOT NULL,
+    applied_at timestamptz NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE cart_promo_codes;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_abandoned (
+    user_id         int8        NOT NULL PRIMARY KEY,
+    cart_updated_at timestamptz NOT NULL,
+    reported_at     timestamptz NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE cart_abandoned;
+-- +goose StatementEnd
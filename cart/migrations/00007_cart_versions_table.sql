@@ -0,0 +1,58 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_versions (
+    user_id int8 NOT NULL PRIMARY KEY,
+    version int8 NOT NULL DEFAULT 0
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE FUNCTION bump_cart_version() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO cart_versions (user_id, version)
+    VALUES (CASE WHEN TG_OP = 'DELETE' THEN OLD.user_id ELSE NEW.user_id END, 1)
+    ON CONFLICT (user_id)
+    DO UPDATE SET version = cart_versions.version + 1;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER cart_items_version
+AFTER INSERT OR UPDATE OR DELETE ON cart_items
+FOR EACH ROW EXECUTE FUNCTION bump_cart_version();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER cart_promo_codes_version
+AFTER INSERT OR UPDATE OR DELETE ON cart_promo_codes
+FOR EACH ROW EXECUTE FUNCTION bump_cart_version();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE TRIGGER cart_checkouts_version
+AFTER INSERT OR UPDATE OR DELETE ON cart_checkouts
+FOR EACH ROW EXECUTE FUNCTION bump_cart_version();
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TRIGGER cart_checkouts_version ON cart_checkouts;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TRIGGER cart_promo_codes_version ON cart_promo_codes;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TRIGGER cart_items_version ON cart_items;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP FUNCTION bump_cart_version();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP TABLE cart_versions;
+-- +goose StatementEnd
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE cart_idempotency_keys (
+    user_id     int8        NOT NULL,
+    key         text        NOT NULL,
+    order_id    int8        NOT NULL DEFAULT 0,
+    fingerprint text        NOT NULL DEFAULT '',
+    expires_at  timestamptz NOT NULL,
+    PRIMARY KEY (user_id, key)
+);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX cart_idempotency_keys_expires_at_idx ON cart_idempotency_keys (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE cart_idempotency_keys;
+-- +goose StatementEnd
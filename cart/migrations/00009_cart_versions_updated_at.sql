@@ -0,0 +1,48 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart_versions ADD COLUMN updated_at timestamptz NOT NULL DEFAULT now();
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+INSERT INTO cart_versions (user_id, updated_at)
+SELECT user_id, max(updated_at) FROM cart_items GROUP BY user_id
+ON CONFLICT (user_id)
+DO UPDATE SET updated_at = EXCLUDED.updated_at;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE INDEX cart_versions_updated_at_idx ON cart_versions (updated_at);
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION bump_cart_version() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO cart_versions (user_id, version, updated_at)
+    VALUES (CASE WHEN TG_OP = 'DELETE' THEN OLD.user_id ELSE NEW.user_id END, 1, now())
+    ON CONFLICT (user_id)
+    DO UPDATE SET version = cart_versions.version + 1, updated_at = now();
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+CREATE OR REPLACE FUNCTION bump_cart_version() RETURNS trigger AS $$
+BEGIN
+    INSERT INTO cart_versions (user_id, version)
+    VALUES (CASE WHEN TG_OP = 'DELETE' THEN OLD.user_id ELSE NEW.user_id END, 1)
+    ON CONFLICT (user_id)
+    DO UPDATE SET version = cart_versions.version + 1;
+    RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+DROP INDEX cart_versions_updated_at_idx;
+-- +goose StatementEnd
+
+-- +goose StatementBegin
+ALTER TABLE cart_versions DROP COLUMN updated_at;
+-- +goose StatementEnd
@@ -0,0 +1,306 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.10
+// 	protoc        v4.25.1
+// source: cart-events.proto
+
+package kafka
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// CartEvent изменение количества товара в корзине
+type CartEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Sku    int64                  `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	// delta на сколько изменилось количество товара, при удалении отрицательное
+	Delta     int64  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Timestamp string `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// reason add, set_count, remove, clear, checkout
+	Reason        string `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CartEvent) Reset() {
+	*x = CartEvent{}
+	mi := &file_cart_events_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CartEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CartEvent) ProtoMessage() {}
+
+func (x *CartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_events_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CartEvent.ProtoReflect.Descriptor instead.
+func (*CartEvent) Descriptor() ([]byte, []int) {
+	return file_cart_events_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *CartEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CartEvent) GetSku() int64 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *CartEvent) GetDelta() int64 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *CartEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+func (x *CartEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+// AbandonedCartEvent корзина без изменений дольше порога
+type AbandonedCartEvent struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=user_id,proto3" json:"user_id,omitempty"`
+	Items  []*AbandonedCartItem   `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	// updated_at время последнего изменения корзины
+	UpdatedAt     string `protobuf:"bytes,3,opt,name=updated_at,proto3" json:"updated_at,omitempty"`
+	Timestamp     string `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbandonedCartEvent) Reset() {
+	*x = AbandonedCartEvent{}
+	mi := &file_cart_events_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbandonedCartEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbandonedCartEvent) ProtoMessage() {}
+
+func (x *AbandonedCartEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_events_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbandonedCartEvent.ProtoReflect.Descriptor instead.
+func (*AbandonedCartEvent) Descriptor() ([]byte, []int) {
+	return file_cart_events_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *AbandonedCartEvent) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AbandonedCartEvent) GetItems() []*AbandonedCartItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *AbandonedCartEvent) GetUpdatedAt() string {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return ""
+}
+
+func (x *AbandonedCartEvent) GetTimestamp() string {
+	if x != nil {
+		return x.Timestamp
+	}
+	return ""
+}
+
+// AbandonedCartItem ...
+type AbandonedCartItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sku           int64                  `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count         uint32                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Price         uint32                 `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AbandonedCartItem) Reset() {
+	*x = AbandonedCartItem{}
+	mi := &file_cart_events_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AbandonedCartItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AbandonedCartItem) ProtoMessage() {}
+
+func (x *AbandonedCartItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_events_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AbandonedCartItem.ProtoReflect.Descriptor instead.
+func (*AbandonedCartItem) Descriptor() ([]byte, []int) {
+	return file_cart_events_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AbandonedCartItem) GetSku() int64 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+func (x *AbandonedCartItem) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *AbandonedCartItem) GetPrice() uint32 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+var File_cart_events_proto protoreflect.FileDescriptor
+
+const file_cart_events_proto_rawDesc = "" +
+	"\n" +
+	"\x11cart-events.proto\"\x83\x01\n" +
+	"\tCartEvent\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\x03R\auser_id\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\x03R\x03sku\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x03R\x05delta\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\tR\ttimestamp\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\"\x96\x01\n" +
+	"\x12AbandonedCartEvent\x12\x18\n" +
+	"\auser_id\x18\x01 \x01(\x03R\auser_id\x12(\n" +
+	"\x05items\x18\x02 \x03(\v2\x12.AbandonedCartItemR\x05items\x12\x1e\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\n" +
+	"updated_at\x12\x1c\n" +
+	"\ttimestamp\x18\x04 \x01(\tR\ttimestamp\"Q\n" +
+	"\x11AbandonedCartItem\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\x03R\x03sku\x12\x14\n" +
+	"\x05count\x18\x02 \x01(\rR\x05count\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\rR\x05priceBLZJgithub.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/api/kafka;kafkab\x06proto3"
+
+var (
+	file_cart_events_proto_rawDescOnce sync.Once
+	file_cart_events_proto_rawDescData []byte
+)
+
+func file_cart_events_proto_rawDescGZIP() []byte {
+	file_cart_events_proto_rawDescOnce.Do(func() {
+		file_cart_events_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_cart_events_proto_rawDesc), len(file_cart_events_proto_rawDesc)))
+	})
+	return file_cart_events_proto_rawDescData
+}
+
+var file_cart_events_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_cart_events_proto_goTypes = []any{
+	(*CartEvent)(nil),          // 0: CartEvent
+	(*AbandonedCartEvent)(nil), // 1: AbandonedCartEvent
+	(*AbandonedCartItem)(nil),  // 2: AbandonedCartItem
+}
+var file_cart_events_proto_depIdxs = []int32{
+	2, // 0: AbandonedCartEvent.items:type_name -> AbandonedCartItem
+	1, // [1:1] is the sub-list for method output_type
+	1, // [1:1] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_cart_events_proto_init() }
+func file_cart_events_proto_init() {
+	if File_cart_events_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_cart_events_proto_rawDesc), len(file_cart_events_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_cart_events_proto_goTypes,
+		DependencyIndexes: file_cart_events_proto_depIdxs,
+		MessageInfos:      file_cart_events_proto_msgTypes,
+	}.Build()
+	File_cart_events_proto = out.File
+	file_cart_events_proto_goTypes = nil
+	file_cart_events_proto_depIdxs = nil
+}
@@ -0,0 +1,48 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/IBM/sarama"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/connectivity"
+)
+
+// GRPCConn соединение готово, если установлено. Простаивающее соединение подключается заново
+// и проверка дожидается результата
+func GRPCConn(conn *grpc.ClientConn) CheckFunc {
+	return func(ctx context.Context) error {
+		conn.Connect()
+
+		for {
+			state := conn.GetState()
+			switch state {
+			case connectivity.Ready:
+				return nil
+			case connectivity.TransientFailure, connectivity.Shutdown:
+				return fmt.Errorf("grpc connection %s", state)
+			}
+
+			if !conn.WaitForStateChange(ctx, state) {
+				return fmt.Errorf("grpc connection %s: %w", state, ctx.Err())
+			}
+		}
+	}
+}
+
+// KafkaClient брокеры доступны, если удается обновить метаданные кластера
+func KafkaClient(client sarama.Client) CheckFunc {
+	return func(_ context.Context) error {
+		if client.Closed() {
+			return errors.New("kafka client closed")
+		}
+
+		if err := client.RefreshMetadata(); err != nil {
+			return fmt.Errorf("kafka RefreshMetadata: %w", err)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,162 @@
+// Package health ...
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
+)
+
+const (
+	// LivenessURL ...
+	LivenessURL = "GET /healthz"
+	// ReadinessURL ...
+	ReadinessURL = "GET /readyz"
+
+	// StatusUp ...
+	StatusUp = "up"
+	// StatusDown ...
+	StatusDown = "down"
+
+	// DefaultTimeout сколько ждать ответа одной зависимости
+	DefaultTimeout = 2 * time.Second
+)
+
+// ErrTimeout ...
+var ErrTimeout = errors.New("check timed out")
+
+// CheckFunc проверяет зависимость, nil - зависимость доступна
+type CheckFunc func(ctx context.Context) error
+
+// DependencyStatus ...
+type DependencyStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// Report ...
+type Report struct {
+	Status string                      `json:"status"`
+	Checks map[string]DependencyStatus `json:"checks,omitempty"`
+}
+
+// Checker отвечает на /healthz (процесс жив) и /readyz (доступны все зависимости)
+type Checker struct {
+	checks  map[string]CheckFunc
+	timeout time.Duration
+	mx      sync.RWMutex
+}
+
+// NewChecker ...
+func NewChecker(timeout time.Duration) *Checker {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+
+	return &Checker{
+		checks:  make(map[string]CheckFunc),
+		timeout: timeout,
+	}
+}
+
+// Add регистрирует проверку зависимости name
+func (c *Checker) Add(name string, check CheckFunc) {
+	c.mx.Lock()
+	defer c.mx.Unlock()
+
+	c.checks[name] = check
+}
+
+// Check опрашивает зависимости параллельно. Проверка, не уложившаяся в таймаут, считается неуспешной,
+// даже если CheckFunc не учитывает контекст
+func (c *Checker) Check(ctx context.Context) Report {
+	c.mx.RLock()
+	checks := make(map[string]CheckFunc, len(c.checks))
+	for name, check := range c.checks {
+		checks[name] = check
+	}
+	c.mx.RUnlock()
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+
+	report := Report{
+		Status: StatusUp,
+		Checks: make(map[string]DependencyStatus, len(checks)),
+	}
+
+	var (
+		wg sync.WaitGroup
+		mx sync.Mutex
+	)
+	for name, check := range checks {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			status := DependencyStatus{Status: StatusUp}
+			if err := run(ctx, check); err != nil {
+				status = DependencyStatus{Status: StatusDown, Error: err.Error()}
+			}
+
+			mx.Lock()
+			defer mx.Unlock()
+			report.Checks[name] = status
+			if status.Status == StatusDown {
+				report.Status = StatusDown
+			}
+		}()
+	}
+	wg.Wait()
+
+	return report
+}
+
+// LivenessHandler ...
+func (c *Checker) LivenessHandler(w http.ResponseWriter, _ *http.Request) {
+	writeReport(w, Report{Status: StatusUp}, http.StatusOK)
+}
+
+// ReadinessHandler 503, если недоступна хотя бы одна зависимость
+func (c *Checker) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := c.Check(r.Context())
+
+	statusCode := http.StatusOK
+	if report.Status != StatusUp {
+		statusCode = http.StatusServiceUnavailable
+		logger.Infow(fmt.Sprintf("not ready: %+v", report.Checks))
+	}
+
+	writeReport(w, report, statusCode)
+}
+
+// run ...
+func run(ctx context.Context, check CheckFunc) error {
+	done := make(chan error, 1)
+	go func() {
+		done <- check(ctx)
+	}()
+
+	select {
+	case err := <-done:
+		return err
+	case <-ctx.Done():
+		return ErrTimeout
+	}
+}
+
+// writeReport ...
+func writeReport(w http.ResponseWriter, report Report, statusCode int) {
+	w.Header().Add("Content-Type", "application/json")
+	w.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		logger.Infow(fmt.Sprintf("Encode : %v", err))
+	}
+}
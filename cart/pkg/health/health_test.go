@@ -0,0 +1,117 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+func TestChecker_ReadinessHandler(t *testing.T) {
+	t.Parallel()
+
+	up := func(context.Context) error { return nil }
+	down := func(context.Context) error { return errors.New("connection refused") }
+	hang := func(context.Context) error {
+		time.Sleep(time.Second)
+		return nil
+	}
+
+	tests := []struct {
+		name           string
+		checks         map[string]CheckFunc
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:           "success without dependencies",
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":\"up\"}\n",
+		},
+		{
+			name:           "success",
+			checks:         map[string]CheckFunc{"db": up, "kafka": up},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "{\"status\":\"up\",\"checks\":{\"db\":{\"status\":\"up\"},\"kafka\":{\"status\":\"up\"}}}\n",
+		},
+		{
+			name:           "err dependency down",
+			checks:         map[string]CheckFunc{"db": up, "kafka": down},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   "{\"status\":\"down\",\"checks\":{\"db\":{\"status\":\"up\"},\"kafka\":{\"status\":\"down\",\"error\":\"connection refused\"}}}\n",
+		},
+		{
+			name:           "err check ignores timeout",
+			checks:         map[string]CheckFunc{"db": hang},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedBody:   "{\"status\":\"down\",\"checks\":{\"db\":{\"status\":\"down\",\"error\":\"check timed out\"}}}\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Setup
+			checker := NewChecker(50 * time.Millisecond)
+			for name, check := range tt.checks {
+				checker.Add(name, check)
+			}
+			w := httptest.NewRecorder()
+
+			// Execute
+			checker.ReadinessHandler(w, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			// Verify
+			assert.Equal(t, tt.expectedStatus, w.Code)
+			assert.Equal(t, tt.expectedBody, w.Body.String())
+		})
+	}
+}
+
+func TestChecker_LivenessHandler(t *testing.T) {
+	t.Parallel()
+
+	// liveness не зависит от состояния зависимостей
+	checker := NewChecker(0)
+	checker.Add("db", func(context.Context) error { return errors.New("down") })
+
+	w := httptest.NewRecorder()
+	checker.LivenessHandler(w, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, "{\"status\":\"up\"}\n", w.Body.String())
+}
+
+func TestGRPCConn(t *testing.T) {
+	t.Parallel()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	server := grpc.NewServer()
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	conn, err := grpc.NewClient("passthrough:///"+lis.Addr().String(), grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer func() { _ = conn.Close() }()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	require.NoError(t, GRPCConn(conn)(ctx))
+
+	// после остановки сервера соединение не восстанавливается
+	server.Stop()
+	require.Eventually(t, func() bool {
+		return GRPCConn(conn)(ctx) != nil
+	}, 5*time.Second, 50*time.Millisecond)
+}
@@ -13,6 +13,8 @@ import (
 type Data struct {
 	traceID string
 	spanID  string
+	// fields остальные пары ключ-значение, пишутся отдельными полями записи
+	fields []interface{}
 }
 
 var (
@@ -55,7 +57,7 @@ func Fatalw(msg string, keysAndValues ...interface{}) {
 		zap.String("service", serviceName),
 		zap.String("traceID", data.traceID),
 		zap.String("spanID", data.spanID),
-	).Fatalf(msg)
+	).With(data.fields...).Fatalf(msg)
 }
 
 // Errorw ...
@@ -65,7 +67,7 @@ func Errorw(msg string, keysAndValues ...interface{}) {
 		zap.String("service", serviceName),
 		zap.String("traceID", data.traceID),
 		zap.String("spanID", data.spanID),
-	).Errorf(msg)
+	).With(data.fields...).Errorf(msg)
 }
 
 // Infow ...
@@ -75,7 +77,7 @@ func Infow(msg string, keysAndValues ...interface{}) {
 		zap.String("service", serviceName),
 		zap.String("traceID", data.traceID),
 		zap.String("spanID", data.spanID),
-	).Infof(msg)
+	).With(data.fields...).Infof(msg)
 }
 
 // Sync в случае grasefull shutdown допишутся все логики
@@ -92,11 +94,14 @@ func extractData(keysAndValues ...interface{}) Data {
 			continue
 		}
 
-		if key == "span" {
-			if span, ok := keysAndValues[i+1].(trace.Span); ok {
-				data.traceID = span.SpanContext().TraceID().String()
-				data.spanID = span.SpanContext().SpanID().String()
-			}
+		if key != "span" {
+			data.fields = append(data.fields, key, keysAndValues[i+1])
+			continue
+		}
+
+		if span, ok := keysAndValues[i+1].(trace.Span); ok {
+			data.traceID = span.SpanContext().TraceID().String()
+			data.spanID = span.SpanContext().SpanID().String()
 		}
 	}
 
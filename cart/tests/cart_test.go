@@ -4,11 +4,15 @@ package tests
 
 import (
 	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/internal/domain/model"
 	"github.com/ozontech/allure-go/pkg/framework/provider"
@@ -26,10 +30,45 @@ func TestCart(t *testing.T) {
 	suite.RunSuite(t, new(Cart))
 }
 
+// testSecret hs256_secret из configs/values_ci.yaml
+const testSecret = "cart-dev-secret"
+
+// authTransport подписывает запросы токеном пользователя
+type authTransport struct {
+	token string
+}
+
+// RoundTrip ...
+func (a authTransport) RoundTrip(r *http.Request) (*http.Response, error) {
+	r = r.Clone(r.Context())
+	r.Header.Set("Authorization", "Bearer "+a.token)
+
+	return http.DefaultTransport.RoundTrip(r)
+}
+
+// userToken HS256 токен с subject userID
+func userToken(userID int64) string {
+	header, _ := json.Marshal(map[string]string{"alg": "HS256", "typ": "JWT"})
+	payload, _ := json.Marshal(map[string]interface{}{
+		"sub": fmt.Sprintf("%d", userID),
+		"exp": time.Now().Add(time.Hour).Unix(),
+	})
+
+	input := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+
+	mac := hmac.New(sha256.New, []byte(testSecret))
+	mac.Write([]byte(input))
+
+	return input + "." + base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
 // BeforeAll выполняется перед запуском тестов
 func (c *Cart) BeforeAll(t provider.T) {
 	c.Host = "http://localhost:8080"
 	t.Logf("host is %v", c.Host)
+
+	// все тесты работают с корзиной пользователя 1
+	http.DefaultClient.Transport = authTransport{token: userToken(1)}
 }
 
 // BeforeEach выполняется перед каждым тестом
@@ -84,7 +123,7 @@ func (c *Cart) TestCart(t provider.T) {
 	})
 
 	t.WithNewStep("Удаляем продукт из корзины", func(t provider.StepCtx) {
-		client := &http.Client{}
+		client := http.DefaultClient
 		req, err := http.NewRequest(http.MethodDelete,
 			fmt.Sprintf("%v/user/%d/cart/%d",
 				c.Host, data.UserID, data.Sku),
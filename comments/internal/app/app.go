@@ -10,6 +10,7 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/health"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/comments/configs"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/comments/internal/app/server"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/comments/internal/middlewares"
@@ -40,8 +41,10 @@ type App struct {
 	config     *configs.Config
 	serverGRPC *grpc.Server
 	repository *repository.Repo
+	shards     *shardmanager.Manager
 	services   *service.Service
 	controller *server.Server
+	checker    *health.Checker
 }
 
 // NewApp ...
@@ -60,6 +63,7 @@ func NewApp(ctx context.Context) (*App, error) {
 	}
 	app.initService()
 	app.initServer()
+	app.initHealth()
 
 	return app, nil
 }
@@ -102,9 +106,14 @@ func (app *App) ListenAndServeHTTP() error {
 		return fmt.Errorf("RegisterCommentsHandler : %v", err)
 	}
 
+	mux := http.NewServeMux()
+	mux.Handle("/", gwmux)
+	mux.HandleFunc(health.LivenessURL, app.checker.LivenessHandler)
+	mux.HandleFunc(health.ReadinessURL, app.checker.ReadinessHandler)
+
 	gwServer := &http.Server{
 		Addr:              fmt.Sprintf(":%s", app.config.Server.HTTPPort),
-		Handler:           gwmux,
+		Handler:           mux,
 		ReadHeaderTimeout: readHeaderTimeout,
 	}
 
@@ -141,11 +150,20 @@ func (app *App) initRepo(ctx context.Context) error {
 		sm.AddShard(db, shardID)
 	}
 
+	app.shards = sm
 	app.repository = repository.NewRepo(sm)
 
 	return nil
 }
 
+// initHealth readiness проверяет каждый шард
+func (app *App) initHealth() {
+	app.checker = health.NewChecker(health.DefaultTimeout)
+	for shardID, pool := range app.shards.GetShardPoolsByID() {
+		app.checker.Add(fmt.Sprintf("shard_%d", shardID), pool.Ping)
+	}
+}
+
 // initService ...
 func (app *App) initService() {
 	app.services = service.NewService(app.repository)
@@ -21,6 +21,7 @@ type node struct {
 type Manager struct {
 	nodes    []node
 	shards   []*pgxpool.Pool
+	shardIDs []int
 	numNodes int
 }
 
@@ -34,6 +35,7 @@ func NewShardManager(numNodes int) *Manager {
 // AddShard ...
 func (m *Manager) AddShard(shard *pgxpool.Pool, shardID int) {
 	m.shards = append(m.shards, shard)
+	m.shardIDs = append(m.shardIDs, shardID)
 
 	for i := 0; i < m.numNodes; i++ {
 		hash := hashVirtualNode(shardID, i)
@@ -81,6 +83,16 @@ func (m *Manager) GetShardPool() []*pgxpool.Pool {
 	return m.shards
 }
 
+// GetShardPoolsByID пулы всех шардов по shard_id
+func (m *Manager) GetShardPoolsByID() map[int]*pgxpool.Pool {
+	pools := make(map[int]*pgxpool.Pool, len(m.shards))
+	for i, pool := range m.shards {
+		pools[m.shardIDs[i]] = pool
+	}
+
+	return pools
+}
+
 // hashVirtualNode ...
 func hashVirtualNode(shardID, i int) uint32 {
 	virtualNodeKey := fmt.Sprintf("%d-%d", shardID, i)
@@ -12,6 +12,7 @@ import (
 	"syscall"
 	"time"
 
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/health"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/logger"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/tracer"
 	config "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/loms/configs"
@@ -104,7 +105,7 @@ func main() {
 		logger.Fatalw(fmt.Sprintf("initDB : %v", err))
 	}
 
-	producer, err := initKafkaProducer(cfg.Kafka.Brokers)
+	kafkaClient, producer, err := initKafkaProducer(cfg.Kafka.Brokers)
 	if err != nil {
 		logger.Fatalw(fmt.Sprintf("initKafka : %v", err))
 	}
@@ -114,8 +115,17 @@ func main() {
 			logger.Errorw(fmt.Sprintf("Ошибка при закрытии продюсера: %v", err))
 		}
 		log.Println("producer.Close success")
+		// продюсер, созданный из клиента, не закрывает его сам
+		if err = kafkaClient.Close(); err != nil {
+			logger.Errorw(fmt.Sprintf("kafkaClient.Close : %v", err))
+		}
 	}()
 
+	checker := health.NewChecker(health.DefaultTimeout)
+	checker.Add("postgres_master", masterPool.Ping)
+	checker.Add("postgres_replica", replicaPool.Ping)
+	checker.Add("kafka", health.KafkaClient(kafkaClient))
+
 	producerOrderEvent := serviceproducer.NewProducer(producer, cfg.Kafka.TopicName)
 
 	services := service.NewService(repo, t.Tracer, producerOrderEvent)
@@ -147,6 +157,8 @@ func main() {
 		mux := http.NewServeMux()
 		mux.Handle("/", gwmux)
 		mux.Handle("/metrics", promhttp.Handler())
+		mux.HandleFunc(health.LivenessURL, checker.LivenessHandler)
+		mux.HandleFunc(health.ReadinessURL, checker.ReadinessHandler)
 
 		gwServer := &http.Server{
 			Addr:              fmt.Sprintf(":%s", cfg.Server.HTTPPort),
@@ -227,17 +239,24 @@ func createPool(cfg *config.Config) (*pgxpool.Pool, *pgxpool.Pool, error) {
 	return poolMaster, poolReplica, nil
 }
 
-// initKafkaProducer ...
-func initKafkaProducer(broker string) (sarama.SyncProducer, error) {
+// initKafkaProducer клиент возвращается для проверки доступности брокеров
+func initKafkaProducer(broker string) (sarama.Client, sarama.SyncProducer, error) {
 	config := sarama.NewConfig()
 	config.Producer.Return.Successes = true
 	config.Producer.Return.Errors = true
 	config.Producer.Partitioner = sarama.NewHashPartitioner
 	config.Producer.RequiredAcks = sarama.WaitForAll
-	producer, err := sarama.NewSyncProducer([]string{broker}, config)
+	client, err := sarama.NewClient([]string{broker}, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	producer, err := sarama.NewSyncProducerFromClient(client)
 	if err != nil {
-		return nil, err
+		//nolint:errcheck, gosec
+		client.Close()
+		return nil, nil, err
 	}
 
-	return producer, nil
+	return client, producer, nil
 }
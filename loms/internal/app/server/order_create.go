@@ -87,7 +87,9 @@ func preparedInputOrderCreate(in *pb.OrderCreateRequest) model.Order {
 	}
 
 	return model.Order{
-		UserID: in.UserID,
-		Items:  items,
+		UserID:     in.UserID,
+		Items:      items,
+		PromoCode:  in.GetPromoCode(),
+		TotalPrice: in.GetTotalPrice(),
 	}
 }
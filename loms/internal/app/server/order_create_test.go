@@ -34,8 +34,10 @@ func TestHandler_OrderCreate(t *testing.T) {
 
 	testRequest := &pb.OrderCreateRequest{
 		// nolint:gosec
-		UserID: rand.Int63(),
-		Items:  testItems,
+		UserID:     rand.Int63(),
+		Items:      testItems,
+		PromoCode:  "WELCOME10",
+		TotalPrice: 900,
 	}
 	// nolint:gosec
 	expectOrderID := rand.Int63()
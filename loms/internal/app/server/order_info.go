@@ -60,8 +60,10 @@ func orderInfoToOrderInfoResponse(orderInfo model.OrderInfo) *pb.OrderInfoRespon
 	}
 
 	return &pb.OrderInfoResponse{
-		Status: orderInfo.Status,
-		UserID: orderInfo.UserID,
-		Items:  pbItem,
+		Status:     orderInfo.Status,
+		UserID:     orderInfo.UserID,
+		Items:      pbItem,
+		PromoCode:  orderInfo.PromoCode,
+		TotalPrice: orderInfo.TotalPrice,
 	}
 }
@@ -5,6 +5,9 @@ package model
 type Order struct {
 	UserID int64
 	Items  []Item
+	// PromoCode примененный в корзине промокод, TotalPrice - итог заказа с учетом скидок
+	PromoCode  string
+	TotalPrice uint64
 }
 
 // Item ...
@@ -6,9 +6,11 @@ type OrderStorage = map[int64]OrderInfo
 
 // OrderInfo ...
 type OrderInfo struct {
-	UserID int64
-	Status string
-	Items  []Item
+	UserID     int64
+	Status     string
+	Items      []Item
+	PromoCode  string
+	TotalPrice uint64
 }
 
 var (
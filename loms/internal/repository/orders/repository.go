@@ -38,9 +38,11 @@ func (or *Repo) CreateOrder(_ context.Context, usersOrders model.Order) (int64,
 	orderID := int64(len(or.storage) + 1)
 
 	order := model.OrderInfo{
-		UserID: usersOrders.UserID,
-		Status: model.StatusOrderNew,
-		Items:  items,
+		UserID:     usersOrders.UserID,
+		Status:     model.StatusOrderNew,
+		Items:      items,
+		PromoCode:  usersOrders.PromoCode,
+		TotalPrice: usersOrders.TotalPrice,
 	}
 	or.storage[orderID] = order
 
@@ -53,12 +55,8 @@ func (or *Repo) SetStatusOrder(_ context.Context, orderID int64, status string)
 	defer or.mx.Unlock()
 
 	if order, ok := or.storage[orderID]; ok {
-		newOrder := model.OrderInfo{
-			UserID: order.UserID,
-			Status: status,
-			Items:  order.Items,
-		}
-		or.storage[orderID] = newOrder
+		order.Status = status
+		or.storage[orderID] = order
 	}
 
 	return nil
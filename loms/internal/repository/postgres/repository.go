@@ -51,9 +51,10 @@ func (r *RepoNoBuilder) CreateOrder(ctx context.Context, usersOrders model.Order
 	}()
 
 	var orderID int64
-	queryOrders := fmt.Sprintf("INSERT INTO %s (user_id) VALUES ($1) returning id;", tableOrders)
+	queryOrders := fmt.Sprintf("INSERT INTO %s (user_id, promo_code, total_price) VALUES ($1, $2, $3) returning id;", tableOrders)
 
-	if err = tx.QueryRow(ctx, queryOrders, usersOrders.UserID).
+	//nolint:gosec
+	if err = tx.QueryRow(ctx, queryOrders, usersOrders.UserID, usersOrders.PromoCode, int64(usersOrders.TotalPrice)).
 		Scan(&orderID); err != nil {
 		//nolint:errcheck, gosec
 		tx.Rollback(ctx)
@@ -99,15 +100,18 @@ func (r *RepoNoBuilder) GetInfoByOrderID(ctx context.Context, orderID int64) (*m
 	defer r.mx.RUnlock()
 	orderInfo := model.OrderInfo{}
 
-	queryOrders := fmt.Sprintf("SELECT user_id, status FROM %s WHERE id = $1;", tableOrders)
+	queryOrders := fmt.Sprintf("SELECT user_id, status, promo_code, total_price FROM %s WHERE id = $1;", tableOrders)
 
+	var totalPrice int64
 	if err := r.Replica.QueryRow(ctx, queryOrders, orderID).
-		Scan(&orderInfo.UserID, &orderInfo.Status); err != nil {
+		Scan(&orderInfo.UserID, &orderInfo.Status, &orderInfo.PromoCode, &totalPrice); err != nil {
 		if errors.Is(err, pgx.ErrNoRows) {
 			return nil, model.ErrOrderPayNotFound
 		}
 		return nil, errors.Wrap(err, "GetInfoByOrderID Scan")
 	}
+	//nolint:gosec
+	orderInfo.TotalPrice = uint64(totalPrice)
 
 	queryItems := fmt.Sprintf("SELECT sku, count FROM %s WHERE order_id = $1;", tableOrdersItems)
 	rows, err := r.Replica.Query(ctx, queryItems, orderID)
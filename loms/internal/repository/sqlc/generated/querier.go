@@ -9,7 +9,7 @@ import (
 )
 
 type Querier interface {
-	AddOrderToOrders(ctx context.Context, userID int64) (int64, error)
+	AddOrderToOrders(ctx context.Context, arg *AddOrderToOrdersParams) (int64, error)
 	AddOrderToOrdersItems(ctx context.Context, arg *AddOrderToOrdersItemsParams) error
 	AddOutbox(ctx context.Context, arg *AddOutboxParams) error
 	DeleteOrders(ctx context.Context, id int64) error
@@ -10,11 +10,17 @@ import (
 )
 
 const addOrderToOrders = `-- name: AddOrderToOrders :one
-INSERT INTO orders (user_id) VALUES ($1) returning id
+INSERT INTO orders (user_id, promo_code, total_price) VALUES ($1, $2, $3) returning id
 `
 
-func (q *Queries) AddOrderToOrders(ctx context.Context, userID int64) (int64, error) {
-	row := q.db.QueryRow(ctx, addOrderToOrders, userID)
+type AddOrderToOrdersParams struct {
+	UserID     int64
+	PromoCode  string
+	TotalPrice int64
+}
+
+func (q *Queries) AddOrderToOrders(ctx context.Context, arg *AddOrderToOrdersParams) (int64, error) {
+	row := q.db.QueryRow(ctx, addOrderToOrders, arg.UserID, arg.PromoCode, arg.TotalPrice)
 	var id int64
 	err := row.Scan(&id)
 	return id, err
@@ -102,12 +108,14 @@ func (q *Queries) DeleteOrdersItems(ctx context.Context, orderID int64) error {
 }
 
 const getInfoOrders = `-- name: GetInfoOrders :many
-SELECT user_id, status FROM orders WHERE id = $1
+SELECT user_id, status, promo_code, total_price FROM orders WHERE id = $1
 `
 
 type GetInfoOrdersRow struct {
-	UserID int64
-	Status string
+	UserID     int64
+	Status     string
+	PromoCode  string
+	TotalPrice int64
 }
 
 func (q *Queries) GetInfoOrders(ctx context.Context, id int64) ([]*GetInfoOrdersRow, error) {
@@ -119,7 +127,12 @@ func (q *Queries) GetInfoOrders(ctx context.Context, id int64) ([]*GetInfoOrders
 	var items []*GetInfoOrdersRow
 	for rows.Next() {
 		var i GetInfoOrdersRow
-		if err := rows.Scan(&i.UserID, &i.Status); err != nil {
+		if err := rows.Scan(
+			&i.UserID,
+			&i.Status,
+			&i.PromoCode,
+			&i.TotalPrice,
+		); err != nil {
 			return nil, err
 		}
 		items = append(items, &i)
@@ -1,5 +1,5 @@
 -- name: AddOrderToOrders :one
-INSERT INTO orders (user_id) VALUES ($1) returning id;
+INSERT INTO orders (user_id, promo_code, total_price) VALUES ($1, $2, $3) returning id;
 
 -- name: AddOrderToOrdersItems :exec
 INSERT INTO orders_items (order_id, sku, count) VALUES
@@ -13,7 +13,7 @@ INSERT INTO orders_items (order_id, sku, count) VALUES
 UPDATE orders SET status = $1 WHERE id = $2;
 
 -- name: GetInfoOrders :many
-SELECT user_id, status FROM orders WHERE id = $1;
+SELECT user_id, status, promo_code, total_price FROM orders WHERE id = $1;
 
 -- name: GetInfoOrdersItems :many
 SELECT sku, count FROM orders_items WHERE order_id = $1;
@@ -77,7 +77,12 @@ func (r *Repo) CreateOrder(ctx context.Context, usersOrders model.Order) (int64,
 		}
 	}()
 
-	orderID, err := r.Master.WithTx(tx).AddOrderToOrders(ctx, usersOrders.UserID)
+	orderID, err := r.Master.WithTx(tx).AddOrderToOrders(ctx, &repository_sqlc.AddOrderToOrdersParams{
+		UserID:    usersOrders.UserID,
+		PromoCode: usersOrders.PromoCode,
+		//nolint:gosec
+		TotalPrice: int64(usersOrders.TotalPrice),
+	})
 	if err != nil {
 		_, span := r.tracer.Start(
 			ctx,
@@ -238,6 +243,9 @@ func (r *Repo) GetInfoByOrderIDMaster(ctx context.Context, orderID int64) (*mode
 
 	orderInfo.UserID = infoOrdersRow[0].UserID
 	orderInfo.Status = infoOrdersRow[0].Status
+	orderInfo.PromoCode = infoOrdersRow[0].PromoCode
+	//nolint:gosec
+	orderInfo.TotalPrice = uint64(infoOrdersRow[0].TotalPrice)
 
 	infoOrdersItemsRow, err := r.Master.GetInfoOrdersItems(ctx, orderID)
 	if err != nil {
@@ -318,6 +326,9 @@ func (r *Repo) GetInfoByOrderIDReplica(ctx context.Context, orderID int64) (*mod
 
 	orderInfo.UserID = infoOrdersRow[0].UserID
 	orderInfo.Status = infoOrdersRow[0].Status
+	orderInfo.PromoCode = infoOrdersRow[0].PromoCode
+	//nolint:gosec
+	orderInfo.TotalPrice = uint64(infoOrdersRow[0].TotalPrice)
 
 	infoOrdersItemsRow, err := r.Replica.GetInfoOrdersItems(ctx, orderID)
 	if err != nil {
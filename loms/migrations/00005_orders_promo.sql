@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE orders
+    ADD COLUMN promo_code  text   not null default '',
+    ADD COLUMN total_price bigint not null default 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE orders
+    DROP COLUMN promo_code,
+    DROP COLUMN total_price;
+-- +goose StatementEnd
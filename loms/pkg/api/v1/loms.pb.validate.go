@@ -113,6 +113,10 @@ func (m *OrderCreateRequest) validate(all bool) error {
 
 	}
 
+	// no validation rules for PromoCode
+
+	// no validation rules for TotalPrice
+
 	if len(errors) > 0 {
 		return OrderCreateRequestMultiError(errors)
 	}
@@ -588,6 +592,10 @@ func (m *OrderInfoResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for PromoCode
+
+	// no validation rules for TotalPrice
+
 	if len(errors) > 0 {
 		return OrderInfoResponseMultiError(errors)
 	}
@@ -3,16 +3,28 @@ package main
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/IBM/sarama"
+	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/cart/pkg/health"
 	config "github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/notifier/configs"
 	"github.com/Sane4eck55/CART-LOMS-COMMENTS-NOTIFIER/notifier/internal/kafka/consumer"
 )
 
+const (
+	// readHeaderTimeout ...
+	readHeaderTimeout = 5 * time.Second
+	// shutdownTimeout ...
+	shutdownTimeout = 5 * time.Second
+)
+
 func main() {
 	ctx, cancel := context.WithCancel(context.Background())
 	defer cancel()
@@ -38,6 +50,16 @@ func main() {
 
 	consumer := &consumer.Consumer{}
 
+	checker := health.NewChecker(health.DefaultTimeout)
+	checker.Add("consumer_group", consumer.Ready)
+	healthServer := initHealthServer(cfg.Server.HTTPPort, checker)
+
+	go func() {
+		if err := healthServer.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Fatalf("healthServer.ListenAndServe : %v", err)
+		}
+	}()
+
 	go func() {
 		// слушаем ошибки группы в отдельной горутине
 		for err := range consumerGroup.Errors() {
@@ -61,6 +83,25 @@ func main() {
 	<-sigterm
 	log.Println("Termination signal received, shutting down...")
 	cancel()
+
+	shutdownCtx, shutdownCancel := context.WithTimeout(context.Background(), shutdownTimeout)
+	defer shutdownCancel()
+	if err := healthServer.Shutdown(shutdownCtx); err != nil {
+		log.Printf("healthServer.Shutdown : %v", err)
+	}
+}
+
+// initHealthServer ...
+func initHealthServer(port string, checker *health.Checker) *http.Server {
+	mux := http.NewServeMux()
+	mux.HandleFunc(health.LivenessURL, checker.LivenessHandler)
+	mux.HandleFunc(health.ReadinessURL, checker.ReadinessHandler)
+
+	return &http.Server{
+		Addr:              fmt.Sprintf(":%s", port),
+		Handler:           mux,
+		ReadHeaderTimeout: readHeaderTimeout,
+	}
 }
 
 // initKafkaConsumerGroup ...
@@ -9,6 +9,10 @@ import (
 
 // Config ...
 type Config struct {
+	Server struct {
+		// HTTPPort порт /healthz и /readyz
+		HTTPPort string `yaml:"http_port"`
+	} `yaml:"service"`
 	Kafka struct {
 		Host            string `yaml:"host"`
 		Port            string `yaml:"port"`
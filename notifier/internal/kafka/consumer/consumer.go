@@ -2,7 +2,10 @@
 package consumer
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/IBM/sarama"
 )
@@ -10,16 +13,29 @@ import (
 // Consumer ...
 type Consumer struct {
 	Partition int32
+	// active сессия группы открыта: между Setup и Cleanup
+	active atomic.Bool
+}
+
+// Ready проверка готовности: участник получил партиции в группе
+func (c *Consumer) Ready(_ context.Context) error {
+	if !c.active.Load() {
+		return errors.New("no active consumer group session")
+	}
+
+	return nil
 }
 
 // Setup ...
 func (c *Consumer) Setup(sess sarama.ConsumerGroupSession) error {
+	c.active.Store(true)
 	fmt.Printf("✅ [Consumer] Участник ID : %s группы запущен, ассайн получен", sess.MemberID())
 	return nil
 }
 
 // Cleanup ...
 func (c *Consumer) Cleanup(sess sarama.ConsumerGroupSession) error {
+	c.active.Store(false)
 	fmt.Printf("👋 [Consumer] Участник ID : %s группы завершает работу ", sess.MemberID())
 	return nil
 }